@@ -0,0 +1,117 @@
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/grnsv/go-cmms/internal/app"
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+const exportUsage = "usage: server export [-format csv|jsonl|b2mml] [-columns a,b] [-delimiter c] [-root id]... [-scope id]... [-status s]... [-class id [-subclasses]] [-gzip] [-o file]"
+
+// listFlag значения флага, который повторяется или содержит несколько
+// значений через запятую
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
+// runExport выполняет подкоманду export: реестр оборудования выгружается
+// в файл -o (со сжатием для .gz) или в stdout
+func runExport(ctx context.Context, export *app.ExportEquipmentUseCase, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := fs.String("format", "", "output format: csv, jsonl or b2mml (default from -o extension, else csv)")
+	var columns, roots, scopes, statuses listFlag
+	fs.Var(&columns, "columns", "CSV columns and JSONL fields (default import fields and Properties.*)")
+	delimiter := fs.String("delimiter", "", "CSV field delimiter (default ,)")
+	fs.Var(&roots, "root", "export the subtree of this equipment (repeatable)")
+	fs.Var(&scopes, "scope", "only equipment with this hierarchy scope (repeatable)")
+	fs.Var(&statuses, "status", "only equipment with this operating status (repeatable)")
+	class := fs.String("class", "", "only equipment of this class")
+	subclasses := fs.Bool("subclasses", false, "include equipment of subclasses of -class")
+	compress := fs.Bool("gzip", false, "gzip the output (default for .gz files)")
+	output := fs.String("o", "", "output file (default stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return errors.New(exportUsage)
+	}
+
+	name := strings.ToLower(*output)
+	if strings.HasSuffix(name, ".gz") {
+		name, *compress = strings.TrimSuffix(name, ".gz"), true
+	}
+	if *format == "" {
+		switch filepath.Ext(name) {
+		case ".jsonl", ".ndjson":
+			*format = string(model.ExportFormatJSONL)
+		case ".xml":
+			*format = string(model.ExportFormatB2MML)
+		default:
+			*format = string(model.ExportFormatCSV)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	x, err := export.Execute(ctx, app.ExportEquipmentInput{
+		Format:    *format,
+		Columns:   columns,
+		Delimiter: *delimiter,
+		RootIDs:   roots,
+		Query: app.QueryEquipmentInput{
+			Statuses:          statuses,
+			HierarchyScopeIDs: scopes,
+			ClassID:           *class,
+			IncludeSubclasses: *subclasses,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Ошибка записи файла может проявиться только при закрытии
+	f, done := os.Stdout, func() error { return nil }
+	if *output != "" {
+		if f, err = os.Create(*output); err != nil {
+			return err
+		}
+		defer f.Close()
+		done = f.Close
+	}
+	if !*compress {
+		if err := x.Write(ctx, f); err != nil {
+			return err
+		}
+		return done()
+	}
+
+	gz := gzip.NewWriter(f)
+	if err := x.Write(ctx, gz); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return done()
+}
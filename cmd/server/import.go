@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/app"
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+const importUsage = "usage: server import [-format csv|jsonl] [-map column=field]... [-delimiter c] [-chunk n] [-dry-run] <file> | server import -resume <id> <file>"
+
+// mappingFlag сопоставления колонок -map колонка=поле; флаг повторяется
+// или содержит несколько сопоставлений через запятую
+type mappingFlag map[string]string
+
+func (m mappingFlag) String() string {
+	return fmt.Sprint(map[string]string(m))
+}
+
+func (m mappingFlag) Set(value string) error {
+	for item := range strings.SplitSeq(value, ",") {
+		i := strings.LastIndexByte(item, '=')
+		if i < 0 {
+			return fmt.Errorf("%w: %q is not column=field", model.ErrImportInvalidMapping, item)
+		}
+		m[strings.TrimSpace(item[:i])] = strings.TrimSpace(item[i+1:])
+	}
+	return nil
+}
+
+// runImport выполняет подкоманду import: задание создаётся (или продолжается
+// по -resume) и выполняется в этом процессе с выводом прогресса
+func runImport(ctx context.Context, importer *app.EquipmentImporter, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	format := fs.String("format", "", "source format: csv or jsonl (default from file extension)")
+	mapping := mappingFlag{}
+	fs.Var(mapping, "map", "column mapping column=field (repeatable, - skips the column)")
+	delimiter := fs.String("delimiter", "", "CSV field delimiter (default ,)")
+	chunk := fs.Int("chunk", 0, "rows committed per transaction (default IMPORT_CHUNK_SIZE)")
+	dryRun := fs.Bool("dry-run", false, "validate rows without saving equipment")
+	resume := fs.String("resume", "", "resume the interrupted import job with this id")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Прерванное по сигналу задание остаётся захваченным до истечения аренды
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var (
+		job *repository.ImportJob
+		err error
+	)
+	switch {
+	case *resume != "":
+		if fs.NArg() != 0 {
+			return errors.New(importUsage)
+		}
+		id, parseErr := uuid.Parse(*resume)
+		if parseErr != nil {
+			return fmt.Errorf("invalid import job id %q: %w", *resume, parseErr)
+		}
+		job, err = importer.Resume(ctx, id, true)
+	default:
+		if fs.NArg() != 1 {
+			return errors.New(importUsage)
+		}
+		path := fs.Arg(0)
+		if *format == "" {
+			*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+			if *format == "ndjson" {
+				*format = string(model.ImportFormatJSONL)
+			}
+		}
+		f, openErr := os.Open(path)
+		if openErr != nil {
+			return openErr
+		}
+		defer f.Close()
+		job, err = importer.Create(ctx, app.CreateImportInput{
+			Format:    *format,
+			Delimiter: *delimiter,
+			Mapping:   mapping,
+			DryRun:    *dryRun,
+			ChunkSize: int32(*chunk),
+			Source:    f,
+			Claim:     true,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("import job %s started at row %d\n", job.ID, job.RowsProcessed)
+	err = importer.Execute(ctx, job, func(job *repository.ImportJob) {
+		fmt.Printf("rows %d, imported %d, errors %d\n", job.RowsProcessed, job.RowsImported, job.ErrorCount)
+	})
+	printImportReport(job)
+	if err != nil {
+		return fmt.Errorf("import job %s: %w (continue with -resume %s)", job.ID, err, job.ID)
+	}
+	return nil
+}
+
+func printImportReport(job *repository.ImportJob) {
+	for _, e := range job.RowErrors {
+		location := fmt.Sprintf("row %d", e.Row)
+		if e.Line != 0 {
+			location += fmt.Sprintf(" (line %d)", e.Line)
+		}
+		if e.EquipmentID != "" {
+			location += " " + e.EquipmentID
+		}
+		if e.Column != "" {
+			location += " [" + e.Column + "]"
+		}
+		fmt.Printf("%s: %s\n", location, e.Message)
+	}
+	if job.ErrorCount > int64(len(job.RowErrors)) {
+		fmt.Printf("... %d more row errors\n", job.ErrorCount-int64(len(job.RowErrors)))
+	}
+	fmt.Printf("import job %s %s: %d rows, %d imported, %d errors\n",
+		job.ID, job.Status, job.RowsProcessed, job.RowsImported, job.ErrorCount)
+}
@@ -7,13 +7,18 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/grnsv/go-cmms/internal/api/handler"
+	api "github.com/grnsv/go-cmms/internal/api/ogen"
 	"github.com/grnsv/go-cmms/internal/app"
 	"github.com/grnsv/go-cmms/internal/config"
+	"github.com/grnsv/go-cmms/internal/domain/model"
 	"github.com/grnsv/go-cmms/internal/infrastructure"
+	"github.com/grnsv/go-cmms/internal/infrastructure/channels"
+	"github.com/grnsv/go-cmms/internal/infrastructure/events"
 	"github.com/grnsv/go-cmms/internal/infrastructure/postgres/repository"
 )
 
@@ -30,26 +35,213 @@ func main() {
 	defer db.Close()
 	log.Println("Database connected successfully")
 
+	// Подкоманда migrate: server migrate up|down|status|goto <version>
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(context.Background(), db, cfg.Database, os.Args[2:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
+	if cfg.Database.AutoMigrate {
+		m, err := newMigrator(db, cfg.Database)
+		if err != nil {
+			log.Fatalf("Failed to load migrations: %v", err)
+		}
+		applied, err := m.Up(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to apply migrations: %v", err)
+		}
+		log.Printf("Migrations applied: %d", len(applied))
+	}
+
 	// 3. Создать репозитории
 	equipmentRepo := repository.NewEquipmentRepository(queries)
 	equipmentClassRepo := repository.NewEquipmentClassRepository(queries)
-	uow := repository.NewUnitOfWork(queries)
+	personRepo := repository.NewPersonRepository(queries)
+	searchRepo := repository.NewSearchRepository(queries)
+	siteUnitRepo := repository.NewSiteUnitRepository(queries)
+	outboxRepo := repository.NewOutboxRepository(queries)
+	webhookRepo := repository.NewWebhookRepository(queries)
+	historyRepo := repository.NewEquipmentHistoryRepository(queries)
+	uow := repository.NewUnitOfWork(db, queries)
+	importer := app.NewEquipmentImporter(uow, app.EquipmentImporterConfig{
+		ChunkSize:     int32(cfg.Import.ChunkSize),
+		MaxSourceSize: int64(cfg.Import.MaxSize),
+		PollInterval:  cfg.Import.PollInterval,
+		Lease:         cfg.Import.Lease,
+	})
+
+	// Подкоманда import: server import [flags] <file>
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err := runImport(context.Background(), importer, os.Args[2:]); err != nil {
+			log.Fatalf("Import failed: %v", err)
+		}
+		return
+	}
 
 	// 4. Создать use cases
+	unitRegistry := model.NewUnitRegistry()
 	listEquipmentUC := app.NewListEquipmentUseCase(equipmentRepo)
-	getEquipmentByIDUC := app.NewGetEquipmentByIDUseCase(equipmentRepo)
-	createEquipmentUC := app.NewCreateEquipmentUseCase(equipmentRepo)
+	queryEquipmentUC := app.NewQueryEquipmentUseCase(equipmentRepo, unitRegistry)
+	getEquipmentByIDUC := app.NewGetEquipmentByIDUseCase(equipmentRepo, historyRepo)
+	createEquipmentUC := app.NewCreateEquipmentUseCase(uow)
+	updateEquipmentUC := app.NewUpdateEquipmentUseCase(uow)
+	getEquipmentTreeUC := app.NewGetEquipmentTreeUseCase(equipmentRepo, historyRepo)
+	moveEquipmentUC := app.NewMoveEquipmentUseCase(uow)
+	getPropertiesUC := app.NewGetEffectivePropertiesUseCase(equipmentRepo, equipmentClassRepo, siteUnitRepo, unitRegistry)
+	listPersonsUC := app.NewListPersonsUseCase(personRepo)
+	createPersonUC := app.NewCreatePersonUseCase(uow)
+	searchUC := app.NewSearchUseCase(searchRepo)
+	listUnitsUC := app.NewListUnitsUseCase(unitRegistry)
+	convertUnitUC := app.NewConvertUnitUseCase(unitRegistry)
+	getSiteUnitsUC := app.NewGetSiteUnitsUseCase(equipmentRepo, siteUnitRepo)
+	setSiteUnitsUC := app.NewSetSiteUnitsUseCase(uow, unitRegistry)
+	webhookSender := events.NewWebhookSender(nil, cfg.Webhook.Timeout)
+	listWebhooksUC := app.NewListWebhooksUseCase(webhookRepo)
+	createWebhookUC := app.NewCreateWebhookUseCase(webhookRepo)
+	getWebhookUC := app.NewGetWebhookUseCase(webhookRepo)
+	updateWebhookUC := app.NewUpdateWebhookUseCase(webhookRepo)
+	deleteWebhookUC := app.NewDeleteWebhookUseCase(webhookRepo)
+	pingWebhookUC := app.NewPingWebhookUseCase(webhookRepo, webhookSender)
+	listDeliveriesUC := app.NewListWebhookDeliveriesUseCase(webhookRepo)
+	replayDeliveryUC := app.NewReplayWebhookDeliveryUseCase(webhookRepo)
+	listHistoryUC := app.NewListEquipmentHistoryUseCase(historyRepo)
+	diffHistoryUC := app.NewDiffEquipmentRevisionsUseCase(historyRepo)
+	deleteEquipmentUC := app.NewDeleteEquipmentUseCase(uow)
+	restoreEquipmentUC := app.NewRestoreEquipmentUseCase(uow)
+	purgeEquipmentUC := app.NewPurgeEquipmentUseCase(uow)
+	getEquipmentInformationUC := app.NewGetEquipmentInformationUseCase(equipmentRepo, equipmentClassRepo, unitRegistry)
+	b2mmlProcessor := app.NewB2MMLProcessor(
+		app.NewApplyEquipmentInformationUseCase(uow),
+		getEquipmentInformationUC,
+		app.B2MMLProcessorConfig{LogicalID: cfg.B2MML.LogicalID},
+	)
+	exportEquipmentUC := app.NewExportEquipmentUseCase(uow, unitRegistry, b2mmlProcessor, int32(cfg.Export.BatchSize))
+
+	// Подкоманда export: server export [flags]
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(context.Background(), exportEquipmentUC, os.Args[2:]); err != nil {
+			log.Fatalf("Export failed: %v", err)
+		}
+		return
+	}
+
+	var b2mmlChannels *app.B2MMLChannels
+	if cfg.B2MML.ProfilePath != "" {
+		b2mmlChannels, err = newB2MMLChannels(cfg.B2MML, b2mmlProcessor, getEquipmentInformationUC)
+		if err != nil {
+			log.Fatalf("Failed to load B2MML master data profile: %v", err)
+		}
+		log.Printf("B2MML master data profile loaded: %d channel routes", len(b2mmlChannels.Routes()))
+	}
 
 	// 5. Создать handler
 	h := handler.NewHandler(
 		listEquipmentUC,
+		queryEquipmentUC,
 		getEquipmentByIDUC,
 		createEquipmentUC,
+		updateEquipmentUC,
+		getEquipmentTreeUC,
+		moveEquipmentUC,
+		getPropertiesUC,
+		listPersonsUC,
+		createPersonUC,
+		searchUC,
+		listUnitsUC,
+		convertUnitUC,
+		getSiteUnitsUC,
+		setSiteUnitsUC,
+		listWebhooksUC,
+		createWebhookUC,
+		getWebhookUC,
+		updateWebhookUC,
+		deleteWebhookUC,
+		pingWebhookUC,
+		listDeliveriesUC,
+		replayDeliveryUC,
+		listHistoryUC,
+		diffHistoryUC,
+		deleteEquipmentUC,
+		restoreEquipmentUC,
+		purgeEquipmentUC,
+		b2mmlProcessor,
+		b2mmlChannels,
+		importer,
 	)
 
+	// Поток событий SSE читает outbox независимо от диспетчера
+	var stream *handler.EventStreamHandler
+	streamCtx, stopStream := context.WithCancel(context.Background())
+	var broadcasters sync.WaitGroup
+	if cfg.Events.Enabled {
+		broadcaster := app.NewEventBroadcaster(outboxRepo, equipmentRepo, app.EventBroadcasterConfig{
+			PollInterval: cfg.Events.PollInterval,
+			BatchSize:    int32(cfg.Events.BatchSize),
+			BufferSize:   cfg.Events.BufferSize,
+		})
+		broadcasters.Go(func() { broadcaster.Run(streamCtx) })
+		stream = handler.NewEventStreamHandler(broadcaster, cfg.Events.Heartbeat)
+	}
+
 	// 6. Создать и запустить HTTP сервер
-	server := createServer(cfg.Server.Address(), h)
-	
+	server, err := createServer(cfg.Server, h, stream, handler.NewEquipmentExportHandler(exportEquipmentUC))
+	if err != nil {
+		log.Fatalf("Failed to create server: %v", err)
+	}
+	// Shutdown не прерывает активные запросы: потоки SSE закрываются вместе с рассылкой
+	server.RegisterOnShutdown(stopStream)
+
+	// Фоновая доставка доменных событий из outbox, отправка webhook и
+	// окончательное удаление оборудования по сроку хранения
+	dispatchCtx, stopDispatch := context.WithCancel(context.Background())
+	var dispatchers sync.WaitGroup
+	if cfg.Outbox.Enabled {
+		sinks := []app.EventSink{events.NewLogSink()}
+		if cfg.Webhook.Enabled {
+			sinks = append(sinks, app.NewWebhookSink(webhookRepo))
+		}
+		if b2mmlChannels != nil {
+			sinks = append(sinks, b2mmlChannels)
+		}
+		dispatcher := app.NewOutboxDispatcher(outboxRepo, app.OutboxDispatcherConfig{
+			BatchSize:    int32(cfg.Outbox.BatchSize),
+			PollInterval: cfg.Outbox.PollInterval,
+			Lease:        cfg.Outbox.Lease,
+			MinBackoff:   cfg.Outbox.MinBackoff,
+			MaxBackoff:   cfg.Outbox.MaxBackoff,
+			Retention:    cfg.Outbox.Retention,
+		}, sinks...)
+		dispatchers.Go(func() { dispatcher.Run(dispatchCtx) })
+	}
+	if cfg.Webhook.Enabled {
+		webhookDispatcher := app.NewWebhookDispatcher(webhookRepo, webhookSender, app.WebhookDispatcherConfig{
+			BatchSize:    int32(cfg.Webhook.BatchSize),
+			PollInterval: cfg.Webhook.PollInterval,
+			MinBackoff:   cfg.Webhook.MinBackoff,
+			MaxBackoff:   cfg.Webhook.MaxBackoff,
+			MaxAttempts:  int32(cfg.Webhook.MaxAttempts),
+			Retention:    cfg.Webhook.Retention,
+		})
+		dispatchers.Go(func() { webhookDispatcher.Run(dispatchCtx) })
+	}
+	if cfg.Deletion.Retention > 0 {
+		purger := app.NewEquipmentPurger(uow, app.EquipmentPurgerConfig{
+			Retention: cfg.Deletion.Retention,
+			Interval:  cfg.Deletion.PurgeInterval,
+			BatchSize: int32(cfg.Deletion.PurgeBatchSize),
+		})
+		dispatchers.Go(func() { purger.Run(dispatchCtx) })
+	}
+
+	if b2mmlChannels != nil {
+		dispatchers.Go(func() { b2mmlChannels.Run(dispatchCtx) })
+	}
+	if cfg.Import.Enabled {
+		dispatchers.Go(func() { importer.Run(dispatchCtx) })
+	}
+
 	go func() {
 		log.Printf("Starting server on %s", server.Addr)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -69,53 +261,66 @@ func main() {
 	if err := server.Shutdown(ctx); err != nil {
 		log.Fatalf("Server shutdown error: %v", err)
 	}
+	stopDispatch()
+	dispatchers.Wait()
+	stopStream()
+	broadcasters.Wait()
 
 	log.Println("Server stopped")
-	
-	// Cleanup
-	_ = uow
-	_ = equipmentClassRepo
 }
 
-// createServer создаёт и конфигурирует HTTP сервер
-func createServer(addr string, h *handler.Handler) *http.Server {
-	mux := http.NewServeMux()
+// newB2MMLChannels загружает MasterDataProfile и открывает каналы его маршрутов
+func newB2MMLChannels(
+	cfg config.B2MMLConfig,
+	processor *app.B2MMLProcessor,
+	get *app.GetEquipmentInformationUseCase,
+) (*app.B2MMLChannels, error) {
+	f, err := os.Open(cfg.ProfilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	profile, err := app.LoadMasterDataProfile(f)
+	if err != nil {
+		return nil, err
+	}
+	opener := channels.NewOpener(nil, cfg.PollInterval)
+	open := func(uri string) (app.B2MMLTransport, error) {
+		return opener.Open(uri)
+	}
+	return app.NewB2MMLChannels(profile, processor, get, open)
+}
 
-	// Примеры маршрутов (в реальности будет сгенерировано ogen)
+// createServer создаёт HTTP сервер: ogen-сервер API монтируется под /api/v1,
+// /health, поток событий SSE (stream nil - отключён) и выгрузка оборудования
+// обслуживаются отдельно
+func createServer(
+	cfg config.ServerConfig,
+	h *handler.Handler,
+	stream *handler.EventStreamHandler,
+	export *handler.EquipmentExportHandler,
+) (*http.Server, error) {
+	apiServer, err := api.NewServer(h, api.WithPathPrefix("/api/v1"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api server: %w", err)
+	}
+
+	mux := http.NewServeMux()
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		fmt.Fprintf(w, `{"status":"ok"}`)
 	})
-
-	mux.HandleFunc("/api/v1/equipment", func(w http.ResponseWriter, r *http.Request) {
-		if r.Method == http.MethodGet {
-			w.Header().Set("Content-Type", "application/json")
-			fmt.Fprintf(w, `{"count":0,"items":[]}`)
-			return
-		}
-		if r.Method == http.MethodPost {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusCreated)
-			fmt.Fprintf(w, `{"id":"placeholder"}`)
-			return
-		}
-		w.WriteHeader(http.StatusMethodNotAllowed)
-	})
-
-	mux.HandleFunc("/api/v1/equipment/", func(w http.ResponseWriter, r *http.Request) {
-		if r.Method == http.MethodGet {
-			w.Header().Set("Content-Type", "application/json")
-			fmt.Fprintf(w, `{"id":"placeholder"}`)
-			return
-		}
-		w.WriteHeader(http.StatusMethodNotAllowed)
-	})
+	if stream != nil {
+		mux.Handle("/api/v1/events/stream", stream)
+	}
+	mux.Handle("/api/v1/equipment/export", export)
+	mux.Handle("/api/v1/", handler.WithChangeInfo(handler.WithAccept(apiServer)))
 
 	return &http.Server{
-		Addr:         addr,
+		Addr:         cfg.Address(),
 		Handler:      mux,
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
-		IdleTimeout:  60 * time.Second,
-	}
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}, nil
 }
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/grnsv/go-cmms/internal/config"
+	"github.com/grnsv/go-cmms/internal/infrastructure/postgres/migrate"
+)
+
+const migrateUsage = "usage: server migrate up|down|status|goto <version>"
+
+// runMigrate выполняет подкоманду migrate
+func runMigrate(ctx context.Context, db *sql.DB, cfg config.DatabaseConfig, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	m, err := newMigrator(db, cfg)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		done, err := m.Up(ctx)
+		printMigrations("applied", done)
+		return err
+	case "down":
+		done, err := m.Down(ctx)
+		printMigrations("reverted", done)
+		return err
+	case "goto":
+		if len(args) != 2 {
+			return errors.New(migrateUsage)
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q: %w", args[1], err)
+		}
+		done, err := m.Goto(ctx, version)
+		printMigrations("migrated", done)
+		return err
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.Applied {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			fmt.Fprintf(w, "%03d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q, %s", args[0], migrateUsage)
+	}
+}
+
+// newMigrator создаёт Migrator для миграций из конфигурации
+func newMigrator(db *sql.DB, cfg config.DatabaseConfig) (*migrate.Migrator, error) {
+	source, err := migrate.Source(cfg.MigrationsPath)
+	if err != nil {
+		return nil, err
+	}
+	return migrate.New(db, source)
+}
+
+func printMigrations(action string, migrations []migrate.Migration) {
+	if len(migrations) == 0 {
+		fmt.Println("no migrations to run")
+		return
+	}
+	for _, m := range migrations {
+		fmt.Printf("%s %03d_%s\n", action, m.Version, m.Name)
+	}
+}
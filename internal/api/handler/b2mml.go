@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+
+	api "github.com/grnsv/go-cmms/internal/api/ogen"
+	"github.com/grnsv/go-cmms/internal/app"
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// B2mmlPost адаптирует POST /b2mml к B2MMLProcessor. Отклонённое сообщение
+// возвращается с кодом по причине отклонения и ответным документом, если он запрошен.
+// Некорректный документ, выражение или курсор Get - 400 с ShowErrorMessage
+// или problem details, если клиент предпочитает JSON
+func (h *Handler) B2mmlPost(ctx context.Context, req api.B2mmlPostReq) (api.B2mmlPostRes, error) {
+	reply, err := h.b2mml.Process(ctx, req.Data)
+	switch {
+	case errors.Is(err, model.ErrB2MMLMalformed),
+		errors.Is(err, model.ErrB2MMLUnsupportedMessage),
+		errors.Is(err, model.ErrB2MMLUnsupportedAction),
+		errors.Is(err, model.ErrB2MMLUnsupportedExpression),
+		errors.Is(err, model.ErrEquipmentClassIDEmpty),
+		errors.Is(err, app.ErrInvalidEquipmentQuery),
+		errors.Is(err, repository.ErrInvalidCursor):
+		return h.b2mmlBadRequest(ctx, err)
+	case err != nil:
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	if reply.Document != nil {
+		body.WriteString(xml.Header)
+		if err := xml.NewEncoder(&body).Encode(reply.Document); err != nil {
+			return nil, err
+		}
+	}
+
+	switch err := reply.Err; {
+	case err == nil && reply.Document == nil:
+		return &api.B2mmlPostNoContent{}, nil
+	case err == nil:
+		return &api.B2mmlPostOK{Data: &body}, nil
+	case errors.Is(err, model.ErrEquipmentNotFound),
+		errors.Is(err, model.ErrEquipmentClassNotFound):
+		return &api.B2mmlPostNotFound{Data: &body}, nil
+	case errors.Is(err, model.ErrEquipmentAlreadyExists),
+		errors.Is(err, model.ErrEquipmentClassAlreadyExists),
+		errors.Is(err, model.ErrEquipmentClassInUse),
+		errors.Is(err, model.ErrEquipmentHasChildren),
+		errors.Is(err, model.ErrEquipmentHasClasses),
+		errors.Is(err, model.ErrEquipmentVersionConflict):
+		return &api.B2mmlPostConflict{Data: &body}, nil
+	case errors.Is(err, model.ErrEquipmentIDEmpty),
+		errors.Is(err, model.ErrEquipmentClassIDEmpty),
+		errors.Is(err, model.ErrEquipmentClassPropertyIDEmpty),
+		errors.Is(err, model.ErrEquipmentInvalidStatus),
+		errors.Is(err, model.ErrEquipmentHierarchyCycle),
+		errors.Is(err, model.ErrEquipmentLevelOrder),
+		isPropertyValueError(err),
+		isUnitError(err):
+		return &api.B2mmlPostUnprocessableEntity{Data: &body}, nil
+	default:
+		return nil, err
+	}
+}
+
+// B2mmlTransactionProfileGet возвращает профиль транзакций B2MML сервера
+func (h *Handler) B2mmlTransactionProfileGet(_ context.Context) (api.B2mmlTransactionProfileGetOK, error) {
+	body, err := encodeB2MMLNoun("TransactionProfile", h.b2mml.TransactionProfile())
+	if err != nil {
+		return api.B2mmlTransactionProfileGetOK{}, err
+	}
+	return api.B2mmlTransactionProfileGetOK{Data: body}, nil
+}
+
+// B2mmlMasterDataProfileGet возвращает загруженный MasterDataProfile
+func (h *Handler) B2mmlMasterDataProfileGet(_ context.Context) (api.B2mmlMasterDataProfileGetRes, error) {
+	if h.b2mmlChannels == nil {
+		return &api.B2mmlMasterDataProfileGetNotFound{}, nil
+	}
+	body, err := encodeB2MMLNoun("MasterDataProfile", h.b2mmlChannels.Profile())
+	if err != nil {
+		return nil, err
+	}
+	return &api.B2mmlMasterDataProfileGetOK{Data: body}, nil
+}
+
+// b2mmlBadRequest возвращает отклонение документа с нарушениями схемы
+func (h *Handler) b2mmlBadRequest(ctx context.Context, err error) (api.B2mmlPostRes, error) {
+	if !acceptsJSON(ctx) {
+		var body bytes.Buffer
+		body.WriteString(xml.Header)
+		if err := xml.NewEncoder(&body).Encode(h.b2mml.ErrorDocument(err)); err != nil {
+			return nil, err
+		}
+		return &api.B2mmlPostBadRequestApplicationXML{Data: &body}, nil
+	}
+
+	return validationProblem("Invalid B2MML document", err), nil
+}
+
+// validationProblem возвращает problem details отклонённого запроса с
+// нарушениями схемы B2MML из err
+func validationProblem(title string, err error) *api.ValidationProblem {
+	problem := &api.ValidationProblem{
+		Title:      title,
+		Status:     http.StatusBadRequest,
+		Detail:     api.NewOptString(err.Error()),
+		Violations: []api.SchemaViolation{},
+	}
+	var verr *b2mml.ValidationError
+	if errors.As(err, &verr) {
+		for _, v := range verr.Violations {
+			problem.Violations = append(problem.Violations, api.SchemaViolation{
+				Path:    v.Path,
+				Code:    api.SchemaViolationCode(v.Code),
+				Message: v.Message,
+			})
+		}
+	}
+	return problem
+}
+
+// encodeB2MMLNoun кодирует существительное B2MML в XML документ с корневым
+// элементом name в пространстве имён B2MML
+func encodeB2MMLNoun(name string, noun any) (*bytes.Buffer, error) {
+	var body bytes.Buffer
+	body.WriteString(xml.Header)
+	start := xml.StartElement{Name: xml.Name{Space: b2mml.Namespace, Local: name}}
+	if err := xml.NewEncoder(&body).EncodeElement(noun, start); err != nil {
+		return nil, err
+	}
+	return &body, nil
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/app"
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// EventStreamHandler обслуживает GET /events/stream: поток доменных событий
+// в формате Server-Sent Events. Ответ потоковый, поэтому handler подключается
+// к серверу напрямую, в обход ogen
+type EventStreamHandler struct {
+	broadcaster *app.EventBroadcaster
+	heartbeat   time.Duration
+}
+
+// NewEventStreamHandler создаёт handler потока событий. heartbeat - период
+// комментариев, поддерживающих соединение через прокси (15 секунд, если не задан)
+func NewEventStreamHandler(broadcaster *app.EventBroadcaster, heartbeat time.Duration) *EventStreamHandler {
+	if heartbeat <= 0 {
+		heartbeat = 15 * time.Second
+	}
+	return &EventStreamHandler{broadcaster: broadcaster, heartbeat: heartbeat}
+}
+
+// ServeHTTP передаёт события клиенту до его отключения. Каждое событие
+// отправляется с id - номером в outbox; при переподключении браузер передаёт
+// его в заголовке Last-Event-ID, и поток продолжается с пропущенных событий
+func (h *EventStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := app.EventStreamFilter{
+		EquipmentIDs: query["equipment_id"],
+		Scope:        query.Get("scope"),
+		EventTypes:   query["type"],
+	}
+
+	var lastEventID *int64
+	if value := r.Header.Get("Last-Event-ID"); value != "" || query.Has("last_event_id") {
+		if value == "" {
+			value = query.Get("last_event_id")
+		}
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || id < 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		lastEventID = &id
+	}
+
+	sub, err := h.broadcaster.Subscribe(r.Context(), filter, lastEventID)
+	switch {
+	case errors.Is(err, model.ErrUnknownEventType), errors.Is(err, model.ErrEquipmentIDEmpty):
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	case errors.Is(err, model.ErrEquipmentNotFound):
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case errors.Is(err, app.ErrEventStreamUnavailable):
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	case err != nil:
+		log.Printf("Event stream subscribe failed: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// Поток не должен обрываться по WriteTimeout сервера
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Printf("Event stream write deadline reset failed: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", (3 * time.Second).Milliseconds())
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				if err := sub.Err(); err != nil && r.Context().Err() == nil {
+					// Клиент переподключится и продолжит с последнего id
+					fmt.Fprintf(w, ": %v\n\n", err)
+					rc.Flush()
+				}
+				return
+			}
+			data, err := json.Marshal(event.Envelope)
+			if err != nil {
+				log.Printf("Event stream encode failed: %v", err)
+				return
+			}
+			fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Sequence, event.Envelope.Type, data)
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		case <-r.Context().Done():
+			return
+		}
+		// Пачку событий из буфера отправляем одним сбросом
+		if len(sub.Events()) > 0 {
+			continue
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
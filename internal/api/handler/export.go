@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/app"
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// exportContentTypes типы содержимого форматов выгрузки
+var exportContentTypes = map[model.ExportFormat]string{
+	model.ExportFormatCSV:   "text/csv; charset=utf-8",
+	model.ExportFormatJSONL: "application/x-ndjson",
+	model.ExportFormatB2MML: "application/xml",
+}
+
+// exportExtensions расширения файлов форматов выгрузки
+var exportExtensions = map[model.ExportFormat]string{
+	model.ExportFormatCSV:   ".csv",
+	model.ExportFormatJSONL: ".jsonl",
+	model.ExportFormatB2MML: ".xml",
+}
+
+// EquipmentExportHandler обслуживает GET /equipment/export: выгрузку реестра
+// оборудования в CSV, JSON Lines или B2MML. Ответ потоковый, поэтому handler
+// подключается к серверу напрямую, в обход ogen
+type EquipmentExportHandler struct {
+	export *app.ExportEquipmentUseCase
+}
+
+// NewEquipmentExportHandler создаёт handler выгрузки оборудования
+func NewEquipmentExportHandler(export *app.ExportEquipmentUseCase) *EquipmentExportHandler {
+	return &EquipmentExportHandler{export: export}
+}
+
+// ServeHTTP проверяет параметры выгрузки и передаёт её клиенту по мере
+// чтения. Формат задаётся параметром format или заголовком Accept; при
+// Accept-Encoding: gzip ответ сжимается
+func (h *EquipmentExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	input, err := exportInput(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	export, err := h.export.Execute(r.Context(), input)
+	switch {
+	case errors.Is(err, model.ErrExportInvalidFormat),
+		errors.Is(err, model.ErrExportInvalidColumn),
+		errors.Is(err, app.ErrInvalidEquipmentQuery),
+		errors.Is(err, model.ErrEquipmentClassIDEmpty),
+		errors.Is(err, model.ErrEquipmentIDEmpty):
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	case errors.Is(err, model.ErrEquipmentNotFound),
+		errors.Is(err, model.ErrEquipmentClassNotFound):
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case err != nil:
+		log.Printf("Equipment export failed: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// Выгрузка не должна обрываться по WriteTimeout сервера
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Printf("Equipment export write deadline reset failed: %v", err)
+	}
+
+	format := export.Format()
+	w.Header().Set("Content-Type", exportContentTypes[format])
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment",
+		map[string]string{"filename": "equipment" + exportExtensions[format]}))
+	w.Header().Add("Vary", "Accept, Accept-Encoding")
+
+	var out io.Writer = w
+	if acceptsGzip(r) {
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+		out = gz
+	}
+	w.WriteHeader(http.StatusOK)
+
+	// Статус уже отправлен: ошибка обрывает выгрузку и только пишется в лог
+	if err := export.Write(r.Context(), out); err != nil {
+		log.Printf("Equipment export interrupted: %v", err)
+	}
+}
+
+// exportInput разбирает параметры запроса выгрузки
+func exportInput(r *http.Request) (app.ExportEquipmentInput, error) {
+	query := r.URL.Query()
+	input := app.ExportEquipmentInput{
+		Format:    query.Get("format"),
+		Columns:   splitList(query["columns"]),
+		Delimiter: query.Get("delimiter"),
+		RootIDs:   query["root"],
+		Query: app.QueryEquipmentInput{
+			Statuses:          query["status"],
+			Levels:            query["equipment_level"],
+			HierarchyScopeIDs: query["hierarchy_scope"],
+			ClassID:           query.Get("class"),
+		},
+	}
+	if input.Format == "" {
+		input.Format = string(acceptedExportFormat(r.Header.Get("Accept")))
+	}
+
+	if value := query.Get("include_subclasses"); value != "" {
+		include, err := strconv.ParseBool(value)
+		if err != nil {
+			return input, fmt.Errorf("include_subclasses: %w", err)
+		}
+		input.Query.IncludeSubclasses = include
+	}
+	for name, field := range map[string]**time.Time{
+		"effective_at":   &input.Query.EffectiveAt,
+		"effective_from": &input.Query.EffectiveFrom,
+		"effective_to":   &input.Query.EffectiveTo,
+	} {
+		t, err := exportTime(query, name)
+		if err != nil {
+			return input, err
+		}
+		*field = t
+	}
+	return input, nil
+}
+
+// exportTime разбирает необязательный параметр-момент времени в RFC 3339
+func exportTime(query url.Values, name string) (*time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return &t, nil
+}
+
+// acceptedExportFormat выбирает формат выгрузки по заголовку Accept; по
+// умолчанию CSV
+func acceptedExportFormat(accept string) model.ExportFormat {
+	for part := range strings.SplitSeq(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "text/csv":
+			return model.ExportFormatCSV
+		case "application/x-ndjson", "application/jsonl":
+			return model.ExportFormatJSONL
+		case "application/xml", "text/xml":
+			return model.ExportFormatB2MML
+		}
+	}
+	return model.ExportFormatCSV
+}
+
+// acceptsGzip сообщает, принимает ли клиент ответ, сжатый gzip
+func acceptsGzip(r *http.Request) bool {
+	for part := range strings.SplitSeq(r.Header.Get("Accept-Encoding"), ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			continue
+		}
+		// gzip;q=0 запрещает сжатие
+		q, err := strconv.ParseFloat(strings.TrimPrefix(strings.TrimSpace(params), "q="), 64)
+		return err != nil || q > 0
+	}
+	return false
+}
+
+// splitList разбирает значения, перечисленные через запятую, в том числе
+// в повторяющемся параметре
+func splitList(values []string) []string {
+	var items []string
+	for _, value := range values {
+		for item := range strings.SplitSeq(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
@@ -2,81 +2,943 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	api "github.com/grnsv/go-cmms/internal/api/ogen"
 	"github.com/grnsv/go-cmms/internal/app"
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
 )
 
-// Handler адаптирует ogen-сгенерированные интерфейсы к use cases.
-// Полные сигнатуры методов, DTO и маршруты генерирует ogen из spec.yaml
+// Handler адаптирует ogen-сгенерированный интерфейс api.Handler к use cases.
+// Операции без реализации обрабатывает api.UnimplementedHandler (501 Not Implemented)
 type Handler struct {
+	api.UnimplementedHandler
+
 	// Use cases
 	listEquipmentUC    *app.ListEquipmentUseCase
+	queryEquipmentUC   *app.QueryEquipmentUseCase
 	getEquipmentByIDUC *app.GetEquipmentByIDUseCase
 	createEquipmentUC  *app.CreateEquipmentUseCase
+	updateEquipmentUC  *app.UpdateEquipmentUseCase
+	getEquipmentTreeUC *app.GetEquipmentTreeUseCase
+	moveEquipmentUC    *app.MoveEquipmentUseCase
+	getPropertiesUC    *app.GetEffectivePropertiesUseCase
+	listPersonsUC      *app.ListPersonsUseCase
+	createPersonUC     *app.CreatePersonUseCase
+	searchUC           *app.SearchUseCase
+	listUnitsUC        *app.ListUnitsUseCase
+	convertUnitUC      *app.ConvertUnitUseCase
+	getSiteUnitsUC     *app.GetSiteUnitsUseCase
+	setSiteUnitsUC     *app.SetSiteUnitsUseCase
+	listWebhooksUC     *app.ListWebhooksUseCase
+	createWebhookUC    *app.CreateWebhookUseCase
+	getWebhookUC       *app.GetWebhookUseCase
+	updateWebhookUC    *app.UpdateWebhookUseCase
+	deleteWebhookUC    *app.DeleteWebhookUseCase
+	pingWebhookUC      *app.PingWebhookUseCase
+	listDeliveriesUC   *app.ListWebhookDeliveriesUseCase
+	replayDeliveryUC   *app.ReplayWebhookDeliveryUseCase
+	listHistoryUC      *app.ListEquipmentHistoryUseCase
+	diffHistoryUC      *app.DiffEquipmentRevisionsUseCase
+	deleteEquipmentUC  *app.DeleteEquipmentUseCase
+	restoreEquipmentUC *app.RestoreEquipmentUseCase
+	purgeEquipmentUC   *app.PurgeEquipmentUseCase
+
+	// b2mml обработчик сообщений B2MML
+	b2mml *app.B2MMLProcessor
+	// b2mmlChannels каналы сообщений из MasterDataProfile; nil - профиль не загружен
+	b2mmlChannels *app.B2MMLChannels
+	// importer задания импорта оборудования
+	importer *app.EquipmentImporter
 }
 
+var _ api.Handler = (*Handler)(nil)
+
 // NewHandler создаёт новый handler с инъекцией use cases
 func NewHandler(
 	listEquipmentUC *app.ListEquipmentUseCase,
+	queryEquipmentUC *app.QueryEquipmentUseCase,
 	getEquipmentByIDUC *app.GetEquipmentByIDUseCase,
 	createEquipmentUC *app.CreateEquipmentUseCase,
+	updateEquipmentUC *app.UpdateEquipmentUseCase,
+	getEquipmentTreeUC *app.GetEquipmentTreeUseCase,
+	moveEquipmentUC *app.MoveEquipmentUseCase,
+	getPropertiesUC *app.GetEffectivePropertiesUseCase,
+	listPersonsUC *app.ListPersonsUseCase,
+	createPersonUC *app.CreatePersonUseCase,
+	searchUC *app.SearchUseCase,
+	listUnitsUC *app.ListUnitsUseCase,
+	convertUnitUC *app.ConvertUnitUseCase,
+	getSiteUnitsUC *app.GetSiteUnitsUseCase,
+	setSiteUnitsUC *app.SetSiteUnitsUseCase,
+	listWebhooksUC *app.ListWebhooksUseCase,
+	createWebhookUC *app.CreateWebhookUseCase,
+	getWebhookUC *app.GetWebhookUseCase,
+	updateWebhookUC *app.UpdateWebhookUseCase,
+	deleteWebhookUC *app.DeleteWebhookUseCase,
+	pingWebhookUC *app.PingWebhookUseCase,
+	listDeliveriesUC *app.ListWebhookDeliveriesUseCase,
+	replayDeliveryUC *app.ReplayWebhookDeliveryUseCase,
+	listHistoryUC *app.ListEquipmentHistoryUseCase,
+	diffHistoryUC *app.DiffEquipmentRevisionsUseCase,
+	deleteEquipmentUC *app.DeleteEquipmentUseCase,
+	restoreEquipmentUC *app.RestoreEquipmentUseCase,
+	purgeEquipmentUC *app.PurgeEquipmentUseCase,
+	b2mml *app.B2MMLProcessor,
+	b2mmlChannels *app.B2MMLChannels,
+	importer *app.EquipmentImporter,
 ) *Handler {
 	return &Handler{
 		listEquipmentUC:    listEquipmentUC,
+		queryEquipmentUC:   queryEquipmentUC,
 		getEquipmentByIDUC: getEquipmentByIDUC,
 		createEquipmentUC:  createEquipmentUC,
+		updateEquipmentUC:  updateEquipmentUC,
+		getEquipmentTreeUC: getEquipmentTreeUC,
+		moveEquipmentUC:    moveEquipmentUC,
+		getPropertiesUC:    getPropertiesUC,
+		listPersonsUC:      listPersonsUC,
+		createPersonUC:     createPersonUC,
+		searchUC:           searchUC,
+		listUnitsUC:        listUnitsUC,
+		convertUnitUC:      convertUnitUC,
+		getSiteUnitsUC:     getSiteUnitsUC,
+		setSiteUnitsUC:     setSiteUnitsUC,
+		listWebhooksUC:     listWebhooksUC,
+		createWebhookUC:    createWebhookUC,
+		getWebhookUC:       getWebhookUC,
+		updateWebhookUC:    updateWebhookUC,
+		deleteWebhookUC:    deleteWebhookUC,
+		pingWebhookUC:      pingWebhookUC,
+		listDeliveriesUC:   listDeliveriesUC,
+		replayDeliveryUC:   replayDeliveryUC,
+		listHistoryUC:      listHistoryUC,
+		diffHistoryUC:      diffHistoryUC,
+		deleteEquipmentUC:  deleteEquipmentUC,
+		restoreEquipmentUC: restoreEquipmentUC,
+		purgeEquipmentUC:   purgeEquipmentUC,
+		b2mml:              b2mml,
+		b2mmlChannels:      b2mmlChannels,
+		importer:           importer,
+	}
+}
+
+// EquipmentGet адаптирует GET /equipment к ListEquipmentUseCase
+func (h *Handler) EquipmentGet(ctx context.Context, params api.EquipmentGetParams) (api.EquipmentGetRes, error) {
+	input := app.ListEquipmentInput{
+		Limit:   params.Limit.Or(0),
+		Cursor:  params.Cursor.Or(""),
+		ClassID: params.Class.Or(""),
+		Deleted: params.Deleted.Or(false),
+	}
+	if status, ok := params.Status.Get(); ok {
+		input.Status = string(status)
+	}
+
+	result, err := h.listEquipmentUC.Execute(ctx, input)
+	switch {
+	case errors.Is(err, repository.ErrInvalidCursor),
+		errors.Is(err, app.ErrInvalidEquipmentQuery):
+		return &api.EquipmentGetBadRequest{}, nil
+	case errors.Is(err, model.ErrEquipmentClassNotFound):
+		return &api.EquipmentGetNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return toEquipmentList(result, nil), nil
+}
+
+// EquipmentQueryPost адаптирует POST /equipment/query к QueryEquipmentUseCase.
+// Поля ответа ограничиваются списком fields из запроса
+func (h *Handler) EquipmentQueryPost(ctx context.Context, req *api.EquipmentQuery) (api.EquipmentQueryPostRes, error) {
+	fields, err := parseEquipmentFields(req.Fields)
+	if err != nil {
+		return &api.EquipmentQueryPostBadRequest{}, nil
+	}
+
+	input := app.QueryEquipmentInput{
+		Levels:            req.EquipmentLevel,
+		HierarchyScopeIDs: req.HierarchyScope,
+		ClassID:           req.Class.Or(""),
+		IncludeSubclasses: req.IncludeSubclasses.Or(false),
+		Sort:              string(req.Sort.Or("")),
+		Desc:              req.Order.Or(api.EquipmentQueryOrderAsc) == api.EquipmentQueryOrderDesc,
+		Limit:             req.Limit.Or(0),
+		Cursor:            req.Cursor.Or(""),
+	}
+	for _, status := range req.Status {
+		input.Statuses = append(input.Statuses, string(status))
+	}
+	if t, ok := req.EffectiveAt.Get(); ok {
+		input.EffectiveAt = &t
+	}
+	if t, ok := req.EffectiveFrom.Get(); ok {
+		input.EffectiveFrom = &t
+	}
+	if t, ok := req.EffectiveTo.Get(); ok {
+		input.EffectiveTo = &t
+	}
+	for _, p := range req.Properties {
+		input.Properties = append(input.Properties, app.PropertyPredicateInput{
+			ID:       p.ID,
+			Operator: string(p.Op),
+			Type:     string(p.Type.Or("")),
+			Value:    p.Value.Or(""),
+			Unit:     p.Unit.Or(""),
+		})
+	}
+
+	result, err := h.queryEquipmentUC.Execute(ctx, input)
+	switch {
+	case errors.Is(err, app.ErrInvalidEquipmentQuery),
+		errors.Is(err, repository.ErrInvalidCursor),
+		errors.Is(err, model.ErrEquipmentClassIDEmpty):
+		return &api.EquipmentQueryPostBadRequest{}, nil
+	case errors.Is(err, model.ErrEquipmentClassNotFound):
+		return &api.EquipmentQueryPostNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return toEquipmentList(result, fields), nil
+}
+
+// EquipmentPost адаптирует POST /equipment к CreateEquipmentUseCase. Тело -
+// EquipmentType или существительное B2MML Equipment в XML; ответ в XML, если
+// клиент его предпочитает или прислал XML
+func (h *Handler) EquipmentPost(ctx context.Context, req api.EquipmentPostReq) (api.EquipmentPostRes, error) {
+	var (
+		input      app.CreateEquipmentInput
+		requestXML bool
+	)
+	switch req := req.(type) {
+	case *api.EquipmentType:
+		input = app.CreateEquipmentInput{
+			ExternalID:  req.EquipmentID.Or(""),
+			ClassID:     req.EquipmentType.Or(""),
+			Description: req.Description.Or(""),
+			Properties:  propertyInputs(req),
+		}
+		if status, ok := req.OperatingStatus.Get(); ok {
+			input.Status = string(status)
+		}
+		data := &b2mml.EquipmentType{}
+		ok, err := decodeB2MMLJSON(req.B2MML, data)
+		if err != nil {
+			return validationProblem("Invalid equipment", err), nil
+		}
+		if ok {
+			if input.ExternalID == "" {
+				input.ExternalID = data.ID.String()
+			}
+			if input.ExternalID != data.ID.String() {
+				return idMismatchProblem("EquipmentID", input.ExternalID, data.ID.String()), nil
+			}
+			input.Data = data
+		}
+	case *api.EquipmentPostReqApplicationXML:
+		requestXML = true
+		data := &b2mml.EquipmentType{}
+		if err := decodeB2MMLBody(req.Data, "Equipment", data); err != nil {
+			return validationProblem("Invalid equipment", err), nil
+		}
+		input = app.CreateEquipmentInput{ExternalID: data.ID.String(), Data: data}
+	}
+
+	result, err := h.createEquipmentUC.Execute(ctx, input)
+	switch {
+	case errors.Is(err, model.ErrEquipmentIDEmpty),
+		errors.Is(err, model.ErrEquipmentInvalidStatus),
+		isPropertyValueError(err),
+		isUnitError(err):
+		return validationProblem("Invalid equipment", err), nil
+	case errors.Is(err, model.ErrEquipmentClassNotFound):
+		return &api.EquipmentPostNotFound{}, nil
+	case errors.Is(err, model.ErrEquipmentAlreadyExists):
+		return &api.EquipmentPostConflict{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if respondXML(ctx, requestXML) {
+		body, err := encodeB2MMLNoun("Equipment", result.Equipment.ToB2MML())
+		if err != nil {
+			return nil, err
+		}
+		return &api.EquipmentPostCreatedApplicationXMLHeaders{
+			ETag:     formatETag(result.Equipment.Version()),
+			Response: api.EquipmentPostCreatedApplicationXML{Data: body},
+		}, nil
+	}
+
+	return &api.EquipmentTypeHeaders{
+		ETag:     formatETag(result.Equipment.Version()),
+		Response: toEquipmentDTO(result.Equipment),
+	}, nil
+}
+
+// idMismatchProblem возвращает отказ для тела запроса, в котором field
+// не совпадает с ID существительного B2MML
+func idMismatchProblem(field, value, id string) *api.ValidationProblem {
+	return validationProblem("Invalid request body",
+		fmt.Errorf("%s %q does not match B2MML ID %q", field, value, id))
+}
+
+// isPropertyValueError сообщает, что значение свойства не прошло проверку
+// по типу данных или определению класса
+func isPropertyValueError(err error) bool {
+	return errors.Is(err, model.ErrEquipmentPropertyIDEmpty) ||
+		errors.Is(err, model.ErrPropertyInvalidDataType) ||
+		errors.Is(err, model.ErrPropertyInvalidValue) ||
+		errors.Is(err, model.ErrPropertyConstraintViolation)
+}
+
+// isUnitError сообщает, что единица измерения неизвестна или несовместима
+func isUnitError(err error) bool {
+	return errors.Is(err, model.ErrUnitUnknown) || errors.Is(err, model.ErrUnitIncompatible)
+}
+
+// isWebhookValidationError сообщает, что адрес или фильтр событий подписки некорректны
+func isWebhookValidationError(err error) bool {
+	return errors.Is(err, model.ErrWebhookInvalidURL) || errors.Is(err, model.ErrUnknownEventType)
+}
+
+// EquipmentIDGet адаптирует GET /equipment/{id} к GetEquipmentByIDUseCase.
+// Версия агрегата возвращается в заголовке ETag; при Accept: application/xml
+// тело - существительное B2MML Equipment с вложенным дочерним оборудованием
+func (h *Handler) EquipmentIDGet(ctx context.Context, params api.EquipmentIDGetParams) (api.EquipmentIDGetRes, error) {
+	input := app.GetEquipmentByIDInput{ExternalID: params.ID, Children: acceptsXML(ctx)}
+	if asOf, ok := params.AsOf.Get(); ok {
+		input.AsOf = &asOf
+	}
+
+	result, err := h.getEquipmentByIDUC.Execute(ctx, input)
+	if errors.Is(err, model.ErrEquipmentNotFound) {
+		return &api.EquipmentIDGetNotFound{}, nil
+	}
+	if err != nil {
+		return nil, err
 	}
+
+	if input.Children {
+		body, err := encodeB2MMLNoun("Equipment", result.Equipment.ToB2MML())
+		if err != nil {
+			return nil, err
+		}
+		return &api.EquipmentIDGetOKApplicationXMLHeaders{
+			ETag:     formatETag(result.Equipment.Version()),
+			Response: api.EquipmentIDGetOKApplicationXML{Data: body},
+		}, nil
+	}
+
+	return &api.EquipmentTypeHeaders{
+		ETag:     formatETag(result.Equipment.Version()),
+		Response: toEquipmentDTO(result.Equipment),
+	}, nil
 }
 
-// Методы адаптеров - должны соответствовать интерфейсам ogen.
-// Точные сигнатуры, DTO и параметры маршрутов генерирует ogen из spec.yaml
+// EquipmentIDPut адаптирует PUT /equipment/{id} к UpdateEquipmentUseCase.
+// Существительное B2MML Equipment (XML или поле B2MML) заменяет данные оборудования.
+// Несовпадение If-Match с текущей версией возвращается как 412 Precondition Failed,
+// некорректный If-Match - как 400
+func (h *Handler) EquipmentIDPut(ctx context.Context, req api.EquipmentIDPutReq, params api.EquipmentIDPutParams) (api.EquipmentIDPutRes, error) {
+	expectedVersion, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return validationProblem("Invalid If-Match", err), nil
+	}
+
+	input := app.UpdateEquipmentInput{
+		ExternalID:      params.ID,
+		ExpectedVersion: expectedVersion,
+	}
+	var requestXML bool
+	data := &b2mml.EquipmentType{}
+	switch req := req.(type) {
+	case *api.EquipmentType:
+		input.Properties = propertyInputs(req)
+		if status, ok := req.OperatingStatus.Get(); ok {
+			input.Status = string(status)
+		}
+		ok, err := decodeB2MMLJSON(req.B2MML, data)
+		if err != nil {
+			return validationProblem("Invalid equipment", err), nil
+		}
+		if ok {
+			input.Data = data
+		}
+	case *api.EquipmentIDPutReqApplicationXML:
+		requestXML = true
+		if err := decodeB2MMLBody(req.Data, "Equipment", data); err != nil {
+			return validationProblem("Invalid equipment", err), nil
+		}
+		input.Data = data
+	}
+	if input.Data != nil && input.Data.ID.String() != params.ID {
+		return idMismatchProblem("id", params.ID, input.Data.ID.String()), nil
+	}
+
+	result, err := h.updateEquipmentUC.Execute(ctx, input)
+	switch {
+	case errors.Is(err, model.ErrEquipmentInvalidStatus),
+		isPropertyValueError(err),
+		isUnitError(err):
+		return validationProblem("Invalid equipment", err), nil
+	case errors.Is(err, model.ErrEquipmentNotFound):
+		return &api.EquipmentIDPutNotFound{}, nil
+	case errors.Is(err, model.ErrEquipmentVersionConflict):
+		return &api.EquipmentIDPutPreconditionFailed{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if respondXML(ctx, requestXML) {
+		body, err := encodeB2MMLNoun("Equipment", result.Equipment.ToB2MML())
+		if err != nil {
+			return nil, err
+		}
+		return &api.EquipmentIDPutOKApplicationXMLHeaders{
+			ETag:     formatETag(result.Equipment.Version()),
+			Response: api.EquipmentIDPutOKApplicationXML{Data: body},
+		}, nil
+	}
+
+	return &api.EquipmentTypeHeaders{
+		ETag:     formatETag(result.Equipment.Version()),
+		Response: toEquipmentDTO(result.Equipment),
+	}, nil
+}
 
-// ListEquipment адаптирует запрос ogen к ListEquipmentUseCase
-func (h *Handler) ListEquipment(ctx context.Context, limit *int, offset *int) (interface{}, error) {
-	l := int32(10)
-	if limit != nil && *limit > 0 {
-		l = int32(*limit)
+// EquipmentIDDelete адаптирует DELETE /equipment/{id} к DeleteEquipmentUseCase
+func (h *Handler) EquipmentIDDelete(ctx context.Context, params api.EquipmentIDDeleteParams) (api.EquipmentIDDeleteRes, error) {
+	input := app.DeleteEquipmentInput{
+		ExternalID: params.ID,
+		Children:   string(params.Children.Or("")),
+		Classes:    string(params.Classes.Or("")),
+	}
+	if ifMatch, ok := params.IfMatch.Get(); ok {
+		expectedVersion, err := parseIfMatch(ifMatch)
+		if err != nil {
+			return &api.EquipmentIDDeleteBadRequest{}, nil
+		}
+		input.ExpectedVersion = expectedVersion
 	}
 
-	o := int32(0)
-	if offset != nil && *offset >= 0 {
-		o = int32(*offset)
+	_, err := h.deleteEquipmentUC.Execute(ctx, input)
+	switch {
+	case errors.Is(err, model.ErrEquipmentNotFound):
+		return &api.EquipmentIDDeleteNotFound{}, nil
+	case errors.Is(err, model.ErrEquipmentVersionConflict):
+		return &api.EquipmentIDDeletePreconditionFailed{}, nil
+	case errors.Is(err, model.ErrEquipmentHasChildren),
+		errors.Is(err, model.ErrEquipmentHasClasses):
+		return &api.EquipmentIDDeleteConflict{}, nil
+	case err != nil:
+		return nil, err
 	}
+	return &api.EquipmentIDDeleteNoContent{}, nil
+}
 
-	result, err := h.listEquipmentUC.Execute(ctx, app.ListEquipmentInput{
-		Limit:  l,
-		Offset: o,
+// EquipmentIDRestorePost адаптирует POST /equipment/{id}/restore к RestoreEquipmentUseCase
+func (h *Handler) EquipmentIDRestorePost(ctx context.Context, params api.EquipmentIDRestorePostParams) (api.EquipmentIDRestorePostRes, error) {
+	result, err := h.restoreEquipmentUC.Execute(ctx, app.RestoreEquipmentInput{
+		ExternalID: params.ID,
 	})
+	switch {
+	case errors.Is(err, model.ErrEquipmentNotFound):
+		return &api.EquipmentIDRestorePostNotFound{}, nil
+	case errors.Is(err, model.ErrEquipmentNotDeleted),
+		errors.Is(err, model.ErrEquipmentParentDeleted):
+		return &api.EquipmentIDRestorePostConflict{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if acceptsXML(ctx) {
+		body, err := encodeB2MMLNoun("Equipment", result.Equipment.ToB2MML())
+		if err != nil {
+			return nil, err
+		}
+		return &api.EquipmentIDRestorePostOKApplicationXMLHeaders{
+			ETag:     formatETag(result.Equipment.Version()),
+			Response: api.EquipmentIDRestorePostOKApplicationXML{Data: body},
+		}, nil
+	}
+
+	return &api.EquipmentTypeHeaders{
+		ETag:     formatETag(result.Equipment.Version()),
+		Response: toEquipmentDTO(result.Equipment),
+	}, nil
+}
+
+// EquipmentIDPurgePost адаптирует POST /equipment/{id}/purge к PurgeEquipmentUseCase
+func (h *Handler) EquipmentIDPurgePost(ctx context.Context, params api.EquipmentIDPurgePostParams) (api.EquipmentIDPurgePostRes, error) {
+	err := h.purgeEquipmentUC.Execute(ctx, app.PurgeEquipmentInput{ExternalID: params.ID})
+	switch {
+	case errors.Is(err, model.ErrEquipmentNotFound):
+		return &api.EquipmentIDPurgePostNotFound{}, nil
+	case errors.Is(err, model.ErrEquipmentNotDeleted):
+		return &api.EquipmentIDPurgePostConflict{}, nil
+	case err != nil:
+		return nil, err
+	}
+	return &api.EquipmentIDPurgePostNoContent{}, nil
+}
+
+// EquipmentIDTreeGet адаптирует GET /equipment/{id}/tree к GetEquipmentTreeUseCase.
+// При Accept: application/xml поддерево возвращается как B2MML Equipment с EquipmentChild
+func (h *Handler) EquipmentIDTreeGet(ctx context.Context, params api.EquipmentIDTreeGetParams) (api.EquipmentIDTreeGetRes, error) {
+	input := app.GetEquipmentTreeInput{
+		ExternalID: params.ID,
+		Depth:      params.Depth.Or(0),
+	}
+	if asOf, ok := params.AsOf.Get(); ok {
+		input.AsOf = &asOf
+	}
+
+	result, err := h.getEquipmentTreeUC.Execute(ctx, input)
+	if errors.Is(err, model.ErrEquipmentNotFound) {
+		return &api.EquipmentIDTreeGetNotFound{}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	if acceptsXML(ctx) {
+		body, err := encodeB2MMLNoun("Equipment", result.Equipment.ToB2MML())
+		if err != nil {
+			return nil, err
+		}
+		return &api.EquipmentIDTreeGetOKApplicationXML{Data: body}, nil
+	}
+
+	tree := toEquipmentTreeDTO(result.Equipment)
+	return &tree, nil
+}
+
+// EquipmentIDHistoryGet адаптирует GET /equipment/{id}/history к ListEquipmentHistoryUseCase
+func (h *Handler) EquipmentIDHistoryGet(ctx context.Context, params api.EquipmentIDHistoryGetParams) (api.EquipmentIDHistoryGetRes, error) {
+	result, err := h.listHistoryUC.Execute(ctx, app.ListEquipmentHistoryInput{
+		ExternalID: params.ID,
+		Before:     params.Before.Or(0),
+		Limit:      params.Limit.Or(0),
+	})
+	switch {
+	case errors.Is(err, model.ErrEquipmentNotFound):
+		return &api.EquipmentIDHistoryGetNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	items := make(api.EquipmentIDHistoryGetOKApplicationJSON, 0, len(result.Items))
+	for _, r := range result.Items {
+		items = append(items, toEquipmentRevisionDTO(r))
+	}
+	return &items, nil
+}
+
+// EquipmentIDHistoryDiffGet адаптирует GET /equipment/{id}/history/diff к DiffEquipmentRevisionsUseCase
+func (h *Handler) EquipmentIDHistoryDiffGet(ctx context.Context, params api.EquipmentIDHistoryDiffGetParams) (api.EquipmentIDHistoryDiffGetRes, error) {
+	result, err := h.diffHistoryUC.Execute(ctx, app.DiffEquipmentRevisionsInput{
+		ExternalID: params.ID,
+		To:         params.To.Or(0),
+		From:       params.From.Or(0),
+	})
+	switch {
+	case errors.Is(err, model.ErrEquipmentNotFound),
+		errors.Is(err, model.ErrEquipmentRevisionNotFound):
+		return &api.EquipmentIDHistoryDiffGetNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	dto := &api.EquipmentDiff{
+		To:      toEquipmentRevisionDTO(result.To),
+		Changes: make([]api.FieldChange, 0, len(result.Changes)),
+	}
+	if result.From != nil {
+		dto.From = api.NewOptEquipmentRevision(toEquipmentRevisionDTO(result.From))
+	}
+	for _, c := range result.Changes {
+		dto.Changes = append(dto.Changes, toFieldChangeDTO(c))
+	}
+	return dto, nil
+}
+
+// EquipmentIDPropertiesGet адаптирует GET /equipment/{id}/properties к GetEffectivePropertiesUseCase
+func (h *Handler) EquipmentIDPropertiesGet(ctx context.Context, params api.EquipmentIDPropertiesGetParams) (api.EquipmentIDPropertiesGetRes, error) {
+	result, err := h.getPropertiesUC.Execute(ctx, app.GetEffectivePropertiesInput{
+		ExternalID: params.ID,
+		Units:      params.Unit,
+		SiteUnits:  params.SiteUnits.Or(false),
+	})
+	switch {
+	case isUnitError(err):
+		return &api.EquipmentIDPropertiesGetBadRequest{}, nil
+	case errors.Is(err, model.ErrEquipmentNotFound):
+		return &api.EquipmentIDPropertiesGetNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	response := make(api.EquipmentIDPropertiesGetOKApplicationJSON, 0, len(result.Properties))
+	for _, prop := range result.Properties {
+		response = append(response, toEffectivePropertyDTO(prop))
+	}
+	return &response, nil
+}
+
+// EquipmentIDMovePost адаптирует POST /equipment/{id}/move к MoveEquipmentUseCase.
+// Нарушение правил иерархии возвращается как 422 Unprocessable Entity
+func (h *Handler) EquipmentIDMovePost(ctx context.Context, req *api.EquipmentMove, params api.EquipmentIDMovePostParams) (api.EquipmentIDMovePostRes, error) {
+	input := app.MoveEquipmentInput{
+		ExternalID: params.ID,
+		ParentID:   req.ParentID.Or(""),
+	}
+	if ifMatch, ok := params.IfMatch.Get(); ok {
+		expectedVersion, err := parseIfMatch(ifMatch)
+		if err != nil {
+			return &api.EquipmentIDMovePostBadRequest{}, nil
+		}
+		input.ExpectedVersion = expectedVersion
+	}
+
+	result, err := h.moveEquipmentUC.Execute(ctx, input)
+	switch {
+	case errors.Is(err, model.ErrEquipmentNotFound):
+		return &api.EquipmentIDMovePostNotFound{}, nil
+	case errors.Is(err, model.ErrEquipmentVersionConflict):
+		return &api.EquipmentIDMovePostPreconditionFailed{}, nil
+	case errors.Is(err, model.ErrEquipmentHierarchyCycle),
+		errors.Is(err, model.ErrEquipmentLevelOrder):
+		return &api.EquipmentIDMovePostUnprocessableEntity{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if acceptsXML(ctx) {
+		body, err := encodeB2MMLNoun("Equipment", result.Equipment.ToB2MML())
+		if err != nil {
+			return nil, err
+		}
+		return &api.EquipmentIDMovePostOKApplicationXMLHeaders{
+			ETag:     formatETag(result.Equipment.Version()),
+			Response: api.EquipmentIDMovePostOKApplicationXML{Data: body},
+		}, nil
+	}
+
+	return &api.EquipmentTypeHeaders{
+		ETag:     formatETag(result.Equipment.Version()),
+		Response: toEquipmentDTO(result.Equipment),
+	}, nil
 }
 
-// GetEquipment адаптирует запрос ogen к GetEquipmentByIDUseCase
-func (h *Handler) GetEquipment(ctx context.Context, id string) (interface{}, error) {
-	result, err := h.getEquipmentByIDUC.Execute(ctx, app.GetEquipmentByIDInput{
-		ExternalID: id,
+// PersonsGet адаптирует GET /persons к ListPersonsUseCase
+func (h *Handler) PersonsGet(ctx context.Context, params api.PersonsGetParams) ([]api.PersonType, error) {
+	result, err := h.listPersonsUC.Execute(ctx, app.ListPersonsInput{
+		Limit:  params.Limit.Or(0),
+		Offset: params.Offset.Or(0),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	items := make([]api.PersonType, 0, len(result.Items))
+	for _, p := range result.Items {
+		items = append(items, toPersonDTO(p))
+	}
+	return items, nil
+}
+
+// PersonsPost адаптирует POST /persons к CreatePersonUseCase. Тело - PersonType
+// (поле B2MML заменяет остальные поля) или существительное B2MML Person в XML
+func (h *Handler) PersonsPost(ctx context.Context, req api.PersonsPostReq) (api.PersonsPostRes, error) {
+	var (
+		input      app.CreatePersonInput
+		requestXML bool
+	)
+	switch req := req.(type) {
+	case *api.PersonType:
+		data := &b2mml.PersonType{}
+		ok, err := decodeB2MMLJSON(req.B2MML, data)
+		switch {
+		case err != nil:
+			return validationProblem("Invalid person", err), nil
+		case ok && data.ID.String() != req.ID:
+			return idMismatchProblem("ID", req.ID, data.ID.String()), nil
+		case ok:
+			input.Person = data
+		default:
+			input.Person = fromPersonDTO(req)
+		}
+	case *api.PersonsPostReqApplicationXML:
+		requestXML = true
+		input.Person = &b2mml.PersonType{}
+		if err := decodeB2MMLBody(req.Data, "Person", input.Person); err != nil {
+			return validationProblem("Invalid person", err), nil
+		}
+	}
+
+	result, err := h.createPersonUC.Execute(ctx, input)
+	switch {
+	case errors.Is(err, model.ErrPersonIDEmpty):
+		return validationProblem("Invalid person", err), nil
+	case errors.Is(err, model.ErrPersonAlreadyExists):
+		return &api.PersonsPostConflict{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if respondXML(ctx, requestXML) {
+		body, err := encodeB2MMLNoun("Person", result.Person.GetB2MMLData())
+		if err != nil {
+			return nil, err
+		}
+		return &api.PersonsPostCreatedApplicationXML{Data: body}, nil
+	}
+
+	dto := toPersonDTO(result.Person)
+	return &dto, nil
+}
+
+// SearchGet адаптирует GET /search к SearchUseCase
+func (h *Handler) SearchGet(ctx context.Context, params api.SearchGetParams) (api.SearchGetRes, error) {
+	input := app.SearchInput{
+		Query:    params.Q,
+		Language: params.Lang.Or(""),
+		Limit:    params.Limit.Or(0),
+	}
+	for _, kind := range params.Kind {
+		input.Kinds = append(input.Kinds, string(kind))
+	}
+
+	result, err := h.searchUC.Execute(ctx, input)
+	if errors.Is(err, app.ErrInvalidSearchQuery) {
+		return &api.SearchGetBadRequest{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.SearchResults{Items: make([]api.SearchHit, 0, len(result.Items))}
+	for _, hit := range result.Items {
+		response.Items = append(response.Items, toSearchHitDTO(hit))
+	}
+	return response, nil
+}
+
+// UnitsGet адаптирует GET /units к ListUnitsUseCase
+func (h *Handler) UnitsGet(ctx context.Context, params api.UnitsGetParams) ([]api.UnitOfMeasure, error) {
+	result, err := h.listUnitsUC.Execute(ctx, app.ListUnitsInput{Kind: params.Kind.Or("")})
+	if err != nil {
+		return nil, err
+	}
+
+	units := make([]api.UnitOfMeasure, 0, len(result.Units))
+	for _, u := range result.Units {
+		units = append(units, toUnitDTO(u))
+	}
+	return units, nil
+}
+
+// UnitsConvertGet адаптирует GET /units/convert к ConvertUnitUseCase
+func (h *Handler) UnitsConvertGet(ctx context.Context, params api.UnitsConvertGetParams) (api.UnitsConvertGetRes, error) {
+	result, err := h.convertUnitUC.Execute(ctx, app.ConvertUnitInput{
+		Value: params.Value,
+		From:  params.From,
+		To:    params.To,
+	})
+	switch {
+	case isUnitError(err):
+		return &api.UnitsConvertGetBadRequest{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return &api.UnitConversion{
+		Value:       result.Value,
+		Unit:        params.To,
+		SourceValue: params.Value,
+		SourceUnit:  params.From,
+	}, nil
 }
 
-// CreateEquipment адаптирует запрос ogen к CreateEquipmentUseCase
-func (h *Handler) CreateEquipment(ctx context.Context, req interface{}) (interface{}, error) {
-	// Ogen десериализует JSON в сгенерированный тип
-	// Здесь нужно преобразовать к нашему CreateEquipmentInput
-	// При реальной генерации ogen это будет типизировано
-	result, err := h.createEquipmentUC.Execute(ctx, app.CreateEquipmentInput{
-		// Поля будут заполнены из десериализованного req
+// SitesIDUnitsGet адаптирует GET /sites/{id}/units к GetSiteUnitsUseCase
+func (h *Handler) SitesIDUnitsGet(ctx context.Context, params api.SitesIDUnitsGetParams) (api.SitesIDUnitsGetRes, error) {
+	result, err := h.getSiteUnitsUC.Execute(ctx, app.GetSiteUnitsInput{SiteID: params.ID})
+	switch {
+	case errors.Is(err, model.ErrEquipmentNotFound):
+		return &api.SitesIDUnitsGetNotFound{}, nil
+	case errors.Is(err, model.ErrEquipmentNotSite):
+		return &api.SitesIDUnitsGetUnprocessableEntity{}, nil
+	case err != nil:
+		return nil, err
+	}
+	return toSiteUnitsDTO(result), nil
+}
+
+// SitesIDUnitsPut адаптирует PUT /sites/{id}/units к SetSiteUnitsUseCase
+func (h *Handler) SitesIDUnitsPut(ctx context.Context, req *api.SiteUnits, params api.SitesIDUnitsPutParams) (api.SitesIDUnitsPutRes, error) {
+	result, err := h.setSiteUnitsUC.Execute(ctx, app.SetSiteUnitsInput{
+		SiteID: params.ID,
+		Units:  req.Units,
 	})
+	switch {
+	case isUnitError(err):
+		return &api.SitesIDUnitsPutBadRequest{}, nil
+	case errors.Is(err, model.ErrEquipmentNotFound):
+		return &api.SitesIDUnitsPutNotFound{}, nil
+	case errors.Is(err, model.ErrEquipmentNotSite):
+		return &api.SitesIDUnitsPutUnprocessableEntity{}, nil
+	case err != nil:
+		return nil, err
+	}
+	return toSiteUnitsDTO(result), nil
+}
+
+// WebhooksGet адаптирует GET /webhooks к ListWebhooksUseCase
+func (h *Handler) WebhooksGet(ctx context.Context) ([]api.Webhook, error) {
+	result, err := h.listWebhooksUC.Execute(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	items := make([]api.Webhook, 0, len(result.Items))
+	for _, w := range result.Items {
+		items = append(items, *toWebhookDTO(w, false))
+	}
+	return items, nil
+}
+
+// WebhooksPost адаптирует POST /webhooks к CreateWebhookUseCase
+func (h *Handler) WebhooksPost(ctx context.Context, req *api.Webhook) (api.WebhooksPostRes, error) {
+	input := app.CreateWebhookInput{
+		URL:         req.URL,
+		Secret:      req.Secret.Or(""),
+		EventTypes:  req.EventTypes,
+		Description: req.Description.Or(""),
+	}
+	if active, ok := req.Active.Get(); ok {
+		input.Active = &active
+	}
+
+	result, err := h.createWebhookUC.Execute(ctx, input)
+	switch {
+	case isWebhookValidationError(err):
+		return &api.WebhooksPostBadRequest{}, nil
+	case err != nil:
+		return nil, err
+	}
+	return toWebhookDTO(result.Subscription, true), nil
+}
+
+// WebhooksIDGet адаптирует GET /webhooks/{id} к GetWebhookUseCase
+func (h *Handler) WebhooksIDGet(ctx context.Context, params api.WebhooksIDGetParams) (api.WebhooksIDGetRes, error) {
+	result, err := h.getWebhookUC.Execute(ctx, app.GetWebhookInput{ID: params.ID})
+	switch {
+	case errors.Is(err, model.ErrWebhookNotFound):
+		return &api.WebhooksIDGetNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+	return toWebhookDTO(result.Subscription, false), nil
+}
+
+// WebhooksIDPut адаптирует PUT /webhooks/{id} к UpdateWebhookUseCase
+func (h *Handler) WebhooksIDPut(ctx context.Context, req *api.Webhook, params api.WebhooksIDPutParams) (api.WebhooksIDPutRes, error) {
+	result, err := h.updateWebhookUC.Execute(ctx, app.UpdateWebhookInput{
+		ID:          params.ID,
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		Description: req.Description.Or(""),
+		Active:      req.Active.Or(true),
+	})
+	switch {
+	case isWebhookValidationError(err):
+		return &api.WebhooksIDPutBadRequest{}, nil
+	case errors.Is(err, model.ErrWebhookNotFound):
+		return &api.WebhooksIDPutNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+	return toWebhookDTO(result.Subscription, false), nil
+}
+
+// WebhooksIDDelete адаптирует DELETE /webhooks/{id} к DeleteWebhookUseCase
+func (h *Handler) WebhooksIDDelete(ctx context.Context, params api.WebhooksIDDeleteParams) (api.WebhooksIDDeleteRes, error) {
+	err := h.deleteWebhookUC.Execute(ctx, app.DeleteWebhookInput{ID: params.ID})
+	switch {
+	case errors.Is(err, model.ErrWebhookNotFound):
+		return &api.WebhooksIDDeleteNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+	return &api.WebhooksIDDeleteNoContent{}, nil
+}
+
+// WebhooksIDPingPost адаптирует POST /webhooks/{id}/ping к PingWebhookUseCase
+func (h *Handler) WebhooksIDPingPost(ctx context.Context, params api.WebhooksIDPingPostParams) (api.WebhooksIDPingPostRes, error) {
+	result, err := h.pingWebhookUC.Execute(ctx, app.PingWebhookInput{ID: params.ID})
+	switch {
+	case errors.Is(err, model.ErrWebhookNotFound):
+		return &api.WebhooksIDPingPostNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	dto := &api.WebhookPingResult{
+		Delivered:  result.Delivered,
+		DurationMs: result.Duration.Milliseconds(),
+	}
+	if result.StatusCode != 0 {
+		dto.StatusCode = api.NewOptInt(result.StatusCode)
+	}
+	if result.Error != "" {
+		dto.Error = api.NewOptString(result.Error)
+	}
+	return dto, nil
+}
+
+// WebhooksIDDeliveriesGet адаптирует GET /webhooks/{id}/deliveries к ListWebhookDeliveriesUseCase
+func (h *Handler) WebhooksIDDeliveriesGet(ctx context.Context, params api.WebhooksIDDeliveriesGetParams) (api.WebhooksIDDeliveriesGetRes, error) {
+	result, err := h.listDeliveriesUC.Execute(ctx, app.ListWebhookDeliveriesInput{
+		SubscriptionID: params.ID,
+		Status:         string(params.Status.Or("")),
+		Limit:          params.Limit.Or(0),
+	})
+	switch {
+	case errors.Is(err, model.ErrWebhookNotFound):
+		return &api.WebhooksIDDeliveriesGetNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	items := make(api.WebhooksIDDeliveriesGetOKApplicationJSON, 0, len(result.Items))
+	for _, d := range result.Items {
+		items = append(items, toWebhookDeliveryDTO(d))
+	}
+	return &items, nil
+}
+
+// WebhooksIDDeliveriesDeliveryIdReplayPost адаптирует
+// POST /webhooks/{id}/deliveries/{deliveryId}/replay к ReplayWebhookDeliveryUseCase
+func (h *Handler) WebhooksIDDeliveriesDeliveryIdReplayPost(ctx context.Context, params api.WebhooksIDDeliveriesDeliveryIdReplayPostParams) (api.WebhooksIDDeliveriesDeliveryIdReplayPostRes, error) {
+	result, err := h.replayDeliveryUC.Execute(ctx, app.ReplayWebhookDeliveryInput{
+		SubscriptionID: params.ID,
+		DeliveryID:     params.DeliveryId,
+	})
+	switch {
+	case errors.Is(err, model.ErrWebhookDeliveryNotFound):
+		return &api.WebhooksIDDeliveriesDeliveryIdReplayPostNotFound{}, nil
+	case errors.Is(err, model.ErrWebhookDeliveryNotDead):
+		return &api.WebhooksIDDeliveriesDeliveryIdReplayPostConflict{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	dto := toWebhookDeliveryDTO(result.Delivery)
+	return &dto, nil
 }
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	api "github.com/grnsv/go-cmms/internal/api/ogen"
+	"github.com/grnsv/go-cmms/internal/app"
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// EquipmentImportsPost адаптирует POST /equipment/imports к EquipmentImporter.Create
+func (h *Handler) EquipmentImportsPost(ctx context.Context, req api.EquipmentImportsPostReq, params api.EquipmentImportsPostParams) (api.EquipmentImportsPostRes, error) {
+	input := app.CreateImportInput{
+		Delimiter: params.Delimiter.Or(""),
+		DryRun:    params.DryRun.Or(false),
+		ChunkSize: params.ChunkSize.Or(0),
+	}
+	var source io.Reader
+	switch req := req.(type) {
+	case *api.EquipmentImportsPostReqTextCsv:
+		input.Format, source = string(model.ImportFormatCSV), req.Data
+	case *api.EquipmentImportsPostReqApplicationXNdjson:
+		input.Format, source = string(model.ImportFormatJSONL), req.Data
+	}
+	input.Source = source
+
+	mapping, err := parseImportMapping(params.Mapping)
+	if err != nil {
+		return validationProblem("Invalid import", err), nil
+	}
+	input.Mapping = mapping
+
+	job, err := h.importer.Create(ctx, input)
+	switch {
+	case errors.Is(err, model.ErrImportInvalidFormat),
+		errors.Is(err, model.ErrImportInvalidMapping):
+		return validationProblem("Invalid import", err), nil
+	case errors.Is(err, model.ErrImportSourceTooLarge):
+		return &api.EquipmentImportsPostRequestEntityTooLarge{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	dto := toImportJobDTO(job)
+	return &dto, nil
+}
+
+// parseImportMapping разбирает сопоставления колонок вида колонка=поле
+func parseImportMapping(values []string) (map[string]string, error) {
+	mapping := make(map[string]string, len(values))
+	for _, value := range values {
+		// Имя поля не содержит "=", имя колонки может содержать
+		i := strings.LastIndexByte(value, '=')
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %q is not column=field", model.ErrImportInvalidMapping, value)
+		}
+		column, target := strings.TrimSpace(value[:i]), strings.TrimSpace(value[i+1:])
+		if _, ok := mapping[column]; ok {
+			return nil, fmt.Errorf("%w: column %q is mapped twice", model.ErrImportInvalidMapping, column)
+		}
+		mapping[column] = target
+	}
+	return mapping, nil
+}
+
+// EquipmentImportsImportIdGet адаптирует GET /equipment/imports/{importId} к EquipmentImporter.Get
+func (h *Handler) EquipmentImportsImportIdGet(ctx context.Context, params api.EquipmentImportsImportIdGetParams) (api.EquipmentImportsImportIdGetRes, error) {
+	job, err := h.importer.Get(ctx, params.ImportId)
+	switch {
+	case errors.Is(err, model.ErrImportJobNotFound):
+		return &api.EquipmentImportsImportIdGetNotFound{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	dto := toImportJobDTO(job)
+	return &dto, nil
+}
+
+// EquipmentImportsImportIdResumePost адаптирует
+// POST /equipment/imports/{importId}/resume к EquipmentImporter.Resume
+func (h *Handler) EquipmentImportsImportIdResumePost(ctx context.Context, params api.EquipmentImportsImportIdResumePostParams) (api.EquipmentImportsImportIdResumePostRes, error) {
+	job, err := h.importer.Resume(ctx, params.ImportId, false)
+	switch {
+	case errors.Is(err, model.ErrImportJobNotFound):
+		return &api.EquipmentImportsImportIdResumePostNotFound{}, nil
+	case errors.Is(err, model.ErrImportJobNotResumable):
+		return &api.EquipmentImportsImportIdResumePostConflict{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	dto := toImportJobDTO(job)
+	return &dto, nil
+}
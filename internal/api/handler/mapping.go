@@ -0,0 +1,571 @@
+package handler
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	api "github.com/grnsv/go-cmms/internal/api/ogen"
+	"github.com/grnsv/go-cmms/internal/app"
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// toEquipmentDTO преобразует агрегат Equipment в DTO API. Поле B2MML
+// передаётся без дочернего оборудования: вложенность отдают только дерево
+// и XML-представление
+func toEquipmentDTO(e *model.Equipment) api.EquipmentType {
+	dto := api.EquipmentType{
+		EquipmentID: api.NewOptString(e.ID().String()),
+	}
+	if class := e.Class(); class != nil {
+		dto.EquipmentType = api.NewOptString(class.ID().String())
+	}
+	if description := equipmentDescription(e); description != "" {
+		dto.Description = api.NewOptString(description)
+	}
+	if status := e.GetOperatingStatus(); status != "" {
+		dto.OperatingStatus = api.NewOptEquipmentTypeOperatingStatus(api.EquipmentTypeOperatingStatus(status))
+	}
+
+	for _, prop := range e.Properties() {
+		value := prop.Value().Value()
+		switch prop.ID().String() {
+		case app.PropertyManufacturer:
+			dto.Manufacturer = api.NewOptString(value)
+		case app.PropertyModel:
+			dto.Model = api.NewOptString(value)
+		case app.PropertySerialNumber:
+			dto.SerialNumber = api.NewOptString(value)
+		case app.PropertyInstallationDate:
+			if t, err := time.Parse(time.RFC3339, value); err == nil {
+				dto.InstallationDate = api.NewOptDateTime(t)
+			}
+		default:
+			dto.Properties = append(dto.Properties, toPropertyValueDTO(prop.ID(), prop.Value()))
+		}
+	}
+	noun := e.ToB2MML()
+	noun.EquipmentChild = nil
+	dto.B2MML = encodeB2MMLJSON(noun)
+	return dto
+}
+
+// toPropertyValueDTO преобразует значение свойства оборудования в DTO API
+func toPropertyValueDTO(id model.EquipmentPropertyID, value model.PropertyValue) api.PropertyValue {
+	dto := api.PropertyValue{ID: id.String(), Value: value.Value()}
+	if value.DataType() != "" {
+		dto.DataType = api.NewOptString(value.DataType())
+	}
+	if value.Unit() != "" {
+		dto.Unit = api.NewOptString(value.Unit())
+	}
+	return dto
+}
+
+// equipmentFields поля EquipmentType, доступные для выборки, и их сброс
+var equipmentFields = map[string]func(dto *api.EquipmentType){
+	"EquipmentID":        func(dto *api.EquipmentType) { dto.EquipmentID.Reset() },
+	"EquipmentType":      func(dto *api.EquipmentType) { dto.EquipmentType.Reset() },
+	"Description":        func(dto *api.EquipmentType) { dto.Description.Reset() },
+	"OperatingStatus":    func(dto *api.EquipmentType) { dto.OperatingStatus.Reset() },
+	"Manufacturer":       func(dto *api.EquipmentType) { dto.Manufacturer.Reset() },
+	"Model":              func(dto *api.EquipmentType) { dto.Model.Reset() },
+	"SerialNumber":       func(dto *api.EquipmentType) { dto.SerialNumber.Reset() },
+	"InstallationDate":   func(dto *api.EquipmentType) { dto.InstallationDate.Reset() },
+	"Location":           func(dto *api.EquipmentType) { dto.Location.Reset() },
+	"MaintenanceHistory": func(dto *api.EquipmentType) { dto.MaintenanceHistory.Reset() },
+	"PerformanceData":    func(dto *api.EquipmentType) { dto.PerformanceData.Reset() },
+	"Properties":         func(dto *api.EquipmentType) { dto.Properties = nil },
+	"B2MML":              func(dto *api.EquipmentType) { dto.B2MML = nil },
+}
+
+// parseEquipmentFields проверяет список запрошенных полей.
+// Пустой список означает все поля
+func parseEquipmentFields(fields []string) (map[string]bool, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	selected := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if _, ok := equipmentFields[f]; !ok {
+			return nil, fmt.Errorf("unknown equipment field %q", f)
+		}
+		selected[f] = true
+	}
+	return selected, nil
+}
+
+// selectEquipmentFields оставляет в DTO только выбранные поля
+func selectEquipmentFields(dto *api.EquipmentType, fields map[string]bool) {
+	if fields == nil {
+		return
+	}
+	for name, reset := range equipmentFields {
+		if !fields[name] {
+			reset(dto)
+		}
+	}
+}
+
+// toEquipmentTreeDTO преобразует оборудование с загруженным поддеревом в DTO API
+func toEquipmentTreeDTO(e *model.Equipment) api.EquipmentTreeNode {
+	node := api.EquipmentTreeNode{
+		Equipment: toEquipmentDTO(e),
+		Children:  make([]api.EquipmentTreeNode, 0, len(e.Children())),
+	}
+	// В дереве B2MML узла включает его загруженное поддерево
+	node.Equipment.B2MML = encodeB2MMLJSON(e.ToB2MML())
+	for _, child := range e.Children() {
+		node.Children = append(node.Children, toEquipmentTreeDTO(child))
+	}
+	return node
+}
+
+// toEffectivePropertyDTO преобразует свойство эффективного листа в DTO API
+func toEffectivePropertyDTO(prop *model.EffectiveProperty) api.EffectiveProperty {
+	dto := api.EffectiveProperty{
+		ID:     prop.ID.String(),
+		Status: api.EffectivePropertyStatus(prop.Status),
+	}
+	optString := func(s string) api.OptString {
+		if s == "" {
+			return api.OptString{}
+		}
+		return api.NewOptString(s)
+	}
+	value := prop.Value
+	dto.Value = optString(value.Value())
+	dto.DataType = optString(value.DataType())
+	dto.Unit = optString(value.Unit())
+	dto.Description = optString(value.Description())
+	if prop.DefinedBy != nil {
+		dto.Class = api.NewOptString(prop.DefinedBy.ID().String())
+	}
+	if prop.Definition != nil {
+		if data := prop.Definition.GetB2MMLData(); data != nil {
+			if !dto.Description.Set {
+				dto.Description = optString(strings.Join(descriptionsToDTO(data.Description), " "))
+			}
+			if data.PropertyType != nil {
+				dto.PropertyType = optString(data.PropertyType.Value)
+			}
+		}
+	}
+	return dto
+}
+
+// toEquipmentList преобразует страницу оборудования в DTO API
+func toEquipmentList(result *app.ListEquipmentOutput, fields map[string]bool) *api.EquipmentList {
+	list := &api.EquipmentList{Items: make([]api.EquipmentType, 0, len(result.Items))}
+	for _, e := range result.Items {
+		dto := toEquipmentDTO(e)
+		selectEquipmentFields(&dto, fields)
+		list.Items = append(list.Items, dto)
+	}
+	if result.NextCursor != "" {
+		list.NextCursor = api.NewOptString(result.NextCursor)
+	}
+	if result.PrevCursor != "" {
+		list.PrevCursor = api.NewOptString(result.PrevCursor)
+	}
+	return list
+}
+
+// propertyInputs извлекает паспортные данные и свойства из DTO в виде свойств оборудования.
+// Тип паспортных строковых данных не задаётся, чтобы его определял класс
+func propertyInputs(dto *api.EquipmentType) []app.PropertyInput {
+	var props []app.PropertyInput
+	add := func(id string, value api.OptString) {
+		if v, ok := value.Get(); ok {
+			props = append(props, app.PropertyInput{ID: id, Value: v})
+		}
+	}
+	add(app.PropertyManufacturer, dto.Manufacturer)
+	add(app.PropertyModel, dto.Model)
+	add(app.PropertySerialNumber, dto.SerialNumber)
+	if t, ok := dto.InstallationDate.Get(); ok {
+		props = append(props, app.PropertyInput{
+			ID:       app.PropertyInstallationDate,
+			Value:    t.Format(time.RFC3339),
+			DataType: "datetime",
+		})
+	}
+	for _, prop := range dto.Properties {
+		props = append(props, app.PropertyInput{
+			ID:       prop.ID,
+			Value:    prop.Value,
+			DataType: prop.DataType.Or(""),
+			Unit:     prop.Unit.Or(""),
+		})
+	}
+	return props
+}
+
+// formatETag формирует значение заголовка ETag из версии агрегата
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseIfMatch извлекает ожидаемую версию из заголовка If-Match.
+// Для "*" возвращает nil (безусловное обновление)
+func parseIfMatch(value string) (*int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "*" {
+		return nil, nil
+	}
+	value = strings.TrimPrefix(value, "W/")
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid If-Match header %q", value)
+	}
+	return &version, nil
+}
+
+// equipmentDescription возвращает первое описание оборудования из B2MML
+func equipmentDescription(e *model.Equipment) string {
+	data := e.GetB2MMLData()
+	if data == nil {
+		return ""
+	}
+	for _, d := range data.Description {
+		if d != nil && d.Value != "" {
+			return d.Value
+		}
+	}
+	return ""
+}
+
+// toSearchHitDTO преобразует результат поиска в DTO API
+func toSearchHitDTO(hit *repository.SearchHit) api.SearchHit {
+	dto := api.SearchHit{
+		Kind:     api.SearchHitKind(hit.Kind),
+		ID:       hit.ID,
+		Headline: headlineHTML(hit.Highlight),
+		Rank:     hit.Rank,
+	}
+	if hit.EquipmentID != "" {
+		dto.EquipmentID = api.NewOptString(hit.EquipmentID)
+	}
+	if hit.Title != "" {
+		dto.Title = api.NewOptString(hit.Title)
+	}
+	return dto
+}
+
+// headlineHTML собирает HTML-фрагмент с совпадениями в тегах mark.
+// Текст экранируется: описания вводятся пользователями
+func headlineHTML(segments []repository.HighlightSegment) string {
+	var b strings.Builder
+	for _, s := range segments {
+		if s.Match {
+			b.WriteString("<mark>" + html.EscapeString(s.Text) + "</mark>")
+		} else {
+			b.WriteString(html.EscapeString(s.Text))
+		}
+	}
+	return b.String()
+}
+
+// toPersonDTO преобразует агрегат Person в DTO API
+func toPersonDTO(p *model.Person) api.PersonType {
+	dto := api.PersonType{ID: p.ID().String()}
+	data := p.GetB2MMLData()
+	if data == nil {
+		return dto
+	}
+
+	if data.Version != nil {
+		dto.Version = api.NewOptString(data.Version.Value)
+	}
+	if name := p.Name(); name != "" {
+		dto.PersonName = api.NewOptString(name)
+	}
+	dto.Description = descriptionsToDTO(data.Description)
+	dto.PublishedDate = dateTimeToDTO(data.PublishedDate)
+	dto.EffectiveStartDate = dateTimeToDTO(data.EffectiveStartDate)
+	dto.EffectiveEndDate = dateTimeToDTO(data.EffectiveEndDate)
+	dto.PersonProperty = personPropertiesToDTO(data.PersonProperty)
+	dto.PersonnelClassID = identifiersToDTO(data.PersonnelClassID)
+	dto.TestSpecificationID = identifiersToDTO(data.TestSpecificationID)
+	dto.B2MML = encodeB2MMLJSON(data)
+	return dto
+}
+
+// fromPersonDTO преобразует DTO API в B2MML структуру Person
+func fromPersonDTO(dto *api.PersonType) *b2mml.PersonType {
+	data := &b2mml.PersonType{
+		ID:                  &b2mml.IdentifierType{Value: dto.ID},
+		Description:         descriptionsFromDTO(dto.Description),
+		PublishedDate:       dateTimeFromDTO(dto.PublishedDate),
+		EffectiveStartDate:  dateTimeFromDTO(dto.EffectiveStartDate),
+		EffectiveEndDate:    dateTimeFromDTO(dto.EffectiveEndDate),
+		PersonProperty:      personPropertiesFromDTO(dto.PersonProperty),
+		PersonnelClassID:    identifiersFromDTO(dto.PersonnelClassID),
+		TestSpecificationID: identifiersFromDTO(dto.TestSpecificationID),
+	}
+	if v, ok := dto.Version.Get(); ok {
+		data.Version = &b2mml.IdentifierType{Value: v}
+	}
+	if name, ok := dto.PersonName.Get(); ok {
+		data.PersonName = &b2mml.PersonNameType{Value: name}
+	}
+	return data
+}
+
+func personPropertiesToDTO(props []*b2mml.PersonPropertyType) []api.PersonPropertyType {
+	var dto []api.PersonPropertyType
+	for _, prop := range props {
+		if prop == nil || prop.ID == nil {
+			continue
+		}
+		p := api.PersonPropertyType{
+			ID:                  prop.ID.Value,
+			Description:         descriptionsToDTO(prop.Description),
+			PersonPropertyChild: personPropertiesToDTO(prop.PersonPropertyChild),
+		}
+		if prop.PersonnelClassPropertyID != nil {
+			p.PersonnelClassPropertyID = api.NewOptString(prop.PersonnelClassPropertyID.Value)
+		}
+		for _, v := range prop.Value {
+			if v == nil {
+				continue
+			}
+			var value api.ValueType
+			if v.ValueString != nil {
+				value.Value = api.NewOptString(v.ValueString.Value)
+			}
+			if v.UnitOfMeasure != nil {
+				value.UnitOfMeasure = api.NewOptString(v.UnitOfMeasure.Value)
+			}
+			p.Value = append(p.Value, value)
+		}
+		dto = append(dto, p)
+	}
+	return dto
+}
+
+func personPropertiesFromDTO(props []api.PersonPropertyType) []*b2mml.PersonPropertyType {
+	var data []*b2mml.PersonPropertyType
+	for _, prop := range props {
+		p := &b2mml.PersonPropertyType{
+			ID:                  &b2mml.IdentifierType{Value: prop.ID},
+			Description:         descriptionsFromDTO(prop.Description),
+			PersonPropertyChild: personPropertiesFromDTO(prop.PersonPropertyChild),
+		}
+		if id, ok := prop.PersonnelClassPropertyID.Get(); ok {
+			p.PersonnelClassPropertyID = &b2mml.IdentifierType{Value: id}
+		}
+		for _, v := range prop.Value {
+			value := &b2mml.ValueType{}
+			if s, ok := v.Value.Get(); ok {
+				value.ValueString = &b2mml.ValueStringType{Value: s}
+			}
+			if unit, ok := v.UnitOfMeasure.Get(); ok {
+				value.UnitOfMeasure = &b2mml.UnitOfMeasureType{Value: unit}
+			}
+			p.Value = append(p.Value, value)
+		}
+		data = append(data, p)
+	}
+	return data
+}
+
+func descriptionsToDTO(descriptions []*b2mml.DescriptionType) []string {
+	var dto []string
+	for _, d := range descriptions {
+		if d != nil {
+			dto = append(dto, d.Value)
+		}
+	}
+	return dto
+}
+
+func descriptionsFromDTO(descriptions []string) []*b2mml.DescriptionType {
+	var data []*b2mml.DescriptionType
+	for _, d := range descriptions {
+		data = append(data, &b2mml.DescriptionType{Value: d})
+	}
+	return data
+}
+
+func identifiersToDTO(ids []*b2mml.IdentifierType) []string {
+	var dto []string
+	for _, id := range ids {
+		if id != nil {
+			dto = append(dto, id.Value)
+		}
+	}
+	return dto
+}
+
+func identifiersFromDTO(ids []string) []*b2mml.IdentifierType {
+	var data []*b2mml.IdentifierType
+	for _, id := range ids {
+		data = append(data, &b2mml.IdentifierType{Value: id})
+	}
+	return data
+}
+
+func dateTimeToDTO(dt *b2mml.DateTimeType) api.OptDateTime {
+	if dt == nil {
+		return api.OptDateTime{}
+	}
+	t, err := time.Parse(time.RFC3339, dt.Value)
+	if err != nil {
+		return api.OptDateTime{}
+	}
+	return api.NewOptDateTime(t)
+}
+
+func dateTimeFromDTO(dt api.OptDateTime) *b2mml.DateTimeType {
+	t, ok := dt.Get()
+	if !ok {
+		return nil
+	}
+	return &b2mml.DateTimeType{Value: t.Format(time.RFC3339)}
+}
+
+// toUnitDTO преобразует единицу измерения в DTO API
+func toUnitDTO(u *model.Unit) api.UnitOfMeasure {
+	dto := api.UnitOfMeasure{
+		Code:      u.Code,
+		Name:      u.Name,
+		Kind:      string(u.Kind()),
+		Dimension: u.Dimension.String(),
+	}
+	if u.UNECE != "" {
+		dto.UneceCode = api.NewOptString(u.UNECE)
+	}
+	return dto
+}
+
+// toSiteUnitsDTO преобразует предпочтительные единицы площадки в DTO API
+func toSiteUnitsDTO(result *app.SiteUnitsOutput) *api.SiteUnits {
+	units := make(api.SiteUnitsUnits, len(result.Units))
+	for kind, code := range result.Units {
+		units[string(kind)] = code
+	}
+	return &api.SiteUnits{
+		SiteID: api.NewOptString(result.SiteID),
+		Units:  units,
+	}
+}
+
+// toWebhookDTO преобразует подписку в DTO; secret выводится только при создании
+func toWebhookDTO(w *model.WebhookSubscription, withSecret bool) *api.Webhook {
+	dto := &api.Webhook{
+		ID:         api.NewOptUUID(w.ID()),
+		URL:        w.URL(),
+		EventTypes: w.EventTypes(),
+		Active:     api.NewOptBool(w.Active()),
+		CreatedAt:  api.NewOptDateTime(w.CreatedAt()),
+		UpdatedAt:  api.NewOptDateTime(w.UpdatedAt()),
+	}
+	if w.Description() != "" {
+		dto.Description = api.NewOptString(w.Description())
+	}
+	if withSecret {
+		dto.Secret = api.NewOptString(w.Secret())
+	}
+	return dto
+}
+
+func toWebhookDeliveryDTO(d *repository.WebhookDelivery) api.WebhookDelivery {
+	dto := api.WebhookDelivery{
+		ID:        d.ID,
+		EventID:   d.EventID,
+		EventType: d.EventType,
+		Status:    api.WebhookDeliveryStatus(d.Status),
+		Attempts:  d.Attempts,
+		CreatedAt: d.CreatedAt,
+	}
+	switch {
+	case d.DeliveredAt != nil:
+		dto.DeliveredAt = api.NewOptDateTime(*d.DeliveredAt)
+	case d.Status == model.WebhookDeliveryPending:
+		dto.NextAttemptAt = api.NewOptDateTime(d.NextAttemptAt)
+	}
+	if d.LastError != "" {
+		dto.LastError = api.NewOptString(d.LastError)
+	}
+	if d.ResponseStatus != 0 {
+		dto.ResponseStatus = api.NewOptInt(d.ResponseStatus)
+	}
+	var payload api.WebhookEvent
+	if err := payload.UnmarshalJSON(d.Payload); err == nil {
+		dto.Payload = api.NewOptWebhookEvent(payload)
+	}
+	return dto
+}
+
+// toImportJobDTO преобразует задание импорта в DTO API
+func toImportJobDTO(job *repository.ImportJob) api.ImportJob {
+	dto := api.ImportJob{
+		ID:            job.ID,
+		Status:        api.ImportJobStatus(job.Status),
+		Format:        api.ImportJobFormat(job.Format),
+		Mapping:       api.NewOptImportJobMapping(job.Mapping),
+		DryRun:        job.DryRun,
+		ChunkSize:     job.ChunkSize,
+		RowsProcessed: job.RowsProcessed,
+		RowsImported:  job.RowsImported,
+		ErrorCount:    job.ErrorCount,
+		RowErrors:     make([]api.ImportRowError, 0, len(job.RowErrors)),
+		CreatedAt:     job.CreatedAt,
+		UpdatedAt:     api.NewOptDateTime(job.UpdatedAt),
+	}
+	for _, e := range job.RowErrors {
+		rowErr := api.ImportRowError{Row: e.Row, Message: e.Message}
+		if e.Line != 0 {
+			rowErr.Line = api.NewOptInt64(e.Line)
+		}
+		if e.EquipmentID != "" {
+			rowErr.EquipmentID = api.NewOptString(e.EquipmentID)
+		}
+		if e.Column != "" {
+			rowErr.Column = api.NewOptString(e.Column)
+		}
+		dto.RowErrors = append(dto.RowErrors, rowErr)
+	}
+	if job.Error != "" {
+		dto.Error = api.NewOptString(job.Error)
+	}
+	if job.FinishedAt != nil {
+		dto.FinishedAt = api.NewOptDateTime(*job.FinishedAt)
+	}
+	return dto
+}
+
+func toEquipmentRevisionDTO(r *repository.EquipmentRevision) api.EquipmentRevision {
+	dto := api.EquipmentRevision{
+		Revision:      r.Revision,
+		RecordVersion: r.RecordVersion,
+		Operation:     api.EquipmentRevisionOperation(r.Operation),
+		ChangedAt:     r.ChangedAt,
+	}
+	if r.ChangedBy != "" {
+		dto.ChangedBy = api.NewOptString(r.ChangedBy)
+	}
+	if r.Reason != "" {
+		dto.Reason = api.NewOptString(r.Reason)
+	}
+	return dto
+}
+
+func toFieldChangeDTO(c model.FieldChange) api.FieldChange {
+	dto := api.FieldChange{Field: c.Field}
+	if c.Old != nil {
+		dto.Old = api.NewOptString(*c.Old)
+	}
+	if c.New != nil {
+		dto.New = api.NewOptString(*c.New)
+	}
+	return dto
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// Заголовки, из которых берутся автор и причина изменения для истории оборудования
+const (
+	ActorHeader        = "X-CMMS-Actor"
+	ChangeReasonHeader = "X-CMMS-Change-Reason"
+)
+
+// WithChangeInfo передаёт автора и причину изменения из заголовков запроса
+// в контекст, откуда их читает репозиторий при записи ревизии
+func WithChangeInfo(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info := repository.ChangeInfo{
+			Actor:  r.Header.Get(ActorHeader),
+			Reason: r.Header.Get(ChangeReasonHeader),
+		}
+		if info.Actor != "" || info.Reason != "" {
+			r = r.WithContext(repository.WithChangeInfo(r.Context(), info))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// acceptKey ключ контекста с качеством форматов ответа из Accept
+type acceptKey struct{}
+
+// mediaQuality качество (q) XML и JSON в заголовках Accept
+type mediaQuality struct {
+	xml, json float64
+}
+
+// WithAccept передаёт в контекст качество форматов ответа из заголовка Accept.
+// Операции с XML-вариантом ответа выбирают формат через acceptsXML, acceptsJSON
+// и respondXML
+func WithAccept(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := acceptQuality(r.Header.Values("Accept"))
+		r = r.WithContext(context.WithValue(r.Context(), acceptKey{}, q))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// acceptsXML сообщает, что клиент предпочитает ответ в B2MML XML; при равном
+// качестве и для диапазонов */*, application/* выбирается JSON
+func acceptsXML(ctx context.Context) bool {
+	q, _ := ctx.Value(acceptKey{}).(mediaQuality)
+	return q.xml > q.json
+}
+
+// respondXML сообщает, что ответ на запрос с телом в XML (requestXML) или JSON
+// выдаётся в XML: клиент предпочитает XML, либо прислал XML и не предпочитает JSON
+func respondXML(ctx context.Context, requestXML bool) bool {
+	return acceptsXML(ctx) || requestXML && !acceptsJSON(ctx)
+}
+
+// acceptsJSON сообщает, что клиент предпочитает JSON, для операций, которые
+// по умолчанию отвечают XML
+func acceptsJSON(ctx context.Context) bool {
+	q, _ := ctx.Value(acceptKey{}).(mediaQuality)
+	return q.json > q.xml
+}
+
+// acceptQuality возвращает качество XML (application/xml, text/xml) и JSON
+// (application/json, application/problem+json) в заголовках Accept.
+// Диапазоны */* и application/* относятся к обоим форматам
+func acceptQuality(accept []string) mediaQuality {
+	var quality mediaQuality
+	for _, header := range accept {
+		for _, item := range strings.Split(header, ",") {
+			mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(item))
+			if err != nil {
+				continue
+			}
+			q := 1.0
+			if v, ok := params["q"]; ok {
+				if q, err = strconv.ParseFloat(v, 64); err != nil {
+					continue
+				}
+			}
+			switch mediaType {
+			case "application/xml", "text/xml":
+				quality.xml = max(quality.xml, q)
+			case "application/json", "application/problem+json":
+				quality.json = max(quality.json, q)
+			case "application/*", "*/*":
+				quality.xml = max(quality.xml, q)
+				quality.json = max(quality.json, q)
+			}
+		}
+	}
+	return quality
+}
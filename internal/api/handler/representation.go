@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-faster/jx"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+)
+
+// b2mmlJSONField поле DTO с существительным B2MML в каноническом JSON
+const b2mmlJSONField = "/B2MML"
+
+// decodeB2MMLBody читает из тела запроса существительное B2MML с корневым
+// элементом name и проверяет его по схеме. Ошибки оборачиваются в
+// model.ErrB2MMLMalformed
+func decodeB2MMLBody(r io.Reader, name string, noun any) error {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	var root struct{ XMLName xml.Name }
+	if err := xml.Unmarshal(body, &root); err != nil {
+		return fmt.Errorf("%w: %v", model.ErrB2MMLMalformed, err)
+	}
+	if root.XMLName.Local != name {
+		return fmt.Errorf("%w: root element %s, expected %s", model.ErrB2MMLMalformed, root.XMLName.Local, name)
+	}
+	if err := b2mml.Validate(bytes.NewReader(body), noun); err != nil {
+		return fmt.Errorf("%w: %w", model.ErrB2MMLMalformed, err)
+	}
+	if err := xml.Unmarshal(body, noun); err != nil {
+		return fmt.Errorf("%w: %v", model.ErrB2MMLMalformed, err)
+	}
+	return nil
+}
+
+// decodeB2MMLJSON заполняет noun из поля B2MML DTO; false - поле не задано.
+// Пути нарушений схемы указываются от поля B2MML
+func decodeB2MMLJSON(raw jx.Raw, noun any) (bool, error) {
+	if len(raw) == 0 || jx.DecodeBytes(raw).Next() == jx.Null {
+		return false, nil
+	}
+	err := b2mml.UnmarshalJSON(raw, noun)
+	var verr *b2mml.ValidationError
+	switch {
+	case errors.As(err, &verr):
+		for i := range verr.Violations {
+			verr.Violations[i].Path = b2mmlJSONField + verr.Violations[i].Path
+		}
+		return true, fmt.Errorf("%w: %w", model.ErrB2MMLMalformed, err)
+	case err != nil:
+		return true, fmt.Errorf("%w: %s: %v", model.ErrB2MMLMalformed, b2mmlJSONField, err)
+	}
+	return true, nil
+}
+
+// encodeB2MMLJSON возвращает существительное B2MML в каноническом JSON для
+// поля B2MML DTO; nil - существительное не кодируется
+func encodeB2MMLJSON(noun any) jx.Raw {
+	data, err := b2mml.MarshalJSON(noun)
+	if err != nil {
+		return nil
+	}
+	return data
+}
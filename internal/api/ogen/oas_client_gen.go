@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/conv"
 	ht "github.com/ogen-go/ogen/http"
 	"github.com/ogen-go/ogen/uri"
 	"go.opentelemetry.io/otel/attribute"
@@ -25,6 +26,78 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// B2mmlMasterDataProfileGet invokes GET /b2mml/master-data-profile operation.
+	//
+	// Документ `MasterDataProfile`, загруженный из `B2MML_PROFILE`. Записи
+	// `TransactionProfile` с `ApplicationID` сервера задают каналы (`ChannelTopic`)
+	// для документов своих `SupportedAction`: `InformationReceiver` - входящие,
+	// `InformationSender` - исходящие `SyncXxx` по доменным событиям.
+	// Каналы:
+	// `http(s)://` (исходящие), `file:///папка` (тема - подпапка),
+	// `bus://имя`
+	// (шина в процессе).
+	//
+	// GET /b2mml/master-data-profile
+	B2mmlMasterDataProfileGet(ctx context.Context) (B2mmlMasterDataProfileGetRes, error)
+	// B2mmlPost invokes POST /b2mml operation.
+	//
+	// Принимает документы ISA-95 B2MML `Get`, `Process` и `Sync` с
+	// существительными
+	// `Equipment`, `EquipmentClass` и `EquipmentInformation`.
+	// На `Get<Noun>` отвечает `Show<Noun>` с сохранёнными объектами.
+	// Выборку задают
+	// существительные с `ID` в DataArea и выражения `Get/Expression` -
+	// путь к
+	// `Equipment` или `EquipmentClass` с предикатами равенства,
+	// объединёнными `and`
+	// (`ID`, `EquipmentLevel`, `EquipmentClassID`, `HierarchyScope/EquipmentID`),
+	// например `/GetEquipment/DataArea/Equipment[EquipmentLevel='Unit']`. Размер
+	// страницы задаёт атрибут `maxItems`, следующую страницу -
+	// `recordSetReferenceId`
+	// из ответа `Show`.
+	// Process и Sync применяются в одной транзакции.
+	// Код действия задаётся атрибутом `actionCode` в
+	// ActionCriteria/ActionExpression
+	// (Add, Change, Replace, Delete) и относится ко всему DataArea; выражение
+	// XPath
+	// не вычисляется. По умолчанию Process выполняет Add, Sync -
+	// Replace.
+	// На Process отвечает `Acknowledge<Noun>` с кодом Accepted или Rejected в
+	// ResponseCriteria (атрибут `acknowledgeCode` глагола: Always по умолчанию,
+	// OnError, Never). На Sync отвечает `ConfirmBOD` (Sender/ConfirmationCode:
+	// Always по умолчанию, OnError, Never).
+	// На `GetTransactionProfile` отвечает `ShowTransactionProfile` с
+	// поддерживаемыми
+	// сочетаниями глагола и существительного; `SupportedAction` с
+	// `TransactionVerb`
+	// и/или `TransactionNoun` в запросе ограничивают ответ.
+	// Документы других
+	// сочетаний отклоняются с кодом 400.
+	// Перед применением документ проверяется по схеме B2MML:
+	// допустимые элементы и
+	// атрибуты, порядок элементов, кратность, обязательные
+	// элементы и атрибуты,
+	// перечисления, форматы идентификаторов и дат. DataArea
+	// должен содержать глагол и существительное из
+	// имени документа. Отклонённый документ возвращается с
+	// кодом 400 и документом
+	// `ShowErrorMessage` (ErrorMessage на каждое нарушение: ThrownFrom - XPath,
+	// ErrorCode - код нарушения) или, если клиент предпочитает JSON
+	// в `Accept`,
+	// `application/problem+json`.
+	//
+	// POST /b2mml
+	B2mmlPost(ctx context.Context, request B2mmlPostReq) (B2mmlPostRes, error)
+	// B2mmlTransactionProfileGet invokes GET /b2mml/transaction-profile operation.
+	//
+	// Существительное B2MML `TransactionProfile` с поддерживаемыми
+	// сервером
+	// сочетаниями глагола и существительного (`SupportedAction`);
+	// то же, что
+	// `ShowTransactionProfile` в ответ на `GetTransactionProfile`.
+	//
+	// GET /b2mml/transaction-profile
+	B2mmlTransactionProfileGet(ctx context.Context) (B2mmlTransactionProfileGetOK, error)
 	// BatchesGet invokes GET /batches operation.
 	//
 	// Получить список батчей.
@@ -37,18 +110,209 @@ type Invoker interface {
 	//
 	// POST /batches
 	BatchesPost(ctx context.Context, request *BatchType) error
+	// EquipmentExportGet invokes GET /equipment/export operation.
+	//
+	// Ответ потоковый и обслуживается отдельным handler в
+	// обход ogen.
+	// Оборудование читается серверным курсором в одной
+	// транзакции только для
+	// чтения и выгружается в порядке обхода иерархии:
+	// родитель раньше потомков.
+	// CSV и JSON Lines содержат эффективные свойства (с учётом
+	// значений классов)
+	// и классы оборудования; с колонками по умолчанию файл
+	// загружается обратно
+	// через POST /equipment/imports. Формат b2mml - один документ
+	// SyncEquipmentInformation: оборудование вложено в ближайшего
+	// выгруженного
+	// предка (EquipmentChild), в конце перечислены классы
+	// выгруженного оборудования.
+	// При `Accept-Encoding: gzip` ответ сжимается. Ошибка после начала
+	// ответа
+	// обрывает поток.
+	//
+	// GET /equipment/export
+	EquipmentExportGet(ctx context.Context, params EquipmentExportGetParams) (EquipmentExportGetRes, error)
 	// EquipmentGet invokes GET /equipment operation.
 	//
-	// Получить список оборудования.
+	// Keyset-пагинация: для перехода по страницам передайте в
+	// `cursor`
+	// значение `next_cursor` или `prev_cursor` из предыдущего ответа.
 	//
 	// GET /equipment
-	EquipmentGet(ctx context.Context) ([]EquipmentType, error)
+	EquipmentGet(ctx context.Context, params EquipmentGetParams) (EquipmentGetRes, error)
+	// EquipmentIDDelete invokes DELETE /equipment/{id} operation.
+	//
+	// Оборудование помечается удалённым (soft delete) и остаётся
+	// доступным через
+	// `GET /equipment?deleted=true`, `POST /equipment/{id}/restore` и
+	// `POST /equipment/{id}/purge`. Если передан If-Match, удаление
+	// выполняется
+	// только при совпадении версии.
+	//
+	// DELETE /equipment/{id}
+	EquipmentIDDelete(ctx context.Context, params EquipmentIDDeleteParams) (EquipmentIDDeleteRes, error)
+	// EquipmentIDGet invokes GET /equipment/{id} operation.
+	//
+	// Получить оборудование по ID.
+	//
+	// GET /equipment/{id}
+	EquipmentIDGet(ctx context.Context, params EquipmentIDGetParams) (EquipmentIDGetRes, error)
+	// EquipmentIDHistoryDiffGet invokes GET /equipment/{id}/history/diff operation.
+	//
+	// Сравнить две ревизии оборудования по полям.
+	//
+	// GET /equipment/{id}/history/diff
+	EquipmentIDHistoryDiffGet(ctx context.Context, params EquipmentIDHistoryDiffGetParams) (EquipmentIDHistoryDiffGetRes, error)
+	// EquipmentIDHistoryGet invokes GET /equipment/{id}/history operation.
+	//
+	// Ревизии от новых к старым. Ревизия записывается при
+	// каждом изменении записи
+	// оборудования, его свойств или классов. Автор и
+	// причина берутся из заголовков
+	// `X-CMMS-Actor` и `X-CMMS-Change-Reason` изменяющего запроса.
+	// История доступна и для удалённого оборудования.
+	//
+	// GET /equipment/{id}/history
+	EquipmentIDHistoryGet(ctx context.Context, params EquipmentIDHistoryGetParams) (EquipmentIDHistoryGetRes, error)
+	// EquipmentIDMovePost invokes POST /equipment/{id}/move operation.
+	//
+	// Перенос запрещён под само оборудование или его
+	// потомка, а также под оборудование,
+	// уровень ISA-95 которого не выше уровня переносимого.
+	// Если передан If-Match,
+	// перенос выполняется только при совпадении версии.
+	//
+	// POST /equipment/{id}/move
+	EquipmentIDMovePost(ctx context.Context, request *EquipmentMove, params EquipmentIDMovePostParams) (EquipmentIDMovePostRes, error)
+	// EquipmentIDPropertiesGet invokes GET /equipment/{id}/properties operation.
+	//
+	// Свойства, определённые классами оборудования и их
+	// родительскими классами
+	// (определение дочернего класса переопределяет
+	// родительское), вместе со значениями
+	// оборудования. Для каждого свойства указано, задано ли
+	// значение оборудованием
+	// (overridden), унаследовано из определения класса (inherited),
+	// отсутствует (missing)
+	// или свойство не определено ни одним классом (local).
+	// Числовые значения с единицей измерения можно
+	// перевести в нужные единицы:
+	// `unit` задаёт единицы (по одной на вид величины), `site_units` -
+	// предпочтительные
+	// единицы площадки, к которой относится оборудование.
+	//
+	// GET /equipment/{id}/properties
+	EquipmentIDPropertiesGet(ctx context.Context, params EquipmentIDPropertiesGetParams) (EquipmentIDPropertiesGetRes, error)
+	// EquipmentIDPurgePost invokes POST /equipment/{id}/purge operation.
+	//
+	// Удаляет из хранилища удалённое оборудование вместе с
+	// потомками, удалёнными
+	// каскадно с ним. История изменений сохраняется.
+	//
+	// POST /equipment/{id}/purge
+	EquipmentIDPurgePost(ctx context.Context, params EquipmentIDPurgePostParams) (EquipmentIDPurgePostRes, error)
+	// EquipmentIDPut invokes PUT /equipment/{id} operation.
+	//
+	// Оптимистичная блокировка: заголовок If-Match должен
+	// содержать ETag,
+	// полученный при чтении оборудования (или `*` для
+	// безусловного обновления).
+	// Существительное B2MML Equipment (XML или поле B2MML в JSON) заменяет
+	// данные, классы и свойства оборудования.
+	//
+	// PUT /equipment/{id}
+	EquipmentIDPut(ctx context.Context, request EquipmentIDPutReq, params EquipmentIDPutParams) (EquipmentIDPutRes, error)
+	// EquipmentIDRestorePost invokes POST /equipment/{id}/restore operation.
+	//
+	// Вместе с оборудованием восстанавливаются потомки,
+	// удалённые каскадно с ним.
+	// Оборудование возвращается в конец списка детей
+	// прежнего родителя; если
+	// родитель окончательно удалён - в корень иерархии.
+	//
+	// POST /equipment/{id}/restore
+	EquipmentIDRestorePost(ctx context.Context, params EquipmentIDRestorePostParams) (EquipmentIDRestorePostRes, error)
+	// EquipmentIDTreeGet invokes GET /equipment/{id}/tree operation.
+	//
+	// Получить дерево дочернего оборудования.
+	//
+	// GET /equipment/{id}/tree
+	EquipmentIDTreeGet(ctx context.Context, params EquipmentIDTreeGetParams) (EquipmentIDTreeGetRes, error)
+	// EquipmentImportsImportIdGet invokes GET /equipment/imports/{importId} operation.
+	//
+	// Получить задание импорта с отчётом об ошибках строк.
+	//
+	// GET /equipment/imports/{importId}
+	EquipmentImportsImportIdGet(ctx context.Context, params EquipmentImportsImportIdGetParams) (EquipmentImportsImportIdGetRes, error)
+	// EquipmentImportsImportIdResumePost invokes POST /equipment/imports/{importId}/resume operation.
+	//
+	// Задание возвращается в очередь и продолжается с
+	// первой незафиксированной
+	// строки; задание dry_run проверяется заново.
+	//
+	// POST /equipment/imports/{importId}/resume
+	EquipmentImportsImportIdResumePost(ctx context.Context, params EquipmentImportsImportIdResumePostParams) (EquipmentImportsImportIdResumePostRes, error)
+	// EquipmentImportsPost invokes POST /equipment/imports operation.
+	//
+	// Формат источника задаётся Content-Type: text/csv (первая строка
+	// - имена
+	// колонок) или application/x-ndjson (строка - плоский объект
+	// колонок).
+	// Колонка импортируется в поле с её именем, если mapping не
+	// задаёт другое:
+	// EquipmentID (обязательно), EquipmentType (классы через ";", первый -
+	// основной), Description, OperatingStatus, EquipmentLevel, ParentID,
+	// Manufacturer, Model, SerialNumber, InstallationDate, Properties.<ID> и
+	// Properties.<ID>.Unit. Колонка неизвестного поля - ошибка
+	// задания.
+	// Задание выполняется в фоне; строки с ошибками
+	// пропускаются и попадают
+	// в отчёт (GET /equipment/imports/{importId}). Строки фиксируются
+	// пачками
+	// по chunk_size, прерванное задание продолжается с первой
+	// незафиксированной
+	// строки. dry_run проверяет строки без сохранения
+	// оборудования.
+	//
+	// POST /equipment/imports
+	EquipmentImportsPost(ctx context.Context, request EquipmentImportsPostReq, params EquipmentImportsPostParams) (EquipmentImportsPostRes, error)
 	// EquipmentPost invokes POST /equipment operation.
 	//
-	// Добавить оборудование.
+	// Тело - EquipmentType (JSON) или существительное B2MML Equipment (XML);
+	// ответ выбирается по Accept.
 	//
 	// POST /equipment
-	EquipmentPost(ctx context.Context, request *EquipmentType) error
+	EquipmentPost(ctx context.Context, request EquipmentPostReq) (EquipmentPostRes, error)
+	// EquipmentQueryPost invokes POST /equipment/query operation.
+	//
+	// Фильтры объединяются через И, значения внутри одного
+	// фильтра - через ИЛИ.
+	// Условия на свойства сравнивают значения с
+	// приведением к типу `type`
+	// (по умолчанию number для числовых значений, иначе string).
+	// Пагинация как у GET /equipment: курсор действителен только
+	// для той же сортировки.
+	//
+	// POST /equipment/query
+	EquipmentQueryPost(ctx context.Context, request *EquipmentQuery) (EquipmentQueryPostRes, error)
+	// EventsStreamGet invokes GET /events/stream operation.
+	//
+	// Ответ потоковый и обслуживается отдельным handler в
+	// обход ogen.
+	// Каждое событие передаётся с `id` - номером события в outbox,
+	//  `event` -
+	// типом события и `data` - JSON конвертом `{id, type, aggregate_type,
+	// aggregate_id, occurred_at, data}`, как в теле webhook. При
+	// переподключении
+	// клиент передаёт последний полученный `id` в заголовке
+	// `Last-Event-ID`
+	// (или параметре `last_event_id`), и поток продолжается с
+	// пропущенных событий,
+	// пока они хранятся в outbox.
+	//
+	// GET /events/stream
+	EventsStreamGet(ctx context.Context, params EventsStreamGetParams) (EventsStreamGetRes, error)
 	// MaterialsGet invokes GET /materials operation.
 	//
 	// Получить список материалов.
@@ -90,13 +354,107 @@ type Invoker interface {
 	// Получить список персон (Person).
 	//
 	// GET /persons
-	PersonsGet(ctx context.Context) ([]PersonType, error)
+	PersonsGet(ctx context.Context, params PersonsGetParams) ([]PersonType, error)
 	// PersonsPost invokes POST /persons operation.
 	//
-	// Добавить персону.
+	// Тело - PersonType (JSON) или существительное B2MML Person (XML);
+	// ответ выбирается по Accept.
 	//
 	// POST /persons
-	PersonsPost(ctx context.Context, request *PersonType) error
+	PersonsPost(ctx context.Context, request PersonsPostReq) (PersonsPostRes, error)
+	// SearchGet invokes GET /search operation.
+	//
+	// Ищет по внешним ID, описаниям (в том числе B2MML Description на
+	// разных языках)
+	// и значениям свойств оборудования. Все слова запроса
+	// обязательны и совпадают
+	// по началу слова. Результаты упорядочены по
+	// релевантности.
+	//
+	// GET /search
+	SearchGet(ctx context.Context, params SearchGetParams) (SearchGetRes, error)
+	// SitesIDUnitsGet invokes GET /sites/{id}/units operation.
+	//
+	// Получить предпочтительные единицы площадки.
+	//
+	// GET /sites/{id}/units
+	SitesIDUnitsGet(ctx context.Context, params SitesIDUnitsGetParams) (SitesIDUnitsGetRes, error)
+	// SitesIDUnitsPut invokes PUT /sites/{id}/units operation.
+	//
+	// Заменить предпочтительные единицы площадки.
+	//
+	// PUT /sites/{id}/units
+	SitesIDUnitsPut(ctx context.Context, request *SiteUnits, params SitesIDUnitsPutParams) (SitesIDUnitsPutRes, error)
+	// UnitsConvertGet invokes GET /units/convert operation.
+	//
+	// Перевести значение в другую единицу измерения.
+	//
+	// GET /units/convert
+	UnitsConvertGet(ctx context.Context, params UnitsConvertGetParams) (UnitsConvertGetRes, error)
+	// UnitsGet invokes GET /units operation.
+	//
+	// Единицы с кодами UN/ECE Recommendation 20 и их обозначения UCUM.
+	// Значения
+	// свойств могут использовать и другие выражения UCUM
+	// (например kg/m3).
+	//
+	// GET /units
+	UnitsGet(ctx context.Context, params UnitsGetParams) ([]UnitOfMeasure, error)
+	// WebhooksGet invokes GET /webhooks operation.
+	//
+	// Получить список подписок на события.
+	//
+	// GET /webhooks
+	WebhooksGet(ctx context.Context) ([]Webhook, error)
+	// WebhooksIDDelete invokes DELETE /webhooks/{id} operation.
+	//
+	// Удалить подписку вместе с её доставками.
+	//
+	// DELETE /webhooks/{id}
+	WebhooksIDDelete(ctx context.Context, params WebhooksIDDeleteParams) (WebhooksIDDeleteRes, error)
+	// WebhooksIDDeliveriesDeliveryIdReplayPost invokes POST /webhooks/{id}/deliveries/{deliveryId}/replay operation.
+	//
+	// Доставка возвращается в очередь со сброшенным
+	// счётчиком попыток.
+	//
+	// POST /webhooks/{id}/deliveries/{deliveryId}/replay
+	WebhooksIDDeliveriesDeliveryIdReplayPost(ctx context.Context, params WebhooksIDDeliveriesDeliveryIdReplayPostParams) (WebhooksIDDeliveriesDeliveryIdReplayPostRes, error)
+	// WebhooksIDDeliveriesGet invokes GET /webhooks/{id}/deliveries operation.
+	//
+	// Status=dead возвращает dead letter - доставки, исчерпавшие
+	// попытки.
+	//
+	// GET /webhooks/{id}/deliveries
+	WebhooksIDDeliveriesGet(ctx context.Context, params WebhooksIDDeliveriesGetParams) (WebhooksIDDeliveriesGetRes, error)
+	// WebhooksIDGet invokes GET /webhooks/{id} operation.
+	//
+	// Получить подписку на события.
+	//
+	// GET /webhooks/{id}
+	WebhooksIDGet(ctx context.Context, params WebhooksIDGetParams) (WebhooksIDGetRes, error)
+	// WebhooksIDPingPost invokes POST /webhooks/{id}/ping operation.
+	//
+	// Событие отправляется синхронно, не сохраняется и не
+	// повторяется.
+	//
+	// POST /webhooks/{id}/ping
+	WebhooksIDPingPost(ctx context.Context, params WebhooksIDPingPostParams) (WebhooksIDPingPostRes, error)
+	// WebhooksIDPut invokes PUT /webhooks/{id} operation.
+	//
+	// Secret не меняется; поле secret в запросе игнорируется.
+	//
+	// PUT /webhooks/{id}
+	WebhooksIDPut(ctx context.Context, request *Webhook, params WebhooksIDPutParams) (WebhooksIDPutRes, error)
+	// WebhooksPost invokes POST /webhooks operation.
+	//
+	// События доставляются POST-запросом с JSON телом (WebhookEvent).
+	// Тело подписывается HMAC-SHA256 по secret: заголовок X-CMMS-Signature
+	// содержит sha256=<hex> от строки "<X-CMMS-Timestamp>.<тело>".
+	// Если secret не задан, он генерируется и возвращается
+	// только в ответе на создание.
+	//
+	// POST /webhooks
+	WebhooksPost(ctx context.Context, request *Webhook) (WebhooksPostRes, error)
 }
 
 // Client implements OAS client.
@@ -115,47 +473,3679 @@ func NewClient(serverURL string, opts ...ClientOption) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	trimTrailingSlashes(u)
+	trimTrailingSlashes(u)
+
+	c, err := newClientConfig(opts...).baseClient()
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		serverURL:  u,
+		baseClient: c,
+	}, nil
+}
+
+type serverURLKey struct{}
+
+// WithServerURL sets context key to override server URL.
+func WithServerURL(ctx context.Context, u *url.URL) context.Context {
+	return context.WithValue(ctx, serverURLKey{}, u)
+}
+
+func (c *Client) requestURL(ctx context.Context) *url.URL {
+	u, ok := ctx.Value(serverURLKey{}).(*url.URL)
+	if !ok {
+		return c.serverURL
+	}
+	return u
+}
+
+// B2mmlMasterDataProfileGet invokes GET /b2mml/master-data-profile operation.
+//
+// Документ `MasterDataProfile`, загруженный из `B2MML_PROFILE`. Записи
+// `TransactionProfile` с `ApplicationID` сервера задают каналы (`ChannelTopic`)
+// для документов своих `SupportedAction`: `InformationReceiver` - входящие,
+// `InformationSender` - исходящие `SyncXxx` по доменным событиям.
+// Каналы:
+// `http(s)://` (исходящие), `file:///папка` (тема - подпапка),
+// `bus://имя`
+// (шина в процессе).
+//
+// GET /b2mml/master-data-profile
+func (c *Client) B2mmlMasterDataProfileGet(ctx context.Context) (B2mmlMasterDataProfileGetRes, error) {
+	res, err := c.sendB2mmlMasterDataProfileGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendB2mmlMasterDataProfileGet(ctx context.Context) (res B2mmlMasterDataProfileGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/b2mml/master-data-profile"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, B2mmlMasterDataProfileGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/b2mml/master-data-profile"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeB2mmlMasterDataProfileGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// B2mmlPost invokes POST /b2mml operation.
+//
+// Принимает документы ISA-95 B2MML `Get`, `Process` и `Sync` с
+// существительными
+// `Equipment`, `EquipmentClass` и `EquipmentInformation`.
+// На `Get<Noun>` отвечает `Show<Noun>` с сохранёнными объектами.
+// Выборку задают
+// существительные с `ID` в DataArea и выражения `Get/Expression` -
+// путь к
+// `Equipment` или `EquipmentClass` с предикатами равенства,
+// объединёнными `and`
+// (`ID`, `EquipmentLevel`, `EquipmentClassID`, `HierarchyScope/EquipmentID`),
+// например `/GetEquipment/DataArea/Equipment[EquipmentLevel='Unit']`. Размер
+// страницы задаёт атрибут `maxItems`, следующую страницу -
+// `recordSetReferenceId`
+// из ответа `Show`.
+// Process и Sync применяются в одной транзакции.
+// Код действия задаётся атрибутом `actionCode` в
+// ActionCriteria/ActionExpression
+// (Add, Change, Replace, Delete) и относится ко всему DataArea; выражение
+// XPath
+// не вычисляется. По умолчанию Process выполняет Add, Sync -
+// Replace.
+// На Process отвечает `Acknowledge<Noun>` с кодом Accepted или Rejected в
+// ResponseCriteria (атрибут `acknowledgeCode` глагола: Always по умолчанию,
+// OnError, Never). На Sync отвечает `ConfirmBOD` (Sender/ConfirmationCode:
+// Always по умолчанию, OnError, Never).
+// На `GetTransactionProfile` отвечает `ShowTransactionProfile` с
+// поддерживаемыми
+// сочетаниями глагола и существительного; `SupportedAction` с
+// `TransactionVerb`
+// и/или `TransactionNoun` в запросе ограничивают ответ.
+// Документы других
+// сочетаний отклоняются с кодом 400.
+// Перед применением документ проверяется по схеме B2MML:
+// допустимые элементы и
+// атрибуты, порядок элементов, кратность, обязательные
+// элементы и атрибуты,
+// перечисления, форматы идентификаторов и дат. DataArea
+// должен содержать глагол и существительное из
+// имени документа. Отклонённый документ возвращается с
+// кодом 400 и документом
+// `ShowErrorMessage` (ErrorMessage на каждое нарушение: ThrownFrom - XPath,
+// ErrorCode - код нарушения) или, если клиент предпочитает JSON
+// в `Accept`,
+// `application/problem+json`.
+//
+// POST /b2mml
+func (c *Client) B2mmlPost(ctx context.Context, request B2mmlPostReq) (B2mmlPostRes, error) {
+	res, err := c.sendB2mmlPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendB2mmlPost(ctx context.Context, request B2mmlPostReq) (res B2mmlPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/b2mml"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, B2mmlPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/b2mml"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeB2mmlPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeB2mmlPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// B2mmlTransactionProfileGet invokes GET /b2mml/transaction-profile operation.
+//
+// Существительное B2MML `TransactionProfile` с поддерживаемыми
+// сервером
+// сочетаниями глагола и существительного (`SupportedAction`);
+// то же, что
+// `ShowTransactionProfile` в ответ на `GetTransactionProfile`.
+//
+// GET /b2mml/transaction-profile
+func (c *Client) B2mmlTransactionProfileGet(ctx context.Context) (B2mmlTransactionProfileGetOK, error) {
+	res, err := c.sendB2mmlTransactionProfileGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendB2mmlTransactionProfileGet(ctx context.Context) (res B2mmlTransactionProfileGetOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/b2mml/transaction-profile"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, B2mmlTransactionProfileGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/b2mml/transaction-profile"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeB2mmlTransactionProfileGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BatchesGet invokes GET /batches operation.
+//
+// Получить список батчей.
+//
+// GET /batches
+func (c *Client) BatchesGet(ctx context.Context) ([]BatchType, error) {
+	res, err := c.sendBatchesGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendBatchesGet(ctx context.Context) (res []BatchType, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/batches"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BatchesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/batches"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBatchesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BatchesPost invokes POST /batches operation.
+//
+// Создать новый батч.
+//
+// POST /batches
+func (c *Client) BatchesPost(ctx context.Context, request *BatchType) error {
+	_, err := c.sendBatchesPost(ctx, request)
+	return err
+}
+
+func (c *Client) sendBatchesPost(ctx context.Context, request *BatchType) (res *BatchesPostCreated, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/batches"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BatchesPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/batches"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeBatchesPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBatchesPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentExportGet invokes GET /equipment/export operation.
+//
+// Ответ потоковый и обслуживается отдельным handler в
+// обход ogen.
+// Оборудование читается серверным курсором в одной
+// транзакции только для
+// чтения и выгружается в порядке обхода иерархии:
+// родитель раньше потомков.
+// CSV и JSON Lines содержат эффективные свойства (с учётом
+// значений классов)
+// и классы оборудования; с колонками по умолчанию файл
+// загружается обратно
+// через POST /equipment/imports. Формат b2mml - один документ
+// SyncEquipmentInformation: оборудование вложено в ближайшего
+// выгруженного
+// предка (EquipmentChild), в конце перечислены классы
+// выгруженного оборудования.
+// При `Accept-Encoding: gzip` ответ сжимается. Ошибка после начала
+// ответа
+// обрывает поток.
+//
+// GET /equipment/export
+func (c *Client) EquipmentExportGet(ctx context.Context, params EquipmentExportGetParams) (EquipmentExportGetRes, error) {
+	res, err := c.sendEquipmentExportGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentExportGet(ctx context.Context, params EquipmentExportGetParams) (res EquipmentExportGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/equipment/export"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentExportGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/equipment/export"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "format" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Format.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "columns" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "columns",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Columns != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Columns {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "delimiter" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "delimiter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Delimiter.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "root" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "root",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Root != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Root {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Status != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Status {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(string(item)))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "equipment_level" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "equipment_level",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.EquipmentLevel != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.EquipmentLevel {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "hierarchy_scope" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "hierarchy_scope",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.HierarchyScope != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.HierarchyScope {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "class" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "class",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Class.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "include_subclasses" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "include_subclasses",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IncludeSubclasses.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "effective_at" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "effective_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.EffectiveAt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "effective_from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "effective_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.EffectiveFrom.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "effective_to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "effective_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.EffectiveTo.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentExportGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentGet invokes GET /equipment operation.
+//
+// Keyset-пагинация: для перехода по страницам передайте в
+// `cursor`
+// значение `next_cursor` или `prev_cursor` из предыдущего ответа.
+//
+// GET /equipment
+func (c *Client) EquipmentGet(ctx context.Context, params EquipmentGetParams) (EquipmentGetRes, error) {
+	res, err := c.sendEquipmentGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentGet(ctx context.Context, params EquipmentGetParams) (res EquipmentGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/equipment"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/equipment"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "class" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "class",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Class.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "deleted" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "deleted",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Deleted.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentIDDelete invokes DELETE /equipment/{id} operation.
+//
+// Оборудование помечается удалённым (soft delete) и остаётся
+// доступным через
+// `GET /equipment?deleted=true`, `POST /equipment/{id}/restore` и
+// `POST /equipment/{id}/purge`. Если передан If-Match, удаление
+// выполняется
+// только при совпадении версии.
+//
+// DELETE /equipment/{id}
+func (c *Client) EquipmentIDDelete(ctx context.Context, params EquipmentIDDeleteParams) (EquipmentIDDeleteRes, error) {
+	res, err := c.sendEquipmentIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentIDDelete(ctx context.Context, params EquipmentIDDeleteParams) (res EquipmentIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/equipment/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/equipment/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "children" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "children",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Children.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "classes" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "classes",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Classes.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentIDGet invokes GET /equipment/{id} operation.
+//
+// Получить оборудование по ID.
+//
+// GET /equipment/{id}
+func (c *Client) EquipmentIDGet(ctx context.Context, params EquipmentIDGetParams) (EquipmentIDGetRes, error) {
+	res, err := c.sendEquipmentIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentIDGet(ctx context.Context, params EquipmentIDGetParams) (res EquipmentIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/equipment/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/equipment/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "as_of" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "as_of",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.AsOf.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentIDHistoryDiffGet invokes GET /equipment/{id}/history/diff operation.
+//
+// Сравнить две ревизии оборудования по полям.
+//
+// GET /equipment/{id}/history/diff
+func (c *Client) EquipmentIDHistoryDiffGet(ctx context.Context, params EquipmentIDHistoryDiffGetParams) (EquipmentIDHistoryDiffGetRes, error) {
+	res, err := c.sendEquipmentIDHistoryDiffGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentIDHistoryDiffGet(ctx context.Context, params EquipmentIDHistoryDiffGetParams) (res EquipmentIDHistoryDiffGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/equipment/{id}/history/diff"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentIDHistoryDiffGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/equipment/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/history/diff"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentIDHistoryDiffGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentIDHistoryGet invokes GET /equipment/{id}/history operation.
+//
+// Ревизии от новых к старым. Ревизия записывается при
+// каждом изменении записи
+// оборудования, его свойств или классов. Автор и
+// причина берутся из заголовков
+// `X-CMMS-Actor` и `X-CMMS-Change-Reason` изменяющего запроса.
+// История доступна и для удалённого оборудования.
+//
+// GET /equipment/{id}/history
+func (c *Client) EquipmentIDHistoryGet(ctx context.Context, params EquipmentIDHistoryGetParams) (EquipmentIDHistoryGetRes, error) {
+	res, err := c.sendEquipmentIDHistoryGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentIDHistoryGet(ctx context.Context, params EquipmentIDHistoryGetParams) (res EquipmentIDHistoryGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/equipment/{id}/history"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentIDHistoryGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/equipment/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/history"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentIDHistoryGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentIDMovePost invokes POST /equipment/{id}/move operation.
+//
+// Перенос запрещён под само оборудование или его
+// потомка, а также под оборудование,
+// уровень ISA-95 которого не выше уровня переносимого.
+// Если передан If-Match,
+// перенос выполняется только при совпадении версии.
+//
+// POST /equipment/{id}/move
+func (c *Client) EquipmentIDMovePost(ctx context.Context, request *EquipmentMove, params EquipmentIDMovePostParams) (EquipmentIDMovePostRes, error) {
+	res, err := c.sendEquipmentIDMovePost(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentIDMovePost(ctx context.Context, request *EquipmentMove, params EquipmentIDMovePostParams) (res EquipmentIDMovePostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/equipment/{id}/move"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentIDMovePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/equipment/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/move"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeEquipmentIDMovePostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentIDMovePostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentIDPropertiesGet invokes GET /equipment/{id}/properties operation.
+//
+// Свойства, определённые классами оборудования и их
+// родительскими классами
+// (определение дочернего класса переопределяет
+// родительское), вместе со значениями
+// оборудования. Для каждого свойства указано, задано ли
+// значение оборудованием
+// (overridden), унаследовано из определения класса (inherited),
+// отсутствует (missing)
+// или свойство не определено ни одним классом (local).
+// Числовые значения с единицей измерения можно
+// перевести в нужные единицы:
+// `unit` задаёт единицы (по одной на вид величины), `site_units` -
+// предпочтительные
+// единицы площадки, к которой относится оборудование.
+//
+// GET /equipment/{id}/properties
+func (c *Client) EquipmentIDPropertiesGet(ctx context.Context, params EquipmentIDPropertiesGetParams) (EquipmentIDPropertiesGetRes, error) {
+	res, err := c.sendEquipmentIDPropertiesGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentIDPropertiesGet(ctx context.Context, params EquipmentIDPropertiesGetParams) (res EquipmentIDPropertiesGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/equipment/{id}/properties"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentIDPropertiesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/equipment/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/properties"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "unit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "unit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Unit != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Unit {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "site_units" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "site_units",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SiteUnits.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentIDPropertiesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentIDPurgePost invokes POST /equipment/{id}/purge operation.
+//
+// Удаляет из хранилища удалённое оборудование вместе с
+// потомками, удалёнными
+// каскадно с ним. История изменений сохраняется.
+//
+// POST /equipment/{id}/purge
+func (c *Client) EquipmentIDPurgePost(ctx context.Context, params EquipmentIDPurgePostParams) (EquipmentIDPurgePostRes, error) {
+	res, err := c.sendEquipmentIDPurgePost(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentIDPurgePost(ctx context.Context, params EquipmentIDPurgePostParams) (res EquipmentIDPurgePostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/equipment/{id}/purge"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentIDPurgePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/equipment/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/purge"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentIDPurgePostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentIDPut invokes PUT /equipment/{id} operation.
+//
+// Оптимистичная блокировка: заголовок If-Match должен
+// содержать ETag,
+// полученный при чтении оборудования (или `*` для
+// безусловного обновления).
+// Существительное B2MML Equipment (XML или поле B2MML в JSON) заменяет
+// данные, классы и свойства оборудования.
+//
+// PUT /equipment/{id}
+func (c *Client) EquipmentIDPut(ctx context.Context, request EquipmentIDPutReq, params EquipmentIDPutParams) (EquipmentIDPutRes, error) {
+	res, err := c.sendEquipmentIDPut(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentIDPut(ctx context.Context, request EquipmentIDPutReq, params EquipmentIDPutParams) (res EquipmentIDPutRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/equipment/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentIDPutOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/equipment/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeEquipmentIDPutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.IfMatch))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentIDPutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentIDRestorePost invokes POST /equipment/{id}/restore operation.
+//
+// Вместе с оборудованием восстанавливаются потомки,
+// удалённые каскадно с ним.
+// Оборудование возвращается в конец списка детей
+// прежнего родителя; если
+// родитель окончательно удалён - в корень иерархии.
+//
+// POST /equipment/{id}/restore
+func (c *Client) EquipmentIDRestorePost(ctx context.Context, params EquipmentIDRestorePostParams) (EquipmentIDRestorePostRes, error) {
+	res, err := c.sendEquipmentIDRestorePost(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentIDRestorePost(ctx context.Context, params EquipmentIDRestorePostParams) (res EquipmentIDRestorePostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/equipment/{id}/restore"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentIDRestorePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/equipment/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/restore"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentIDRestorePostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentIDTreeGet invokes GET /equipment/{id}/tree operation.
+//
+// Получить дерево дочернего оборудования.
+//
+// GET /equipment/{id}/tree
+func (c *Client) EquipmentIDTreeGet(ctx context.Context, params EquipmentIDTreeGetParams) (EquipmentIDTreeGetRes, error) {
+	res, err := c.sendEquipmentIDTreeGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentIDTreeGet(ctx context.Context, params EquipmentIDTreeGetParams) (res EquipmentIDTreeGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/equipment/{id}/tree"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentIDTreeGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/equipment/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/tree"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "depth" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "depth",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Depth.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "as_of" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "as_of",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.AsOf.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentIDTreeGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentImportsImportIdGet invokes GET /equipment/imports/{importId} operation.
+//
+// Получить задание импорта с отчётом об ошибках строк.
+//
+// GET /equipment/imports/{importId}
+func (c *Client) EquipmentImportsImportIdGet(ctx context.Context, params EquipmentImportsImportIdGetParams) (EquipmentImportsImportIdGetRes, error) {
+	res, err := c.sendEquipmentImportsImportIdGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentImportsImportIdGet(ctx context.Context, params EquipmentImportsImportIdGetParams) (res EquipmentImportsImportIdGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/equipment/imports/{importId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentImportsImportIdGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/equipment/imports/"
+	{
+		// Encode "importId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "importId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ImportId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentImportsImportIdGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentImportsImportIdResumePost invokes POST /equipment/imports/{importId}/resume operation.
+//
+// Задание возвращается в очередь и продолжается с
+// первой незафиксированной
+// строки; задание dry_run проверяется заново.
+//
+// POST /equipment/imports/{importId}/resume
+func (c *Client) EquipmentImportsImportIdResumePost(ctx context.Context, params EquipmentImportsImportIdResumePostParams) (EquipmentImportsImportIdResumePostRes, error) {
+	res, err := c.sendEquipmentImportsImportIdResumePost(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentImportsImportIdResumePost(ctx context.Context, params EquipmentImportsImportIdResumePostParams) (res EquipmentImportsImportIdResumePostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/equipment/imports/{importId}/resume"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentImportsImportIdResumePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/equipment/imports/"
+	{
+		// Encode "importId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "importId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ImportId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/resume"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentImportsImportIdResumePostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentImportsPost invokes POST /equipment/imports operation.
+//
+// Формат источника задаётся Content-Type: text/csv (первая строка
+// - имена
+// колонок) или application/x-ndjson (строка - плоский объект
+// колонок).
+// Колонка импортируется в поле с её именем, если mapping не
+// задаёт другое:
+// EquipmentID (обязательно), EquipmentType (классы через ";", первый -
+// основной), Description, OperatingStatus, EquipmentLevel, ParentID,
+// Manufacturer, Model, SerialNumber, InstallationDate, Properties.<ID> и
+// Properties.<ID>.Unit. Колонка неизвестного поля - ошибка
+// задания.
+// Задание выполняется в фоне; строки с ошибками
+// пропускаются и попадают
+// в отчёт (GET /equipment/imports/{importId}). Строки фиксируются
+// пачками
+// по chunk_size, прерванное задание продолжается с первой
+// незафиксированной
+// строки. dry_run проверяет строки без сохранения
+// оборудования.
+//
+// POST /equipment/imports
+func (c *Client) EquipmentImportsPost(ctx context.Context, request EquipmentImportsPostReq, params EquipmentImportsPostParams) (EquipmentImportsPostRes, error) {
+	res, err := c.sendEquipmentImportsPost(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendEquipmentImportsPost(ctx context.Context, request EquipmentImportsPostReq, params EquipmentImportsPostParams) (res EquipmentImportsPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/equipment/imports"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentImportsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/equipment/imports"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "mapping" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mapping",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Mapping != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Mapping {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dry_run" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dry_run",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DryRun.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "chunk_size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "chunk_size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ChunkSize.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "delimiter" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "delimiter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Delimiter.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeEquipmentImportsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentImportsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentPost invokes POST /equipment operation.
+//
+// Тело - EquipmentType (JSON) или существительное B2MML Equipment (XML);
+// ответ выбирается по Accept.
+//
+// POST /equipment
+func (c *Client) EquipmentPost(ctx context.Context, request EquipmentPostReq) (EquipmentPostRes, error) {
+	res, err := c.sendEquipmentPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendEquipmentPost(ctx context.Context, request EquipmentPostReq) (res EquipmentPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/equipment"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/equipment"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeEquipmentPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EquipmentQueryPost invokes POST /equipment/query operation.
+//
+// Фильтры объединяются через И, значения внутри одного
+// фильтра - через ИЛИ.
+// Условия на свойства сравнивают значения с
+// приведением к типу `type`
+// (по умолчанию number для числовых значений, иначе string).
+// Пагинация как у GET /equipment: курсор действителен только
+// для той же сортировки.
+//
+// POST /equipment/query
+func (c *Client) EquipmentQueryPost(ctx context.Context, request *EquipmentQuery) (EquipmentQueryPostRes, error) {
+	res, err := c.sendEquipmentQueryPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendEquipmentQueryPost(ctx context.Context, request *EquipmentQuery) (res EquipmentQueryPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/equipment/query"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentQueryPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/equipment/query"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeEquipmentQueryPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEquipmentQueryPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EventsStreamGet invokes GET /events/stream operation.
+//
+// Ответ потоковый и обслуживается отдельным handler в
+// обход ogen.
+// Каждое событие передаётся с `id` - номером события в outbox,
+//
+//	`event` -
+//
+// типом события и `data` - JSON конвертом `{id, type, aggregate_type,
+// aggregate_id, occurred_at, data}`, как в теле webhook. При
+// переподключении
+// клиент передаёт последний полученный `id` в заголовке
+// `Last-Event-ID`
+// (или параметре `last_event_id`), и поток продолжается с
+// пропущенных событий,
+// пока они хранятся в outbox.
+//
+// GET /events/stream
+func (c *Client) EventsStreamGet(ctx context.Context, params EventsStreamGetParams) (EventsStreamGetRes, error) {
+	res, err := c.sendEventsStreamGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEventsStreamGet(ctx context.Context, params EventsStreamGetParams) (res EventsStreamGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/events/stream"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, EventsStreamGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/events/stream"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "equipment_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "equipment_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.EquipmentID != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.EquipmentID {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "scope" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "scope",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Scope.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Type != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Type {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "last_event_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "last_event_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.QueryLastEventID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Last-Event-ID",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.HeaderLastEventID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeEventsStreamGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MaterialsGet invokes GET /materials operation.
+//
+// Получить список материалов.
+//
+// GET /materials
+func (c *Client) MaterialsGet(ctx context.Context) ([]MaterialType, error) {
+	res, err := c.sendMaterialsGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendMaterialsGet(ctx context.Context) (res []MaterialType, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/materials"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MaterialsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/materials"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMaterialsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MaterialsPost invokes POST /materials operation.
+//
+// Добавить материал.
+//
+// POST /materials
+func (c *Client) MaterialsPost(ctx context.Context, request *MaterialType) error {
+	_, err := c.sendMaterialsPost(ctx, request)
+	return err
+}
+
+func (c *Client) sendMaterialsPost(ctx context.Context, request *MaterialType) (res *MaterialsPostCreated, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/materials"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MaterialsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/materials"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeMaterialsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMaterialsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PersonnelClassesGet invokes GET /personnel-classes operation.
+//
+// Получить список классов персонала (PersonnelClass).
+//
+// GET /personnel-classes
+func (c *Client) PersonnelClassesGet(ctx context.Context) ([]PersonnelClassType, error) {
+	res, err := c.sendPersonnelClassesGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendPersonnelClassesGet(ctx context.Context) (res []PersonnelClassType, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/personnel-classes"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PersonnelClassesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/personnel-classes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePersonnelClassesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PersonnelClassesPost invokes POST /personnel-classes operation.
+//
+// Добавить класс персонала.
+//
+// POST /personnel-classes
+func (c *Client) PersonnelClassesPost(ctx context.Context, request *PersonnelClassType) error {
+	_, err := c.sendPersonnelClassesPost(ctx, request)
+	return err
+}
+
+func (c *Client) sendPersonnelClassesPost(ctx context.Context, request *PersonnelClassType) (res *PersonnelClassesPostCreated, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/personnel-classes"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PersonnelClassesPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/personnel-classes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePersonnelClassesPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePersonnelClassesPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PersonnelInformationGet invokes GET /personnel-information operation.
+//
+// Получить информацию о персонале (PersonnelInformation).
+//
+// GET /personnel-information
+func (c *Client) PersonnelInformationGet(ctx context.Context) ([]PersonnelInformationType, error) {
+	res, err := c.sendPersonnelInformationGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendPersonnelInformationGet(ctx context.Context) (res []PersonnelInformationType, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/personnel-information"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PersonnelInformationGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/personnel-information"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePersonnelInformationGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PersonnelInformationPost invokes POST /personnel-information operation.
+//
+// Добавить информацию о персонале.
+//
+// POST /personnel-information
+func (c *Client) PersonnelInformationPost(ctx context.Context, request *PersonnelInformationType) error {
+	_, err := c.sendPersonnelInformationPost(ctx, request)
+	return err
+}
+
+func (c *Client) sendPersonnelInformationPost(ctx context.Context, request *PersonnelInformationType) (res *PersonnelInformationPostCreated, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/personnel-information"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PersonnelInformationPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/personnel-information"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePersonnelInformationPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePersonnelInformationPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PersonsGet invokes GET /persons operation.
+//
+// Получить список персон (Person).
+//
+// GET /persons
+func (c *Client) PersonsGet(ctx context.Context, params PersonsGetParams) ([]PersonType, error) {
+	res, err := c.sendPersonsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPersonsGet(ctx context.Context, params PersonsGetParams) (res []PersonType, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/persons"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PersonsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/persons"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePersonsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PersonsPost invokes POST /persons operation.
+//
+// Тело - PersonType (JSON) или существительное B2MML Person (XML);
+// ответ выбирается по Accept.
+//
+// POST /persons
+func (c *Client) PersonsPost(ctx context.Context, request PersonsPostReq) (PersonsPostRes, error) {
+	res, err := c.sendPersonsPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPersonsPost(ctx context.Context, request PersonsPostReq) (res PersonsPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/persons"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PersonsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/persons"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePersonsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePersonsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SearchGet invokes GET /search operation.
+//
+// Ищет по внешним ID, описаниям (в том числе B2MML Description на
+// разных языках)
+// и значениям свойств оборудования. Все слова запроса
+// обязательны и совпадают
+// по началу слова. Результаты упорядочены по
+// релевантности.
+//
+// GET /search
+func (c *Client) SearchGet(ctx context.Context, params SearchGetParams) (SearchGetRes, error) {
+	res, err := c.sendSearchGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendSearchGet(ctx context.Context, params SearchGetParams) (res SearchGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/search"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/search"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Q))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "lang" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "lang",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Lang.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Kind != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Kind {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(string(item)))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
-	c, err := newClientConfig(opts...).baseClient()
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
-		return nil, err
+		return res, errors.Wrap(err, "create request")
 	}
-	return &Client{
-		serverURL:  u,
-		baseClient: c,
-	}, nil
-}
 
-type serverURLKey struct{}
-
-// WithServerURL sets context key to override server URL.
-func WithServerURL(ctx context.Context, u *url.URL) context.Context {
-	return context.WithValue(ctx, serverURLKey{}, u)
-}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
 
-func (c *Client) requestURL(ctx context.Context) *url.URL {
-	u, ok := ctx.Value(serverURLKey{}).(*url.URL)
-	if !ok {
-		return c.serverURL
+	stage = "DecodeResponse"
+	result, err := decodeSearchGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
 	}
-	return u
+
+	return result, nil
 }
 
-// BatchesGet invokes GET /batches operation.
+// SitesIDUnitsGet invokes GET /sites/{id}/units operation.
 //
-// Получить список батчей.
+// Получить предпочтительные единицы площадки.
 //
-// GET /batches
-func (c *Client) BatchesGet(ctx context.Context) ([]BatchType, error) {
-	res, err := c.sendBatchesGet(ctx)
+// GET /sites/{id}/units
+func (c *Client) SitesIDUnitsGet(ctx context.Context, params SitesIDUnitsGetParams) (SitesIDUnitsGetRes, error) {
+	res, err := c.sendSitesIDUnitsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendBatchesGet(ctx context.Context) (res []BatchType, err error) {
+func (c *Client) sendSitesIDUnitsGet(ctx context.Context, params SitesIDUnitsGetParams) (res SitesIDUnitsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/batches"),
+		semconv.URLTemplateKey.String("/sites/{id}/units"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -171,7 +4161,7 @@ func (c *Client) sendBatchesGet(ctx context.Context) (res []BatchType, err error
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, BatchesGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SitesIDUnitsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -188,8 +4178,27 @@ func (c *Client) sendBatchesGet(ctx context.Context) (res []BatchType, err error
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/batches"
+	var pathParts [3]string
+	pathParts[0] = "/sites/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/units"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -206,7 +4215,7 @@ func (c *Client) sendBatchesGet(ctx context.Context) (res []BatchType, err error
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeBatchesGetResponse(resp)
+	result, err := decodeSitesIDUnitsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -214,20 +4223,20 @@ func (c *Client) sendBatchesGet(ctx context.Context) (res []BatchType, err error
 	return result, nil
 }
 
-// BatchesPost invokes POST /batches operation.
+// SitesIDUnitsPut invokes PUT /sites/{id}/units operation.
 //
-// Создать новый батч.
+// Заменить предпочтительные единицы площадки.
 //
-// POST /batches
-func (c *Client) BatchesPost(ctx context.Context, request *BatchType) error {
-	_, err := c.sendBatchesPost(ctx, request)
-	return err
+// PUT /sites/{id}/units
+func (c *Client) SitesIDUnitsPut(ctx context.Context, request *SiteUnits, params SitesIDUnitsPutParams) (SitesIDUnitsPutRes, error) {
+	res, err := c.sendSitesIDUnitsPut(ctx, request, params)
+	return res, err
 }
 
-func (c *Client) sendBatchesPost(ctx context.Context, request *BatchType) (res *BatchesPostCreated, err error) {
+func (c *Client) sendSitesIDUnitsPut(ctx context.Context, request *SiteUnits, params SitesIDUnitsPutParams) (res SitesIDUnitsPutRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/batches"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/sites/{id}/units"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -243,7 +4252,7 @@ func (c *Client) sendBatchesPost(ctx context.Context, request *BatchType) (res *
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, BatchesPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SitesIDUnitsPutOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -260,16 +4269,35 @@ func (c *Client) sendBatchesPost(ctx context.Context, request *BatchType) (res *
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/batches"
+	var pathParts [3]string
+	pathParts[0] = "/sites/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/units"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeBatchesPostRequest(request, r); err != nil {
+	if err := encodeSitesIDUnitsPutRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -281,7 +4309,7 @@ func (c *Client) sendBatchesPost(ctx context.Context, request *BatchType) (res *
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeBatchesPostResponse(resp)
+	result, err := decodeSitesIDUnitsPutResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -289,20 +4317,20 @@ func (c *Client) sendBatchesPost(ctx context.Context, request *BatchType) (res *
 	return result, nil
 }
 
-// EquipmentGet invokes GET /equipment operation.
+// UnitsConvertGet invokes GET /units/convert operation.
 //
-// Получить список оборудования.
+// Перевести значение в другую единицу измерения.
 //
-// GET /equipment
-func (c *Client) EquipmentGet(ctx context.Context) ([]EquipmentType, error) {
-	res, err := c.sendEquipmentGet(ctx)
+// GET /units/convert
+func (c *Client) UnitsConvertGet(ctx context.Context, params UnitsConvertGetParams) (UnitsConvertGetRes, error) {
+	res, err := c.sendUnitsConvertGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendEquipmentGet(ctx context.Context) (res []EquipmentType, err error) {
+func (c *Client) sendUnitsConvertGet(ctx context.Context, params UnitsConvertGetParams) (res UnitsConvertGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/equipment"),
+		semconv.URLTemplateKey.String("/units/convert"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -318,7 +4346,7 @@ func (c *Client) sendEquipmentGet(ctx context.Context) (res []EquipmentType, err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UnitsConvertGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -336,9 +4364,55 @@ func (c *Client) sendEquipmentGet(ctx context.Context) (res []EquipmentType, err
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/equipment"
+	pathParts[0] = "/units/convert"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "value" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "value",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.Float64ToString(params.Value))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.From))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.To))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -353,7 +4427,7 @@ func (c *Client) sendEquipmentGet(ctx context.Context) (res []EquipmentType, err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeEquipmentGetResponse(resp)
+	result, err := decodeUnitsConvertGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -361,20 +4435,23 @@ func (c *Client) sendEquipmentGet(ctx context.Context) (res []EquipmentType, err
 	return result, nil
 }
 
-// EquipmentPost invokes POST /equipment operation.
+// UnitsGet invokes GET /units operation.
 //
-// Добавить оборудование.
+// Единицы с кодами UN/ECE Recommendation 20 и их обозначения UCUM.
+// Значения
+// свойств могут использовать и другие выражения UCUM
+// (например kg/m3).
 //
-// POST /equipment
-func (c *Client) EquipmentPost(ctx context.Context, request *EquipmentType) error {
-	_, err := c.sendEquipmentPost(ctx, request)
-	return err
+// GET /units
+func (c *Client) UnitsGet(ctx context.Context, params UnitsGetParams) ([]UnitOfMeasure, error) {
+	res, err := c.sendUnitsGet(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendEquipmentPost(ctx context.Context, request *EquipmentType) (res *EquipmentPostCreated, err error) {
+func (c *Client) sendUnitsGet(ctx context.Context, params UnitsGetParams) (res []UnitOfMeasure, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/equipment"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/units"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -390,7 +4467,7 @@ func (c *Client) sendEquipmentPost(ctx context.Context, request *EquipmentType)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, EquipmentPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UnitsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -408,17 +4485,35 @@ func (c *Client) sendEquipmentPost(ctx context.Context, request *EquipmentType)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/equipment"
+	pathParts[0] = "/units"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Kind.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeEquipmentPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -428,7 +4523,7 @@ func (c *Client) sendEquipmentPost(ctx context.Context, request *EquipmentType)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeEquipmentPostResponse(resp)
+	result, err := decodeUnitsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -436,20 +4531,20 @@ func (c *Client) sendEquipmentPost(ctx context.Context, request *EquipmentType)
 	return result, nil
 }
 
-// MaterialsGet invokes GET /materials operation.
+// WebhooksGet invokes GET /webhooks operation.
 //
-// Получить список материалов.
+// Получить список подписок на события.
 //
-// GET /materials
-func (c *Client) MaterialsGet(ctx context.Context) ([]MaterialType, error) {
-	res, err := c.sendMaterialsGet(ctx)
+// GET /webhooks
+func (c *Client) WebhooksGet(ctx context.Context) ([]Webhook, error) {
+	res, err := c.sendWebhooksGet(ctx)
 	return res, err
 }
 
-func (c *Client) sendMaterialsGet(ctx context.Context) (res []MaterialType, err error) {
+func (c *Client) sendWebhooksGet(ctx context.Context) (res []Webhook, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/materials"),
+		semconv.URLTemplateKey.String("/webhooks"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -465,7 +4560,7 @@ func (c *Client) sendMaterialsGet(ctx context.Context) (res []MaterialType, err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MaterialsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhooksGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -483,7 +4578,7 @@ func (c *Client) sendMaterialsGet(ctx context.Context) (res []MaterialType, err
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/materials"
+	pathParts[0] = "/webhooks"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -500,7 +4595,7 @@ func (c *Client) sendMaterialsGet(ctx context.Context) (res []MaterialType, err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMaterialsGetResponse(resp)
+	result, err := decodeWebhooksGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -508,20 +4603,20 @@ func (c *Client) sendMaterialsGet(ctx context.Context) (res []MaterialType, err
 	return result, nil
 }
 
-// MaterialsPost invokes POST /materials operation.
+// WebhooksIDDelete invokes DELETE /webhooks/{id} operation.
 //
-// Добавить материал.
+// Удалить подписку вместе с её доставками.
 //
-// POST /materials
-func (c *Client) MaterialsPost(ctx context.Context, request *MaterialType) error {
-	_, err := c.sendMaterialsPost(ctx, request)
-	return err
+// DELETE /webhooks/{id}
+func (c *Client) WebhooksIDDelete(ctx context.Context, params WebhooksIDDeleteParams) (WebhooksIDDeleteRes, error) {
+	res, err := c.sendWebhooksIDDelete(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendMaterialsPost(ctx context.Context, request *MaterialType) (res *MaterialsPostCreated, err error) {
+func (c *Client) sendWebhooksIDDelete(ctx context.Context, params WebhooksIDDeleteParams) (res WebhooksIDDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/materials"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/webhooks/{id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -537,7 +4632,7 @@ func (c *Client) sendMaterialsPost(ctx context.Context, request *MaterialType) (
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MaterialsPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhooksIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -554,18 +4649,33 @@ func (c *Client) sendMaterialsPost(ctx context.Context, request *MaterialType) (
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/materials"
+	var pathParts [2]string
+	pathParts[0] = "/webhooks/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeMaterialsPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -575,7 +4685,7 @@ func (c *Client) sendMaterialsPost(ctx context.Context, request *MaterialType) (
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMaterialsPostResponse(resp)
+	result, err := decodeWebhooksIDDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -583,20 +4693,21 @@ func (c *Client) sendMaterialsPost(ctx context.Context, request *MaterialType) (
 	return result, nil
 }
 
-// PersonnelClassesGet invokes GET /personnel-classes operation.
+// WebhooksIDDeliveriesDeliveryIdReplayPost invokes POST /webhooks/{id}/deliveries/{deliveryId}/replay operation.
 //
-// Получить список классов персонала (PersonnelClass).
+// Доставка возвращается в очередь со сброшенным
+// счётчиком попыток.
 //
-// GET /personnel-classes
-func (c *Client) PersonnelClassesGet(ctx context.Context) ([]PersonnelClassType, error) {
-	res, err := c.sendPersonnelClassesGet(ctx)
+// POST /webhooks/{id}/deliveries/{deliveryId}/replay
+func (c *Client) WebhooksIDDeliveriesDeliveryIdReplayPost(ctx context.Context, params WebhooksIDDeliveriesDeliveryIdReplayPostParams) (WebhooksIDDeliveriesDeliveryIdReplayPostRes, error) {
+	res, err := c.sendWebhooksIDDeliveriesDeliveryIdReplayPost(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPersonnelClassesGet(ctx context.Context) (res []PersonnelClassType, err error) {
+func (c *Client) sendWebhooksIDDeliveriesDeliveryIdReplayPost(ctx context.Context, params WebhooksIDDeliveriesDeliveryIdReplayPostParams) (res WebhooksIDDeliveriesDeliveryIdReplayPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/personnel-classes"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/webhooks/{id}/deliveries/{deliveryId}/replay"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -612,7 +4723,7 @@ func (c *Client) sendPersonnelClassesGet(ctx context.Context) (res []PersonnelCl
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PersonnelClassesGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhooksIDDeliveriesDeliveryIdReplayPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -629,12 +4740,50 @@ func (c *Client) sendPersonnelClassesGet(ctx context.Context) (res []PersonnelCl
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/personnel-classes"
+	var pathParts [5]string
+	pathParts[0] = "/webhooks/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/deliveries/"
+	{
+		// Encode "deliveryId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "deliveryId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.DeliveryId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/replay"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -647,7 +4796,7 @@ func (c *Client) sendPersonnelClassesGet(ctx context.Context) (res []PersonnelCl
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePersonnelClassesGetResponse(resp)
+	result, err := decodeWebhooksIDDeliveriesDeliveryIdReplayPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -655,20 +4804,21 @@ func (c *Client) sendPersonnelClassesGet(ctx context.Context) (res []PersonnelCl
 	return result, nil
 }
 
-// PersonnelClassesPost invokes POST /personnel-classes operation.
+// WebhooksIDDeliveriesGet invokes GET /webhooks/{id}/deliveries operation.
 //
-// Добавить класс персонала.
+// Status=dead возвращает dead letter - доставки, исчерпавшие
+// попытки.
 //
-// POST /personnel-classes
-func (c *Client) PersonnelClassesPost(ctx context.Context, request *PersonnelClassType) error {
-	_, err := c.sendPersonnelClassesPost(ctx, request)
-	return err
+// GET /webhooks/{id}/deliveries
+func (c *Client) WebhooksIDDeliveriesGet(ctx context.Context, params WebhooksIDDeliveriesGetParams) (WebhooksIDDeliveriesGetRes, error) {
+	res, err := c.sendWebhooksIDDeliveriesGet(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendPersonnelClassesPost(ctx context.Context, request *PersonnelClassType) (res *PersonnelClassesPostCreated, err error) {
+func (c *Client) sendWebhooksIDDeliveriesGet(ctx context.Context, params WebhooksIDDeliveriesGetParams) (res WebhooksIDDeliveriesGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/personnel-classes"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/webhooks/{id}/deliveries"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -684,7 +4834,7 @@ func (c *Client) sendPersonnelClassesPost(ctx context.Context, request *Personne
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PersonnelClassesPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhooksIDDeliveriesGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -701,18 +4851,72 @@ func (c *Client) sendPersonnelClassesPost(ctx context.Context, request *Personne
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/personnel-classes"
+	var pathParts [3]string
+	pathParts[0] = "/webhooks/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/deliveries"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePersonnelClassesPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -722,7 +4926,7 @@ func (c *Client) sendPersonnelClassesPost(ctx context.Context, request *Personne
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePersonnelClassesPostResponse(resp)
+	result, err := decodeWebhooksIDDeliveriesGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -730,20 +4934,20 @@ func (c *Client) sendPersonnelClassesPost(ctx context.Context, request *Personne
 	return result, nil
 }
 
-// PersonnelInformationGet invokes GET /personnel-information operation.
+// WebhooksIDGet invokes GET /webhooks/{id} operation.
 //
-// Получить информацию о персонале (PersonnelInformation).
+// Получить подписку на события.
 //
-// GET /personnel-information
-func (c *Client) PersonnelInformationGet(ctx context.Context) ([]PersonnelInformationType, error) {
-	res, err := c.sendPersonnelInformationGet(ctx)
+// GET /webhooks/{id}
+func (c *Client) WebhooksIDGet(ctx context.Context, params WebhooksIDGetParams) (WebhooksIDGetRes, error) {
+	res, err := c.sendWebhooksIDGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPersonnelInformationGet(ctx context.Context) (res []PersonnelInformationType, err error) {
+func (c *Client) sendWebhooksIDGet(ctx context.Context, params WebhooksIDGetParams) (res WebhooksIDGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/personnel-information"),
+		semconv.URLTemplateKey.String("/webhooks/{id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -759,7 +4963,7 @@ func (c *Client) sendPersonnelInformationGet(ctx context.Context) (res []Personn
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PersonnelInformationGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhooksIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -776,8 +4980,26 @@ func (c *Client) sendPersonnelInformationGet(ctx context.Context) (res []Personn
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/personnel-information"
+	var pathParts [2]string
+	pathParts[0] = "/webhooks/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -794,7 +5016,7 @@ func (c *Client) sendPersonnelInformationGet(ctx context.Context) (res []Personn
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePersonnelInformationGetResponse(resp)
+	result, err := decodeWebhooksIDGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -802,20 +5024,21 @@ func (c *Client) sendPersonnelInformationGet(ctx context.Context) (res []Personn
 	return result, nil
 }
 
-// PersonnelInformationPost invokes POST /personnel-information operation.
+// WebhooksIDPingPost invokes POST /webhooks/{id}/ping operation.
 //
-// Добавить информацию о персонале.
+// Событие отправляется синхронно, не сохраняется и не
+// повторяется.
 //
-// POST /personnel-information
-func (c *Client) PersonnelInformationPost(ctx context.Context, request *PersonnelInformationType) error {
-	_, err := c.sendPersonnelInformationPost(ctx, request)
-	return err
+// POST /webhooks/{id}/ping
+func (c *Client) WebhooksIDPingPost(ctx context.Context, params WebhooksIDPingPostParams) (WebhooksIDPingPostRes, error) {
+	res, err := c.sendWebhooksIDPingPost(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendPersonnelInformationPost(ctx context.Context, request *PersonnelInformationType) (res *PersonnelInformationPostCreated, err error) {
+func (c *Client) sendWebhooksIDPingPost(ctx context.Context, params WebhooksIDPingPostParams) (res WebhooksIDPingPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/personnel-information"),
+		semconv.URLTemplateKey.String("/webhooks/{id}/ping"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -831,7 +5054,7 @@ func (c *Client) sendPersonnelInformationPost(ctx context.Context, request *Pers
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PersonnelInformationPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhooksIDPingPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -848,8 +5071,27 @@ func (c *Client) sendPersonnelInformationPost(ctx context.Context, request *Pers
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/personnel-information"
+	var pathParts [3]string
+	pathParts[0] = "/webhooks/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/ping"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -857,9 +5099,6 @@ func (c *Client) sendPersonnelInformationPost(ctx context.Context, request *Pers
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePersonnelInformationPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -869,7 +5108,7 @@ func (c *Client) sendPersonnelInformationPost(ctx context.Context, request *Pers
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePersonnelInformationPostResponse(resp)
+	result, err := decodeWebhooksIDPingPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -877,20 +5116,20 @@ func (c *Client) sendPersonnelInformationPost(ctx context.Context, request *Pers
 	return result, nil
 }
 
-// PersonsGet invokes GET /persons operation.
+// WebhooksIDPut invokes PUT /webhooks/{id} operation.
 //
-// Получить список персон (Person).
+// Secret не меняется; поле secret в запросе игнорируется.
 //
-// GET /persons
-func (c *Client) PersonsGet(ctx context.Context) ([]PersonType, error) {
-	res, err := c.sendPersonsGet(ctx)
+// PUT /webhooks/{id}
+func (c *Client) WebhooksIDPut(ctx context.Context, request *Webhook, params WebhooksIDPutParams) (WebhooksIDPutRes, error) {
+	res, err := c.sendWebhooksIDPut(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPersonsGet(ctx context.Context) (res []PersonType, err error) {
+func (c *Client) sendWebhooksIDPut(ctx context.Context, request *Webhook, params WebhooksIDPutParams) (res WebhooksIDPutRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/persons"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/webhooks/{id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -906,7 +5145,7 @@ func (c *Client) sendPersonsGet(ctx context.Context) (res []PersonType, err erro
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PersonsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhooksIDPutOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -923,15 +5162,36 @@ func (c *Client) sendPersonsGet(ctx context.Context) (res []PersonType, err erro
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/persons"
+	var pathParts [2]string
+	pathParts[0] = "/webhooks/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeWebhooksIDPutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -941,7 +5201,7 @@ func (c *Client) sendPersonsGet(ctx context.Context) (res []PersonType, err erro
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePersonsGetResponse(resp)
+	result, err := decodeWebhooksIDPutResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -949,20 +5209,24 @@ func (c *Client) sendPersonsGet(ctx context.Context) (res []PersonType, err erro
 	return result, nil
 }
 
-// PersonsPost invokes POST /persons operation.
+// WebhooksPost invokes POST /webhooks operation.
 //
-// Добавить персону.
+// События доставляются POST-запросом с JSON телом (WebhookEvent).
+// Тело подписывается HMAC-SHA256 по secret: заголовок X-CMMS-Signature
+// содержит sha256=<hex> от строки "<X-CMMS-Timestamp>.<тело>".
+// Если secret не задан, он генерируется и возвращается
+// только в ответе на создание.
 //
-// POST /persons
-func (c *Client) PersonsPost(ctx context.Context, request *PersonType) error {
-	_, err := c.sendPersonsPost(ctx, request)
-	return err
+// POST /webhooks
+func (c *Client) WebhooksPost(ctx context.Context, request *Webhook) (WebhooksPostRes, error) {
+	res, err := c.sendWebhooksPost(ctx, request)
+	return res, err
 }
 
-func (c *Client) sendPersonsPost(ctx context.Context, request *PersonType) (res *PersonsPostCreated, err error) {
+func (c *Client) sendWebhooksPost(ctx context.Context, request *Webhook) (res WebhooksPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/persons"),
+		semconv.URLTemplateKey.String("/webhooks"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -978,7 +5242,7 @@ func (c *Client) sendPersonsPost(ctx context.Context, request *PersonType) (res
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PersonsPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, WebhooksPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -996,7 +5260,7 @@ func (c *Client) sendPersonsPost(ctx context.Context, request *PersonType) (res
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/persons"
+	pathParts[0] = "/webhooks"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1004,7 +5268,7 @@ func (c *Client) sendPersonsPost(ctx context.Context, request *PersonType) (res
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePersonsPostRequest(request, r); err != nil {
+	if err := encodeWebhooksPostRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1016,7 +5280,7 @@ func (c *Client) sendPersonsPost(ctx context.Context, request *PersonType) (res
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePersonsPostResponse(resp)
+	result, err := decodeWebhooksPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -0,0 +1,146 @@
+// Code generated by ogen, DO NOT EDIT.
+package api
+
+type B2mmlMasterDataProfileGetRes interface {
+	b2mmlMasterDataProfileGetRes()
+}
+
+type B2mmlPostRes interface {
+	b2mmlPostRes()
+}
+
+type EquipmentExportGetRes interface {
+	equipmentExportGetRes()
+}
+
+type EquipmentGetRes interface {
+	equipmentGetRes()
+}
+
+type EquipmentIDDeleteRes interface {
+	equipmentIDDeleteRes()
+}
+
+type EquipmentIDGetRes interface {
+	equipmentIDGetRes()
+}
+
+type EquipmentIDHistoryDiffGetRes interface {
+	equipmentIDHistoryDiffGetRes()
+}
+
+type EquipmentIDHistoryGetRes interface {
+	equipmentIDHistoryGetRes()
+}
+
+type EquipmentIDMovePostRes interface {
+	equipmentIDMovePostRes()
+}
+
+type EquipmentIDPropertiesGetRes interface {
+	equipmentIDPropertiesGetRes()
+}
+
+type EquipmentIDPurgePostRes interface {
+	equipmentIDPurgePostRes()
+}
+
+type EquipmentIDPutReq interface {
+	equipmentIDPutReq()
+}
+
+type EquipmentIDPutRes interface {
+	equipmentIDPutRes()
+}
+
+type EquipmentIDRestorePostRes interface {
+	equipmentIDRestorePostRes()
+}
+
+type EquipmentIDTreeGetRes interface {
+	equipmentIDTreeGetRes()
+}
+
+type EquipmentImportsImportIdGetRes interface {
+	equipmentImportsImportIdGetRes()
+}
+
+type EquipmentImportsImportIdResumePostRes interface {
+	equipmentImportsImportIdResumePostRes()
+}
+
+type EquipmentImportsPostReq interface {
+	equipmentImportsPostReq()
+}
+
+type EquipmentImportsPostRes interface {
+	equipmentImportsPostRes()
+}
+
+type EquipmentPostReq interface {
+	equipmentPostReq()
+}
+
+type EquipmentPostRes interface {
+	equipmentPostRes()
+}
+
+type EquipmentQueryPostRes interface {
+	equipmentQueryPostRes()
+}
+
+type EventsStreamGetRes interface {
+	eventsStreamGetRes()
+}
+
+type PersonsPostReq interface {
+	personsPostReq()
+}
+
+type PersonsPostRes interface {
+	personsPostRes()
+}
+
+type SearchGetRes interface {
+	searchGetRes()
+}
+
+type SitesIDUnitsGetRes interface {
+	sitesIDUnitsGetRes()
+}
+
+type SitesIDUnitsPutRes interface {
+	sitesIDUnitsPutRes()
+}
+
+type UnitsConvertGetRes interface {
+	unitsConvertGetRes()
+}
+
+type WebhooksIDDeleteRes interface {
+	webhooksIDDeleteRes()
+}
+
+type WebhooksIDDeliveriesDeliveryIdReplayPostRes interface {
+	webhooksIDDeliveriesDeliveryIdReplayPostRes()
+}
+
+type WebhooksIDDeliveriesGetRes interface {
+	webhooksIDDeliveriesGetRes()
+}
+
+type WebhooksIDGetRes interface {
+	webhooksIDGetRes()
+}
+
+type WebhooksIDPingPostRes interface {
+	webhooksIDPingPostRes()
+}
+
+type WebhooksIDPutRes interface {
+	webhooksIDPutRes()
+}
+
+type WebhooksPostRes interface {
+	webhooksPostRes()
+}
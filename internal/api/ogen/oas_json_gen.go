@@ -1005,6 +1005,263 @@ func (s *CoordinatesType) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *EffectiveProperty) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EffectiveProperty) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Str(s.ID)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		if s.Value.Set {
+			e.FieldStart("value")
+			s.Value.Encode(e)
+		}
+	}
+	{
+		if s.DataType.Set {
+			e.FieldStart("data_type")
+			s.DataType.Encode(e)
+		}
+	}
+	{
+		if s.Unit.Set {
+			e.FieldStart("unit")
+			s.Unit.Encode(e)
+		}
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.Class.Set {
+			e.FieldStart("class")
+			s.Class.Encode(e)
+		}
+	}
+	{
+		if s.PropertyType.Set {
+			e.FieldStart("property_type")
+			s.PropertyType.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfEffectiveProperty = [8]string{
+	0: "id",
+	1: "status",
+	2: "value",
+	3: "data_type",
+	4: "unit",
+	5: "description",
+	6: "class",
+	7: "property_type",
+}
+
+// Decode decodes EffectiveProperty from json.
+func (s *EffectiveProperty) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EffectiveProperty to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "value":
+			if err := func() error {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		case "data_type":
+			if err := func() error {
+				s.DataType.Reset()
+				if err := s.DataType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data_type\"")
+			}
+		case "unit":
+			if err := func() error {
+				s.Unit.Reset()
+				if err := s.Unit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unit\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "class":
+			if err := func() error {
+				s.Class.Reset()
+				if err := s.Class.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"class\"")
+			}
+		case "property_type":
+			if err := func() error {
+				s.PropertyType.Reset()
+				if err := s.PropertyType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"property_type\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EffectiveProperty")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEffectiveProperty) {
+					name = jsonFieldsNameOfEffectiveProperty[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EffectiveProperty) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EffectiveProperty) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EffectivePropertyStatus as json.
+func (s EffectivePropertyStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EffectivePropertyStatus from json.
+func (s *EffectivePropertyStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EffectivePropertyStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EffectivePropertyStatus(v) {
+	case EffectivePropertyStatusMissing:
+		*s = EffectivePropertyStatusMissing
+	case EffectivePropertyStatusInherited:
+		*s = EffectivePropertyStatusInherited
+	case EffectivePropertyStatusOverridden:
+		*s = EffectivePropertyStatusOverridden
+	case EffectivePropertyStatusLocal:
+		*s = EffectivePropertyStatusLocal
+	default:
+		*s = EffectivePropertyStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EffectivePropertyStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EffectivePropertyStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *ElectricalPropertiesType) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -1103,2741 +1360,6682 @@ func (s *ElectricalPropertiesType) UnmarshalJSON(data []byte) error {
 }
 
 // Encode implements json.Marshaler.
-func (s *EquipmentType) Encode(e *jx.Encoder) {
+func (s *EquipmentDiff) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EquipmentType) encodeFields(e *jx.Encoder) {
-	{
-		if s.EquipmentID.Set {
-			e.FieldStart("EquipmentID")
-			s.EquipmentID.Encode(e)
-		}
-	}
+func (s *EquipmentDiff) encodeFields(e *jx.Encoder) {
 	{
-		if s.EquipmentType.Set {
-			e.FieldStart("EquipmentType")
-			s.EquipmentType.Encode(e)
-		}
-	}
-	{
-		if s.Description.Set {
-			e.FieldStart("Description")
-			s.Description.Encode(e)
-		}
-	}
-	{
-		if s.Manufacturer.Set {
-			e.FieldStart("Manufacturer")
-			s.Manufacturer.Encode(e)
-		}
-	}
-	{
-		if s.Model.Set {
-			e.FieldStart("Model")
-			s.Model.Encode(e)
-		}
-	}
-	{
-		if s.SerialNumber.Set {
-			e.FieldStart("SerialNumber")
-			s.SerialNumber.Encode(e)
-		}
-	}
-	{
-		if s.InstallationDate.Set {
-			e.FieldStart("InstallationDate")
-			s.InstallationDate.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.Location.Set {
-			e.FieldStart("Location")
-			s.Location.Encode(e)
+		if s.From.Set {
+			e.FieldStart("from")
+			s.From.Encode(e)
 		}
 	}
 	{
-		if s.MaintenanceHistory.Set {
-			e.FieldStart("MaintenanceHistory")
-			s.MaintenanceHistory.Encode(e)
-		}
+		e.FieldStart("to")
+		s.To.Encode(e)
 	}
 	{
-		if s.PerformanceData.Set {
-			e.FieldStart("PerformanceData")
-			s.PerformanceData.Encode(e)
+		e.FieldStart("changes")
+		e.ArrStart()
+		for _, elem := range s.Changes {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfEquipmentType = [10]string{
-	0: "EquipmentID",
-	1: "EquipmentType",
-	2: "Description",
-	3: "Manufacturer",
-	4: "Model",
-	5: "SerialNumber",
-	6: "InstallationDate",
-	7: "Location",
-	8: "MaintenanceHistory",
-	9: "PerformanceData",
+var jsonFieldsNameOfEquipmentDiff = [3]string{
+	0: "from",
+	1: "to",
+	2: "changes",
 }
 
-// Decode decodes EquipmentType from json.
-func (s *EquipmentType) Decode(d *jx.Decoder) error {
+// Decode decodes EquipmentDiff from json.
+func (s *EquipmentDiff) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EquipmentType to nil")
+		return errors.New("invalid: unable to decode EquipmentDiff to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "EquipmentID":
+		case "from":
 			if err := func() error {
-				s.EquipmentID.Reset()
-				if err := s.EquipmentID.Decode(d); err != nil {
+				s.From.Reset()
+				if err := s.From.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EquipmentID\"")
+				return errors.Wrap(err, "decode field \"from\"")
 			}
-		case "EquipmentType":
+		case "to":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.EquipmentType.Reset()
-				if err := s.EquipmentType.Decode(d); err != nil {
+				if err := s.To.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EquipmentType\"")
+				return errors.Wrap(err, "decode field \"to\"")
 			}
-		case "Description":
+		case "changes":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
-			}
-		case "Manufacturer":
-			if err := func() error {
-				s.Manufacturer.Reset()
-				if err := s.Manufacturer.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Manufacturer\"")
-			}
-		case "Model":
-			if err := func() error {
-				s.Model.Reset()
-				if err := s.Model.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Model\"")
-			}
-		case "SerialNumber":
-			if err := func() error {
-				s.SerialNumber.Reset()
-				if err := s.SerialNumber.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SerialNumber\"")
-			}
-		case "InstallationDate":
-			if err := func() error {
-				s.InstallationDate.Reset()
-				if err := s.InstallationDate.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"InstallationDate\"")
-			}
-		case "Location":
-			if err := func() error {
-				s.Location.Reset()
-				if err := s.Location.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Location\"")
-			}
-		case "MaintenanceHistory":
-			if err := func() error {
-				s.MaintenanceHistory.Reset()
-				if err := s.MaintenanceHistory.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaintenanceHistory\"")
-			}
-		case "PerformanceData":
-			if err := func() error {
-				s.PerformanceData.Reset()
-				if err := s.PerformanceData.Decode(d); err != nil {
+				s.Changes = make([]FieldChange, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem FieldChange
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Changes = append(s.Changes, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PerformanceData\"")
+				return errors.Wrap(err, "decode field \"changes\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EquipmentType")
+		return errors.Wrap(err, "decode EquipmentDiff")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000110,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEquipmentDiff) {
+					name = jsonFieldsNameOfEquipmentDiff[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EquipmentType) MarshalJSON() ([]byte, error) {
+func (s *EquipmentDiff) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EquipmentType) UnmarshalJSON(data []byte) error {
+func (s *EquipmentDiff) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s HierarchyScopeType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
+// Encode encodes EquipmentIDHistoryGetOKApplicationJSON as json.
+func (s EquipmentIDHistoryGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []EquipmentRevision(s)
 
-// encodeFields implements json.Marshaler.
-func (s HierarchyScopeType) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
 
-		if len(elem) != 0 {
-			e.Raw(elem)
+// Decode decodes EquipmentIDHistoryGetOKApplicationJSON from json.
+func (s *EquipmentIDHistoryGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EquipmentIDHistoryGetOKApplicationJSON to nil")
+	}
+	var unwrapped []EquipmentRevision
+	if err := func() error {
+		unwrapped = make([]EquipmentRevision, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem EquipmentRevision
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = EquipmentIDHistoryGetOKApplicationJSON(unwrapped)
+	return nil
 }
 
-// Decode decodes HierarchyScopeType from json.
-func (s *HierarchyScopeType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode HierarchyScopeType to nil")
+// MarshalJSON implements stdjson.Marshaler.
+func (s EquipmentIDHistoryGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EquipmentIDHistoryGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EquipmentIDPropertiesGetOKApplicationJSON as json.
+func (s EquipmentIDPropertiesGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []EffectiveProperty(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
+	e.ArrEnd()
+}
+
+// Decode decodes EquipmentIDPropertiesGetOKApplicationJSON from json.
+func (s *EquipmentIDPropertiesGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EquipmentIDPropertiesGetOKApplicationJSON to nil")
+	}
+	var unwrapped []EffectiveProperty
+	if err := func() error {
+		unwrapped = make([]EffectiveProperty, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem EffectiveProperty
+			if err := elem.Decode(d); err != nil {
 				return err
 			}
+			unwrapped = append(unwrapped, elem)
 			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		}); err != nil {
+			return err
 		}
-		m[string(k)] = elem
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode HierarchyScopeType")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = EquipmentIDPropertiesGetOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s HierarchyScopeType) MarshalJSON() ([]byte, error) {
+func (s EquipmentIDPropertiesGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HierarchyScopeType) UnmarshalJSON(data []byte) error {
+func (s *EquipmentIDPropertiesGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *LocationType) Encode(e *jx.Encoder) {
+func (s *EquipmentList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *LocationType) encodeFields(e *jx.Encoder) {
-	{
-		if s.SiteID.Set {
-			e.FieldStart("SiteID")
-			s.SiteID.Encode(e)
-		}
-	}
-	{
-		if s.BuildingID.Set {
-			e.FieldStart("BuildingID")
-			s.BuildingID.Encode(e)
-		}
-	}
+func (s *EquipmentList) encodeFields(e *jx.Encoder) {
 	{
-		if s.FloorID.Set {
-			e.FieldStart("FloorID")
-			s.FloorID.Encode(e)
+		e.FieldStart("items")
+		e.ArrStart()
+		for _, elem := range s.Items {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 	{
-		if s.AreaID.Set {
-			e.FieldStart("AreaID")
-			s.AreaID.Encode(e)
+		if s.NextCursor.Set {
+			e.FieldStart("next_cursor")
+			s.NextCursor.Encode(e)
 		}
 	}
 	{
-		if s.Coordinates.Set {
-			e.FieldStart("Coordinates")
-			s.Coordinates.Encode(e)
+		if s.PrevCursor.Set {
+			e.FieldStart("prev_cursor")
+			s.PrevCursor.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfLocationType = [5]string{
-	0: "SiteID",
-	1: "BuildingID",
-	2: "FloorID",
-	3: "AreaID",
-	4: "Coordinates",
+var jsonFieldsNameOfEquipmentList = [3]string{
+	0: "items",
+	1: "next_cursor",
+	2: "prev_cursor",
 }
 
-// Decode decodes LocationType from json.
-func (s *LocationType) Decode(d *jx.Decoder) error {
+// Decode decodes EquipmentList from json.
+func (s *EquipmentList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LocationType to nil")
+		return errors.New("invalid: unable to decode EquipmentList to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "SiteID":
-			if err := func() error {
-				s.SiteID.Reset()
-				if err := s.SiteID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SiteID\"")
-			}
-		case "BuildingID":
-			if err := func() error {
-				s.BuildingID.Reset()
-				if err := s.BuildingID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"BuildingID\"")
-			}
-		case "FloorID":
+		case "items":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.FloorID.Reset()
-				if err := s.FloorID.Decode(d); err != nil {
+				s.Items = make([]EquipmentType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EquipmentType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Items = append(s.Items, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"FloorID\"")
+				return errors.Wrap(err, "decode field \"items\"")
 			}
-		case "AreaID":
+		case "next_cursor":
 			if err := func() error {
-				s.AreaID.Reset()
-				if err := s.AreaID.Decode(d); err != nil {
+				s.NextCursor.Reset()
+				if err := s.NextCursor.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AreaID\"")
+				return errors.Wrap(err, "decode field \"next_cursor\"")
 			}
-		case "Coordinates":
+		case "prev_cursor":
 			if err := func() error {
-				s.Coordinates.Reset()
-				if err := s.Coordinates.Decode(d); err != nil {
+				s.PrevCursor.Reset()
+				if err := s.PrevCursor.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Coordinates\"")
+				return errors.Wrap(err, "decode field \"prev_cursor\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode LocationType")
+		return errors.Wrap(err, "decode EquipmentList")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *LocationType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LocationType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *MaintenanceEventType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MaintenanceEventType) encodeFields(e *jx.Encoder) {
-	{
-		if s.EventID.Set {
-			e.FieldStart("EventID")
-			s.EventID.Encode(e)
-		}
-	}
-	{
-		if s.EventDate.Set {
-			e.FieldStart("EventDate")
-			s.EventDate.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.Description.Set {
-			e.FieldStart("Description")
-			s.Description.Encode(e)
-		}
-	}
-	{
-		if s.PerformedBy.Set {
-			e.FieldStart("PerformedBy")
-			s.PerformedBy.Encode(e)
-		}
-	}
-	{
-		if s.PartsReplaced.Set {
-			e.FieldStart("PartsReplaced")
-			s.PartsReplaced.Encode(e)
-		}
-	}
-	{
-		if s.DowntimeHours.Set {
-			e.FieldStart("DowntimeHours")
-			s.DowntimeHours.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfMaintenanceEventType = [6]string{
-	0: "EventID",
-	1: "EventDate",
-	2: "Description",
-	3: "PerformedBy",
-	4: "PartsReplaced",
-	5: "DowntimeHours",
-}
-
-// Decode decodes MaintenanceEventType from json.
-func (s *MaintenanceEventType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MaintenanceEventType to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "EventID":
-			if err := func() error {
-				s.EventID.Reset()
-				if err := s.EventID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EventID\"")
-			}
-		case "EventDate":
-			if err := func() error {
-				s.EventDate.Reset()
-				if err := s.EventDate.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EventDate\"")
-			}
-		case "Description":
-			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
-			}
-		case "PerformedBy":
-			if err := func() error {
-				s.PerformedBy.Reset()
-				if err := s.PerformedBy.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PerformedBy\"")
-			}
-		case "PartsReplaced":
-			if err := func() error {
-				s.PartsReplaced.Reset()
-				if err := s.PartsReplaced.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PartsReplaced\"")
-			}
-		case "DowntimeHours":
-			if err := func() error {
-				s.DowntimeHours.Reset()
-				if err := s.DowntimeHours.Decode(d); err != nil {
-					return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEquipmentList) {
+					name = jsonFieldsNameOfEquipmentList[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"DowntimeHours\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		default:
-			return d.Skip()
 		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MaintenanceEventType")
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MaintenanceEventType) MarshalJSON() ([]byte, error) {
+func (s *EquipmentList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MaintenanceEventType) UnmarshalJSON(data []byte) error {
+func (s *EquipmentList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *MaintenanceHistoryType) Encode(e *jx.Encoder) {
+func (s *EquipmentMove) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *MaintenanceHistoryType) encodeFields(e *jx.Encoder) {
+func (s *EquipmentMove) encodeFields(e *jx.Encoder) {
 	{
-		if s.MaintenanceEvent != nil {
-			e.FieldStart("MaintenanceEvent")
-			e.ArrStart()
-			for _, elem := range s.MaintenanceEvent {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.ParentID.Set {
+			e.FieldStart("parent_id")
+			s.ParentID.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfMaintenanceHistoryType = [1]string{
-	0: "MaintenanceEvent",
+var jsonFieldsNameOfEquipmentMove = [1]string{
+	0: "parent_id",
 }
 
-// Decode decodes MaintenanceHistoryType from json.
-func (s *MaintenanceHistoryType) Decode(d *jx.Decoder) error {
+// Decode decodes EquipmentMove from json.
+func (s *EquipmentMove) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MaintenanceHistoryType to nil")
+		return errors.New("invalid: unable to decode EquipmentMove to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "MaintenanceEvent":
+		case "parent_id":
 			if err := func() error {
-				s.MaintenanceEvent = make([]MaintenanceEventType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem MaintenanceEventType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.MaintenanceEvent = append(s.MaintenanceEvent, elem)
-					return nil
-				}); err != nil {
+				s.ParentID.Reset()
+				if err := s.ParentID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaintenanceEvent\"")
+				return errors.Wrap(err, "decode field \"parent_id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MaintenanceHistoryType")
+		return errors.Wrap(err, "decode EquipmentMove")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MaintenanceHistoryType) MarshalJSON() ([]byte, error) {
+func (s *EquipmentMove) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MaintenanceHistoryType) UnmarshalJSON(data []byte) error {
+func (s *EquipmentMove) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *MaterialCategoryType) Encode(e *jx.Encoder) {
+func (s *EquipmentQuery) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *MaterialCategoryType) encodeFields(e *jx.Encoder) {
+func (s *EquipmentQuery) encodeFields(e *jx.Encoder) {
 	{
-		if s.CategoryCode.Set {
-			e.FieldStart("CategoryCode")
-			s.CategoryCode.Encode(e)
+		if s.Status != nil {
+			e.FieldStart("status")
+			e.ArrStart()
+			for _, elem := range s.Status {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.CategoryDescription.Set {
-			e.FieldStart("CategoryDescription")
-			s.CategoryDescription.Encode(e)
+		if s.EquipmentLevel != nil {
+			e.FieldStart("equipment_level")
+			e.ArrStart()
+			for _, elem := range s.EquipmentLevel {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.HierarchyScope != nil {
+			e.FieldStart("hierarchy_scope")
+			e.ArrStart()
+			for _, elem := range s.HierarchyScope {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Class.Set {
+			e.FieldStart("class")
+			s.Class.Encode(e)
+		}
+	}
+	{
+		if s.IncludeSubclasses.Set {
+			e.FieldStart("include_subclasses")
+			s.IncludeSubclasses.Encode(e)
+		}
+	}
+	{
+		if s.EffectiveAt.Set {
+			e.FieldStart("effective_at")
+			s.EffectiveAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EffectiveFrom.Set {
+			e.FieldStart("effective_from")
+			s.EffectiveFrom.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EffectiveTo.Set {
+			e.FieldStart("effective_to")
+			s.EffectiveTo.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Properties != nil {
+			e.FieldStart("properties")
+			e.ArrStart()
+			for _, elem := range s.Properties {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Sort.Set {
+			e.FieldStart("sort")
+			s.Sort.Encode(e)
+		}
+	}
+	{
+		if s.Order.Set {
+			e.FieldStart("order")
+			s.Order.Encode(e)
+		}
+	}
+	{
+		if s.Fields != nil {
+			e.FieldStart("fields")
+			e.ArrStart()
+			for _, elem := range s.Fields {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Limit.Set {
+			e.FieldStart("limit")
+			s.Limit.Encode(e)
+		}
+	}
+	{
+		if s.Cursor.Set {
+			e.FieldStart("cursor")
+			s.Cursor.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfMaterialCategoryType = [2]string{
-	0: "CategoryCode",
-	1: "CategoryDescription",
+var jsonFieldsNameOfEquipmentQuery = [14]string{
+	0:  "status",
+	1:  "equipment_level",
+	2:  "hierarchy_scope",
+	3:  "class",
+	4:  "include_subclasses",
+	5:  "effective_at",
+	6:  "effective_from",
+	7:  "effective_to",
+	8:  "properties",
+	9:  "sort",
+	10: "order",
+	11: "fields",
+	12: "limit",
+	13: "cursor",
 }
 
-// Decode decodes MaterialCategoryType from json.
-func (s *MaterialCategoryType) Decode(d *jx.Decoder) error {
+// Decode decodes EquipmentQuery from json.
+func (s *EquipmentQuery) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MaterialCategoryType to nil")
+		return errors.New("invalid: unable to decode EquipmentQuery to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "CategoryCode":
+		case "status":
 			if err := func() error {
-				s.CategoryCode.Reset()
-				if err := s.CategoryCode.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CategoryCode\"")
+				s.Status = make([]EquipmentQueryStatusItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EquipmentQueryStatusItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Status = append(s.Status, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
 			}
-		case "CategoryDescription":
+		case "equipment_level":
 			if err := func() error {
-				s.CategoryDescription.Reset()
-				if err := s.CategoryDescription.Decode(d); err != nil {
+				s.EquipmentLevel = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.EquipmentLevel = append(s.EquipmentLevel, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CategoryDescription\"")
+				return errors.Wrap(err, "decode field \"equipment_level\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MaterialCategoryType")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *MaterialCategoryType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MaterialCategoryType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *MaterialCompositionType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MaterialCompositionType) encodeFields(e *jx.Encoder) {
-	{
-		if s.BaseMaterial.Set {
-			e.FieldStart("BaseMaterial")
-			s.BaseMaterial.Encode(e)
-		}
-	}
-	{
-		if s.Additives.Set {
-			e.FieldStart("Additives")
-			s.Additives.Encode(e)
-		}
-	}
-	{
-		if s.Contaminants.Set {
-			e.FieldStart("Contaminants")
-			s.Contaminants.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfMaterialCompositionType = [3]string{
-	0: "BaseMaterial",
-	1: "Additives",
-	2: "Contaminants",
-}
-
-// Decode decodes MaterialCompositionType from json.
-func (s *MaterialCompositionType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MaterialCompositionType to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "BaseMaterial":
+		case "hierarchy_scope":
 			if err := func() error {
-				s.BaseMaterial.Reset()
-				if err := s.BaseMaterial.Decode(d); err != nil {
+				s.HierarchyScope = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.HierarchyScope = append(s.HierarchyScope, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"BaseMaterial\"")
+				return errors.Wrap(err, "decode field \"hierarchy_scope\"")
 			}
-		case "Additives":
+		case "class":
 			if err := func() error {
-				s.Additives.Reset()
-				if err := s.Additives.Decode(d); err != nil {
+				s.Class.Reset()
+				if err := s.Class.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Additives\"")
+				return errors.Wrap(err, "decode field \"class\"")
 			}
-		case "Contaminants":
+		case "include_subclasses":
 			if err := func() error {
-				s.Contaminants.Reset()
-				if err := s.Contaminants.Decode(d); err != nil {
+				s.IncludeSubclasses.Reset()
+				if err := s.IncludeSubclasses.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Contaminants\"")
+				return errors.Wrap(err, "decode field \"include_subclasses\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MaterialCompositionType")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *MaterialCompositionType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MaterialCompositionType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *MaterialPropertiesType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MaterialPropertiesType) encodeFields(e *jx.Encoder) {
-	{
-		if s.PhysicalProperties.Set {
-			e.FieldStart("PhysicalProperties")
-			s.PhysicalProperties.Encode(e)
-		}
-	}
-	{
-		if s.ChemicalProperties.Set {
-			e.FieldStart("ChemicalProperties")
-			s.ChemicalProperties.Encode(e)
-		}
-	}
-	{
-		if s.MechanicalProperties.Set {
-			e.FieldStart("MechanicalProperties")
-			s.MechanicalProperties.Encode(e)
-		}
-	}
-	{
-		if s.ThermalProperties.Set {
-			e.FieldStart("ThermalProperties")
-			s.ThermalProperties.Encode(e)
-		}
-	}
-	{
-		if s.ElectricalProperties.Set {
-			e.FieldStart("ElectricalProperties")
-			s.ElectricalProperties.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfMaterialPropertiesType = [5]string{
-	0: "PhysicalProperties",
-	1: "ChemicalProperties",
-	2: "MechanicalProperties",
-	3: "ThermalProperties",
-	4: "ElectricalProperties",
-}
-
-// Decode decodes MaterialPropertiesType from json.
-func (s *MaterialPropertiesType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MaterialPropertiesType to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "PhysicalProperties":
+		case "effective_at":
 			if err := func() error {
-				s.PhysicalProperties.Reset()
-				if err := s.PhysicalProperties.Decode(d); err != nil {
+				s.EffectiveAt.Reset()
+				if err := s.EffectiveAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PhysicalProperties\"")
+				return errors.Wrap(err, "decode field \"effective_at\"")
 			}
-		case "ChemicalProperties":
+		case "effective_from":
 			if err := func() error {
-				s.ChemicalProperties.Reset()
-				if err := s.ChemicalProperties.Decode(d); err != nil {
+				s.EffectiveFrom.Reset()
+				if err := s.EffectiveFrom.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ChemicalProperties\"")
+				return errors.Wrap(err, "decode field \"effective_from\"")
 			}
-		case "MechanicalProperties":
+		case "effective_to":
 			if err := func() error {
-				s.MechanicalProperties.Reset()
-				if err := s.MechanicalProperties.Decode(d); err != nil {
+				s.EffectiveTo.Reset()
+				if err := s.EffectiveTo.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MechanicalProperties\"")
+				return errors.Wrap(err, "decode field \"effective_to\"")
 			}
-		case "ThermalProperties":
+		case "properties":
 			if err := func() error {
-				s.ThermalProperties.Reset()
-				if err := s.ThermalProperties.Decode(d); err != nil {
+				s.Properties = make([]PropertyPredicate, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PropertyPredicate
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Properties = append(s.Properties, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ThermalProperties\"")
+				return errors.Wrap(err, "decode field \"properties\"")
 			}
-		case "ElectricalProperties":
+		case "sort":
 			if err := func() error {
-				s.ElectricalProperties.Reset()
-				if err := s.ElectricalProperties.Decode(d); err != nil {
+				s.Sort.Reset()
+				if err := s.Sort.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ElectricalProperties\"")
+				return errors.Wrap(err, "decode field \"sort\"")
+			}
+		case "order":
+			if err := func() error {
+				s.Order.Reset()
+				if err := s.Order.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"order\"")
+			}
+		case "fields":
+			if err := func() error {
+				s.Fields = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Fields = append(s.Fields, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fields\"")
+			}
+		case "limit":
+			if err := func() error {
+				s.Limit.Reset()
+				if err := s.Limit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"limit\"")
+			}
+		case "cursor":
+			if err := func() error {
+				s.Cursor.Reset()
+				if err := s.Cursor.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cursor\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MaterialPropertiesType")
+		return errors.Wrap(err, "decode EquipmentQuery")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MaterialPropertiesType) MarshalJSON() ([]byte, error) {
+func (s *EquipmentQuery) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MaterialPropertiesType) UnmarshalJSON(data []byte) error {
+func (s *EquipmentQuery) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MaterialSourceType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes EquipmentQueryOrder as json.
+func (s EquipmentQueryOrder) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// encodeFields encodes fields.
-func (s *MaterialSourceType) encodeFields(e *jx.Encoder) {
-	{
-		if s.SourceID.Set {
-			e.FieldStart("SourceID")
-			s.SourceID.Encode(e)
-		}
-	}
-	{
-		if s.SourceName.Set {
-			e.FieldStart("SourceName")
-			s.SourceName.Encode(e)
-		}
+// Decode decodes EquipmentQueryOrder from json.
+func (s *EquipmentQueryOrder) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EquipmentQueryOrder to nil")
 	}
-	{
-		if s.SourceLocation.Set {
-			e.FieldStart("SourceLocation")
-			s.SourceLocation.Encode(e)
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	{
-		if s.SupplyChainStep.Set {
-			e.FieldStart("SupplyChainStep")
-			s.SupplyChainStep.Encode(e)
-		}
+	// Try to use constant string.
+	switch EquipmentQueryOrder(v) {
+	case EquipmentQueryOrderAsc:
+		*s = EquipmentQueryOrderAsc
+	case EquipmentQueryOrderDesc:
+		*s = EquipmentQueryOrderDesc
+	default:
+		*s = EquipmentQueryOrder(v)
 	}
+
+	return nil
 }
 
-var jsonFieldsNameOfMaterialSourceType = [4]string{
-	0: "SourceID",
-	1: "SourceName",
-	2: "SourceLocation",
-	3: "SupplyChainStep",
+// MarshalJSON implements stdjson.Marshaler.
+func (s EquipmentQueryOrder) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes MaterialSourceType from json.
-func (s *MaterialSourceType) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EquipmentQueryOrder) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EquipmentQuerySort as json.
+func (s EquipmentQuerySort) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EquipmentQuerySort from json.
+func (s *EquipmentQuerySort) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MaterialSourceType to nil")
+		return errors.New("invalid: unable to decode EquipmentQuerySort to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EquipmentQuerySort(v) {
+	case EquipmentQuerySortCreatedAt:
+		*s = EquipmentQuerySortCreatedAt
+	case EquipmentQuerySortUpdatedAt:
+		*s = EquipmentQuerySortUpdatedAt
+	case EquipmentQuerySortExternalID:
+		*s = EquipmentQuerySortExternalID
+	case EquipmentQuerySortEffectiveStartDate:
+		*s = EquipmentQuerySortEffectiveStartDate
+	case EquipmentQuerySortEquipmentLevel:
+		*s = EquipmentQuerySortEquipmentLevel
+	case EquipmentQuerySortOperatingStatus:
+		*s = EquipmentQuerySortOperatingStatus
+	default:
+		*s = EquipmentQuerySort(v)
 	}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "SourceID":
-			if err := func() error {
-				s.SourceID.Reset()
-				if err := s.SourceID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SourceID\"")
-			}
-		case "SourceName":
-			if err := func() error {
-				s.SourceName.Reset()
-				if err := s.SourceName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SourceName\"")
-			}
-		case "SourceLocation":
-			if err := func() error {
-				s.SourceLocation.Reset()
-				if err := s.SourceLocation.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SourceLocation\"")
-			}
-		case "SupplyChainStep":
-			if err := func() error {
-				s.SupplyChainStep.Reset()
-				if err := s.SupplyChainStep.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SupplyChainStep\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MaterialSourceType")
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EquipmentQuerySort) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EquipmentQuerySort) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EquipmentQueryStatusItem as json.
+func (s EquipmentQueryStatusItem) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EquipmentQueryStatusItem from json.
+func (s *EquipmentQueryStatusItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EquipmentQueryStatusItem to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EquipmentQueryStatusItem(v) {
+	case EquipmentQueryStatusItemActive:
+		*s = EquipmentQueryStatusItemActive
+	case EquipmentQueryStatusItemInactive:
+		*s = EquipmentQueryStatusItemInactive
+	case EquipmentQueryStatusItemMaintenance:
+		*s = EquipmentQueryStatusItemMaintenance
+	default:
+		*s = EquipmentQueryStatusItem(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MaterialSourceType) MarshalJSON() ([]byte, error) {
+func (s EquipmentQueryStatusItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MaterialSourceType) UnmarshalJSON(data []byte) error {
+func (s *EquipmentQueryStatusItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *MaterialType) Encode(e *jx.Encoder) {
+func (s *EquipmentRevision) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *MaterialType) encodeFields(e *jx.Encoder) {
-	{
-		if s.MaterialID.Set {
-			e.FieldStart("MaterialID")
-			s.MaterialID.Encode(e)
-		}
-	}
-	{
-		if s.MaterialDescription.Set {
-			e.FieldStart("MaterialDescription")
-			s.MaterialDescription.Encode(e)
-		}
-	}
-	{
-		if s.MaterialTypeCode.Set {
-			e.FieldStart("MaterialTypeCode")
-			s.MaterialTypeCode.Encode(e)
-		}
-	}
-	{
-		if s.MaterialCategory.Set {
-			e.FieldStart("MaterialCategory")
-			s.MaterialCategory.Encode(e)
-		}
-	}
-	{
-		if s.MaterialProperties.Set {
-			e.FieldStart("MaterialProperties")
-			s.MaterialProperties.Encode(e)
-		}
-	}
+func (s *EquipmentRevision) encodeFields(e *jx.Encoder) {
 	{
-		if s.MaterialComposition.Set {
-			e.FieldStart("MaterialComposition")
-			s.MaterialComposition.Encode(e)
-		}
+		e.FieldStart("revision")
+		e.Int32(s.Revision)
 	}
 	{
-		if s.MaterialSource.Set {
-			e.FieldStart("MaterialSource")
-			s.MaterialSource.Encode(e)
-		}
+		e.FieldStart("record_version")
+		e.Int64(s.RecordVersion)
 	}
 	{
-		if s.MaterialUsage.Set {
-			e.FieldStart("MaterialUsage")
-			s.MaterialUsage.Encode(e)
-		}
+		e.FieldStart("operation")
+		s.Operation.Encode(e)
 	}
 	{
-		if s.MaterialHandlingInstructions.Set {
-			e.FieldStart("MaterialHandlingInstructions")
-			s.MaterialHandlingInstructions.Encode(e)
-		}
+		e.FieldStart("changed_at")
+		json.EncodeDateTime(e, s.ChangedAt)
 	}
 	{
-		if s.RegulatoryInformation.Set {
-			e.FieldStart("RegulatoryInformation")
-			s.RegulatoryInformation.Encode(e)
+		if s.ChangedBy.Set {
+			e.FieldStart("changed_by")
+			s.ChangedBy.Encode(e)
 		}
 	}
 	{
-		if s.TraceabilityInformation.Set {
-			e.FieldStart("TraceabilityInformation")
-			s.TraceabilityInformation.Encode(e)
+		if s.Reason.Set {
+			e.FieldStart("reason")
+			s.Reason.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfMaterialType = [11]string{
-	0:  "MaterialID",
-	1:  "MaterialDescription",
-	2:  "MaterialTypeCode",
-	3:  "MaterialCategory",
-	4:  "MaterialProperties",
-	5:  "MaterialComposition",
-	6:  "MaterialSource",
-	7:  "MaterialUsage",
-	8:  "MaterialHandlingInstructions",
-	9:  "RegulatoryInformation",
-	10: "TraceabilityInformation",
+var jsonFieldsNameOfEquipmentRevision = [6]string{
+	0: "revision",
+	1: "record_version",
+	2: "operation",
+	3: "changed_at",
+	4: "changed_by",
+	5: "reason",
 }
 
-// Decode decodes MaterialType from json.
-func (s *MaterialType) Decode(d *jx.Decoder) error {
+// Decode decodes EquipmentRevision from json.
+func (s *EquipmentRevision) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MaterialType to nil")
+		return errors.New("invalid: unable to decode EquipmentRevision to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "MaterialID":
-			if err := func() error {
-				s.MaterialID.Reset()
-				if err := s.MaterialID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaterialID\"")
-			}
-		case "MaterialDescription":
-			if err := func() error {
-				s.MaterialDescription.Reset()
-				if err := s.MaterialDescription.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaterialDescription\"")
-			}
-		case "MaterialTypeCode":
+		case "revision":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.MaterialTypeCode.Reset()
-				if err := s.MaterialTypeCode.Decode(d); err != nil {
+				v, err := d.Int32()
+				s.Revision = int32(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaterialTypeCode\"")
+				return errors.Wrap(err, "decode field \"revision\"")
 			}
-		case "MaterialCategory":
+		case "record_version":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.MaterialCategory.Reset()
-				if err := s.MaterialCategory.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.RecordVersion = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaterialCategory\"")
+				return errors.Wrap(err, "decode field \"record_version\"")
 			}
-		case "MaterialProperties":
+		case "operation":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.MaterialProperties.Reset()
-				if err := s.MaterialProperties.Decode(d); err != nil {
+				if err := s.Operation.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaterialProperties\"")
+				return errors.Wrap(err, "decode field \"operation\"")
 			}
-		case "MaterialComposition":
+		case "changed_at":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.MaterialComposition.Reset()
-				if err := s.MaterialComposition.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.ChangedAt = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaterialComposition\"")
+				return errors.Wrap(err, "decode field \"changed_at\"")
 			}
-		case "MaterialSource":
+		case "changed_by":
 			if err := func() error {
-				s.MaterialSource.Reset()
-				if err := s.MaterialSource.Decode(d); err != nil {
+				s.ChangedBy.Reset()
+				if err := s.ChangedBy.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaterialSource\"")
+				return errors.Wrap(err, "decode field \"changed_by\"")
 			}
-		case "MaterialUsage":
+		case "reason":
 			if err := func() error {
-				s.MaterialUsage.Reset()
-				if err := s.MaterialUsage.Decode(d); err != nil {
+				s.Reason.Reset()
+				if err := s.Reason.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaterialUsage\"")
-			}
-		case "MaterialHandlingInstructions":
-			if err := func() error {
-				s.MaterialHandlingInstructions.Reset()
-				if err := s.MaterialHandlingInstructions.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaterialHandlingInstructions\"")
-			}
-		case "RegulatoryInformation":
-			if err := func() error {
-				s.RegulatoryInformation.Reset()
-				if err := s.RegulatoryInformation.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RegulatoryInformation\"")
-			}
-		case "TraceabilityInformation":
-			if err := func() error {
-				s.TraceabilityInformation.Reset()
-				if err := s.TraceabilityInformation.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TraceabilityInformation\"")
+				return errors.Wrap(err, "decode field \"reason\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MaterialType")
+		return errors.Wrap(err, "decode EquipmentRevision")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEquipmentRevision) {
+					name = jsonFieldsNameOfEquipmentRevision[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MaterialType) MarshalJSON() ([]byte, error) {
+func (s *EquipmentRevision) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MaterialType) UnmarshalJSON(data []byte) error {
+func (s *EquipmentRevision) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EquipmentRevisionOperation as json.
+func (s EquipmentRevisionOperation) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EquipmentRevisionOperation from json.
+func (s *EquipmentRevisionOperation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EquipmentRevisionOperation to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EquipmentRevisionOperation(v) {
+	case EquipmentRevisionOperationCreated:
+		*s = EquipmentRevisionOperationCreated
+	case EquipmentRevisionOperationUpdated:
+		*s = EquipmentRevisionOperationUpdated
+	case EquipmentRevisionOperationMoved:
+		*s = EquipmentRevisionOperationMoved
+	case EquipmentRevisionOperationDeleted:
+		*s = EquipmentRevisionOperationDeleted
+	case EquipmentRevisionOperationRestored:
+		*s = EquipmentRevisionOperationRestored
+	default:
+		*s = EquipmentRevisionOperation(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EquipmentRevisionOperation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EquipmentRevisionOperation) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *MaterialUsageType) Encode(e *jx.Encoder) {
+func (s *EquipmentTreeNode) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *MaterialUsageType) encodeFields(e *jx.Encoder) {
-	{
-		if s.IntendedUse.Set {
-			e.FieldStart("IntendedUse")
-			s.IntendedUse.Encode(e)
-		}
-	}
+func (s *EquipmentTreeNode) encodeFields(e *jx.Encoder) {
 	{
-		if s.ApplicationMethod.Set {
-			e.FieldStart("ApplicationMethod")
-			s.ApplicationMethod.Encode(e)
-		}
-	}
-	{
-		if s.QuantityRequired.Set {
-			e.FieldStart("QuantityRequired")
-			s.QuantityRequired.Encode(e)
-		}
+		e.FieldStart("equipment")
+		s.Equipment.Encode(e)
 	}
 	{
-		if s.UnitOfMeasure.Set {
-			e.FieldStart("UnitOfMeasure")
-			s.UnitOfMeasure.Encode(e)
+		e.FieldStart("children")
+		e.ArrStart()
+		for _, elem := range s.Children {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfMaterialUsageType = [4]string{
-	0: "IntendedUse",
-	1: "ApplicationMethod",
-	2: "QuantityRequired",
-	3: "UnitOfMeasure",
+var jsonFieldsNameOfEquipmentTreeNode = [2]string{
+	0: "equipment",
+	1: "children",
 }
 
-// Decode decodes MaterialUsageType from json.
-func (s *MaterialUsageType) Decode(d *jx.Decoder) error {
+// Decode decodes EquipmentTreeNode from json.
+func (s *EquipmentTreeNode) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MaterialUsageType to nil")
+		return errors.New("invalid: unable to decode EquipmentTreeNode to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "IntendedUse":
-			if err := func() error {
-				s.IntendedUse.Reset()
-				if err := s.IntendedUse.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"IntendedUse\"")
-			}
-		case "ApplicationMethod":
-			if err := func() error {
-				s.ApplicationMethod.Reset()
-				if err := s.ApplicationMethod.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ApplicationMethod\"")
-			}
-		case "QuantityRequired":
+		case "equipment":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.QuantityRequired.Reset()
-				if err := s.QuantityRequired.Decode(d); err != nil {
+				if err := s.Equipment.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"QuantityRequired\"")
+				return errors.Wrap(err, "decode field \"equipment\"")
 			}
-		case "UnitOfMeasure":
+		case "children":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.UnitOfMeasure.Reset()
-				if err := s.UnitOfMeasure.Decode(d); err != nil {
+				s.Children = make([]EquipmentTreeNode, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EquipmentTreeNode
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Children = append(s.Children, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"UnitOfMeasure\"")
+				return errors.Wrap(err, "decode field \"children\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MaterialUsageType")
+		return errors.Wrap(err, "decode EquipmentTreeNode")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEquipmentTreeNode) {
+					name = jsonFieldsNameOfEquipmentTreeNode[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MaterialUsageType) MarshalJSON() ([]byte, error) {
+func (s *EquipmentTreeNode) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MaterialUsageType) UnmarshalJSON(data []byte) error {
+func (s *EquipmentTreeNode) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *MechanicalPropertiesType) Encode(e *jx.Encoder) {
+func (s *EquipmentType) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *MechanicalPropertiesType) encodeFields(e *jx.Encoder) {
+func (s *EquipmentType) encodeFields(e *jx.Encoder) {
 	{
-		if s.TensileStrength.Set {
-			e.FieldStart("TensileStrength")
-			s.TensileStrength.Encode(e)
+		if s.EquipmentID.Set {
+			e.FieldStart("EquipmentID")
+			s.EquipmentID.Encode(e)
 		}
 	}
 	{
-		if s.YieldStrength.Set {
-			e.FieldStart("YieldStrength")
-			s.YieldStrength.Encode(e)
+		if s.EquipmentType.Set {
+			e.FieldStart("EquipmentType")
+			s.EquipmentType.Encode(e)
 		}
 	}
 	{
-		if s.Elongation.Set {
-			e.FieldStart("Elongation")
-			s.Elongation.Encode(e)
+		if s.Description.Set {
+			e.FieldStart("Description")
+			s.Description.Encode(e)
 		}
 	}
 	{
-		if s.Hardness.Set {
-			e.FieldStart("Hardness")
-			s.Hardness.Encode(e)
+		if s.OperatingStatus.Set {
+			e.FieldStart("OperatingStatus")
+			s.OperatingStatus.Encode(e)
 		}
 	}
-}
-
-var jsonFieldsNameOfMechanicalPropertiesType = [4]string{
-	0: "TensileStrength",
-	1: "YieldStrength",
-	2: "Elongation",
-	3: "Hardness",
-}
-
-// Decode decodes MechanicalPropertiesType from json.
-func (s *MechanicalPropertiesType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MechanicalPropertiesType to nil")
+	{
+		if s.Manufacturer.Set {
+			e.FieldStart("Manufacturer")
+			s.Manufacturer.Encode(e)
+		}
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "TensileStrength":
-			if err := func() error {
-				s.TensileStrength.Reset()
-				if err := s.TensileStrength.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TensileStrength\"")
-			}
-		case "YieldStrength":
-			if err := func() error {
-				s.YieldStrength.Reset()
-				if err := s.YieldStrength.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"YieldStrength\"")
-			}
-		case "Elongation":
-			if err := func() error {
-				s.Elongation.Reset()
-				if err := s.Elongation.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Elongation\"")
-			}
-		case "Hardness":
-			if err := func() error {
-				s.Hardness.Reset()
-				if err := s.Hardness.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Hardness\"")
-			}
-		default:
-			return d.Skip()
+	{
+		if s.Model.Set {
+			e.FieldStart("Model")
+			s.Model.Encode(e)
 		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MechanicalPropertiesType")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *MechanicalPropertiesType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MechanicalPropertiesType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *MetricType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MetricType) encodeFields(e *jx.Encoder) {
 	{
-		if s.MetricName.Set {
-			e.FieldStart("MetricName")
-			s.MetricName.Encode(e)
+		if s.SerialNumber.Set {
+			e.FieldStart("SerialNumber")
+			s.SerialNumber.Encode(e)
 		}
 	}
 	{
-		if s.Value.Set {
-			e.FieldStart("Value")
-			s.Value.Encode(e)
+		if s.InstallationDate.Set {
+			e.FieldStart("InstallationDate")
+			s.InstallationDate.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.UnitOfMeasure.Set {
-			e.FieldStart("UnitOfMeasure")
-			s.UnitOfMeasure.Encode(e)
+		if s.Location.Set {
+			e.FieldStart("Location")
+			s.Location.Encode(e)
 		}
 	}
 	{
-		if s.Timestamp.Set {
-			e.FieldStart("Timestamp")
-			s.Timestamp.Encode(e, json.EncodeDateTime)
+		if s.MaintenanceHistory.Set {
+			e.FieldStart("MaintenanceHistory")
+			s.MaintenanceHistory.Encode(e)
+		}
+	}
+	{
+		if s.PerformanceData.Set {
+			e.FieldStart("PerformanceData")
+			s.PerformanceData.Encode(e)
+		}
+	}
+	{
+		if s.Properties != nil {
+			e.FieldStart("Properties")
+			e.ArrStart()
+			for _, elem := range s.Properties {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if len(s.B2MML) != 0 {
+			e.FieldStart("B2MML")
+			e.Raw(s.B2MML)
 		}
 	}
 }
 
-var jsonFieldsNameOfMetricType = [4]string{
-	0: "MetricName",
-	1: "Value",
-	2: "UnitOfMeasure",
-	3: "Timestamp",
+var jsonFieldsNameOfEquipmentType = [13]string{
+	0:  "EquipmentID",
+	1:  "EquipmentType",
+	2:  "Description",
+	3:  "OperatingStatus",
+	4:  "Manufacturer",
+	5:  "Model",
+	6:  "SerialNumber",
+	7:  "InstallationDate",
+	8:  "Location",
+	9:  "MaintenanceHistory",
+	10: "PerformanceData",
+	11: "Properties",
+	12: "B2MML",
 }
 
-// Decode decodes MetricType from json.
-func (s *MetricType) Decode(d *jx.Decoder) error {
+// Decode decodes EquipmentType from json.
+func (s *EquipmentType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MetricType to nil")
+		return errors.New("invalid: unable to decode EquipmentType to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "MetricName":
+		case "EquipmentID":
 			if err := func() error {
-				s.MetricName.Reset()
-				if err := s.MetricName.Decode(d); err != nil {
+				s.EquipmentID.Reset()
+				if err := s.EquipmentID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MetricName\"")
+				return errors.Wrap(err, "decode field \"EquipmentID\"")
 			}
-		case "Value":
+		case "EquipmentType":
 			if err := func() error {
-				s.Value.Reset()
-				if err := s.Value.Decode(d); err != nil {
+				s.EquipmentType.Reset()
+				if err := s.EquipmentType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Value\"")
+				return errors.Wrap(err, "decode field \"EquipmentType\"")
 			}
-		case "UnitOfMeasure":
+		case "Description":
 			if err := func() error {
-				s.UnitOfMeasure.Reset()
-				if err := s.UnitOfMeasure.Decode(d); err != nil {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"UnitOfMeasure\"")
+				return errors.Wrap(err, "decode field \"Description\"")
 			}
-		case "Timestamp":
+		case "OperatingStatus":
 			if err := func() error {
-				s.Timestamp.Reset()
-				if err := s.Timestamp.Decode(d, json.DecodeDateTime); err != nil {
+				s.OperatingStatus.Reset()
+				if err := s.OperatingStatus.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Timestamp\"")
+				return errors.Wrap(err, "decode field \"OperatingStatus\"")
+			}
+		case "Manufacturer":
+			if err := func() error {
+				s.Manufacturer.Reset()
+				if err := s.Manufacturer.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Manufacturer\"")
+			}
+		case "Model":
+			if err := func() error {
+				s.Model.Reset()
+				if err := s.Model.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Model\"")
+			}
+		case "SerialNumber":
+			if err := func() error {
+				s.SerialNumber.Reset()
+				if err := s.SerialNumber.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SerialNumber\"")
+			}
+		case "InstallationDate":
+			if err := func() error {
+				s.InstallationDate.Reset()
+				if err := s.InstallationDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"InstallationDate\"")
+			}
+		case "Location":
+			if err := func() error {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Location\"")
+			}
+		case "MaintenanceHistory":
+			if err := func() error {
+				s.MaintenanceHistory.Reset()
+				if err := s.MaintenanceHistory.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaintenanceHistory\"")
+			}
+		case "PerformanceData":
+			if err := func() error {
+				s.PerformanceData.Reset()
+				if err := s.PerformanceData.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PerformanceData\"")
+			}
+		case "Properties":
+			if err := func() error {
+				s.Properties = make([]PropertyValue, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PropertyValue
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Properties = append(s.Properties, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Properties\"")
+			}
+		case "B2MML":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.B2MML = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"B2MML\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode MetricType")
+		return errors.Wrap(err, "decode EquipmentType")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MetricType) MarshalJSON() ([]byte, error) {
+func (s *EquipmentType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MetricType) UnmarshalJSON(data []byte) error {
+func (s *EquipmentType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes AdditivesType as json.
-func (o OptAdditivesType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes EquipmentTypeOperatingStatus as json.
+func (s EquipmentTypeOperatingStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes AdditivesType from json.
-func (o *OptAdditivesType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptAdditivesType to nil")
+// Decode decodes EquipmentTypeOperatingStatus from json.
+func (s *EquipmentTypeOperatingStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EquipmentTypeOperatingStatus to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.StrBytes()
+	if err != nil {
 		return err
 	}
+	// Try to use constant string.
+	switch EquipmentTypeOperatingStatus(v) {
+	case EquipmentTypeOperatingStatusActive:
+		*s = EquipmentTypeOperatingStatusActive
+	case EquipmentTypeOperatingStatusInactive:
+		*s = EquipmentTypeOperatingStatusInactive
+	case EquipmentTypeOperatingStatusMaintenance:
+		*s = EquipmentTypeOperatingStatusMaintenance
+	default:
+		*s = EquipmentTypeOperatingStatus(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptAdditivesType) MarshalJSON() ([]byte, error) {
+func (s EquipmentTypeOperatingStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptAdditivesType) UnmarshalJSON(data []byte) error {
+func (s *EquipmentTypeOperatingStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes BatchRecordsType as json.
-func (o OptBatchRecordsType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes BatchRecordsType from json.
-func (o *OptBatchRecordsType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBatchRecordsType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptBatchRecordsType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBatchRecordsType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+// Encode implements json.Marshaler.
+func (s *FieldChange) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Encode encodes BatchTypeBatchStatus as json.
-func (o OptBatchTypeBatchStatus) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// encodeFields encodes fields.
+func (s *FieldChange) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("field")
+		e.Str(s.Field)
 	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes BatchTypeBatchStatus from json.
-func (o *OptBatchTypeBatchStatus) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBatchTypeBatchStatus to nil")
+	{
+		if s.Old.Set {
+			e.FieldStart("old")
+			s.Old.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.New.Set {
+			e.FieldStart("new")
+			s.New.Encode(e)
+		}
 	}
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptBatchTypeBatchStatus) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBatchTypeBatchStatus) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfFieldChange = [3]string{
+	0: "field",
+	1: "old",
+	2: "new",
 }
 
-// Encode encodes CertificationDetailsType as json.
-func (o OptCertificationDetailsType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes FieldChange from json.
+func (s *FieldChange) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode FieldChange to nil")
 	}
-	o.Value.Encode(e)
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes CertificationDetailsType from json.
-func (o *OptCertificationDetailsType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCertificationDetailsType to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "field":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Field = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"field\"")
+			}
+		case "old":
+			if err := func() error {
+				s.Old.Reset()
+				if err := s.Old.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"old\"")
+			}
+		case "new":
+			if err := func() error {
+				s.New.Reset()
+				if err := s.New.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"new\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode FieldChange")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfFieldChange) {
+					name = jsonFieldsNameOfFieldChange[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptCertificationDetailsType) MarshalJSON() ([]byte, error) {
+func (s *FieldChange) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCertificationDetailsType) UnmarshalJSON(data []byte) error {
+func (s *FieldChange) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ChemicalPropertiesType as json.
-func (o OptChemicalPropertiesType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s HierarchyScopeType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s HierarchyScopeType) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes ChemicalPropertiesType from json.
-func (o *OptChemicalPropertiesType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptChemicalPropertiesType to nil")
+// Decode decodes HierarchyScopeType from json.
+func (s *HierarchyScopeType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HierarchyScopeType to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HierarchyScopeType")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptChemicalPropertiesType) MarshalJSON() ([]byte, error) {
+func (s HierarchyScopeType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptChemicalPropertiesType) UnmarshalJSON(data []byte) error {
+func (s *HierarchyScopeType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ContaminantsType as json.
-func (o OptContaminantsType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *ImportJob) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes ContaminantsType from json.
-func (o *OptContaminantsType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptContaminantsType to nil")
+// encodeFields encodes fields.
+func (s *ImportJob) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		e.FieldStart("format")
+		s.Format.Encode(e)
+	}
+	{
+		if s.Mapping.Set {
+			e.FieldStart("mapping")
+			s.Mapping.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("dry_run")
+		e.Bool(s.DryRun)
+	}
+	{
+		e.FieldStart("chunk_size")
+		e.Int32(s.ChunkSize)
+	}
+	{
+		e.FieldStart("rows_processed")
+		e.Int64(s.RowsProcessed)
+	}
+	{
+		e.FieldStart("rows_imported")
+		e.Int64(s.RowsImported)
+	}
+	{
+		e.FieldStart("error_count")
+		e.Int64(s.ErrorCount)
+	}
+	{
+		if s.RowErrors != nil {
+			e.FieldStart("row_errors")
+			e.ArrStart()
+			for _, elem := range s.RowErrors {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.FinishedAt.Set {
+			e.FieldStart("finished_at")
+			s.FinishedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptContaminantsType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
+var jsonFieldsNameOfImportJob = [14]string{
+	0:  "id",
+	1:  "status",
+	2:  "format",
+	3:  "mapping",
+	4:  "dry_run",
+	5:  "chunk_size",
+	6:  "rows_processed",
+	7:  "rows_imported",
+	8:  "error_count",
+	9:  "row_errors",
+	10: "error",
+	11: "created_at",
+	12: "updated_at",
+	13: "finished_at",
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptContaminantsType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
+// Decode decodes ImportJob from json.
+func (s *ImportJob) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportJob to nil")
+	}
+	var requiredBitSet [2]uint8
 
-// Encode encodes CoordinatesType as json.
-func (o OptCoordinatesType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "format":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Format.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"format\"")
+			}
+		case "mapping":
+			if err := func() error {
+				s.Mapping.Reset()
+				if err := s.Mapping.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mapping\"")
+			}
+		case "dry_run":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.DryRun = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dry_run\"")
+			}
+		case "chunk_size":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int32()
+				s.ChunkSize = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chunk_size\"")
+			}
+		case "rows_processed":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int64()
+				s.RowsProcessed = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rows_processed\"")
+			}
+		case "rows_imported":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int64()
+				s.RowsImported = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rows_imported\"")
+			}
+		case "error_count":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ErrorCount = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error_count\"")
+			}
+		case "row_errors":
+			if err := func() error {
+				s.RowErrors = make([]ImportRowError, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ImportRowError
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.RowErrors = append(s.RowErrors, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"row_errors\"")
+			}
+		case "error":
+			if err := func() error {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		case "finished_at":
+			if err := func() error {
+				s.FinishedAt.Reset()
+				if err := s.FinishedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"finished_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ImportJob")
 	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes CoordinatesType from json.
-func (o *OptCoordinatesType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCoordinatesType to nil")
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11110111,
+		0b00001001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfImportJob) {
+					name = jsonFieldsNameOfImportJob[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptCoordinatesType) MarshalJSON() ([]byte, error) {
+func (s *ImportJob) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCoordinatesType) UnmarshalJSON(data []byte) error {
+func (s *ImportJob) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes time.Time as json.
-func (o OptDate) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
-	}
-	format(e, o.Value)
+// Encode encodes ImportJobFormat as json.
+func (s ImportJobFormat) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes time.Time from json.
-func (o *OptDate) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptDate to nil")
+// Decode decodes ImportJobFormat from json.
+func (s *ImportJobFormat) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportJobFormat to nil")
 	}
-	o.Set = true
-	v, err := format(d)
+	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
-	o.Value = v
+	// Try to use constant string.
+	switch ImportJobFormat(v) {
+	case ImportJobFormatCsv:
+		*s = ImportJobFormatCsv
+	case ImportJobFormatJsonl:
+		*s = ImportJobFormatJsonl
+	default:
+		*s = ImportJobFormat(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDate) MarshalJSON() ([]byte, error) {
+func (s ImportJobFormat) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDate)
+	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDate) UnmarshalJSON(data []byte) error {
+func (s *ImportJobFormat) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDate)
+	return s.Decode(d)
 }
 
-// Encode encodes time.Time as json.
-func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s ImportJobMapping) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ImportJobMapping) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
 	}
-	format(e, o.Value)
 }
 
-// Decode decodes time.Time from json.
-func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptDateTime to nil")
+// Decode decodes ImportJobMapping from json.
+func (s *ImportJobMapping) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportJobMapping to nil")
 	}
-	o.Set = true
-	v, err := format(d)
-	if err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ImportJobMapping")
 	}
-	o.Value = v
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDateTime) MarshalJSON() ([]byte, error) {
+func (s ImportJobMapping) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
+	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+func (s *ImportJobMapping) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
+	return s.Decode(d)
 }
 
-// Encode encodes ElectricalPropertiesType as json.
-func (o OptElectricalPropertiesType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes ImportJobStatus as json.
+func (s ImportJobStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes ElectricalPropertiesType from json.
-func (o *OptElectricalPropertiesType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptElectricalPropertiesType to nil")
+// Decode decodes ImportJobStatus from json.
+func (s *ImportJobStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportJobStatus to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.StrBytes()
+	if err != nil {
 		return err
 	}
+	// Try to use constant string.
+	switch ImportJobStatus(v) {
+	case ImportJobStatusPending:
+		*s = ImportJobStatusPending
+	case ImportJobStatusRunning:
+		*s = ImportJobStatusRunning
+	case ImportJobStatusCompleted:
+		*s = ImportJobStatusCompleted
+	case ImportJobStatusFailed:
+		*s = ImportJobStatusFailed
+	default:
+		*s = ImportJobStatus(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptElectricalPropertiesType) MarshalJSON() ([]byte, error) {
+func (s ImportJobStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptElectricalPropertiesType) UnmarshalJSON(data []byte) error {
+func (s *ImportJobStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes float64 as json.
-func (o OptFloat64) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Float64(float64(o.Value))
+// Encode implements json.Marshaler.
+func (s *ImportRowError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes float64 from json.
-func (o *OptFloat64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptFloat64 to nil")
+// encodeFields encodes fields.
+func (s *ImportRowError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("row")
+		e.Int64(s.Row)
 	}
-	o.Set = true
-	v, err := d.Float64()
-	if err != nil {
-		return err
+	{
+		if s.Line.Set {
+			e.FieldStart("line")
+			s.Line.Encode(e)
+		}
+	}
+	{
+		if s.EquipmentID.Set {
+			e.FieldStart("equipment_id")
+			s.EquipmentID.Encode(e)
+		}
+	}
+	{
+		if s.Column.Set {
+			e.FieldStart("column")
+			s.Column.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
 	}
-	o.Value = float64(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptFloat64) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptFloat64) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfImportRowError = [5]string{
+	0: "row",
+	1: "line",
+	2: "equipment_id",
+	3: "column",
+	4: "message",
 }
 
-// Encode encodes HierarchyScopeType as json.
-func (o OptHierarchyScopeType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes ImportRowError from json.
+func (s *ImportRowError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportRowError to nil")
 	}
-	o.Value.Encode(e)
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes HierarchyScopeType from json.
-func (o *OptHierarchyScopeType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptHierarchyScopeType to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "row":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.Row = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"row\"")
+			}
+		case "line":
+			if err := func() error {
+				s.Line.Reset()
+				if err := s.Line.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"line\"")
+			}
+		case "equipment_id":
+			if err := func() error {
+				s.EquipmentID.Reset()
+				if err := s.EquipmentID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"equipment_id\"")
+			}
+		case "column":
+			if err := func() error {
+				s.Column.Reset()
+				if err := s.Column.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"column\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ImportRowError")
 	}
-	o.Set = true
-	o.Value = make(HierarchyScopeType)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00010001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfImportRowError) {
+					name = jsonFieldsNameOfImportRowError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptHierarchyScopeType) MarshalJSON() ([]byte, error) {
+func (s *ImportRowError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptHierarchyScopeType) UnmarshalJSON(data []byte) error {
+func (s *ImportRowError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes LocationType as json.
-func (o OptLocationType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *LocationType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LocationType) encodeFields(e *jx.Encoder) {
+	{
+		if s.SiteID.Set {
+			e.FieldStart("SiteID")
+			s.SiteID.Encode(e)
+		}
 	}
-	o.Value.Encode(e)
+	{
+		if s.BuildingID.Set {
+			e.FieldStart("BuildingID")
+			s.BuildingID.Encode(e)
+		}
+	}
+	{
+		if s.FloorID.Set {
+			e.FieldStart("FloorID")
+			s.FloorID.Encode(e)
+		}
+	}
+	{
+		if s.AreaID.Set {
+			e.FieldStart("AreaID")
+			s.AreaID.Encode(e)
+		}
+	}
+	{
+		if s.Coordinates.Set {
+			e.FieldStart("Coordinates")
+			s.Coordinates.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfLocationType = [5]string{
+	0: "SiteID",
+	1: "BuildingID",
+	2: "FloorID",
+	3: "AreaID",
+	4: "Coordinates",
 }
 
 // Decode decodes LocationType from json.
-func (o *OptLocationType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptLocationType to nil")
+func (s *LocationType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LocationType to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "SiteID":
+			if err := func() error {
+				s.SiteID.Reset()
+				if err := s.SiteID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SiteID\"")
+			}
+		case "BuildingID":
+			if err := func() error {
+				s.BuildingID.Reset()
+				if err := s.BuildingID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"BuildingID\"")
+			}
+		case "FloorID":
+			if err := func() error {
+				s.FloorID.Reset()
+				if err := s.FloorID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"FloorID\"")
+			}
+		case "AreaID":
+			if err := func() error {
+				s.AreaID.Reset()
+				if err := s.AreaID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AreaID\"")
+			}
+		case "Coordinates":
+			if err := func() error {
+				s.Coordinates.Reset()
+				if err := s.Coordinates.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Coordinates\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LocationType")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptLocationType) MarshalJSON() ([]byte, error) {
+func (s *LocationType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptLocationType) UnmarshalJSON(data []byte) error {
+func (s *LocationType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MaintenanceHistoryType as json.
-func (o OptMaintenanceHistoryType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *MaintenanceEventType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes MaintenanceHistoryType from json.
-func (o *OptMaintenanceHistoryType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMaintenanceHistoryType to nil")
+// encodeFields encodes fields.
+func (s *MaintenanceEventType) encodeFields(e *jx.Encoder) {
+	{
+		if s.EventID.Set {
+			e.FieldStart("EventID")
+			s.EventID.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.EventDate.Set {
+			e.FieldStart("EventDate")
+			s.EventDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("Description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.PerformedBy.Set {
+			e.FieldStart("PerformedBy")
+			s.PerformedBy.Encode(e)
+		}
+	}
+	{
+		if s.PartsReplaced.Set {
+			e.FieldStart("PartsReplaced")
+			s.PartsReplaced.Encode(e)
+		}
+	}
+	{
+		if s.DowntimeHours.Set {
+			e.FieldStart("DowntimeHours")
+			s.DowntimeHours.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptMaintenanceHistoryType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMaintenanceHistoryType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfMaintenanceEventType = [6]string{
+	0: "EventID",
+	1: "EventDate",
+	2: "Description",
+	3: "PerformedBy",
+	4: "PartsReplaced",
+	5: "DowntimeHours",
 }
 
-// Encode encodes MaterialCategoryType as json.
-func (o OptMaterialCategoryType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes MaintenanceEventType from json.
+func (s *MaintenanceEventType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MaintenanceEventType to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes MaterialCategoryType from json.
-func (o *OptMaterialCategoryType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMaterialCategoryType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "EventID":
+			if err := func() error {
+				s.EventID.Reset()
+				if err := s.EventID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EventID\"")
+			}
+		case "EventDate":
+			if err := func() error {
+				s.EventDate.Reset()
+				if err := s.EventDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EventDate\"")
+			}
+		case "Description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "PerformedBy":
+			if err := func() error {
+				s.PerformedBy.Reset()
+				if err := s.PerformedBy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PerformedBy\"")
+			}
+		case "PartsReplaced":
+			if err := func() error {
+				s.PartsReplaced.Reset()
+				if err := s.PartsReplaced.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PartsReplaced\"")
+			}
+		case "DowntimeHours":
+			if err := func() error {
+				s.DowntimeHours.Reset()
+				if err := s.DowntimeHours.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DowntimeHours\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MaintenanceEventType")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMaterialCategoryType) MarshalJSON() ([]byte, error) {
+func (s *MaintenanceEventType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMaterialCategoryType) UnmarshalJSON(data []byte) error {
+func (s *MaintenanceEventType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MaterialCompositionType as json.
-func (o OptMaterialCompositionType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *MaintenanceHistoryType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes MaterialCompositionType from json.
-func (o *OptMaterialCompositionType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMaterialCompositionType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+// encodeFields encodes fields.
+func (s *MaintenanceHistoryType) encodeFields(e *jx.Encoder) {
+	{
+		if s.MaintenanceEvent != nil {
+			e.FieldStart("MaintenanceEvent")
+			e.ArrStart()
+			for _, elem := range s.MaintenanceEvent {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptMaterialCompositionType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMaterialCompositionType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfMaintenanceHistoryType = [1]string{
+	0: "MaintenanceEvent",
 }
 
-// Encode encodes MaterialPropertiesType as json.
-func (o OptMaterialPropertiesType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes MaintenanceHistoryType from json.
+func (s *MaintenanceHistoryType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MaintenanceHistoryType to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes MaterialPropertiesType from json.
-func (o *OptMaterialPropertiesType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMaterialPropertiesType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "MaintenanceEvent":
+			if err := func() error {
+				s.MaintenanceEvent = make([]MaintenanceEventType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem MaintenanceEventType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.MaintenanceEvent = append(s.MaintenanceEvent, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaintenanceEvent\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MaintenanceHistoryType")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMaterialPropertiesType) MarshalJSON() ([]byte, error) {
+func (s *MaintenanceHistoryType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMaterialPropertiesType) UnmarshalJSON(data []byte) error {
+func (s *MaintenanceHistoryType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MaterialSourceType as json.
-func (o OptMaterialSourceType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *MaterialCategoryType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes MaterialSourceType from json.
-func (o *OptMaterialSourceType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMaterialSourceType to nil")
+// encodeFields encodes fields.
+func (s *MaterialCategoryType) encodeFields(e *jx.Encoder) {
+	{
+		if s.CategoryCode.Set {
+			e.FieldStart("CategoryCode")
+			s.CategoryCode.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.CategoryDescription.Set {
+			e.FieldStart("CategoryDescription")
+			s.CategoryDescription.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptMaterialSourceType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMaterialSourceType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfMaterialCategoryType = [2]string{
+	0: "CategoryCode",
+	1: "CategoryDescription",
 }
 
-// Encode encodes MaterialUsageType as json.
-func (o OptMaterialUsageType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes MaterialCategoryType from json.
+func (s *MaterialCategoryType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MaterialCategoryType to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes MaterialUsageType from json.
-func (o *OptMaterialUsageType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMaterialUsageType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "CategoryCode":
+			if err := func() error {
+				s.CategoryCode.Reset()
+				if err := s.CategoryCode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"CategoryCode\"")
+			}
+		case "CategoryDescription":
+			if err := func() error {
+				s.CategoryDescription.Reset()
+				if err := s.CategoryDescription.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"CategoryDescription\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MaterialCategoryType")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMaterialUsageType) MarshalJSON() ([]byte, error) {
+func (s *MaterialCategoryType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMaterialUsageType) UnmarshalJSON(data []byte) error {
+func (s *MaterialCategoryType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MechanicalPropertiesType as json.
-func (o OptMechanicalPropertiesType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *MaterialCompositionType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes MechanicalPropertiesType from json.
-func (o *OptMechanicalPropertiesType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMechanicalPropertiesType to nil")
+// encodeFields encodes fields.
+func (s *MaterialCompositionType) encodeFields(e *jx.Encoder) {
+	{
+		if s.BaseMaterial.Set {
+			e.FieldStart("BaseMaterial")
+			s.BaseMaterial.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Additives.Set {
+			e.FieldStart("Additives")
+			s.Additives.Encode(e)
+		}
+	}
+	{
+		if s.Contaminants.Set {
+			e.FieldStart("Contaminants")
+			s.Contaminants.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptMechanicalPropertiesType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMechanicalPropertiesType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfMaterialCompositionType = [3]string{
+	0: "BaseMaterial",
+	1: "Additives",
+	2: "Contaminants",
 }
 
-// Encode encodes PerformanceDataType as json.
-func (o OptPerformanceDataType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes MaterialCompositionType from json.
+func (s *MaterialCompositionType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MaterialCompositionType to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes PerformanceDataType from json.
-func (o *OptPerformanceDataType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptPerformanceDataType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "BaseMaterial":
+			if err := func() error {
+				s.BaseMaterial.Reset()
+				if err := s.BaseMaterial.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"BaseMaterial\"")
+			}
+		case "Additives":
+			if err := func() error {
+				s.Additives.Reset()
+				if err := s.Additives.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Additives\"")
+			}
+		case "Contaminants":
+			if err := func() error {
+				s.Contaminants.Reset()
+				if err := s.Contaminants.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Contaminants\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MaterialCompositionType")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptPerformanceDataType) MarshalJSON() ([]byte, error) {
+func (s *MaterialCompositionType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptPerformanceDataType) UnmarshalJSON(data []byte) error {
+func (s *MaterialCompositionType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes PhysicalPropertiesType as json.
-func (o OptPhysicalPropertiesType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *MaterialPropertiesType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes PhysicalPropertiesType from json.
-func (o *OptPhysicalPropertiesType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptPhysicalPropertiesType to nil")
+// encodeFields encodes fields.
+func (s *MaterialPropertiesType) encodeFields(e *jx.Encoder) {
+	{
+		if s.PhysicalProperties.Set {
+			e.FieldStart("PhysicalProperties")
+			s.PhysicalProperties.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.ChemicalProperties.Set {
+			e.FieldStart("ChemicalProperties")
+			s.ChemicalProperties.Encode(e)
+		}
+	}
+	{
+		if s.MechanicalProperties.Set {
+			e.FieldStart("MechanicalProperties")
+			s.MechanicalProperties.Encode(e)
+		}
+	}
+	{
+		if s.ThermalProperties.Set {
+			e.FieldStart("ThermalProperties")
+			s.ThermalProperties.Encode(e)
+		}
+	}
+	{
+		if s.ElectricalProperties.Set {
+			e.FieldStart("ElectricalProperties")
+			s.ElectricalProperties.Encode(e)
+		}
 	}
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptPhysicalPropertiesType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
+var jsonFieldsNameOfMaterialPropertiesType = [5]string{
+	0: "PhysicalProperties",
+	1: "ChemicalProperties",
+	2: "MechanicalProperties",
+	3: "ThermalProperties",
+	4: "ElectricalProperties",
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptPhysicalPropertiesType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes RecordTypeRecordData as json.
-func (o OptRecordTypeRecordData) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes MaterialPropertiesType from json.
+func (s *MaterialPropertiesType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MaterialPropertiesType to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes RecordTypeRecordData from json.
-func (o *OptRecordTypeRecordData) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptRecordTypeRecordData to nil")
-	}
-	o.Set = true
-	o.Value = make(RecordTypeRecordData)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "PhysicalProperties":
+			if err := func() error {
+				s.PhysicalProperties.Reset()
+				if err := s.PhysicalProperties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PhysicalProperties\"")
+			}
+		case "ChemicalProperties":
+			if err := func() error {
+				s.ChemicalProperties.Reset()
+				if err := s.ChemicalProperties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ChemicalProperties\"")
+			}
+		case "MechanicalProperties":
+			if err := func() error {
+				s.MechanicalProperties.Reset()
+				if err := s.MechanicalProperties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MechanicalProperties\"")
+			}
+		case "ThermalProperties":
+			if err := func() error {
+				s.ThermalProperties.Reset()
+				if err := s.ThermalProperties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ThermalProperties\"")
+			}
+		case "ElectricalProperties":
+			if err := func() error {
+				s.ElectricalProperties.Reset()
+				if err := s.ElectricalProperties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ElectricalProperties\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MaterialPropertiesType")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptRecordTypeRecordData) MarshalJSON() ([]byte, error) {
+func (s *MaterialPropertiesType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptRecordTypeRecordData) UnmarshalJSON(data []byte) error {
+func (s *MaterialPropertiesType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes RegulatoryInformationType as json.
-func (o OptRegulatoryInformationType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *MaterialSourceType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes RegulatoryInformationType from json.
-func (o *OptRegulatoryInformationType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptRegulatoryInformationType to nil")
+// encodeFields encodes fields.
+func (s *MaterialSourceType) encodeFields(e *jx.Encoder) {
+	{
+		if s.SourceID.Set {
+			e.FieldStart("SourceID")
+			s.SourceID.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.SourceName.Set {
+			e.FieldStart("SourceName")
+			s.SourceName.Encode(e)
+		}
+	}
+	{
+		if s.SourceLocation.Set {
+			e.FieldStart("SourceLocation")
+			s.SourceLocation.Encode(e)
+		}
+	}
+	{
+		if s.SupplyChainStep.Set {
+			e.FieldStart("SupplyChainStep")
+			s.SupplyChainStep.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptRegulatoryInformationType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptRegulatoryInformationType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfMaterialSourceType = [4]string{
+	0: "SourceID",
+	1: "SourceName",
+	2: "SourceLocation",
+	3: "SupplyChainStep",
 }
 
-// Encode encodes ResourceLocationType as json.
-func (o OptResourceLocationType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes MaterialSourceType from json.
+func (s *MaterialSourceType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MaterialSourceType to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes ResourceLocationType from json.
-func (o *OptResourceLocationType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptResourceLocationType to nil")
-	}
-	o.Set = true
-	o.Value = make(ResourceLocationType)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "SourceID":
+			if err := func() error {
+				s.SourceID.Reset()
+				if err := s.SourceID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SourceID\"")
+			}
+		case "SourceName":
+			if err := func() error {
+				s.SourceName.Reset()
+				if err := s.SourceName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SourceName\"")
+			}
+		case "SourceLocation":
+			if err := func() error {
+				s.SourceLocation.Reset()
+				if err := s.SourceLocation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SourceLocation\"")
+			}
+		case "SupplyChainStep":
+			if err := func() error {
+				s.SupplyChainStep.Reset()
+				if err := s.SupplyChainStep.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SupplyChainStep\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MaterialSourceType")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptResourceLocationType) MarshalJSON() ([]byte, error) {
+func (s *MaterialSourceType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptResourceLocationType) UnmarshalJSON(data []byte) error {
+func (s *MaterialSourceType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes SpatialDefinitionType as json.
-func (o OptSpatialDefinitionType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *MaterialType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes SpatialDefinitionType from json.
-func (o *OptSpatialDefinitionType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptSpatialDefinitionType to nil")
+// encodeFields encodes fields.
+func (s *MaterialType) encodeFields(e *jx.Encoder) {
+	{
+		if s.MaterialID.Set {
+			e.FieldStart("MaterialID")
+			s.MaterialID.Encode(e)
+		}
 	}
-	o.Set = true
-	o.Value = make(SpatialDefinitionType)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.MaterialDescription.Set {
+			e.FieldStart("MaterialDescription")
+			s.MaterialDescription.Encode(e)
+		}
+	}
+	{
+		if s.MaterialTypeCode.Set {
+			e.FieldStart("MaterialTypeCode")
+			s.MaterialTypeCode.Encode(e)
+		}
+	}
+	{
+		if s.MaterialCategory.Set {
+			e.FieldStart("MaterialCategory")
+			s.MaterialCategory.Encode(e)
+		}
+	}
+	{
+		if s.MaterialProperties.Set {
+			e.FieldStart("MaterialProperties")
+			s.MaterialProperties.Encode(e)
+		}
+	}
+	{
+		if s.MaterialComposition.Set {
+			e.FieldStart("MaterialComposition")
+			s.MaterialComposition.Encode(e)
+		}
+	}
+	{
+		if s.MaterialSource.Set {
+			e.FieldStart("MaterialSource")
+			s.MaterialSource.Encode(e)
+		}
+	}
+	{
+		if s.MaterialUsage.Set {
+			e.FieldStart("MaterialUsage")
+			s.MaterialUsage.Encode(e)
+		}
+	}
+	{
+		if s.MaterialHandlingInstructions.Set {
+			e.FieldStart("MaterialHandlingInstructions")
+			s.MaterialHandlingInstructions.Encode(e)
+		}
+	}
+	{
+		if s.RegulatoryInformation.Set {
+			e.FieldStart("RegulatoryInformation")
+			s.RegulatoryInformation.Encode(e)
+		}
+	}
+	{
+		if s.TraceabilityInformation.Set {
+			e.FieldStart("TraceabilityInformation")
+			s.TraceabilityInformation.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptSpatialDefinitionType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptSpatialDefinitionType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfMaterialType = [11]string{
+	0:  "MaterialID",
+	1:  "MaterialDescription",
+	2:  "MaterialTypeCode",
+	3:  "MaterialCategory",
+	4:  "MaterialProperties",
+	5:  "MaterialComposition",
+	6:  "MaterialSource",
+	7:  "MaterialUsage",
+	8:  "MaterialHandlingInstructions",
+	9:  "RegulatoryInformation",
+	10: "TraceabilityInformation",
 }
 
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes MaterialType from json.
+func (s *MaterialType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MaterialType to nil")
 	}
-	e.Str(string(o.Value))
-}
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
-	}
-	o.Set = true
-	v, err := d.Str()
-	if err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "MaterialID":
+			if err := func() error {
+				s.MaterialID.Reset()
+				if err := s.MaterialID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaterialID\"")
+			}
+		case "MaterialDescription":
+			if err := func() error {
+				s.MaterialDescription.Reset()
+				if err := s.MaterialDescription.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaterialDescription\"")
+			}
+		case "MaterialTypeCode":
+			if err := func() error {
+				s.MaterialTypeCode.Reset()
+				if err := s.MaterialTypeCode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaterialTypeCode\"")
+			}
+		case "MaterialCategory":
+			if err := func() error {
+				s.MaterialCategory.Reset()
+				if err := s.MaterialCategory.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaterialCategory\"")
+			}
+		case "MaterialProperties":
+			if err := func() error {
+				s.MaterialProperties.Reset()
+				if err := s.MaterialProperties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaterialProperties\"")
+			}
+		case "MaterialComposition":
+			if err := func() error {
+				s.MaterialComposition.Reset()
+				if err := s.MaterialComposition.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaterialComposition\"")
+			}
+		case "MaterialSource":
+			if err := func() error {
+				s.MaterialSource.Reset()
+				if err := s.MaterialSource.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaterialSource\"")
+			}
+		case "MaterialUsage":
+			if err := func() error {
+				s.MaterialUsage.Reset()
+				if err := s.MaterialUsage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaterialUsage\"")
+			}
+		case "MaterialHandlingInstructions":
+			if err := func() error {
+				s.MaterialHandlingInstructions.Reset()
+				if err := s.MaterialHandlingInstructions.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaterialHandlingInstructions\"")
+			}
+		case "RegulatoryInformation":
+			if err := func() error {
+				s.RegulatoryInformation.Reset()
+				if err := s.RegulatoryInformation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RegulatoryInformation\"")
+			}
+		case "TraceabilityInformation":
+			if err := func() error {
+				s.TraceabilityInformation.Reset()
+				if err := s.TraceabilityInformation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TraceabilityInformation\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MaterialType")
 	}
-	o.Value = string(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
+func (s *MaterialType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
+func (s *MaterialType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ThermalPropertiesType as json.
-func (o OptThermalPropertiesType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *MaterialUsageType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes ThermalPropertiesType from json.
-func (o *OptThermalPropertiesType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptThermalPropertiesType to nil")
+// encodeFields encodes fields.
+func (s *MaterialUsageType) encodeFields(e *jx.Encoder) {
+	{
+		if s.IntendedUse.Set {
+			e.FieldStart("IntendedUse")
+			s.IntendedUse.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.ApplicationMethod.Set {
+			e.FieldStart("ApplicationMethod")
+			s.ApplicationMethod.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptThermalPropertiesType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
+	{
+		if s.QuantityRequired.Set {
+			e.FieldStart("QuantityRequired")
+			s.QuantityRequired.Encode(e)
+		}
+	}
+	{
+		if s.UnitOfMeasure.Set {
+			e.FieldStart("UnitOfMeasure")
+			s.UnitOfMeasure.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfMaterialUsageType = [4]string{
+	0: "IntendedUse",
+	1: "ApplicationMethod",
+	2: "QuantityRequired",
+	3: "UnitOfMeasure",
+}
+
+// Decode decodes MaterialUsageType from json.
+func (s *MaterialUsageType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MaterialUsageType to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "IntendedUse":
+			if err := func() error {
+				s.IntendedUse.Reset()
+				if err := s.IntendedUse.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"IntendedUse\"")
+			}
+		case "ApplicationMethod":
+			if err := func() error {
+				s.ApplicationMethod.Reset()
+				if err := s.ApplicationMethod.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ApplicationMethod\"")
+			}
+		case "QuantityRequired":
+			if err := func() error {
+				s.QuantityRequired.Reset()
+				if err := s.QuantityRequired.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"QuantityRequired\"")
+			}
+		case "UnitOfMeasure":
+			if err := func() error {
+				s.UnitOfMeasure.Reset()
+				if err := s.UnitOfMeasure.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"UnitOfMeasure\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MaterialUsageType")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MaterialUsageType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MaterialUsageType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MechanicalPropertiesType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MechanicalPropertiesType) encodeFields(e *jx.Encoder) {
+	{
+		if s.TensileStrength.Set {
+			e.FieldStart("TensileStrength")
+			s.TensileStrength.Encode(e)
+		}
+	}
+	{
+		if s.YieldStrength.Set {
+			e.FieldStart("YieldStrength")
+			s.YieldStrength.Encode(e)
+		}
+	}
+	{
+		if s.Elongation.Set {
+			e.FieldStart("Elongation")
+			s.Elongation.Encode(e)
+		}
+	}
+	{
+		if s.Hardness.Set {
+			e.FieldStart("Hardness")
+			s.Hardness.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfMechanicalPropertiesType = [4]string{
+	0: "TensileStrength",
+	1: "YieldStrength",
+	2: "Elongation",
+	3: "Hardness",
+}
+
+// Decode decodes MechanicalPropertiesType from json.
+func (s *MechanicalPropertiesType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MechanicalPropertiesType to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "TensileStrength":
+			if err := func() error {
+				s.TensileStrength.Reset()
+				if err := s.TensileStrength.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TensileStrength\"")
+			}
+		case "YieldStrength":
+			if err := func() error {
+				s.YieldStrength.Reset()
+				if err := s.YieldStrength.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"YieldStrength\"")
+			}
+		case "Elongation":
+			if err := func() error {
+				s.Elongation.Reset()
+				if err := s.Elongation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Elongation\"")
+			}
+		case "Hardness":
+			if err := func() error {
+				s.Hardness.Reset()
+				if err := s.Hardness.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Hardness\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MechanicalPropertiesType")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MechanicalPropertiesType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MechanicalPropertiesType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MetricType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MetricType) encodeFields(e *jx.Encoder) {
+	{
+		if s.MetricName.Set {
+			e.FieldStart("MetricName")
+			s.MetricName.Encode(e)
+		}
+	}
+	{
+		if s.Value.Set {
+			e.FieldStart("Value")
+			s.Value.Encode(e)
+		}
+	}
+	{
+		if s.UnitOfMeasure.Set {
+			e.FieldStart("UnitOfMeasure")
+			s.UnitOfMeasure.Encode(e)
+		}
+	}
+	{
+		if s.Timestamp.Set {
+			e.FieldStart("Timestamp")
+			s.Timestamp.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfMetricType = [4]string{
+	0: "MetricName",
+	1: "Value",
+	2: "UnitOfMeasure",
+	3: "Timestamp",
+}
+
+// Decode decodes MetricType from json.
+func (s *MetricType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MetricType to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "MetricName":
+			if err := func() error {
+				s.MetricName.Reset()
+				if err := s.MetricName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MetricName\"")
+			}
+		case "Value":
+			if err := func() error {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Value\"")
+			}
+		case "UnitOfMeasure":
+			if err := func() error {
+				s.UnitOfMeasure.Reset()
+				if err := s.UnitOfMeasure.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"UnitOfMeasure\"")
+			}
+		case "Timestamp":
+			if err := func() error {
+				s.Timestamp.Reset()
+				if err := s.Timestamp.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Timestamp\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MetricType")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MetricType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MetricType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes AdditivesType as json.
+func (o OptAdditivesType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes AdditivesType from json.
+func (o *OptAdditivesType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptAdditivesType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptAdditivesType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptAdditivesType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BatchRecordsType as json.
+func (o OptBatchRecordsType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes BatchRecordsType from json.
+func (o *OptBatchRecordsType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBatchRecordsType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBatchRecordsType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBatchRecordsType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BatchTypeBatchStatus as json.
+func (o OptBatchTypeBatchStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes BatchTypeBatchStatus from json.
+func (o *OptBatchTypeBatchStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBatchTypeBatchStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBatchTypeBatchStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBatchTypeBatchStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CertificationDetailsType as json.
+func (o OptCertificationDetailsType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CertificationDetailsType from json.
+func (o *OptCertificationDetailsType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCertificationDetailsType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCertificationDetailsType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCertificationDetailsType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ChemicalPropertiesType as json.
+func (o OptChemicalPropertiesType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ChemicalPropertiesType from json.
+func (o *OptChemicalPropertiesType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptChemicalPropertiesType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptChemicalPropertiesType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptChemicalPropertiesType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ContaminantsType as json.
+func (o OptContaminantsType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ContaminantsType from json.
+func (o *OptContaminantsType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptContaminantsType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptContaminantsType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptContaminantsType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CoordinatesType as json.
+func (o OptCoordinatesType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CoordinatesType from json.
+func (o *OptCoordinatesType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCoordinatesType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCoordinatesType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCoordinatesType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptDate) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptDate) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDate to nil")
+	}
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDate)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDate)
+}
+
+// Encode encodes time.Time as json.
+func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDateTime to nil")
+	}
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes ElectricalPropertiesType as json.
+func (o OptElectricalPropertiesType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ElectricalPropertiesType from json.
+func (o *OptElectricalPropertiesType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptElectricalPropertiesType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptElectricalPropertiesType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptElectricalPropertiesType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EquipmentQueryOrder as json.
+func (o OptEquipmentQueryOrder) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EquipmentQueryOrder from json.
+func (o *OptEquipmentQueryOrder) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEquipmentQueryOrder to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEquipmentQueryOrder) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEquipmentQueryOrder) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EquipmentQuerySort as json.
+func (o OptEquipmentQuerySort) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EquipmentQuerySort from json.
+func (o *OptEquipmentQuerySort) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEquipmentQuerySort to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEquipmentQuerySort) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEquipmentQuerySort) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EquipmentRevision as json.
+func (o OptEquipmentRevision) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes EquipmentRevision from json.
+func (o *OptEquipmentRevision) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEquipmentRevision to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEquipmentRevision) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEquipmentRevision) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EquipmentTypeOperatingStatus as json.
+func (o OptEquipmentTypeOperatingStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EquipmentTypeOperatingStatus from json.
+func (o *OptEquipmentTypeOperatingStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEquipmentTypeOperatingStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEquipmentTypeOperatingStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEquipmentTypeOperatingStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes float64 as json.
+func (o OptFloat64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Float64(float64(o.Value))
+}
+
+// Decode decodes float64 from json.
+func (o *OptFloat64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFloat64 to nil")
+	}
+	o.Set = true
+	v, err := d.Float64()
+	if err != nil {
+		return err
+	}
+	o.Value = float64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptFloat64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptFloat64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HierarchyScopeType as json.
+func (o OptHierarchyScopeType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes HierarchyScopeType from json.
+func (o *OptHierarchyScopeType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptHierarchyScopeType to nil")
+	}
+	o.Set = true
+	o.Value = make(HierarchyScopeType)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptHierarchyScopeType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptHierarchyScopeType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ImportJobMapping as json.
+func (o OptImportJobMapping) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ImportJobMapping from json.
+func (o *OptImportJobMapping) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptImportJobMapping to nil")
+	}
+	o.Set = true
+	o.Value = make(ImportJobMapping)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptImportJobMapping) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptImportJobMapping) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
+	}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int32 as json.
+func (o OptInt32) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int32(int32(o.Value))
+}
+
+// Decode decodes int32 from json.
+func (o *OptInt32) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt32 to nil")
+	}
+	o.Set = true
+	v, err := d.Int32()
+	if err != nil {
+		return err
+	}
+	o.Value = int32(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt32) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt32) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int64 as json.
+func (o OptInt64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int64(int64(o.Value))
+}
+
+// Decode decodes int64 from json.
+func (o *OptInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt64 to nil")
+	}
+	o.Set = true
+	v, err := d.Int64()
+	if err != nil {
+		return err
+	}
+	o.Value = int64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LocationType as json.
+func (o OptLocationType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes LocationType from json.
+func (o *OptLocationType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptLocationType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptLocationType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptLocationType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MaintenanceHistoryType as json.
+func (o OptMaintenanceHistoryType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes MaintenanceHistoryType from json.
+func (o *OptMaintenanceHistoryType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMaintenanceHistoryType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMaintenanceHistoryType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMaintenanceHistoryType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MaterialCategoryType as json.
+func (o OptMaterialCategoryType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes MaterialCategoryType from json.
+func (o *OptMaterialCategoryType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMaterialCategoryType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMaterialCategoryType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMaterialCategoryType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MaterialCompositionType as json.
+func (o OptMaterialCompositionType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes MaterialCompositionType from json.
+func (o *OptMaterialCompositionType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMaterialCompositionType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMaterialCompositionType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMaterialCompositionType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MaterialPropertiesType as json.
+func (o OptMaterialPropertiesType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes MaterialPropertiesType from json.
+func (o *OptMaterialPropertiesType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMaterialPropertiesType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMaterialPropertiesType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMaterialPropertiesType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MaterialSourceType as json.
+func (o OptMaterialSourceType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes MaterialSourceType from json.
+func (o *OptMaterialSourceType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMaterialSourceType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMaterialSourceType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMaterialSourceType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MaterialUsageType as json.
+func (o OptMaterialUsageType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes MaterialUsageType from json.
+func (o *OptMaterialUsageType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMaterialUsageType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMaterialUsageType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMaterialUsageType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MechanicalPropertiesType as json.
+func (o OptMechanicalPropertiesType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes MechanicalPropertiesType from json.
+func (o *OptMechanicalPropertiesType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMechanicalPropertiesType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMechanicalPropertiesType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMechanicalPropertiesType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PerformanceDataType as json.
+func (o OptPerformanceDataType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes PerformanceDataType from json.
+func (o *OptPerformanceDataType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptPerformanceDataType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptPerformanceDataType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptPerformanceDataType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PhysicalPropertiesType as json.
+func (o OptPhysicalPropertiesType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes PhysicalPropertiesType from json.
+func (o *OptPhysicalPropertiesType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptPhysicalPropertiesType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptPhysicalPropertiesType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptPhysicalPropertiesType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PropertyPredicateType as json.
+func (o OptPropertyPredicateType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes PropertyPredicateType from json.
+func (o *OptPropertyPredicateType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptPropertyPredicateType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptPropertyPredicateType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptPropertyPredicateType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RecordTypeRecordData as json.
+func (o OptRecordTypeRecordData) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes RecordTypeRecordData from json.
+func (o *OptRecordTypeRecordData) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptRecordTypeRecordData to nil")
+	}
+	o.Set = true
+	o.Value = make(RecordTypeRecordData)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptRecordTypeRecordData) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptRecordTypeRecordData) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RegulatoryInformationType as json.
+func (o OptRegulatoryInformationType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes RegulatoryInformationType from json.
+func (o *OptRegulatoryInformationType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptRegulatoryInformationType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptRegulatoryInformationType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptRegulatoryInformationType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ResourceLocationType as json.
+func (o OptResourceLocationType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ResourceLocationType from json.
+func (o *OptResourceLocationType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptResourceLocationType to nil")
+	}
+	o.Set = true
+	o.Value = make(ResourceLocationType)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptResourceLocationType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptResourceLocationType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SpatialDefinitionType as json.
+func (o OptSpatialDefinitionType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes SpatialDefinitionType from json.
+func (o *OptSpatialDefinitionType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptSpatialDefinitionType to nil")
+	}
+	o.Set = true
+	o.Value = make(SpatialDefinitionType)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptSpatialDefinitionType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptSpatialDefinitionType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
+	}
+	o.Set = true
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ThermalPropertiesType as json.
+func (o OptThermalPropertiesType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ThermalPropertiesType from json.
+func (o *OptThermalPropertiesType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptThermalPropertiesType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptThermalPropertiesType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
 func (s *OptThermalPropertiesType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TraceabilityInformationType as json.
-func (o OptTraceabilityInformationType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode encodes TraceabilityInformationType as json.
+func (o OptTraceabilityInformationType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes TraceabilityInformationType from json.
+func (o *OptTraceabilityInformationType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptTraceabilityInformationType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptTraceabilityInformationType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptTraceabilityInformationType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes uuid.UUID as json.
+func (o OptUUID) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	json.EncodeUUID(e, o.Value)
+}
+
+// Decode decodes uuid.UUID from json.
+func (o *OptUUID) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUUID to nil")
+	}
+	o.Set = true
+	v, err := json.DecodeUUID(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUUID) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUUID) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes WebhookEvent as json.
+func (o OptWebhookEvent) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes WebhookEvent from json.
+func (o *OptWebhookEvent) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptWebhookEvent to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptWebhookEvent) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptWebhookEvent) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PerformanceDataType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PerformanceDataType) encodeFields(e *jx.Encoder) {
+	{
+		if s.Metric != nil {
+			e.FieldStart("Metric")
+			e.ArrStart()
+			for _, elem := range s.Metric {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfPerformanceDataType = [1]string{
+	0: "Metric",
+}
+
+// Decode decodes PerformanceDataType from json.
+func (s *PerformanceDataType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PerformanceDataType to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Metric":
+			if err := func() error {
+				s.Metric = make([]MetricType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem MetricType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Metric = append(s.Metric, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Metric\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PerformanceDataType")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PerformanceDataType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PerformanceDataType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PersonPropertyType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PersonPropertyType) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("ID")
+		e.Str(s.ID)
+	}
+	{
+		if s.Description != nil {
+			e.FieldStart("Description")
+			e.ArrStart()
+			for _, elem := range s.Description {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Value != nil {
+			e.FieldStart("Value")
+			e.ArrStart()
+			for _, elem := range s.Value {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PersonPropertyChild != nil {
+			e.FieldStart("PersonPropertyChild")
+			e.ArrStart()
+			for _, elem := range s.PersonPropertyChild {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PersonnelClassPropertyID.Set {
+			e.FieldStart("PersonnelClassPropertyID")
+			s.PersonnelClassPropertyID.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfPersonPropertyType = [5]string{
+	0: "ID",
+	1: "Description",
+	2: "Value",
+	3: "PersonPropertyChild",
+	4: "PersonnelClassPropertyID",
+}
+
+// Decode decodes PersonPropertyType from json.
+func (s *PersonPropertyType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PersonPropertyType to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ID":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ID\"")
+			}
+		case "Description":
+			if err := func() error {
+				s.Description = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Description = append(s.Description, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "Value":
+			if err := func() error {
+				s.Value = make([]ValueType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ValueType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Value = append(s.Value, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Value\"")
+			}
+		case "PersonPropertyChild":
+			if err := func() error {
+				s.PersonPropertyChild = make([]PersonPropertyType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PersonPropertyType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.PersonPropertyChild = append(s.PersonPropertyChild, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PersonPropertyChild\"")
+			}
+		case "PersonnelClassPropertyID":
+			if err := func() error {
+				s.PersonnelClassPropertyID.Reset()
+				if err := s.PersonnelClassPropertyID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PersonnelClassPropertyID\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PersonPropertyType")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPersonPropertyType) {
+					name = jsonFieldsNameOfPersonPropertyType[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PersonPropertyType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PersonPropertyType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PersonType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PersonType) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("ID")
+		e.Str(s.ID)
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("Version")
+			s.Version.Encode(e)
+		}
+	}
+	{
+		if s.Description != nil {
+			e.FieldStart("Description")
+			e.ArrStart()
+			for _, elem := range s.Description {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PublishedDate.Set {
+			e.FieldStart("PublishedDate")
+			s.PublishedDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EffectiveStartDate.Set {
+			e.FieldStart("EffectiveStartDate")
+			s.EffectiveStartDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EffectiveEndDate.Set {
+			e.FieldStart("EffectiveEndDate")
+			s.EffectiveEndDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.HierarchyScope.Set {
+			e.FieldStart("HierarchyScope")
+			s.HierarchyScope.Encode(e)
+		}
+	}
+	{
+		if s.PersonName.Set {
+			e.FieldStart("PersonName")
+			s.PersonName.Encode(e)
+		}
+	}
+	{
+		if s.SpatialDefinition.Set {
+			e.FieldStart("SpatialDefinition")
+			s.SpatialDefinition.Encode(e)
+		}
+	}
+	{
+		if s.OperationalLocation.Set {
+			e.FieldStart("OperationalLocation")
+			s.OperationalLocation.Encode(e)
+		}
+	}
+	{
+		if s.PersonProperty != nil {
+			e.FieldStart("PersonProperty")
+			e.ArrStart()
+			for _, elem := range s.PersonProperty {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PersonnelClassID != nil {
+			e.FieldStart("PersonnelClassID")
+			e.ArrStart()
+			for _, elem := range s.PersonnelClassID {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.TestSpecificationID != nil {
+			e.FieldStart("TestSpecificationID")
+			e.ArrStart()
+			for _, elem := range s.TestSpecificationID {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if len(s.B2MML) != 0 {
+			e.FieldStart("B2MML")
+			e.Raw(s.B2MML)
+		}
+	}
+}
+
+var jsonFieldsNameOfPersonType = [14]string{
+	0:  "ID",
+	1:  "Version",
+	2:  "Description",
+	3:  "PublishedDate",
+	4:  "EffectiveStartDate",
+	5:  "EffectiveEndDate",
+	6:  "HierarchyScope",
+	7:  "PersonName",
+	8:  "SpatialDefinition",
+	9:  "OperationalLocation",
+	10: "PersonProperty",
+	11: "PersonnelClassID",
+	12: "TestSpecificationID",
+	13: "B2MML",
+}
+
+// Decode decodes PersonType from json.
+func (s *PersonType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PersonType to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ID":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ID\"")
+			}
+		case "Version":
+			if err := func() error {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Version\"")
+			}
+		case "Description":
+			if err := func() error {
+				s.Description = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Description = append(s.Description, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "PublishedDate":
+			if err := func() error {
+				s.PublishedDate.Reset()
+				if err := s.PublishedDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PublishedDate\"")
+			}
+		case "EffectiveStartDate":
+			if err := func() error {
+				s.EffectiveStartDate.Reset()
+				if err := s.EffectiveStartDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EffectiveStartDate\"")
+			}
+		case "EffectiveEndDate":
+			if err := func() error {
+				s.EffectiveEndDate.Reset()
+				if err := s.EffectiveEndDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EffectiveEndDate\"")
+			}
+		case "HierarchyScope":
+			if err := func() error {
+				s.HierarchyScope.Reset()
+				if err := s.HierarchyScope.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"HierarchyScope\"")
+			}
+		case "PersonName":
+			if err := func() error {
+				s.PersonName.Reset()
+				if err := s.PersonName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PersonName\"")
+			}
+		case "SpatialDefinition":
+			if err := func() error {
+				s.SpatialDefinition.Reset()
+				if err := s.SpatialDefinition.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SpatialDefinition\"")
+			}
+		case "OperationalLocation":
+			if err := func() error {
+				s.OperationalLocation.Reset()
+				if err := s.OperationalLocation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"OperationalLocation\"")
+			}
+		case "PersonProperty":
+			if err := func() error {
+				s.PersonProperty = make([]PersonPropertyType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PersonPropertyType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.PersonProperty = append(s.PersonProperty, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PersonProperty\"")
+			}
+		case "PersonnelClassID":
+			if err := func() error {
+				s.PersonnelClassID = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.PersonnelClassID = append(s.PersonnelClassID, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PersonnelClassID\"")
+			}
+		case "TestSpecificationID":
+			if err := func() error {
+				s.TestSpecificationID = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.TestSpecificationID = append(s.TestSpecificationID, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TestSpecificationID\"")
+			}
+		case "B2MML":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.B2MML = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"B2MML\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PersonType")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00000001,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPersonType) {
+					name = jsonFieldsNameOfPersonType[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PersonType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PersonType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PersonnelClassPropertyType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PersonnelClassPropertyType) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("ID")
+		e.Str(s.ID)
+	}
+	{
+		if s.Description != nil {
+			e.FieldStart("Description")
+			e.ArrStart()
+			for _, elem := range s.Description {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PropertyType.Set {
+			e.FieldStart("PropertyType")
+			s.PropertyType.Encode(e)
+		}
+	}
+	{
+		if s.Value != nil {
+			e.FieldStart("Value")
+			e.ArrStart()
+			for _, elem := range s.Value {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PersonnelClassPropertyChild != nil {
+			e.FieldStart("PersonnelClassPropertyChild")
+			e.ArrStart()
+			for _, elem := range s.PersonnelClassPropertyChild {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfPersonnelClassPropertyType = [5]string{
+	0: "ID",
+	1: "Description",
+	2: "PropertyType",
+	3: "Value",
+	4: "PersonnelClassPropertyChild",
+}
+
+// Decode decodes PersonnelClassPropertyType from json.
+func (s *PersonnelClassPropertyType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PersonnelClassPropertyType to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ID":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ID\"")
+			}
+		case "Description":
+			if err := func() error {
+				s.Description = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Description = append(s.Description, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "PropertyType":
+			if err := func() error {
+				s.PropertyType.Reset()
+				if err := s.PropertyType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PropertyType\"")
+			}
+		case "Value":
+			if err := func() error {
+				s.Value = make([]ValueType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ValueType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Value = append(s.Value, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Value\"")
+			}
+		case "PersonnelClassPropertyChild":
+			if err := func() error {
+				s.PersonnelClassPropertyChild = make([]PersonnelClassPropertyType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PersonnelClassPropertyType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.PersonnelClassPropertyChild = append(s.PersonnelClassPropertyChild, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PersonnelClassPropertyChild\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PersonnelClassPropertyType")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPersonnelClassPropertyType) {
+					name = jsonFieldsNameOfPersonnelClassPropertyType[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PersonnelClassPropertyType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PersonnelClassPropertyType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PersonnelClassType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PersonnelClassType) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("ID")
+		e.Str(s.ID)
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("Version")
+			s.Version.Encode(e)
+		}
+	}
+	{
+		if s.Description != nil {
+			e.FieldStart("Description")
+			e.ArrStart()
+			for _, elem := range s.Description {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PublishedDate.Set {
+			e.FieldStart("PublishedDate")
+			s.PublishedDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EffectiveStartDate.Set {
+			e.FieldStart("EffectiveStartDate")
+			s.EffectiveStartDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EffectiveEndDate.Set {
+			e.FieldStart("EffectiveEndDate")
+			s.EffectiveEndDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.HierarchyScope.Set {
+			e.FieldStart("HierarchyScope")
+			s.HierarchyScope.Encode(e)
+		}
+	}
+	{
+		if s.PersonnelClassBaseID != nil {
+			e.FieldStart("PersonnelClassBaseID")
+			e.ArrStart()
+			for _, elem := range s.PersonnelClassBaseID {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PersonnelClassProperty != nil {
+			e.FieldStart("PersonnelClassProperty")
+			e.ArrStart()
+			for _, elem := range s.PersonnelClassProperty {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PersonSourceID != nil {
+			e.FieldStart("PersonSourceID")
+			e.ArrStart()
+			for _, elem := range s.PersonSourceID {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.TestSpecificationID != nil {
+			e.FieldStart("TestSpecificationID")
+			e.ArrStart()
+			for _, elem := range s.TestSpecificationID {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfPersonnelClassType = [11]string{
+	0:  "ID",
+	1:  "Version",
+	2:  "Description",
+	3:  "PublishedDate",
+	4:  "EffectiveStartDate",
+	5:  "EffectiveEndDate",
+	6:  "HierarchyScope",
+	7:  "PersonnelClassBaseID",
+	8:  "PersonnelClassProperty",
+	9:  "PersonSourceID",
+	10: "TestSpecificationID",
+}
+
+// Decode decodes PersonnelClassType from json.
+func (s *PersonnelClassType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PersonnelClassType to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ID":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ID\"")
+			}
+		case "Version":
+			if err := func() error {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Version\"")
+			}
+		case "Description":
+			if err := func() error {
+				s.Description = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Description = append(s.Description, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "PublishedDate":
+			if err := func() error {
+				s.PublishedDate.Reset()
+				if err := s.PublishedDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PublishedDate\"")
+			}
+		case "EffectiveStartDate":
+			if err := func() error {
+				s.EffectiveStartDate.Reset()
+				if err := s.EffectiveStartDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EffectiveStartDate\"")
+			}
+		case "EffectiveEndDate":
+			if err := func() error {
+				s.EffectiveEndDate.Reset()
+				if err := s.EffectiveEndDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EffectiveEndDate\"")
+			}
+		case "HierarchyScope":
+			if err := func() error {
+				s.HierarchyScope.Reset()
+				if err := s.HierarchyScope.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"HierarchyScope\"")
+			}
+		case "PersonnelClassBaseID":
+			if err := func() error {
+				s.PersonnelClassBaseID = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.PersonnelClassBaseID = append(s.PersonnelClassBaseID, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PersonnelClassBaseID\"")
+			}
+		case "PersonnelClassProperty":
+			if err := func() error {
+				s.PersonnelClassProperty = make([]PersonnelClassPropertyType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PersonnelClassPropertyType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.PersonnelClassProperty = append(s.PersonnelClassProperty, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PersonnelClassProperty\"")
+			}
+		case "PersonSourceID":
+			if err := func() error {
+				s.PersonSourceID = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.PersonSourceID = append(s.PersonSourceID, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PersonSourceID\"")
+			}
+		case "TestSpecificationID":
+			if err := func() error {
+				s.TestSpecificationID = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.TestSpecificationID = append(s.TestSpecificationID, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TestSpecificationID\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PersonnelClassType")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00000001,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPersonnelClassType) {
+					name = jsonFieldsNameOfPersonnelClassType[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PersonnelClassType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PersonnelClassType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PersonnelInformationType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PersonnelInformationType) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("ID")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Description != nil {
+			e.FieldStart("Description")
+			e.ArrStart()
+			for _, elem := range s.Description {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.HierarchyScope.Set {
+			e.FieldStart("HierarchyScope")
+			s.HierarchyScope.Encode(e)
+		}
+	}
+	{
+		if s.PublishedDate.Set {
+			e.FieldStart("PublishedDate")
+			s.PublishedDate.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Person != nil {
+			e.FieldStart("Person")
+			e.ArrStart()
+			for _, elem := range s.Person {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PersonnelClass != nil {
+			e.FieldStart("PersonnelClass")
+			e.ArrStart()
+			for _, elem := range s.PersonnelClass {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.TestSpecification != nil {
+			e.FieldStart("TestSpecification")
+			e.ArrStart()
+			for _, elem := range s.TestSpecification {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfPersonnelInformationType = [7]string{
+	0: "ID",
+	1: "Description",
+	2: "HierarchyScope",
+	3: "PublishedDate",
+	4: "Person",
+	5: "PersonnelClass",
+	6: "TestSpecification",
+}
+
+// Decode decodes PersonnelInformationType from json.
+func (s *PersonnelInformationType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PersonnelInformationType to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes TraceabilityInformationType from json.
-func (o *OptTraceabilityInformationType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptTraceabilityInformationType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ID":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ID\"")
+			}
+		case "Description":
+			if err := func() error {
+				s.Description = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Description = append(s.Description, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "HierarchyScope":
+			if err := func() error {
+				s.HierarchyScope.Reset()
+				if err := s.HierarchyScope.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"HierarchyScope\"")
+			}
+		case "PublishedDate":
+			if err := func() error {
+				s.PublishedDate.Reset()
+				if err := s.PublishedDate.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PublishedDate\"")
+			}
+		case "Person":
+			if err := func() error {
+				s.Person = make([]PersonType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PersonType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Person = append(s.Person, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Person\"")
+			}
+		case "PersonnelClass":
+			if err := func() error {
+				s.PersonnelClass = make([]PersonnelClassType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PersonnelClassType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.PersonnelClass = append(s.PersonnelClass, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PersonnelClass\"")
+			}
+		case "TestSpecification":
+			if err := func() error {
+				s.TestSpecification = make([]TestSpecificationType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TestSpecificationType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.TestSpecification = append(s.TestSpecification, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TestSpecification\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PersonnelInformationType")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptTraceabilityInformationType) MarshalJSON() ([]byte, error) {
+func (s *PersonnelInformationType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptTraceabilityInformationType) UnmarshalJSON(data []byte) error {
+func (s *PersonnelInformationType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PerformanceDataType) Encode(e *jx.Encoder) {
+func (s *PhysicalPropertiesType) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PerformanceDataType) encodeFields(e *jx.Encoder) {
+func (s *PhysicalPropertiesType) encodeFields(e *jx.Encoder) {
 	{
-		if s.Metric != nil {
-			e.FieldStart("Metric")
-			e.ArrStart()
-			for _, elem := range s.Metric {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Density.Set {
+			e.FieldStart("Density")
+			s.Density.Encode(e)
+		}
+	}
+	{
+		if s.Viscosity.Set {
+			e.FieldStart("Viscosity")
+			s.Viscosity.Encode(e)
+		}
+	}
+	{
+		if s.ParticleSize.Set {
+			e.FieldStart("ParticleSize")
+			s.ParticleSize.Encode(e)
+		}
+	}
+	{
+		if s.SurfaceArea.Set {
+			e.FieldStart("SurfaceArea")
+			s.SurfaceArea.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfPerformanceDataType = [1]string{
-	0: "Metric",
+var jsonFieldsNameOfPhysicalPropertiesType = [4]string{
+	0: "Density",
+	1: "Viscosity",
+	2: "ParticleSize",
+	3: "SurfaceArea",
 }
 
-// Decode decodes PerformanceDataType from json.
-func (s *PerformanceDataType) Decode(d *jx.Decoder) error {
+// Decode decodes PhysicalPropertiesType from json.
+func (s *PhysicalPropertiesType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PerformanceDataType to nil")
+		return errors.New("invalid: unable to decode PhysicalPropertiesType to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Metric":
+		case "Density":
 			if err := func() error {
-				s.Metric = make([]MetricType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem MetricType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Metric = append(s.Metric, elem)
-					return nil
-				}); err != nil {
+				s.Density.Reset()
+				if err := s.Density.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Metric\"")
+				return errors.Wrap(err, "decode field \"Density\"")
+			}
+		case "Viscosity":
+			if err := func() error {
+				s.Viscosity.Reset()
+				if err := s.Viscosity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Viscosity\"")
+			}
+		case "ParticleSize":
+			if err := func() error {
+				s.ParticleSize.Reset()
+				if err := s.ParticleSize.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ParticleSize\"")
+			}
+		case "SurfaceArea":
+			if err := func() error {
+				s.SurfaceArea.Reset()
+				if err := s.SurfaceArea.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SurfaceArea\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PerformanceDataType")
+		return errors.Wrap(err, "decode PhysicalPropertiesType")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PerformanceDataType) MarshalJSON() ([]byte, error) {
+func (s *PhysicalPropertiesType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PerformanceDataType) UnmarshalJSON(data []byte) error {
+func (s *PhysicalPropertiesType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PersonPropertyType) Encode(e *jx.Encoder) {
+func (s *PropertyPredicate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PersonPropertyType) encodeFields(e *jx.Encoder) {
+func (s *PropertyPredicate) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("ID")
+		e.FieldStart("id")
 		e.Str(s.ID)
 	}
 	{
-		if s.Description != nil {
-			e.FieldStart("Description")
-			e.ArrStart()
-			for _, elem := range s.Description {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Value != nil {
-			e.FieldStart("Value")
-			e.ArrStart()
-			for _, elem := range s.Value {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("op")
+		s.Op.Encode(e)
 	}
 	{
-		if s.PersonPropertyChild != nil {
-			e.FieldStart("PersonPropertyChild")
-			e.ArrStart()
-			for _, elem := range s.PersonPropertyChild {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Value.Set {
+			e.FieldStart("value")
+			s.Value.Encode(e)
 		}
 	}
 	{
-		if s.PersonnelClassPropertyID.Set {
-			e.FieldStart("PersonnelClassPropertyID")
-			s.PersonnelClassPropertyID.Encode(e)
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+	{
+		if s.Unit.Set {
+			e.FieldStart("unit")
+			s.Unit.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfPersonPropertyType = [5]string{
-	0: "ID",
-	1: "Description",
-	2: "Value",
-	3: "PersonPropertyChild",
-	4: "PersonnelClassPropertyID",
+var jsonFieldsNameOfPropertyPredicate = [5]string{
+	0: "id",
+	1: "op",
+	2: "value",
+	3: "type",
+	4: "unit",
 }
 
-// Decode decodes PersonPropertyType from json.
-func (s *PersonPropertyType) Decode(d *jx.Decoder) error {
+// Decode decodes PropertyPredicate from json.
+func (s *PropertyPredicate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PersonPropertyType to nil")
+		return errors.New("invalid: unable to decode PropertyPredicate to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ID":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
@@ -3847,82 +8045,59 @@ func (s *PersonPropertyType) Decode(d *jx.Decoder) error {
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ID\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "Description":
+		case "op":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Description = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Description = append(s.Description, elem)
-					return nil
-				}); err != nil {
+				if err := s.Op.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
+				return errors.Wrap(err, "decode field \"op\"")
 			}
-		case "Value":
+		case "value":
 			if err := func() error {
-				s.Value = make([]ValueType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem ValueType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Value = append(s.Value, elem)
-					return nil
-				}); err != nil {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Value\"")
+				return errors.Wrap(err, "decode field \"value\"")
 			}
-		case "PersonPropertyChild":
+		case "type":
 			if err := func() error {
-				s.PersonPropertyChild = make([]PersonPropertyType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PersonPropertyType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.PersonPropertyChild = append(s.PersonPropertyChild, elem)
-					return nil
-				}); err != nil {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PersonPropertyChild\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "PersonnelClassPropertyID":
+		case "unit":
 			if err := func() error {
-				s.PersonnelClassPropertyID.Reset()
-				if err := s.PersonnelClassPropertyID.Decode(d); err != nil {
+				s.Unit.Reset()
+				if err := s.Unit.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PersonnelClassPropertyID\"")
+				return errors.Wrap(err, "decode field \"unit\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PersonPropertyType")
+		return errors.Wrap(err, "decode PropertyPredicate")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3934,8 +8109,8 @@ func (s *PersonPropertyType) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPersonPropertyType) {
-					name = jsonFieldsNameOfPersonPropertyType[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPropertyPredicate) {
+					name = jsonFieldsNameOfPropertyPredicate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3956,523 +8131,654 @@ func (s *PersonPropertyType) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PersonPropertyType) MarshalJSON() ([]byte, error) {
+func (s *PropertyPredicate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PersonPropertyType) UnmarshalJSON(data []byte) error {
+func (s *PropertyPredicate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *PersonType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes PropertyPredicateOp as json.
+func (s PropertyPredicateOp) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// encodeFields encodes fields.
-func (s *PersonType) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("ID")
-		e.Str(s.ID)
-	}
-	{
-		if s.Version.Set {
-			e.FieldStart("Version")
-			s.Version.Encode(e)
-		}
-	}
-	{
-		if s.Description != nil {
-			e.FieldStart("Description")
-			e.ArrStart()
-			for _, elem := range s.Description {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.PublishedDate.Set {
-			e.FieldStart("PublishedDate")
-			s.PublishedDate.Encode(e, json.EncodeDateTime)
-		}
+// Decode decodes PropertyPredicateOp from json.
+func (s *PropertyPredicateOp) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PropertyPredicateOp to nil")
 	}
-	{
-		if s.EffectiveStartDate.Set {
-			e.FieldStart("EffectiveStartDate")
-			s.EffectiveStartDate.Encode(e, json.EncodeDateTime)
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	{
-		if s.EffectiveEndDate.Set {
-			e.FieldStart("EffectiveEndDate")
-			s.EffectiveEndDate.Encode(e, json.EncodeDateTime)
-		}
+	// Try to use constant string.
+	switch PropertyPredicateOp(v) {
+	case PropertyPredicateOpEq:
+		*s = PropertyPredicateOpEq
+	case PropertyPredicateOpNe:
+		*s = PropertyPredicateOpNe
+	case PropertyPredicateOpLt:
+		*s = PropertyPredicateOpLt
+	case PropertyPredicateOpLte:
+		*s = PropertyPredicateOpLte
+	case PropertyPredicateOpGt:
+		*s = PropertyPredicateOpGt
+	case PropertyPredicateOpGte:
+		*s = PropertyPredicateOpGte
+	case PropertyPredicateOpLike:
+		*s = PropertyPredicateOpLike
+	case PropertyPredicateOpExists:
+		*s = PropertyPredicateOpExists
+	default:
+		*s = PropertyPredicateOp(v)
 	}
-	{
-		if s.HierarchyScope.Set {
-			e.FieldStart("HierarchyScope")
-			s.HierarchyScope.Encode(e)
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s PropertyPredicateOp) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PropertyPredicateOp) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PropertyPredicateType as json.
+func (s PropertyPredicateType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes PropertyPredicateType from json.
+func (s *PropertyPredicateType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PropertyPredicateType to nil")
 	}
-	{
-		if s.PersonName.Set {
-			e.FieldStart("PersonName")
-			s.PersonName.Encode(e)
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	{
-		if s.SpatialDefinition.Set {
-			e.FieldStart("SpatialDefinition")
-			s.SpatialDefinition.Encode(e)
-		}
+	// Try to use constant string.
+	switch PropertyPredicateType(v) {
+	case PropertyPredicateTypeString:
+		*s = PropertyPredicateTypeString
+	case PropertyPredicateTypeNumber:
+		*s = PropertyPredicateTypeNumber
+	case PropertyPredicateTypeDatetime:
+		*s = PropertyPredicateTypeDatetime
+	case PropertyPredicateTypeBoolean:
+		*s = PropertyPredicateTypeBoolean
+	default:
+		*s = PropertyPredicateType(v)
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s PropertyPredicateType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PropertyPredicateType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PropertyValue) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PropertyValue) encodeFields(e *jx.Encoder) {
 	{
-		if s.OperationalLocation.Set {
-			e.FieldStart("OperationalLocation")
-			s.OperationalLocation.Encode(e)
-		}
+		e.FieldStart("id")
+		e.Str(s.ID)
 	}
 	{
-		if s.PersonProperty != nil {
-			e.FieldStart("PersonProperty")
-			e.ArrStart()
-			for _, elem := range s.PersonProperty {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("value")
+		e.Str(s.Value)
 	}
 	{
-		if s.PersonnelClassID != nil {
-			e.FieldStart("PersonnelClassID")
-			e.ArrStart()
-			for _, elem := range s.PersonnelClassID {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.DataType.Set {
+			e.FieldStart("data_type")
+			s.DataType.Encode(e)
 		}
 	}
 	{
-		if s.TestSpecificationID != nil {
-			e.FieldStart("TestSpecificationID")
-			e.ArrStart()
-			for _, elem := range s.TestSpecificationID {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.Unit.Set {
+			e.FieldStart("unit")
+			s.Unit.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfPersonType = [13]string{
-	0:  "ID",
-	1:  "Version",
-	2:  "Description",
-	3:  "PublishedDate",
-	4:  "EffectiveStartDate",
-	5:  "EffectiveEndDate",
-	6:  "HierarchyScope",
-	7:  "PersonName",
-	8:  "SpatialDefinition",
-	9:  "OperationalLocation",
-	10: "PersonProperty",
-	11: "PersonnelClassID",
-	12: "TestSpecificationID",
+var jsonFieldsNameOfPropertyValue = [4]string{
+	0: "id",
+	1: "value",
+	2: "data_type",
+	3: "unit",
 }
 
-// Decode decodes PersonType from json.
-func (s *PersonType) Decode(d *jx.Decoder) error {
+// Decode decodes PropertyValue from json.
+func (s *PropertyValue) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PersonType to nil")
+		return errors.New("invalid: unable to decode PropertyValue to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ID":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.ID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ID\"")
-			}
-		case "Version":
-			if err := func() error {
-				s.Version.Reset()
-				if err := s.Version.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Version\"")
-			}
-		case "Description":
-			if err := func() error {
-				s.Description = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Description = append(s.Description, elem)
-					return nil
-				}); err != nil {
+				s.ID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "PublishedDate":
+		case "value":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.PublishedDate.Reset()
-				if err := s.PublishedDate.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Str()
+				s.Value = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PublishedDate\"")
+				return errors.Wrap(err, "decode field \"value\"")
 			}
-		case "EffectiveStartDate":
+		case "data_type":
 			if err := func() error {
-				s.EffectiveStartDate.Reset()
-				if err := s.EffectiveStartDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.DataType.Reset()
+				if err := s.DataType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EffectiveStartDate\"")
+				return errors.Wrap(err, "decode field \"data_type\"")
 			}
-		case "EffectiveEndDate":
+		case "unit":
 			if err := func() error {
-				s.EffectiveEndDate.Reset()
-				if err := s.EffectiveEndDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.Unit.Reset()
+				if err := s.Unit.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EffectiveEndDate\"")
+				return errors.Wrap(err, "decode field \"unit\"")
 			}
-		case "HierarchyScope":
-			if err := func() error {
-				s.HierarchyScope.Reset()
-				if err := s.HierarchyScope.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PropertyValue")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPropertyValue) {
+					name = jsonFieldsNameOfPropertyValue[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"HierarchyScope\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "PersonName":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PropertyValue) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PropertyValue) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RecordType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RecordType) encodeFields(e *jx.Encoder) {
+	{
+		if s.RecordID.Set {
+			e.FieldStart("RecordID")
+			s.RecordID.Encode(e)
+		}
+	}
+	{
+		if s.RecordType.Set {
+			e.FieldStart("RecordType")
+			s.RecordType.Encode(e)
+		}
+	}
+	{
+		if s.RecordData.Set {
+			e.FieldStart("RecordData")
+			s.RecordData.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRecordType = [3]string{
+	0: "RecordID",
+	1: "RecordType",
+	2: "RecordData",
+}
+
+// Decode decodes RecordType from json.
+func (s *RecordType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RecordType to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "RecordID":
 			if err := func() error {
-				s.PersonName.Reset()
-				if err := s.PersonName.Decode(d); err != nil {
+				s.RecordID.Reset()
+				if err := s.RecordID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PersonName\"")
+				return errors.Wrap(err, "decode field \"RecordID\"")
 			}
-		case "SpatialDefinition":
+		case "RecordType":
 			if err := func() error {
-				s.SpatialDefinition.Reset()
-				if err := s.SpatialDefinition.Decode(d); err != nil {
+				s.RecordType.Reset()
+				if err := s.RecordType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SpatialDefinition\"")
+				return errors.Wrap(err, "decode field \"RecordType\"")
 			}
-		case "OperationalLocation":
+		case "RecordData":
 			if err := func() error {
-				s.OperationalLocation.Reset()
-				if err := s.OperationalLocation.Decode(d); err != nil {
+				s.RecordData.Reset()
+				if err := s.RecordData.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"OperationalLocation\"")
+				return errors.Wrap(err, "decode field \"RecordData\"")
 			}
-		case "PersonProperty":
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RecordType")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RecordType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RecordType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s RecordTypeRecordData) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s RecordTypeRecordData) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes RecordTypeRecordData from json.
+func (s *RecordTypeRecordData) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RecordTypeRecordData to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RecordTypeRecordData")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s RecordTypeRecordData) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RecordTypeRecordData) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RegulatoryInformationType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RegulatoryInformationType) encodeFields(e *jx.Encoder) {
+	{
+		if s.RegulatoryStatus.Set {
+			e.FieldStart("RegulatoryStatus")
+			s.RegulatoryStatus.Encode(e)
+		}
+	}
+	{
+		if s.ComplianceStandards.Set {
+			e.FieldStart("ComplianceStandards")
+			s.ComplianceStandards.Encode(e)
+		}
+	}
+	{
+		if s.CertificationDetails.Set {
+			e.FieldStart("CertificationDetails")
+			s.CertificationDetails.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRegulatoryInformationType = [3]string{
+	0: "RegulatoryStatus",
+	1: "ComplianceStandards",
+	2: "CertificationDetails",
+}
+
+// Decode decodes RegulatoryInformationType from json.
+func (s *RegulatoryInformationType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RegulatoryInformationType to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "RegulatoryStatus":
 			if err := func() error {
-				s.PersonProperty = make([]PersonPropertyType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PersonPropertyType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.PersonProperty = append(s.PersonProperty, elem)
-					return nil
-				}); err != nil {
+				s.RegulatoryStatus.Reset()
+				if err := s.RegulatoryStatus.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PersonProperty\"")
+				return errors.Wrap(err, "decode field \"RegulatoryStatus\"")
 			}
-		case "PersonnelClassID":
+		case "ComplianceStandards":
 			if err := func() error {
-				s.PersonnelClassID = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.PersonnelClassID = append(s.PersonnelClassID, elem)
-					return nil
-				}); err != nil {
+				s.ComplianceStandards.Reset()
+				if err := s.ComplianceStandards.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PersonnelClassID\"")
+				return errors.Wrap(err, "decode field \"ComplianceStandards\"")
 			}
-		case "TestSpecificationID":
+		case "CertificationDetails":
 			if err := func() error {
-				s.TestSpecificationID = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.TestSpecificationID = append(s.TestSpecificationID, elem)
-					return nil
-				}); err != nil {
+				s.CertificationDetails.Reset()
+				if err := s.CertificationDetails.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TestSpecificationID\"")
+				return errors.Wrap(err, "decode field \"CertificationDetails\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PersonType")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00000001,
-		0b00000000,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfPersonType) {
-					name = jsonFieldsNameOfPersonType[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode RegulatoryInformationType")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PersonType) MarshalJSON() ([]byte, error) {
+func (s *RegulatoryInformationType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PersonType) UnmarshalJSON(data []byte) error {
+func (s *RegulatoryInformationType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PersonnelClassPropertyType) Encode(e *jx.Encoder) {
+func (s ResourceLocationType) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *PersonnelClassPropertyType) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("ID")
-		e.Str(s.ID)
+// encodeFields implements json.Marshaler.
+func (s ResourceLocationType) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	{
-		if s.Description != nil {
-			e.FieldStart("Description")
-			e.ArrStart()
-			for _, elem := range s.Description {
-				e.Str(elem)
+}
+
+// Decode decodes ResourceLocationType from json.
+func (s *ResourceLocationType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ResourceLocationType to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-			e.ArrEnd()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ResourceLocationType")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ResourceLocationType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ResourceLocationType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SchemaViolation) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SchemaViolation) encodeFields(e *jx.Encoder) {
 	{
-		if s.PropertyType.Set {
-			e.FieldStart("PropertyType")
-			s.PropertyType.Encode(e)
-		}
+		e.FieldStart("path")
+		e.Str(s.Path)
 	}
 	{
-		if s.Value != nil {
-			e.FieldStart("Value")
-			e.ArrStart()
-			for _, elem := range s.Value {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("code")
+		s.Code.Encode(e)
 	}
 	{
-		if s.PersonnelClassPropertyChild != nil {
-			e.FieldStart("PersonnelClassPropertyChild")
-			e.ArrStart()
-			for _, elem := range s.PersonnelClassPropertyChild {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("message")
+		e.Str(s.Message)
 	}
 }
 
-var jsonFieldsNameOfPersonnelClassPropertyType = [5]string{
-	0: "ID",
-	1: "Description",
-	2: "PropertyType",
-	3: "Value",
-	4: "PersonnelClassPropertyChild",
+var jsonFieldsNameOfSchemaViolation = [3]string{
+	0: "path",
+	1: "code",
+	2: "message",
 }
 
-// Decode decodes PersonnelClassPropertyType from json.
-func (s *PersonnelClassPropertyType) Decode(d *jx.Decoder) error {
+// Decode decodes SchemaViolation from json.
+func (s *SchemaViolation) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PersonnelClassPropertyType to nil")
+		return errors.New("invalid: unable to decode SchemaViolation to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ID":
+		case "path":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.ID = string(v)
+				s.Path = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ID\"")
-			}
-		case "Description":
-			if err := func() error {
-				s.Description = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Description = append(s.Description, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
-			}
-		case "PropertyType":
-			if err := func() error {
-				s.PropertyType.Reset()
-				if err := s.PropertyType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PropertyType\"")
-			}
-		case "Value":
-			if err := func() error {
-				s.Value = make([]ValueType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem ValueType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Value = append(s.Value, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Value\"")
+				return errors.Wrap(err, "decode field \"path\"")
 			}
-		case "PersonnelClassPropertyChild":
-			if err := func() error {
-				s.PersonnelClassPropertyChild = make([]PersonnelClassPropertyType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PersonnelClassPropertyType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.PersonnelClassPropertyChild = append(s.PersonnelClassPropertyChild, elem)
-					return nil
-				}); err != nil {
+		case "code":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Code.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PersonnelClassPropertyChild\"")
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PersonnelClassPropertyType")
+		return errors.Wrap(err, "decode SchemaViolation")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4484,8 +8790,8 @@ func (s *PersonnelClassPropertyType) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPersonnelClassPropertyType) {
-					name = jsonFieldsNameOfPersonnelClassPropertyType[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSchemaViolation) {
+					name = jsonFieldsNameOfSchemaViolation[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4506,357 +8812,625 @@ func (s *PersonnelClassPropertyType) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PersonnelClassPropertyType) MarshalJSON() ([]byte, error) {
+func (s *SchemaViolation) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PersonnelClassPropertyType) UnmarshalJSON(data []byte) error {
+func (s *SchemaViolation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SchemaViolationCode as json.
+func (s SchemaViolationCode) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes SchemaViolationCode from json.
+func (s *SchemaViolationCode) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SchemaViolationCode to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch SchemaViolationCode(v) {
+	case SchemaViolationCodeRequired:
+		*s = SchemaViolationCodeRequired
+	case SchemaViolationCodeEnumeration:
+		*s = SchemaViolationCodeEnumeration
+	case SchemaViolationCodeCardinality:
+		*s = SchemaViolationCodeCardinality
+	case SchemaViolationCodeFormat:
+		*s = SchemaViolationCodeFormat
+	case SchemaViolationCodeUnexpected:
+		*s = SchemaViolationCodeUnexpected
+	default:
+		*s = SchemaViolationCode(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SchemaViolationCode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SchemaViolationCode) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PersonnelClassType) Encode(e *jx.Encoder) {
+func (s *SearchHit) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PersonnelClassType) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("ID")
-		e.Str(s.ID)
-	}
-	{
-		if s.Version.Set {
-			e.FieldStart("Version")
-			s.Version.Encode(e)
-		}
-	}
-	{
-		if s.Description != nil {
-			e.FieldStart("Description")
-			e.ArrStart()
-			for _, elem := range s.Description {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.PublishedDate.Set {
-			e.FieldStart("PublishedDate")
-			s.PublishedDate.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.EffectiveStartDate.Set {
-			e.FieldStart("EffectiveStartDate")
-			s.EffectiveStartDate.Encode(e, json.EncodeDateTime)
-		}
-	}
+func (s *SearchHit) encodeFields(e *jx.Encoder) {
 	{
-		if s.EffectiveEndDate.Set {
-			e.FieldStart("EffectiveEndDate")
-			s.EffectiveEndDate.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("kind")
+		s.Kind.Encode(e)
 	}
 	{
-		if s.HierarchyScope.Set {
-			e.FieldStart("HierarchyScope")
-			s.HierarchyScope.Encode(e)
-		}
+		e.FieldStart("id")
+		e.Str(s.ID)
 	}
 	{
-		if s.PersonnelClassBaseID != nil {
-			e.FieldStart("PersonnelClassBaseID")
-			e.ArrStart()
-			for _, elem := range s.PersonnelClassBaseID {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.EquipmentID.Set {
+			e.FieldStart("equipment_id")
+			s.EquipmentID.Encode(e)
 		}
 	}
 	{
-		if s.PersonnelClassProperty != nil {
-			e.FieldStart("PersonnelClassProperty")
-			e.ArrStart()
-			for _, elem := range s.PersonnelClassProperty {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Title.Set {
+			e.FieldStart("title")
+			s.Title.Encode(e)
 		}
 	}
 	{
-		if s.PersonSourceID != nil {
-			e.FieldStart("PersonSourceID")
-			e.ArrStart()
-			for _, elem := range s.PersonSourceID {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("headline")
+		e.Str(s.Headline)
 	}
 	{
-		if s.TestSpecificationID != nil {
-			e.FieldStart("TestSpecificationID")
-			e.ArrStart()
-			for _, elem := range s.TestSpecificationID {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("rank")
+		e.Float32(s.Rank)
 	}
 }
 
-var jsonFieldsNameOfPersonnelClassType = [11]string{
-	0:  "ID",
-	1:  "Version",
-	2:  "Description",
-	3:  "PublishedDate",
-	4:  "EffectiveStartDate",
-	5:  "EffectiveEndDate",
-	6:  "HierarchyScope",
-	7:  "PersonnelClassBaseID",
-	8:  "PersonnelClassProperty",
-	9:  "PersonSourceID",
-	10: "TestSpecificationID",
+var jsonFieldsNameOfSearchHit = [6]string{
+	0: "kind",
+	1: "id",
+	2: "equipment_id",
+	3: "title",
+	4: "headline",
+	5: "rank",
 }
 
-// Decode decodes PersonnelClassType from json.
-func (s *PersonnelClassType) Decode(d *jx.Decoder) error {
+// Decode decodes SearchHit from json.
+func (s *SearchHit) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PersonnelClassType to nil")
+		return errors.New("invalid: unable to decode SearchHit to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ID":
+		case "kind":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.ID = string(v)
-				if err != nil {
+				if err := s.Kind.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ID\"")
+				return errors.Wrap(err, "decode field \"kind\"")
 			}
-		case "Version":
+		case "id":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Version.Reset()
-				if err := s.Version.Decode(d); err != nil {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Version\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "Description":
+		case "equipment_id":
 			if err := func() error {
-				s.Description = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Description = append(s.Description, elem)
-					return nil
-				}); err != nil {
+				s.EquipmentID.Reset()
+				if err := s.EquipmentID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
+				return errors.Wrap(err, "decode field \"equipment_id\"")
 			}
-		case "PublishedDate":
+		case "title":
 			if err := func() error {
-				s.PublishedDate.Reset()
-				if err := s.PublishedDate.Decode(d, json.DecodeDateTime); err != nil {
+				s.Title.Reset()
+				if err := s.Title.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PublishedDate\"")
+				return errors.Wrap(err, "decode field \"title\"")
 			}
-		case "EffectiveStartDate":
+		case "headline":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.EffectiveStartDate.Reset()
-				if err := s.EffectiveStartDate.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Str()
+				s.Headline = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EffectiveStartDate\"")
+				return errors.Wrap(err, "decode field \"headline\"")
 			}
-		case "EffectiveEndDate":
+		case "rank":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.EffectiveEndDate.Reset()
-				if err := s.EffectiveEndDate.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Float32()
+				s.Rank = float32(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EffectiveEndDate\"")
+				return errors.Wrap(err, "decode field \"rank\"")
 			}
-		case "HierarchyScope":
-			if err := func() error {
-				s.HierarchyScope.Reset()
-				if err := s.HierarchyScope.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SearchHit")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00110011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSearchHit) {
+					name = jsonFieldsNameOfSearchHit[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"HierarchyScope\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "PersonnelClassBaseID":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SearchHit) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SearchHit) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SearchHitKind as json.
+func (s SearchHitKind) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes SearchHitKind from json.
+func (s *SearchHitKind) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SearchHitKind to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch SearchHitKind(v) {
+	case SearchHitKindEquipment:
+		*s = SearchHitKindEquipment
+	case SearchHitKindEquipmentClass:
+		*s = SearchHitKindEquipmentClass
+	case SearchHitKindPhysicalAsset:
+		*s = SearchHitKindPhysicalAsset
+	default:
+		*s = SearchHitKind(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SearchHitKind) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SearchHitKind) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SearchResults) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SearchResults) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("items")
+		e.ArrStart()
+		for _, elem := range s.Items {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfSearchResults = [1]string{
+	0: "items",
+}
+
+// Decode decodes SearchResults from json.
+func (s *SearchResults) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SearchResults to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "items":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.PersonnelClassBaseID = make([]string, 0)
+				s.Items = make([]SearchHit, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
+					var elem SearchHit
+					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.PersonnelClassBaseID = append(s.PersonnelClassBaseID, elem)
+					s.Items = append(s.Items, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PersonnelClassBaseID\"")
+				return errors.Wrap(err, "decode field \"items\"")
 			}
-		case "PersonnelClassProperty":
-			if err := func() error {
-				s.PersonnelClassProperty = make([]PersonnelClassPropertyType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PersonnelClassPropertyType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.PersonnelClassProperty = append(s.PersonnelClassProperty, elem)
-					return nil
-				}); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SearchResults")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSearchResults) {
+					name = jsonFieldsNameOfSearchResults[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PersonnelClassProperty\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "PersonSourceID":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SearchResults) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SearchResults) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SiteUnits) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SiteUnits) encodeFields(e *jx.Encoder) {
+	{
+		if s.SiteID.Set {
+			e.FieldStart("site_id")
+			s.SiteID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("units")
+		s.Units.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfSiteUnits = [2]string{
+	0: "site_id",
+	1: "units",
+}
+
+// Decode decodes SiteUnits from json.
+func (s *SiteUnits) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SiteUnits to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "site_id":
 			if err := func() error {
-				s.PersonSourceID = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.PersonSourceID = append(s.PersonSourceID, elem)
-					return nil
-				}); err != nil {
+				s.SiteID.Reset()
+				if err := s.SiteID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PersonSourceID\"")
+				return errors.Wrap(err, "decode field \"site_id\"")
 			}
-		case "TestSpecificationID":
+		case "units":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.TestSpecificationID = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.TestSpecificationID = append(s.TestSpecificationID, elem)
-					return nil
-				}); err != nil {
+				if err := s.Units.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TestSpecificationID\"")
+				return errors.Wrap(err, "decode field \"units\"")
 			}
 		default:
 			return d.Skip()
 		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode PersonnelClassType")
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SiteUnits")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000010,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSiteUnits) {
+					name = jsonFieldsNameOfSiteUnits[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SiteUnits) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SiteUnits) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s SiteUnitsUnits) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s SiteUnitsUnits) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
+	}
+}
+
+// Decode decodes SiteUnitsUnits from json.
+func (s *SiteUnitsUnits) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SiteUnitsUnits to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SiteUnitsUnits")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SiteUnitsUnits) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SiteUnitsUnits) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s SpatialDefinitionType) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s SpatialDefinitionType) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00000001,
-		0b00000000,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfPersonnelClassType) {
-					name = jsonFieldsNameOfPersonnelClassType[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+}
+
+// Decode decodes SpatialDefinitionType from json.
+func (s *SpatialDefinitionType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SpatialDefinitionType to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SpatialDefinitionType")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PersonnelClassType) MarshalJSON() ([]byte, error) {
+func (s SpatialDefinitionType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PersonnelClassType) UnmarshalJSON(data []byte) error {
+func (s *SpatialDefinitionType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PersonnelInformationType) Encode(e *jx.Encoder) {
+func (s *TestSpecificationType) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PersonnelInformationType) encodeFields(e *jx.Encoder) {
+func (s *TestSpecificationType) encodeFields(e *jx.Encoder) {
 	{
 		if s.ID.Set {
 			e.FieldStart("ID")
@@ -4864,74 +9438,31 @@ func (s *PersonnelInformationType) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.Description != nil {
+		if s.Description.Set {
 			e.FieldStart("Description")
-			e.ArrStart()
-			for _, elem := range s.Description {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.HierarchyScope.Set {
-			e.FieldStart("HierarchyScope")
-			s.HierarchyScope.Encode(e)
-		}
-	}
-	{
-		if s.PublishedDate.Set {
-			e.FieldStart("PublishedDate")
-			s.PublishedDate.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.Person != nil {
-			e.FieldStart("Person")
-			e.ArrStart()
-			for _, elem := range s.Person {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.PersonnelClass != nil {
-			e.FieldStart("PersonnelClass")
-			e.ArrStart()
-			for _, elem := range s.PersonnelClass {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+			s.Description.Encode(e)
 		}
 	}
-	{
-		if s.TestSpecification != nil {
-			e.FieldStart("TestSpecification")
-			e.ArrStart()
-			for _, elem := range s.TestSpecification {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+	for k, elem := range s.AdditionalProps {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
 		}
 	}
 }
 
-var jsonFieldsNameOfPersonnelInformationType = [7]string{
+var jsonFieldsNameOfTestSpecificationType = [2]string{
 	0: "ID",
 	1: "Description",
-	2: "HierarchyScope",
-	3: "PublishedDate",
-	4: "Person",
-	5: "PersonnelClass",
-	6: "TestSpecification",
 }
 
-// Decode decodes PersonnelInformationType from json.
-func (s *PersonnelInformationType) Decode(d *jx.Decoder) error {
+// Decode decodes TestSpecificationType from json.
+func (s *TestSpecificationType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PersonnelInformationType to nil")
+		return errors.New("invalid: unable to decode TestSpecificationType to nil")
 	}
+	s.AdditionalProps = map[string]jx.Raw{}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -4947,1203 +9478,2008 @@ func (s *PersonnelInformationType) Decode(d *jx.Decoder) error {
 			}
 		case "Description":
 			if err := func() error {
-				s.Description = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Description = append(s.Description, elem)
-					return nil
-				}); err != nil {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"Description\"")
 			}
-		case "HierarchyScope":
-			if err := func() error {
-				s.HierarchyScope.Reset()
-				if err := s.HierarchyScope.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"HierarchyScope\"")
-			}
-		case "PublishedDate":
-			if err := func() error {
-				s.PublishedDate.Reset()
-				if err := s.PublishedDate.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PublishedDate\"")
-			}
-		case "Person":
-			if err := func() error {
-				s.Person = make([]PersonType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PersonType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Person = append(s.Person, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Person\"")
-			}
-		case "PersonnelClass":
+		default:
+			var elem jx.Raw
 			if err := func() error {
-				s.PersonnelClass = make([]PersonnelClassType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PersonnelClassType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.PersonnelClass = append(s.PersonnelClass, elem)
-					return nil
-				}); err != nil {
+				v, err := d.RawAppend(nil)
+				elem = jx.Raw(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PersonnelClass\"")
+				return errors.Wrapf(err, "decode field %q", k)
 			}
-		case "TestSpecification":
-			if err := func() error {
-				s.TestSpecification = make([]TestSpecificationType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TestSpecificationType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.TestSpecification = append(s.TestSpecification, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TestSpecification\"")
+			s.AdditionalProps[string(k)] = elem
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TestSpecificationType")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TestSpecificationType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TestSpecificationType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s TestSpecificationTypeAdditional) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s TestSpecificationTypeAdditional) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes TestSpecificationTypeAdditional from json.
+func (s *TestSpecificationTypeAdditional) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TestSpecificationTypeAdditional to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PersonnelInformationType")
+		return errors.Wrap(err, "decode TestSpecificationTypeAdditional")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PersonnelInformationType) MarshalJSON() ([]byte, error) {
+func (s TestSpecificationTypeAdditional) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PersonnelInformationType) UnmarshalJSON(data []byte) error {
+func (s *TestSpecificationTypeAdditional) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PhysicalPropertiesType) Encode(e *jx.Encoder) {
+func (s *ThermalPropertiesType) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PhysicalPropertiesType) encodeFields(e *jx.Encoder) {
+func (s *ThermalPropertiesType) encodeFields(e *jx.Encoder) {
 	{
-		if s.Density.Set {
-			e.FieldStart("Density")
-			s.Density.Encode(e)
+		if s.MeltingPoint.Set {
+			e.FieldStart("MeltingPoint")
+			s.MeltingPoint.Encode(e)
 		}
 	}
 	{
-		if s.Viscosity.Set {
-			e.FieldStart("Viscosity")
-			s.Viscosity.Encode(e)
+		if s.BoilingPoint.Set {
+			e.FieldStart("BoilingPoint")
+			s.BoilingPoint.Encode(e)
 		}
 	}
 	{
-		if s.ParticleSize.Set {
-			e.FieldStart("ParticleSize")
-			s.ParticleSize.Encode(e)
+		if s.ThermalConductivity.Set {
+			e.FieldStart("ThermalConductivity")
+			s.ThermalConductivity.Encode(e)
 		}
 	}
 	{
-		if s.SurfaceArea.Set {
-			e.FieldStart("SurfaceArea")
-			s.SurfaceArea.Encode(e)
+		if s.SpecificHeat.Set {
+			e.FieldStart("SpecificHeat")
+			s.SpecificHeat.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfPhysicalPropertiesType = [4]string{
-	0: "Density",
-	1: "Viscosity",
-	2: "ParticleSize",
-	3: "SurfaceArea",
+var jsonFieldsNameOfThermalPropertiesType = [4]string{
+	0: "MeltingPoint",
+	1: "BoilingPoint",
+	2: "ThermalConductivity",
+	3: "SpecificHeat",
 }
 
-// Decode decodes PhysicalPropertiesType from json.
-func (s *PhysicalPropertiesType) Decode(d *jx.Decoder) error {
+// Decode decodes ThermalPropertiesType from json.
+func (s *ThermalPropertiesType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PhysicalPropertiesType to nil")
+		return errors.New("invalid: unable to decode ThermalPropertiesType to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Density":
+		case "MeltingPoint":
 			if err := func() error {
-				s.Density.Reset()
-				if err := s.Density.Decode(d); err != nil {
+				s.MeltingPoint.Reset()
+				if err := s.MeltingPoint.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Density\"")
+				return errors.Wrap(err, "decode field \"MeltingPoint\"")
 			}
-		case "Viscosity":
+		case "BoilingPoint":
 			if err := func() error {
-				s.Viscosity.Reset()
-				if err := s.Viscosity.Decode(d); err != nil {
+				s.BoilingPoint.Reset()
+				if err := s.BoilingPoint.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Viscosity\"")
+				return errors.Wrap(err, "decode field \"BoilingPoint\"")
 			}
-		case "ParticleSize":
+		case "ThermalConductivity":
 			if err := func() error {
-				s.ParticleSize.Reset()
-				if err := s.ParticleSize.Decode(d); err != nil {
+				s.ThermalConductivity.Reset()
+				if err := s.ThermalConductivity.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ParticleSize\"")
+				return errors.Wrap(err, "decode field \"ThermalConductivity\"")
 			}
-		case "SurfaceArea":
+		case "SpecificHeat":
 			if err := func() error {
-				s.SurfaceArea.Reset()
-				if err := s.SurfaceArea.Decode(d); err != nil {
+				s.SpecificHeat.Reset()
+				if err := s.SpecificHeat.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SurfaceArea\"")
+				return errors.Wrap(err, "decode field \"SpecificHeat\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PhysicalPropertiesType")
+		return errors.Wrap(err, "decode ThermalPropertiesType")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PhysicalPropertiesType) MarshalJSON() ([]byte, error) {
+func (s *ThermalPropertiesType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PhysicalPropertiesType) UnmarshalJSON(data []byte) error {
+func (s *ThermalPropertiesType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RecordType) Encode(e *jx.Encoder) {
+func (s *TraceHistoryType) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RecordType) encodeFields(e *jx.Encoder) {
+func (s *TraceHistoryType) encodeFields(e *jx.Encoder) {
 	{
-		if s.RecordID.Set {
-			e.FieldStart("RecordID")
-			s.RecordID.Encode(e)
+		if s.Timestamp.Set {
+			e.FieldStart("Timestamp")
+			s.Timestamp.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.RecordType.Set {
-			e.FieldStart("RecordType")
-			s.RecordType.Encode(e)
+		if s.Location.Set {
+			e.FieldStart("Location")
+			s.Location.Encode(e)
 		}
 	}
 	{
-		if s.RecordData.Set {
-			e.FieldStart("RecordData")
-			s.RecordData.Encode(e)
+		if s.Action.Set {
+			e.FieldStart("Action")
+			s.Action.Encode(e)
+		}
+	}
+	{
+		if s.ResponsibleParty.Set {
+			e.FieldStart("ResponsibleParty")
+			s.ResponsibleParty.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRecordType = [3]string{
-	0: "RecordID",
-	1: "RecordType",
-	2: "RecordData",
+var jsonFieldsNameOfTraceHistoryType = [4]string{
+	0: "Timestamp",
+	1: "Location",
+	2: "Action",
+	3: "ResponsibleParty",
 }
 
-// Decode decodes RecordType from json.
-func (s *RecordType) Decode(d *jx.Decoder) error {
+// Decode decodes TraceHistoryType from json.
+func (s *TraceHistoryType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RecordType to nil")
+		return errors.New("invalid: unable to decode TraceHistoryType to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "RecordID":
+		case "Timestamp":
 			if err := func() error {
-				s.RecordID.Reset()
-				if err := s.RecordID.Decode(d); err != nil {
+				s.Timestamp.Reset()
+				if err := s.Timestamp.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RecordID\"")
+				return errors.Wrap(err, "decode field \"Timestamp\"")
 			}
-		case "RecordType":
+		case "Location":
 			if err := func() error {
-				s.RecordType.Reset()
-				if err := s.RecordType.Decode(d); err != nil {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RecordType\"")
+				return errors.Wrap(err, "decode field \"Location\"")
 			}
-		case "RecordData":
+		case "Action":
 			if err := func() error {
-				s.RecordData.Reset()
-				if err := s.RecordData.Decode(d); err != nil {
+				s.Action.Reset()
+				if err := s.Action.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RecordData\"")
+				return errors.Wrap(err, "decode field \"Action\"")
+			}
+		case "ResponsibleParty":
+			if err := func() error {
+				s.ResponsibleParty.Reset()
+				if err := s.ResponsibleParty.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ResponsibleParty\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RecordType")
+		return errors.Wrap(err, "decode TraceHistoryType")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RecordType) MarshalJSON() ([]byte, error) {
+func (s *TraceHistoryType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RecordType) UnmarshalJSON(data []byte) error {
+func (s *TraceHistoryType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s RecordTypeRecordData) Encode(e *jx.Encoder) {
+func (s *TraceabilityInformationType) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s RecordTypeRecordData) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
+// encodeFields encodes fields.
+func (s *TraceabilityInformationType) encodeFields(e *jx.Encoder) {
+	{
+		if s.BatchNumber.Set {
+			e.FieldStart("BatchNumber")
+			s.BatchNumber.Encode(e)
 		}
 	}
-}
-
-// Decode decodes RecordTypeRecordData from json.
-func (s *RecordTypeRecordData) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RecordTypeRecordData to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+	{
+		if s.LotNumber.Set {
+			e.FieldStart("LotNumber")
+			s.LotNumber.Encode(e)
 		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RecordTypeRecordData")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s RecordTypeRecordData) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RecordTypeRecordData) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *RegulatoryInformationType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *RegulatoryInformationType) encodeFields(e *jx.Encoder) {
 	{
-		if s.RegulatoryStatus.Set {
-			e.FieldStart("RegulatoryStatus")
-			s.RegulatoryStatus.Encode(e)
+		if s.ProductionDate.Set {
+			e.FieldStart("ProductionDate")
+			s.ProductionDate.Encode(e, json.EncodeDate)
 		}
 	}
 	{
-		if s.ComplianceStandards.Set {
-			e.FieldStart("ComplianceStandards")
-			s.ComplianceStandards.Encode(e)
+		if s.ExpirationDate.Set {
+			e.FieldStart("ExpirationDate")
+			s.ExpirationDate.Encode(e, json.EncodeDate)
 		}
 	}
 	{
-		if s.CertificationDetails.Set {
-			e.FieldStart("CertificationDetails")
-			s.CertificationDetails.Encode(e)
+		if s.TraceHistory != nil {
+			e.FieldStart("TraceHistory")
+			e.ArrStart()
+			for _, elem := range s.TraceHistory {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfRegulatoryInformationType = [3]string{
-	0: "RegulatoryStatus",
-	1: "ComplianceStandards",
-	2: "CertificationDetails",
+var jsonFieldsNameOfTraceabilityInformationType = [5]string{
+	0: "BatchNumber",
+	1: "LotNumber",
+	2: "ProductionDate",
+	3: "ExpirationDate",
+	4: "TraceHistory",
 }
 
-// Decode decodes RegulatoryInformationType from json.
-func (s *RegulatoryInformationType) Decode(d *jx.Decoder) error {
+// Decode decodes TraceabilityInformationType from json.
+func (s *TraceabilityInformationType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RegulatoryInformationType to nil")
+		return errors.New("invalid: unable to decode TraceabilityInformationType to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "RegulatoryStatus":
+		case "BatchNumber":
 			if err := func() error {
-				s.RegulatoryStatus.Reset()
-				if err := s.RegulatoryStatus.Decode(d); err != nil {
+				s.BatchNumber.Reset()
+				if err := s.BatchNumber.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RegulatoryStatus\"")
+				return errors.Wrap(err, "decode field \"BatchNumber\"")
 			}
-		case "ComplianceStandards":
+		case "LotNumber":
 			if err := func() error {
-				s.ComplianceStandards.Reset()
-				if err := s.ComplianceStandards.Decode(d); err != nil {
+				s.LotNumber.Reset()
+				if err := s.LotNumber.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ComplianceStandards\"")
+				return errors.Wrap(err, "decode field \"LotNumber\"")
 			}
-		case "CertificationDetails":
+		case "ProductionDate":
 			if err := func() error {
-				s.CertificationDetails.Reset()
-				if err := s.CertificationDetails.Decode(d); err != nil {
+				s.ProductionDate.Reset()
+				if err := s.ProductionDate.Decode(d, json.DecodeDate); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CertificationDetails\"")
+				return errors.Wrap(err, "decode field \"ProductionDate\"")
+			}
+		case "ExpirationDate":
+			if err := func() error {
+				s.ExpirationDate.Reset()
+				if err := s.ExpirationDate.Decode(d, json.DecodeDate); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ExpirationDate\"")
+			}
+		case "TraceHistory":
+			if err := func() error {
+				s.TraceHistory = make([]TraceHistoryType, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TraceHistoryType
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.TraceHistory = append(s.TraceHistory, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TraceHistory\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RegulatoryInformationType")
+		return errors.Wrap(err, "decode TraceabilityInformationType")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RegulatoryInformationType) MarshalJSON() ([]byte, error) {
+func (s *TraceabilityInformationType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RegulatoryInformationType) UnmarshalJSON(data []byte) error {
+func (s *TraceabilityInformationType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s ResourceLocationType) Encode(e *jx.Encoder) {
+func (s *UnitConversion) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s ResourceLocationType) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+// encodeFields encodes fields.
+func (s *UnitConversion) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("value")
+		e.Float64(s.Value)
+	}
+	{
+		e.FieldStart("unit")
+		e.Str(s.Unit)
+	}
+	{
+		e.FieldStart("source_value")
+		e.Float64(s.SourceValue)
+	}
+	{
+		e.FieldStart("source_unit")
+		e.Str(s.SourceUnit)
 	}
 }
 
-// Decode decodes ResourceLocationType from json.
-func (s *ResourceLocationType) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfUnitConversion = [4]string{
+	0: "value",
+	1: "unit",
+	2: "source_value",
+	3: "source_unit",
+}
+
+// Decode decodes UnitConversion from json.
+func (s *UnitConversion) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ResourceLocationType to nil")
+		return errors.New("invalid: unable to decode UnitConversion to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "value":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Float64()
+				s.Value = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		case "unit":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Unit = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unit\"")
+			}
+		case "source_value":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.SourceValue = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source_value\"")
+			}
+		case "source_unit":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.SourceUnit = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source_unit\"")
+			}
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ResourceLocationType")
+		return errors.Wrap(err, "decode UnitConversion")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUnitConversion) {
+					name = jsonFieldsNameOfUnitConversion[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ResourceLocationType) MarshalJSON() ([]byte, error) {
+func (s *UnitConversion) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ResourceLocationType) UnmarshalJSON(data []byte) error {
+func (s *UnitConversion) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s SpatialDefinitionType) Encode(e *jx.Encoder) {
+func (s *UnitOfMeasure) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s SpatialDefinitionType) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
+// encodeFields encodes fields.
+func (s *UnitOfMeasure) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("code")
+		e.Str(s.Code)
+	}
+	{
+		if s.UneceCode.Set {
+			e.FieldStart("unece_code")
+			s.UneceCode.Encode(e)
 		}
 	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("kind")
+		e.Str(s.Kind)
+	}
+	{
+		e.FieldStart("dimension")
+		e.Str(s.Dimension)
+	}
 }
 
-// Decode decodes SpatialDefinitionType from json.
-func (s *SpatialDefinitionType) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfUnitOfMeasure = [5]string{
+	0: "code",
+	1: "unece_code",
+	2: "name",
+	3: "kind",
+	4: "dimension",
+}
+
+// Decode decodes UnitOfMeasure from json.
+func (s *UnitOfMeasure) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SpatialDefinitionType to nil")
+		return errors.New("invalid: unable to decode UnitOfMeasure to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Code = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "unece_code":
+			if err := func() error {
+				s.UneceCode.Reset()
+				if err := s.UneceCode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unece_code\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "kind":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Kind = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kind\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		case "dimension":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Dimension = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dimension\"")
+			}
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SpatialDefinitionType")
+		return errors.Wrap(err, "decode UnitOfMeasure")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUnitOfMeasure) {
+					name = jsonFieldsNameOfUnitOfMeasure[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s SpatialDefinitionType) MarshalJSON() ([]byte, error) {
+func (s *UnitOfMeasure) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SpatialDefinitionType) UnmarshalJSON(data []byte) error {
+func (s *UnitOfMeasure) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TestSpecificationType) Encode(e *jx.Encoder) {
+func (s *ValidationProblem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TestSpecificationType) encodeFields(e *jx.Encoder) {
+func (s *ValidationProblem) encodeFields(e *jx.Encoder) {
 	{
-		if s.ID.Set {
-			e.FieldStart("ID")
-			s.ID.Encode(e)
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
 		}
 	}
 	{
-		if s.Description.Set {
-			e.FieldStart("Description")
-			s.Description.Encode(e)
+		e.FieldStart("title")
+		e.Str(s.Title)
+	}
+	{
+		e.FieldStart("status")
+		e.Int(s.Status)
+	}
+	{
+		if s.Detail.Set {
+			e.FieldStart("detail")
+			s.Detail.Encode(e)
 		}
 	}
-	for k, elem := range s.AdditionalProps {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
+	{
+		e.FieldStart("violations")
+		e.ArrStart()
+		for _, elem := range s.Violations {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTestSpecificationType = [2]string{
-	0: "ID",
-	1: "Description",
+var jsonFieldsNameOfValidationProblem = [5]string{
+	0: "type",
+	1: "title",
+	2: "status",
+	3: "detail",
+	4: "violations",
 }
 
-// Decode decodes TestSpecificationType from json.
-func (s *TestSpecificationType) Decode(d *jx.Decoder) error {
+// Decode decodes ValidationProblem from json.
+func (s *ValidationProblem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TestSpecificationType to nil")
+		return errors.New("invalid: unable to decode ValidationProblem to nil")
 	}
-	s.AdditionalProps = map[string]jx.Raw{}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ID":
+		case "type":
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ID\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "Description":
+		case "title":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Title = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
+				return errors.Wrap(err, "decode field \"title\"")
 			}
-		default:
-			var elem jx.Raw
+		case "status":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.RawAppend(nil)
-				elem = jx.Raw(v)
+				v, err := d.Int()
+				s.Status = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrapf(err, "decode field %q", k)
+				return errors.Wrap(err, "decode field \"status\"")
 			}
-			s.AdditionalProps[string(k)] = elem
+		case "detail":
+			if err := func() error {
+				s.Detail.Reset()
+				if err := s.Detail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"detail\"")
+			}
+		case "violations":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.Violations = make([]SchemaViolation, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem SchemaViolation
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Violations = append(s.Violations, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"violations\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TestSpecificationType")
+		return errors.Wrap(err, "decode ValidationProblem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00010110,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfValidationProblem) {
+					name = jsonFieldsNameOfValidationProblem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TestSpecificationType) MarshalJSON() ([]byte, error) {
+func (s *ValidationProblem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TestSpecificationType) UnmarshalJSON(data []byte) error {
+func (s *ValidationProblem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s TestSpecificationTypeAdditional) Encode(e *jx.Encoder) {
+func (s *ValueType) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s TestSpecificationTypeAdditional) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
+// encodeFields encodes fields.
+func (s *ValueType) encodeFields(e *jx.Encoder) {
+	{
+		if s.Value.Set {
+			e.FieldStart("value")
+			s.Value.Encode(e)
+		}
+	}
+	{
+		if s.UnitOfMeasure.Set {
+			e.FieldStart("unitOfMeasure")
+			s.UnitOfMeasure.Encode(e)
 		}
 	}
 }
 
-// Decode decodes TestSpecificationTypeAdditional from json.
-func (s *TestSpecificationTypeAdditional) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfValueType = [2]string{
+	0: "value",
+	1: "unitOfMeasure",
+}
+
+// Decode decodes ValueType from json.
+func (s *ValueType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TestSpecificationTypeAdditional to nil")
+		return errors.New("invalid: unable to decode ValueType to nil")
 	}
-	m := s.init()
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "value":
+			if err := func() error {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		case "unitOfMeasure":
+			if err := func() error {
+				s.UnitOfMeasure.Reset()
+				if err := s.UnitOfMeasure.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unitOfMeasure\"")
+			}
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TestSpecificationTypeAdditional")
+		return errors.Wrap(err, "decode ValueType")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s TestSpecificationTypeAdditional) MarshalJSON() ([]byte, error) {
+func (s *ValueType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TestSpecificationTypeAdditional) UnmarshalJSON(data []byte) error {
+func (s *ValueType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ThermalPropertiesType) Encode(e *jx.Encoder) {
+func (s *Webhook) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ThermalPropertiesType) encodeFields(e *jx.Encoder) {
+func (s *Webhook) encodeFields(e *jx.Encoder) {
 	{
-		if s.MeltingPoint.Set {
-			e.FieldStart("MeltingPoint")
-			s.MeltingPoint.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.BoilingPoint.Set {
-			e.FieldStart("BoilingPoint")
-			s.BoilingPoint.Encode(e)
+		e.FieldStart("url")
+		e.Str(s.URL)
+	}
+	{
+		if s.Secret.Set {
+			e.FieldStart("secret")
+			s.Secret.Encode(e)
 		}
 	}
 	{
-		if s.ThermalConductivity.Set {
-			e.FieldStart("ThermalConductivity")
-			s.ThermalConductivity.Encode(e)
+		if s.EventTypes != nil {
+			e.FieldStart("event_types")
+			e.ArrStart()
+			for _, elem := range s.EventTypes {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.SpecificHeat.Set {
-			e.FieldStart("SpecificHeat")
-			s.SpecificHeat.Encode(e)
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.Active.Set {
+			e.FieldStart("active")
+			s.Active.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfThermalPropertiesType = [4]string{
-	0: "MeltingPoint",
-	1: "BoilingPoint",
-	2: "ThermalConductivity",
-	3: "SpecificHeat",
-}
-
-// Decode decodes ThermalPropertiesType from json.
-func (s *ThermalPropertiesType) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfWebhook = [8]string{
+	0: "id",
+	1: "url",
+	2: "secret",
+	3: "event_types",
+	4: "description",
+	5: "active",
+	6: "created_at",
+	7: "updated_at",
+}
+
+// Decode decodes Webhook from json.
+func (s *Webhook) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ThermalPropertiesType to nil")
+		return errors.New("invalid: unable to decode Webhook to nil")
 	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "MeltingPoint":
+		case "id":
 			if err := func() error {
-				s.MeltingPoint.Reset()
-				if err := s.MeltingPoint.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MeltingPoint\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "BoilingPoint":
+		case "url":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.BoilingPoint.Reset()
-				if err := s.BoilingPoint.Decode(d); err != nil {
+				v, err := d.Str()
+				s.URL = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"BoilingPoint\"")
+				return errors.Wrap(err, "decode field \"url\"")
 			}
-		case "ThermalConductivity":
+		case "secret":
 			if err := func() error {
-				s.ThermalConductivity.Reset()
-				if err := s.ThermalConductivity.Decode(d); err != nil {
+				s.Secret.Reset()
+				if err := s.Secret.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ThermalConductivity\"")
+				return errors.Wrap(err, "decode field \"secret\"")
 			}
-		case "SpecificHeat":
+		case "event_types":
 			if err := func() error {
-				s.SpecificHeat.Reset()
-				if err := s.SpecificHeat.Decode(d); err != nil {
+				s.EventTypes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.EventTypes = append(s.EventTypes, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SpecificHeat\"")
+				return errors.Wrap(err, "decode field \"event_types\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "active":
+			if err := func() error {
+				s.Active.Reset()
+				if err := s.Active.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"active\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ThermalPropertiesType")
+		return errors.Wrap(err, "decode Webhook")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000010,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfWebhook) {
+					name = jsonFieldsNameOfWebhook[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ThermalPropertiesType) MarshalJSON() ([]byte, error) {
+func (s *Webhook) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ThermalPropertiesType) UnmarshalJSON(data []byte) error {
+func (s *Webhook) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TraceHistoryType) Encode(e *jx.Encoder) {
+func (s *WebhookDelivery) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TraceHistoryType) encodeFields(e *jx.Encoder) {
+func (s *WebhookDelivery) encodeFields(e *jx.Encoder) {
 	{
-		if s.Timestamp.Set {
-			e.FieldStart("Timestamp")
-			s.Timestamp.Encode(e, json.EncodeDateTime)
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("event_id")
+		json.EncodeUUID(e, s.EventID)
+	}
+	{
+		e.FieldStart("event_type")
+		e.Str(s.EventType)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		e.FieldStart("attempts")
+		e.Int32(s.Attempts)
+	}
+	{
+		if s.NextAttemptAt.Set {
+			e.FieldStart("next_attempt_at")
+			s.NextAttemptAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.Location.Set {
-			e.FieldStart("Location")
-			s.Location.Encode(e)
+		if s.LastError.Set {
+			e.FieldStart("last_error")
+			s.LastError.Encode(e)
 		}
 	}
 	{
-		if s.Action.Set {
-			e.FieldStart("Action")
-			s.Action.Encode(e)
+		if s.ResponseStatus.Set {
+			e.FieldStart("response_status")
+			s.ResponseStatus.Encode(e)
 		}
 	}
 	{
-		if s.ResponsibleParty.Set {
-			e.FieldStart("ResponsibleParty")
-			s.ResponsibleParty.Encode(e)
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.DeliveredAt.Set {
+			e.FieldStart("delivered_at")
+			s.DeliveredAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Payload.Set {
+			e.FieldStart("payload")
+			s.Payload.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfTraceHistoryType = [4]string{
-	0: "Timestamp",
-	1: "Location",
-	2: "Action",
-	3: "ResponsibleParty",
+var jsonFieldsNameOfWebhookDelivery = [11]string{
+	0:  "id",
+	1:  "event_id",
+	2:  "event_type",
+	3:  "status",
+	4:  "attempts",
+	5:  "next_attempt_at",
+	6:  "last_error",
+	7:  "response_status",
+	8:  "created_at",
+	9:  "delivered_at",
+	10: "payload",
 }
 
-// Decode decodes TraceHistoryType from json.
-func (s *TraceHistoryType) Decode(d *jx.Decoder) error {
+// Decode decodes WebhookDelivery from json.
+func (s *WebhookDelivery) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TraceHistoryType to nil")
+		return errors.New("invalid: unable to decode WebhookDelivery to nil")
 	}
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Timestamp":
+		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Timestamp.Reset()
-				if err := s.Timestamp.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Timestamp\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "Location":
+		case "event_id":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Location.Reset()
-				if err := s.Location.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.EventID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Location\"")
+				return errors.Wrap(err, "decode field \"event_id\"")
 			}
-		case "Action":
+		case "event_type":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Action.Reset()
-				if err := s.Action.Decode(d); err != nil {
+				v, err := d.Str()
+				s.EventType = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Action\"")
+				return errors.Wrap(err, "decode field \"event_type\"")
 			}
-		case "ResponsibleParty":
+		case "status":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.ResponsibleParty.Reset()
-				if err := s.ResponsibleParty.Decode(d); err != nil {
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ResponsibleParty\"")
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "attempts":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int32()
+				s.Attempts = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"attempts\"")
+			}
+		case "next_attempt_at":
+			if err := func() error {
+				s.NextAttemptAt.Reset()
+				if err := s.NextAttemptAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"next_attempt_at\"")
+			}
+		case "last_error":
+			if err := func() error {
+				s.LastError.Reset()
+				if err := s.LastError.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_error\"")
+			}
+		case "response_status":
+			if err := func() error {
+				s.ResponseStatus.Reset()
+				if err := s.ResponseStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"response_status\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "delivered_at":
+			if err := func() error {
+				s.DeliveredAt.Reset()
+				if err := s.DeliveredAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"delivered_at\"")
+			}
+		case "payload":
+			if err := func() error {
+				s.Payload.Reset()
+				if err := s.Payload.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"payload\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TraceHistoryType")
+		return errors.Wrap(err, "decode WebhookDelivery")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00011111,
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfWebhookDelivery) {
+					name = jsonFieldsNameOfWebhookDelivery[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TraceHistoryType) MarshalJSON() ([]byte, error) {
+func (s *WebhookDelivery) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *WebhookDelivery) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes WebhookDeliveryStatus as json.
+func (s WebhookDeliveryStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes WebhookDeliveryStatus from json.
+func (s *WebhookDeliveryStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode WebhookDeliveryStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch WebhookDeliveryStatus(v) {
+	case WebhookDeliveryStatusPending:
+		*s = WebhookDeliveryStatusPending
+	case WebhookDeliveryStatusDelivered:
+		*s = WebhookDeliveryStatusDelivered
+	case WebhookDeliveryStatusDead:
+		*s = WebhookDeliveryStatusDead
+	default:
+		*s = WebhookDeliveryStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s WebhookDeliveryStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TraceHistoryType) UnmarshalJSON(data []byte) error {
+func (s *WebhookDeliveryStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TraceabilityInformationType) Encode(e *jx.Encoder) {
+func (s *WebhookEvent) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TraceabilityInformationType) encodeFields(e *jx.Encoder) {
+func (s *WebhookEvent) encodeFields(e *jx.Encoder) {
 	{
-		if s.BatchNumber.Set {
-			e.FieldStart("BatchNumber")
-			s.BatchNumber.Encode(e)
-		}
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
 	{
-		if s.LotNumber.Set {
-			e.FieldStart("LotNumber")
-			s.LotNumber.Encode(e)
-		}
+		e.FieldStart("type")
+		e.Str(s.Type)
 	}
 	{
-		if s.ProductionDate.Set {
-			e.FieldStart("ProductionDate")
-			s.ProductionDate.Encode(e, json.EncodeDate)
+		if s.AggregateType.Set {
+			e.FieldStart("aggregate_type")
+			s.AggregateType.Encode(e)
 		}
 	}
 	{
-		if s.ExpirationDate.Set {
-			e.FieldStart("ExpirationDate")
-			s.ExpirationDate.Encode(e, json.EncodeDate)
+		if s.AggregateID.Set {
+			e.FieldStart("aggregate_id")
+			s.AggregateID.Encode(e)
 		}
 	}
 	{
-		if s.TraceHistory != nil {
-			e.FieldStart("TraceHistory")
-			e.ArrStart()
-			for _, elem := range s.TraceHistory {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("occurred_at")
+		json.EncodeDateTime(e, s.OccurredAt)
+	}
+	{
+		e.FieldStart("data")
+		s.Data.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfTraceabilityInformationType = [5]string{
-	0: "BatchNumber",
-	1: "LotNumber",
-	2: "ProductionDate",
-	3: "ExpirationDate",
-	4: "TraceHistory",
+var jsonFieldsNameOfWebhookEvent = [6]string{
+	0: "id",
+	1: "type",
+	2: "aggregate_type",
+	3: "aggregate_id",
+	4: "occurred_at",
+	5: "data",
 }
 
-// Decode decodes TraceabilityInformationType from json.
-func (s *TraceabilityInformationType) Decode(d *jx.Decoder) error {
+// Decode decodes WebhookEvent from json.
+func (s *WebhookEvent) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TraceabilityInformationType to nil")
+		return errors.New("invalid: unable to decode WebhookEvent to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "BatchNumber":
+		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.BatchNumber.Reset()
-				if err := s.BatchNumber.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"BatchNumber\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "LotNumber":
+		case "type":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.LotNumber.Reset()
-				if err := s.LotNumber.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"LotNumber\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "ProductionDate":
+		case "aggregate_type":
 			if err := func() error {
-				s.ProductionDate.Reset()
-				if err := s.ProductionDate.Decode(d, json.DecodeDate); err != nil {
+				s.AggregateType.Reset()
+				if err := s.AggregateType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ProductionDate\"")
+				return errors.Wrap(err, "decode field \"aggregate_type\"")
 			}
-		case "ExpirationDate":
+		case "aggregate_id":
 			if err := func() error {
-				s.ExpirationDate.Reset()
-				if err := s.ExpirationDate.Decode(d, json.DecodeDate); err != nil {
+				s.AggregateID.Reset()
+				if err := s.AggregateID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ExpirationDate\"")
+				return errors.Wrap(err, "decode field \"aggregate_id\"")
 			}
-		case "TraceHistory":
+		case "occurred_at":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.TraceHistory = make([]TraceHistoryType, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TraceHistoryType
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.TraceHistory = append(s.TraceHistory, elem)
-					return nil
-				}); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.OccurredAt = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TraceHistory\"")
+				return errors.Wrap(err, "decode field \"occurred_at\"")
+			}
+		case "data":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.Data.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TraceabilityInformationType")
+		return errors.Wrap(err, "decode WebhookEvent")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00110011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfWebhookEvent) {
+					name = jsonFieldsNameOfWebhookEvent[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TraceabilityInformationType) MarshalJSON() ([]byte, error) {
+func (s *WebhookEvent) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TraceabilityInformationType) UnmarshalJSON(data []byte) error {
+func (s *WebhookEvent) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ValueType) Encode(e *jx.Encoder) {
+func (s WebhookEventData) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s WebhookEventData) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes WebhookEventData from json.
+func (s *WebhookEventData) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode WebhookEventData to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode WebhookEventData")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s WebhookEventData) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *WebhookEventData) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *WebhookPingResult) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ValueType) encodeFields(e *jx.Encoder) {
+func (s *WebhookPingResult) encodeFields(e *jx.Encoder) {
 	{
-		if s.Value.Set {
-			e.FieldStart("value")
-			s.Value.Encode(e)
+		e.FieldStart("delivered")
+		e.Bool(s.Delivered)
+	}
+	{
+		if s.StatusCode.Set {
+			e.FieldStart("status_code")
+			s.StatusCode.Encode(e)
 		}
 	}
 	{
-		if s.UnitOfMeasure.Set {
-			e.FieldStart("unitOfMeasure")
-			s.UnitOfMeasure.Encode(e)
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
 		}
 	}
+	{
+		e.FieldStart("duration_ms")
+		e.Int64(s.DurationMs)
+	}
 }
 
-var jsonFieldsNameOfValueType = [2]string{
-	0: "value",
-	1: "unitOfMeasure",
+var jsonFieldsNameOfWebhookPingResult = [4]string{
+	0: "delivered",
+	1: "status_code",
+	2: "error",
+	3: "duration_ms",
 }
 
-// Decode decodes ValueType from json.
-func (s *ValueType) Decode(d *jx.Decoder) error {
+// Decode decodes WebhookPingResult from json.
+func (s *WebhookPingResult) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ValueType to nil")
+		return errors.New("invalid: unable to decode WebhookPingResult to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "value":
+		case "delivered":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Value.Reset()
-				if err := s.Value.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.Delivered = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"value\"")
+				return errors.Wrap(err, "decode field \"delivered\"")
 			}
-		case "unitOfMeasure":
+		case "status_code":
 			if err := func() error {
-				s.UnitOfMeasure.Reset()
-				if err := s.UnitOfMeasure.Decode(d); err != nil {
+				s.StatusCode.Reset()
+				if err := s.StatusCode.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"unitOfMeasure\"")
+				return errors.Wrap(err, "decode field \"status_code\"")
+			}
+		case "error":
+			if err := func() error {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		case "duration_ms":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.DurationMs = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_ms\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ValueType")
+		return errors.Wrap(err, "decode WebhookPingResult")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfWebhookPingResult) {
+					name = jsonFieldsNameOfWebhookPingResult[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ValueType) MarshalJSON() ([]byte, error) {
+func (s *WebhookPingResult) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ValueType) UnmarshalJSON(data []byte) error {
+func (s *WebhookPingResult) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes WebhooksIDDeliveriesGetOKApplicationJSON as json.
+func (s WebhooksIDDeliveriesGetOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []WebhookDelivery(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes WebhooksIDDeliveriesGetOKApplicationJSON from json.
+func (s *WebhooksIDDeliveriesGetOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode WebhooksIDDeliveriesGetOKApplicationJSON to nil")
+	}
+	var unwrapped []WebhookDelivery
+	if err := func() error {
+		unwrapped = make([]WebhookDelivery, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem WebhookDelivery
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = WebhooksIDDeliveriesGetOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s WebhooksIDDeliveriesGetOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *WebhooksIDDeliveriesGetOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
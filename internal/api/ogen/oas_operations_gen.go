@@ -6,16 +6,48 @@ package api
 type OperationName = string
 
 const (
-	BatchesGetOperation               OperationName = "BatchesGet"
-	BatchesPostOperation              OperationName = "BatchesPost"
-	EquipmentGetOperation             OperationName = "EquipmentGet"
-	EquipmentPostOperation            OperationName = "EquipmentPost"
-	MaterialsGetOperation             OperationName = "MaterialsGet"
-	MaterialsPostOperation            OperationName = "MaterialsPost"
-	PersonnelClassesGetOperation      OperationName = "PersonnelClassesGet"
-	PersonnelClassesPostOperation     OperationName = "PersonnelClassesPost"
-	PersonnelInformationGetOperation  OperationName = "PersonnelInformationGet"
-	PersonnelInformationPostOperation OperationName = "PersonnelInformationPost"
-	PersonsGetOperation               OperationName = "PersonsGet"
-	PersonsPostOperation              OperationName = "PersonsPost"
+	B2mmlMasterDataProfileGetOperation                OperationName = "B2mmlMasterDataProfileGet"
+	B2mmlPostOperation                                OperationName = "B2mmlPost"
+	B2mmlTransactionProfileGetOperation               OperationName = "B2mmlTransactionProfileGet"
+	BatchesGetOperation                               OperationName = "BatchesGet"
+	BatchesPostOperation                              OperationName = "BatchesPost"
+	EquipmentExportGetOperation                       OperationName = "EquipmentExportGet"
+	EquipmentGetOperation                             OperationName = "EquipmentGet"
+	EquipmentIDDeleteOperation                        OperationName = "EquipmentIDDelete"
+	EquipmentIDGetOperation                           OperationName = "EquipmentIDGet"
+	EquipmentIDHistoryDiffGetOperation                OperationName = "EquipmentIDHistoryDiffGet"
+	EquipmentIDHistoryGetOperation                    OperationName = "EquipmentIDHistoryGet"
+	EquipmentIDMovePostOperation                      OperationName = "EquipmentIDMovePost"
+	EquipmentIDPropertiesGetOperation                 OperationName = "EquipmentIDPropertiesGet"
+	EquipmentIDPurgePostOperation                     OperationName = "EquipmentIDPurgePost"
+	EquipmentIDPutOperation                           OperationName = "EquipmentIDPut"
+	EquipmentIDRestorePostOperation                   OperationName = "EquipmentIDRestorePost"
+	EquipmentIDTreeGetOperation                       OperationName = "EquipmentIDTreeGet"
+	EquipmentImportsImportIdGetOperation              OperationName = "EquipmentImportsImportIdGet"
+	EquipmentImportsImportIdResumePostOperation       OperationName = "EquipmentImportsImportIdResumePost"
+	EquipmentImportsPostOperation                     OperationName = "EquipmentImportsPost"
+	EquipmentPostOperation                            OperationName = "EquipmentPost"
+	EquipmentQueryPostOperation                       OperationName = "EquipmentQueryPost"
+	EventsStreamGetOperation                          OperationName = "EventsStreamGet"
+	MaterialsGetOperation                             OperationName = "MaterialsGet"
+	MaterialsPostOperation                            OperationName = "MaterialsPost"
+	PersonnelClassesGetOperation                      OperationName = "PersonnelClassesGet"
+	PersonnelClassesPostOperation                     OperationName = "PersonnelClassesPost"
+	PersonnelInformationGetOperation                  OperationName = "PersonnelInformationGet"
+	PersonnelInformationPostOperation                 OperationName = "PersonnelInformationPost"
+	PersonsGetOperation                               OperationName = "PersonsGet"
+	PersonsPostOperation                              OperationName = "PersonsPost"
+	SearchGetOperation                                OperationName = "SearchGet"
+	SitesIDUnitsGetOperation                          OperationName = "SitesIDUnitsGet"
+	SitesIDUnitsPutOperation                          OperationName = "SitesIDUnitsPut"
+	UnitsConvertGetOperation                          OperationName = "UnitsConvertGet"
+	UnitsGetOperation                                 OperationName = "UnitsGet"
+	WebhooksGetOperation                              OperationName = "WebhooksGet"
+	WebhooksIDDeleteOperation                         OperationName = "WebhooksIDDelete"
+	WebhooksIDDeliveriesDeliveryIdReplayPostOperation OperationName = "WebhooksIDDeliveriesDeliveryIdReplayPost"
+	WebhooksIDDeliveriesGetOperation                  OperationName = "WebhooksIDDeliveriesGet"
+	WebhooksIDGetOperation                            OperationName = "WebhooksIDGet"
+	WebhooksIDPingPostOperation                       OperationName = "WebhooksIDPingPost"
+	WebhooksIDPutOperation                            OperationName = "WebhooksIDPut"
+	WebhooksPostOperation                             OperationName = "WebhooksPost"
 )
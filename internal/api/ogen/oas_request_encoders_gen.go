@@ -6,10 +6,21 @@ import (
 	"bytes"
 	"net/http"
 
+	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
 	ht "github.com/ogen-go/ogen/http"
 )
 
+func encodeB2mmlPostRequest(
+	req B2mmlPostReq,
+	r *http.Request,
+) error {
+	const contentType = "application/xml"
+	body := req
+	ht.SetBody(r, body, contentType)
+	return nil
+}
+
 func encodeBatchesPostRequest(
 	req *BatchType,
 	r *http.Request,
@@ -24,8 +35,90 @@ func encodeBatchesPostRequest(
 	return nil
 }
 
+func encodeEquipmentIDMovePostRequest(
+	req *EquipmentMove,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeEquipmentIDPutRequest(
+	req EquipmentIDPutReq,
+	r *http.Request,
+) error {
+	switch req := req.(type) {
+	case *EquipmentType:
+		const contentType = "application/json"
+		e := new(jx.Encoder)
+		{
+			req.Encode(e)
+		}
+		encoded := e.Bytes()
+		ht.SetBody(r, bytes.NewReader(encoded), contentType)
+		return nil
+	case *EquipmentIDPutReqApplicationXML:
+		const contentType = "application/xml"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	default:
+		return errors.Errorf("unexpected request type: %T", req)
+	}
+}
+
+func encodeEquipmentImportsPostRequest(
+	req EquipmentImportsPostReq,
+	r *http.Request,
+) error {
+	switch req := req.(type) {
+	case *EquipmentImportsPostReqApplicationXNdjson:
+		const contentType = "application/x-ndjson"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	case *EquipmentImportsPostReqTextCsv:
+		const contentType = "text/csv"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	default:
+		return errors.Errorf("unexpected request type: %T", req)
+	}
+}
+
 func encodeEquipmentPostRequest(
-	req *EquipmentType,
+	req EquipmentPostReq,
+	r *http.Request,
+) error {
+	switch req := req.(type) {
+	case *EquipmentType:
+		const contentType = "application/json"
+		e := new(jx.Encoder)
+		{
+			req.Encode(e)
+		}
+		encoded := e.Bytes()
+		ht.SetBody(r, bytes.NewReader(encoded), contentType)
+		return nil
+	case *EquipmentPostReqApplicationXML:
+		const contentType = "application/xml"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	default:
+		return errors.Errorf("unexpected request type: %T", req)
+	}
+}
+
+func encodeEquipmentQueryPostRequest(
+	req *EquipmentQuery,
 	r *http.Request,
 ) error {
 	const contentType = "application/json"
@@ -81,7 +174,59 @@ func encodePersonnelInformationPostRequest(
 }
 
 func encodePersonsPostRequest(
-	req *PersonType,
+	req PersonsPostReq,
+	r *http.Request,
+) error {
+	switch req := req.(type) {
+	case *PersonType:
+		const contentType = "application/json"
+		e := new(jx.Encoder)
+		{
+			req.Encode(e)
+		}
+		encoded := e.Bytes()
+		ht.SetBody(r, bytes.NewReader(encoded), contentType)
+		return nil
+	case *PersonsPostReqApplicationXML:
+		const contentType = "application/xml"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	default:
+		return errors.Errorf("unexpected request type: %T", req)
+	}
+}
+
+func encodeSitesIDUnitsPutRequest(
+	req *SiteUnits,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeWebhooksIDPutRequest(
+	req *Webhook,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeWebhooksPostRequest(
+	req *Webhook,
 	r *http.Request,
 ) error {
 	const contentType = "application/json"
@@ -3,10 +3,12 @@
 package api
 
 import (
+	"io"
 	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
+	"github.com/google/uuid"
 )
 
 // Ref: #/components/schemas/AdditiveType
@@ -61,6 +63,140 @@ func (s *AdditivesType) SetAdditive(val []AdditiveType) {
 	s.Additive = val
 }
 
+// B2mmlMasterDataProfileGetNotFound is response for B2mmlMasterDataProfileGet operation.
+type B2mmlMasterDataProfileGetNotFound struct{}
+
+func (*B2mmlMasterDataProfileGetNotFound) b2mmlMasterDataProfileGetRes() {}
+
+type B2mmlMasterDataProfileGetOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s B2mmlMasterDataProfileGetOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*B2mmlMasterDataProfileGetOK) b2mmlMasterDataProfileGetRes() {}
+
+type B2mmlPostBadRequestApplicationXML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s B2mmlPostBadRequestApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*B2mmlPostBadRequestApplicationXML) b2mmlPostRes() {}
+
+type B2mmlPostConflict struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s B2mmlPostConflict) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*B2mmlPostConflict) b2mmlPostRes() {}
+
+// B2mmlPostNoContent is response for B2mmlPost operation.
+type B2mmlPostNoContent struct{}
+
+func (*B2mmlPostNoContent) b2mmlPostRes() {}
+
+type B2mmlPostNotFound struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s B2mmlPostNotFound) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*B2mmlPostNotFound) b2mmlPostRes() {}
+
+type B2mmlPostOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s B2mmlPostOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*B2mmlPostOK) b2mmlPostRes() {}
+
+type B2mmlPostReq struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s B2mmlPostReq) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type B2mmlPostUnprocessableEntity struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s B2mmlPostUnprocessableEntity) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*B2mmlPostUnprocessableEntity) b2mmlPostRes() {}
+
+type B2mmlTransactionProfileGetOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s B2mmlTransactionProfileGetOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
 // Ref: #/components/schemas/BatchRecordsType
 type BatchRecordsType struct {
 	Record []RecordType `json:"Record"`
@@ -400,2969 +536,7036 @@ func (s *CoordinatesType) SetZ(val OptFloat64) {
 	s.Z = val
 }
 
-// Ref: #/components/schemas/ElectricalPropertiesType
-type ElectricalPropertiesType struct {
-	Conductivity       OptFloat64 `json:"Conductivity"`
-	DielectricConstant OptFloat64 `json:"DielectricConstant"`
-	Resistance         OptFloat64 `json:"Resistance"`
-}
+// Правило для зависимых объектов при удалении
+// оборудования.
+// Ref: #/components/schemas/DeletePolicy
+type DeletePolicy string
 
-// GetConductivity returns the value of Conductivity.
-func (s *ElectricalPropertiesType) GetConductivity() OptFloat64 {
-	return s.Conductivity
-}
+const (
+	DeletePolicyBlock   DeletePolicy = "block"
+	DeletePolicyOrphan  DeletePolicy = "orphan"
+	DeletePolicyCascade DeletePolicy = "cascade"
+)
 
-// GetDielectricConstant returns the value of DielectricConstant.
-func (s *ElectricalPropertiesType) GetDielectricConstant() OptFloat64 {
-	return s.DielectricConstant
+// AllValues returns all DeletePolicy values.
+func (DeletePolicy) AllValues() []DeletePolicy {
+	return []DeletePolicy{
+		DeletePolicyBlock,
+		DeletePolicyOrphan,
+		DeletePolicyCascade,
+	}
 }
 
-// GetResistance returns the value of Resistance.
-func (s *ElectricalPropertiesType) GetResistance() OptFloat64 {
-	return s.Resistance
+// MarshalText implements encoding.TextMarshaler.
+func (s DeletePolicy) MarshalText() ([]byte, error) {
+	switch s {
+	case DeletePolicyBlock:
+		return []byte(s), nil
+	case DeletePolicyOrphan:
+		return []byte(s), nil
+	case DeletePolicyCascade:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetConductivity sets the value of Conductivity.
-func (s *ElectricalPropertiesType) SetConductivity(val OptFloat64) {
-	s.Conductivity = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DeletePolicy) UnmarshalText(data []byte) error {
+	switch DeletePolicy(data) {
+	case DeletePolicyBlock:
+		*s = DeletePolicyBlock
+		return nil
+	case DeletePolicyOrphan:
+		*s = DeletePolicyOrphan
+		return nil
+	case DeletePolicyCascade:
+		*s = DeletePolicyCascade
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetDielectricConstant sets the value of DielectricConstant.
-func (s *ElectricalPropertiesType) SetDielectricConstant(val OptFloat64) {
-	s.DielectricConstant = val
+// Ref: #/components/schemas/EffectiveProperty
+type EffectiveProperty struct {
+	ID          string                  `json:"id"`
+	Status      EffectivePropertyStatus `json:"status"`
+	Value       OptString               `json:"value"`
+	DataType    OptString               `json:"data_type"`
+	Unit        OptString               `json:"unit"`
+	Description OptString               `json:"description"`
+	// B2MML ID класса, в котором объявлено действующее
+	// определение свойства.
+	Class OptString `json:"class"`
+	// Тип свойства из определения класса (ClassPropertyType).
+	PropertyType OptString `json:"property_type"`
 }
 
-// SetResistance sets the value of Resistance.
-func (s *ElectricalPropertiesType) SetResistance(val OptFloat64) {
-	s.Resistance = val
+// GetID returns the value of ID.
+func (s *EffectiveProperty) GetID() string {
+	return s.ID
 }
 
-// EquipmentPostCreated is response for EquipmentPost operation.
-type EquipmentPostCreated struct{}
+// GetStatus returns the value of Status.
+func (s *EffectiveProperty) GetStatus() EffectivePropertyStatus {
+	return s.Status
+}
 
-// Ref: #/components/schemas/EquipmentType
-type EquipmentType struct {
-	EquipmentID        OptString                 `json:"EquipmentID"`
-	EquipmentType      OptString                 `json:"EquipmentType"`
-	Description        OptString                 `json:"Description"`
-	Manufacturer       OptString                 `json:"Manufacturer"`
-	Model              OptString                 `json:"Model"`
-	SerialNumber       OptString                 `json:"SerialNumber"`
-	InstallationDate   OptDateTime               `json:"InstallationDate"`
-	Location           OptLocationType           `json:"Location"`
-	MaintenanceHistory OptMaintenanceHistoryType `json:"MaintenanceHistory"`
-	PerformanceData    OptPerformanceDataType    `json:"PerformanceData"`
+// GetValue returns the value of Value.
+func (s *EffectiveProperty) GetValue() OptString {
+	return s.Value
 }
 
-// GetEquipmentID returns the value of EquipmentID.
-func (s *EquipmentType) GetEquipmentID() OptString {
-	return s.EquipmentID
+// GetDataType returns the value of DataType.
+func (s *EffectiveProperty) GetDataType() OptString {
+	return s.DataType
 }
 
-// GetEquipmentType returns the value of EquipmentType.
-func (s *EquipmentType) GetEquipmentType() OptString {
-	return s.EquipmentType
+// GetUnit returns the value of Unit.
+func (s *EffectiveProperty) GetUnit() OptString {
+	return s.Unit
 }
 
 // GetDescription returns the value of Description.
-func (s *EquipmentType) GetDescription() OptString {
+func (s *EffectiveProperty) GetDescription() OptString {
 	return s.Description
 }
 
-// GetManufacturer returns the value of Manufacturer.
-func (s *EquipmentType) GetManufacturer() OptString {
-	return s.Manufacturer
+// GetClass returns the value of Class.
+func (s *EffectiveProperty) GetClass() OptString {
+	return s.Class
 }
 
-// GetModel returns the value of Model.
-func (s *EquipmentType) GetModel() OptString {
-	return s.Model
+// GetPropertyType returns the value of PropertyType.
+func (s *EffectiveProperty) GetPropertyType() OptString {
+	return s.PropertyType
 }
 
-// GetSerialNumber returns the value of SerialNumber.
-func (s *EquipmentType) GetSerialNumber() OptString {
-	return s.SerialNumber
+// SetID sets the value of ID.
+func (s *EffectiveProperty) SetID(val string) {
+	s.ID = val
 }
 
-// GetInstallationDate returns the value of InstallationDate.
-func (s *EquipmentType) GetInstallationDate() OptDateTime {
-	return s.InstallationDate
+// SetStatus sets the value of Status.
+func (s *EffectiveProperty) SetStatus(val EffectivePropertyStatus) {
+	s.Status = val
 }
 
-// GetLocation returns the value of Location.
-func (s *EquipmentType) GetLocation() OptLocationType {
-	return s.Location
+// SetValue sets the value of Value.
+func (s *EffectiveProperty) SetValue(val OptString) {
+	s.Value = val
 }
 
-// GetMaintenanceHistory returns the value of MaintenanceHistory.
-func (s *EquipmentType) GetMaintenanceHistory() OptMaintenanceHistoryType {
-	return s.MaintenanceHistory
+// SetDataType sets the value of DataType.
+func (s *EffectiveProperty) SetDataType(val OptString) {
+	s.DataType = val
 }
 
-// GetPerformanceData returns the value of PerformanceData.
-func (s *EquipmentType) GetPerformanceData() OptPerformanceDataType {
-	return s.PerformanceData
+// SetUnit sets the value of Unit.
+func (s *EffectiveProperty) SetUnit(val OptString) {
+	s.Unit = val
 }
 
-// SetEquipmentID sets the value of EquipmentID.
-func (s *EquipmentType) SetEquipmentID(val OptString) {
-	s.EquipmentID = val
+// SetDescription sets the value of Description.
+func (s *EffectiveProperty) SetDescription(val OptString) {
+	s.Description = val
 }
 
-// SetEquipmentType sets the value of EquipmentType.
-func (s *EquipmentType) SetEquipmentType(val OptString) {
-	s.EquipmentType = val
+// SetClass sets the value of Class.
+func (s *EffectiveProperty) SetClass(val OptString) {
+	s.Class = val
 }
 
-// SetDescription sets the value of Description.
-func (s *EquipmentType) SetDescription(val OptString) {
-	s.Description = val
+// SetPropertyType sets the value of PropertyType.
+func (s *EffectiveProperty) SetPropertyType(val OptString) {
+	s.PropertyType = val
 }
 
-// SetManufacturer sets the value of Manufacturer.
-func (s *EquipmentType) SetManufacturer(val OptString) {
-	s.Manufacturer = val
-}
+type EffectivePropertyStatus string
 
-// SetModel sets the value of Model.
-func (s *EquipmentType) SetModel(val OptString) {
-	s.Model = val
+const (
+	EffectivePropertyStatusMissing    EffectivePropertyStatus = "missing"
+	EffectivePropertyStatusInherited  EffectivePropertyStatus = "inherited"
+	EffectivePropertyStatusOverridden EffectivePropertyStatus = "overridden"
+	EffectivePropertyStatusLocal      EffectivePropertyStatus = "local"
+)
+
+// AllValues returns all EffectivePropertyStatus values.
+func (EffectivePropertyStatus) AllValues() []EffectivePropertyStatus {
+	return []EffectivePropertyStatus{
+		EffectivePropertyStatusMissing,
+		EffectivePropertyStatusInherited,
+		EffectivePropertyStatusOverridden,
+		EffectivePropertyStatusLocal,
+	}
 }
 
-// SetSerialNumber sets the value of SerialNumber.
-func (s *EquipmentType) SetSerialNumber(val OptString) {
-	s.SerialNumber = val
+// MarshalText implements encoding.TextMarshaler.
+func (s EffectivePropertyStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case EffectivePropertyStatusMissing:
+		return []byte(s), nil
+	case EffectivePropertyStatusInherited:
+		return []byte(s), nil
+	case EffectivePropertyStatusOverridden:
+		return []byte(s), nil
+	case EffectivePropertyStatusLocal:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetInstallationDate sets the value of InstallationDate.
-func (s *EquipmentType) SetInstallationDate(val OptDateTime) {
-	s.InstallationDate = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EffectivePropertyStatus) UnmarshalText(data []byte) error {
+	switch EffectivePropertyStatus(data) {
+	case EffectivePropertyStatusMissing:
+		*s = EffectivePropertyStatusMissing
+		return nil
+	case EffectivePropertyStatusInherited:
+		*s = EffectivePropertyStatusInherited
+		return nil
+	case EffectivePropertyStatusOverridden:
+		*s = EffectivePropertyStatusOverridden
+		return nil
+	case EffectivePropertyStatusLocal:
+		*s = EffectivePropertyStatusLocal
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetLocation sets the value of Location.
-func (s *EquipmentType) SetLocation(val OptLocationType) {
-	s.Location = val
+// Ref: #/components/schemas/ElectricalPropertiesType
+type ElectricalPropertiesType struct {
+	Conductivity       OptFloat64 `json:"Conductivity"`
+	DielectricConstant OptFloat64 `json:"DielectricConstant"`
+	Resistance         OptFloat64 `json:"Resistance"`
 }
 
-// SetMaintenanceHistory sets the value of MaintenanceHistory.
-func (s *EquipmentType) SetMaintenanceHistory(val OptMaintenanceHistoryType) {
-	s.MaintenanceHistory = val
+// GetConductivity returns the value of Conductivity.
+func (s *ElectricalPropertiesType) GetConductivity() OptFloat64 {
+	return s.Conductivity
 }
 
-// SetPerformanceData sets the value of PerformanceData.
-func (s *EquipmentType) SetPerformanceData(val OptPerformanceDataType) {
-	s.PerformanceData = val
+// GetDielectricConstant returns the value of DielectricConstant.
+func (s *ElectricalPropertiesType) GetDielectricConstant() OptFloat64 {
+	return s.DielectricConstant
 }
 
-// Ref: #/components/schemas/HierarchyScopeType
-type HierarchyScopeType map[string]jx.Raw
+// GetResistance returns the value of Resistance.
+func (s *ElectricalPropertiesType) GetResistance() OptFloat64 {
+	return s.Resistance
+}
 
-func (s *HierarchyScopeType) init() HierarchyScopeType {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetConductivity sets the value of Conductivity.
+func (s *ElectricalPropertiesType) SetConductivity(val OptFloat64) {
+	s.Conductivity = val
 }
 
-// Ref: #/components/schemas/LocationType
-type LocationType struct {
-	SiteID      OptString          `json:"SiteID"`
-	BuildingID  OptString          `json:"BuildingID"`
-	FloorID     OptString          `json:"FloorID"`
-	AreaID      OptString          `json:"AreaID"`
-	Coordinates OptCoordinatesType `json:"Coordinates"`
+// SetDielectricConstant sets the value of DielectricConstant.
+func (s *ElectricalPropertiesType) SetDielectricConstant(val OptFloat64) {
+	s.DielectricConstant = val
 }
 
-// GetSiteID returns the value of SiteID.
-func (s *LocationType) GetSiteID() OptString {
-	return s.SiteID
+// SetResistance sets the value of Resistance.
+func (s *ElectricalPropertiesType) SetResistance(val OptFloat64) {
+	s.Resistance = val
 }
 
-// GetBuildingID returns the value of BuildingID.
-func (s *LocationType) GetBuildingID() OptString {
-	return s.BuildingID
+// Ref: #/components/schemas/EquipmentDiff
+type EquipmentDiff struct {
+	From    OptEquipmentRevision `json:"from"`
+	To      EquipmentRevision    `json:"to"`
+	Changes []FieldChange        `json:"changes"`
 }
 
-// GetFloorID returns the value of FloorID.
-func (s *LocationType) GetFloorID() OptString {
-	return s.FloorID
+// GetFrom returns the value of From.
+func (s *EquipmentDiff) GetFrom() OptEquipmentRevision {
+	return s.From
 }
 
-// GetAreaID returns the value of AreaID.
-func (s *LocationType) GetAreaID() OptString {
-	return s.AreaID
+// GetTo returns the value of To.
+func (s *EquipmentDiff) GetTo() EquipmentRevision {
+	return s.To
 }
 
-// GetCoordinates returns the value of Coordinates.
-func (s *LocationType) GetCoordinates() OptCoordinatesType {
-	return s.Coordinates
+// GetChanges returns the value of Changes.
+func (s *EquipmentDiff) GetChanges() []FieldChange {
+	return s.Changes
 }
 
-// SetSiteID sets the value of SiteID.
-func (s *LocationType) SetSiteID(val OptString) {
-	s.SiteID = val
+// SetFrom sets the value of From.
+func (s *EquipmentDiff) SetFrom(val OptEquipmentRevision) {
+	s.From = val
 }
 
-// SetBuildingID sets the value of BuildingID.
-func (s *LocationType) SetBuildingID(val OptString) {
-	s.BuildingID = val
+// SetTo sets the value of To.
+func (s *EquipmentDiff) SetTo(val EquipmentRevision) {
+	s.To = val
 }
 
-// SetFloorID sets the value of FloorID.
-func (s *LocationType) SetFloorID(val OptString) {
-	s.FloorID = val
+// SetChanges sets the value of Changes.
+func (s *EquipmentDiff) SetChanges(val []FieldChange) {
+	s.Changes = val
 }
 
-// SetAreaID sets the value of AreaID.
-func (s *LocationType) SetAreaID(val OptString) {
-	s.AreaID = val
-}
+func (*EquipmentDiff) equipmentIDHistoryDiffGetRes() {}
 
-// SetCoordinates sets the value of Coordinates.
-func (s *LocationType) SetCoordinates(val OptCoordinatesType) {
-	s.Coordinates = val
-}
+// EquipmentExportGetBadRequest is response for EquipmentExportGet operation.
+type EquipmentExportGetBadRequest struct{}
 
-// Ref: #/components/schemas/MaintenanceEventType
-type MaintenanceEventType struct {
-	EventID       OptString   `json:"EventID"`
-	EventDate     OptDateTime `json:"EventDate"`
-	Description   OptString   `json:"Description"`
-	PerformedBy   OptString   `json:"PerformedBy"`
-	PartsReplaced OptString   `json:"PartsReplaced"`
-	DowntimeHours OptFloat64  `json:"DowntimeHours"`
-}
+func (*EquipmentExportGetBadRequest) equipmentExportGetRes() {}
 
-// GetEventID returns the value of EventID.
-func (s *MaintenanceEventType) GetEventID() OptString {
-	return s.EventID
-}
+type EquipmentExportGetFormat string
 
-// GetEventDate returns the value of EventDate.
-func (s *MaintenanceEventType) GetEventDate() OptDateTime {
-	return s.EventDate
+const (
+	EquipmentExportGetFormatCsv   EquipmentExportGetFormat = "csv"
+	EquipmentExportGetFormatJsonl EquipmentExportGetFormat = "jsonl"
+	EquipmentExportGetFormatB2mml EquipmentExportGetFormat = "b2mml"
+)
+
+// AllValues returns all EquipmentExportGetFormat values.
+func (EquipmentExportGetFormat) AllValues() []EquipmentExportGetFormat {
+	return []EquipmentExportGetFormat{
+		EquipmentExportGetFormatCsv,
+		EquipmentExportGetFormatJsonl,
+		EquipmentExportGetFormatB2mml,
+	}
 }
 
-// GetDescription returns the value of Description.
-func (s *MaintenanceEventType) GetDescription() OptString {
-	return s.Description
+// MarshalText implements encoding.TextMarshaler.
+func (s EquipmentExportGetFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case EquipmentExportGetFormatCsv:
+		return []byte(s), nil
+	case EquipmentExportGetFormatJsonl:
+		return []byte(s), nil
+	case EquipmentExportGetFormatB2mml:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetPerformedBy returns the value of PerformedBy.
-func (s *MaintenanceEventType) GetPerformedBy() OptString {
-	return s.PerformedBy
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EquipmentExportGetFormat) UnmarshalText(data []byte) error {
+	switch EquipmentExportGetFormat(data) {
+	case EquipmentExportGetFormatCsv:
+		*s = EquipmentExportGetFormatCsv
+		return nil
+	case EquipmentExportGetFormatJsonl:
+		*s = EquipmentExportGetFormatJsonl
+		return nil
+	case EquipmentExportGetFormatB2mml:
+		*s = EquipmentExportGetFormatB2mml
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetPartsReplaced returns the value of PartsReplaced.
-func (s *MaintenanceEventType) GetPartsReplaced() OptString {
-	return s.PartsReplaced
+// EquipmentExportGetNotFound is response for EquipmentExportGet operation.
+type EquipmentExportGetNotFound struct{}
+
+func (*EquipmentExportGetNotFound) equipmentExportGetRes() {}
+
+type EquipmentExportGetOKApplicationXML struct {
+	Data io.Reader
 }
 
-// GetDowntimeHours returns the value of DowntimeHours.
-func (s *MaintenanceEventType) GetDowntimeHours() OptFloat64 {
-	return s.DowntimeHours
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentExportGetOKApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetEventID sets the value of EventID.
-func (s *MaintenanceEventType) SetEventID(val OptString) {
-	s.EventID = val
+func (*EquipmentExportGetOKApplicationXML) equipmentExportGetRes() {}
+
+type EquipmentExportGetOKApplicationXNdjson struct {
+	Data io.Reader
 }
 
-// SetEventDate sets the value of EventDate.
-func (s *MaintenanceEventType) SetEventDate(val OptDateTime) {
-	s.EventDate = val
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentExportGetOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetDescription sets the value of Description.
-func (s *MaintenanceEventType) SetDescription(val OptString) {
-	s.Description = val
+func (*EquipmentExportGetOKApplicationXNdjson) equipmentExportGetRes() {}
+
+type EquipmentExportGetOKTextCsv struct {
+	Data io.Reader
 }
 
-// SetPerformedBy sets the value of PerformedBy.
-func (s *MaintenanceEventType) SetPerformedBy(val OptString) {
-	s.PerformedBy = val
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentExportGetOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetPartsReplaced sets the value of PartsReplaced.
-func (s *MaintenanceEventType) SetPartsReplaced(val OptString) {
-	s.PartsReplaced = val
+func (*EquipmentExportGetOKTextCsv) equipmentExportGetRes() {}
+
+type EquipmentExportGetStatusItem string
+
+const (
+	EquipmentExportGetStatusItemActive      EquipmentExportGetStatusItem = "active"
+	EquipmentExportGetStatusItemInactive    EquipmentExportGetStatusItem = "inactive"
+	EquipmentExportGetStatusItemMaintenance EquipmentExportGetStatusItem = "maintenance"
+)
+
+// AllValues returns all EquipmentExportGetStatusItem values.
+func (EquipmentExportGetStatusItem) AllValues() []EquipmentExportGetStatusItem {
+	return []EquipmentExportGetStatusItem{
+		EquipmentExportGetStatusItemActive,
+		EquipmentExportGetStatusItemInactive,
+		EquipmentExportGetStatusItemMaintenance,
+	}
 }
 
-// SetDowntimeHours sets the value of DowntimeHours.
-func (s *MaintenanceEventType) SetDowntimeHours(val OptFloat64) {
-	s.DowntimeHours = val
+// MarshalText implements encoding.TextMarshaler.
+func (s EquipmentExportGetStatusItem) MarshalText() ([]byte, error) {
+	switch s {
+	case EquipmentExportGetStatusItemActive:
+		return []byte(s), nil
+	case EquipmentExportGetStatusItemInactive:
+		return []byte(s), nil
+	case EquipmentExportGetStatusItemMaintenance:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// Ref: #/components/schemas/MaintenanceHistoryType
-type MaintenanceHistoryType struct {
-	MaintenanceEvent []MaintenanceEventType `json:"MaintenanceEvent"`
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EquipmentExportGetStatusItem) UnmarshalText(data []byte) error {
+	switch EquipmentExportGetStatusItem(data) {
+	case EquipmentExportGetStatusItemActive:
+		*s = EquipmentExportGetStatusItemActive
+		return nil
+	case EquipmentExportGetStatusItemInactive:
+		*s = EquipmentExportGetStatusItemInactive
+		return nil
+	case EquipmentExportGetStatusItemMaintenance:
+		*s = EquipmentExportGetStatusItemMaintenance
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetMaintenanceEvent returns the value of MaintenanceEvent.
-func (s *MaintenanceHistoryType) GetMaintenanceEvent() []MaintenanceEventType {
-	return s.MaintenanceEvent
+// EquipmentGetBadRequest is response for EquipmentGet operation.
+type EquipmentGetBadRequest struct{}
+
+func (*EquipmentGetBadRequest) equipmentGetRes() {}
+
+// EquipmentGetNotFound is response for EquipmentGet operation.
+type EquipmentGetNotFound struct{}
+
+func (*EquipmentGetNotFound) equipmentGetRes() {}
+
+type EquipmentGetStatus string
+
+const (
+	EquipmentGetStatusActive      EquipmentGetStatus = "active"
+	EquipmentGetStatusInactive    EquipmentGetStatus = "inactive"
+	EquipmentGetStatusMaintenance EquipmentGetStatus = "maintenance"
+)
+
+// AllValues returns all EquipmentGetStatus values.
+func (EquipmentGetStatus) AllValues() []EquipmentGetStatus {
+	return []EquipmentGetStatus{
+		EquipmentGetStatusActive,
+		EquipmentGetStatusInactive,
+		EquipmentGetStatusMaintenance,
+	}
 }
 
-// SetMaintenanceEvent sets the value of MaintenanceEvent.
-func (s *MaintenanceHistoryType) SetMaintenanceEvent(val []MaintenanceEventType) {
-	s.MaintenanceEvent = val
+// MarshalText implements encoding.TextMarshaler.
+func (s EquipmentGetStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case EquipmentGetStatusActive:
+		return []byte(s), nil
+	case EquipmentGetStatusInactive:
+		return []byte(s), nil
+	case EquipmentGetStatusMaintenance:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// Ref: #/components/schemas/MaterialCategoryType
-type MaterialCategoryType struct {
-	CategoryCode        OptString `json:"CategoryCode"`
-	CategoryDescription OptString `json:"CategoryDescription"`
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EquipmentGetStatus) UnmarshalText(data []byte) error {
+	switch EquipmentGetStatus(data) {
+	case EquipmentGetStatusActive:
+		*s = EquipmentGetStatusActive
+		return nil
+	case EquipmentGetStatusInactive:
+		*s = EquipmentGetStatusInactive
+		return nil
+	case EquipmentGetStatusMaintenance:
+		*s = EquipmentGetStatusMaintenance
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetCategoryCode returns the value of CategoryCode.
-func (s *MaterialCategoryType) GetCategoryCode() OptString {
-	return s.CategoryCode
+// EquipmentIDDeleteBadRequest is response for EquipmentIDDelete operation.
+type EquipmentIDDeleteBadRequest struct{}
+
+func (*EquipmentIDDeleteBadRequest) equipmentIDDeleteRes() {}
+
+// EquipmentIDDeleteConflict is response for EquipmentIDDelete operation.
+type EquipmentIDDeleteConflict struct{}
+
+func (*EquipmentIDDeleteConflict) equipmentIDDeleteRes() {}
+
+// EquipmentIDDeleteNoContent is response for EquipmentIDDelete operation.
+type EquipmentIDDeleteNoContent struct{}
+
+func (*EquipmentIDDeleteNoContent) equipmentIDDeleteRes() {}
+
+// EquipmentIDDeleteNotFound is response for EquipmentIDDelete operation.
+type EquipmentIDDeleteNotFound struct{}
+
+func (*EquipmentIDDeleteNotFound) equipmentIDDeleteRes() {}
+
+// EquipmentIDDeletePreconditionFailed is response for EquipmentIDDelete operation.
+type EquipmentIDDeletePreconditionFailed struct{}
+
+func (*EquipmentIDDeletePreconditionFailed) equipmentIDDeleteRes() {}
+
+// EquipmentIDGetNotFound is response for EquipmentIDGet operation.
+type EquipmentIDGetNotFound struct{}
+
+func (*EquipmentIDGetNotFound) equipmentIDGetRes() {}
+
+// Существительное B2MML Equipment со свойствами и дочерним
+// оборудованием.
+type EquipmentIDGetOKApplicationXML struct {
+	Data io.Reader
 }
 
-// GetCategoryDescription returns the value of CategoryDescription.
-func (s *MaterialCategoryType) GetCategoryDescription() OptString {
-	return s.CategoryDescription
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentIDGetOKApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetCategoryCode sets the value of CategoryCode.
-func (s *MaterialCategoryType) SetCategoryCode(val OptString) {
-	s.CategoryCode = val
+// EquipmentIDGetOKApplicationXMLHeaders wraps EquipmentIDGetOKApplicationXML with response headers.
+type EquipmentIDGetOKApplicationXMLHeaders struct {
+	ETag     string
+	Response EquipmentIDGetOKApplicationXML
 }
 
-// SetCategoryDescription sets the value of CategoryDescription.
-func (s *MaterialCategoryType) SetCategoryDescription(val OptString) {
-	s.CategoryDescription = val
+// GetETag returns the value of ETag.
+func (s *EquipmentIDGetOKApplicationXMLHeaders) GetETag() string {
+	return s.ETag
 }
 
-// Ref: #/components/schemas/MaterialCompositionType
-type MaterialCompositionType struct {
-	BaseMaterial OptString           `json:"BaseMaterial"`
-	Additives    OptAdditivesType    `json:"Additives"`
-	Contaminants OptContaminantsType `json:"Contaminants"`
+// GetResponse returns the value of Response.
+func (s *EquipmentIDGetOKApplicationXMLHeaders) GetResponse() EquipmentIDGetOKApplicationXML {
+	return s.Response
 }
 
-// GetBaseMaterial returns the value of BaseMaterial.
-func (s *MaterialCompositionType) GetBaseMaterial() OptString {
-	return s.BaseMaterial
+// SetETag sets the value of ETag.
+func (s *EquipmentIDGetOKApplicationXMLHeaders) SetETag(val string) {
+	s.ETag = val
 }
 
-// GetAdditives returns the value of Additives.
-func (s *MaterialCompositionType) GetAdditives() OptAdditivesType {
-	return s.Additives
+// SetResponse sets the value of Response.
+func (s *EquipmentIDGetOKApplicationXMLHeaders) SetResponse(val EquipmentIDGetOKApplicationXML) {
+	s.Response = val
 }
 
-// GetContaminants returns the value of Contaminants.
-func (s *MaterialCompositionType) GetContaminants() OptContaminantsType {
-	return s.Contaminants
+func (*EquipmentIDGetOKApplicationXMLHeaders) equipmentIDGetRes() {}
+
+// EquipmentIDHistoryDiffGetNotFound is response for EquipmentIDHistoryDiffGet operation.
+type EquipmentIDHistoryDiffGetNotFound struct{}
+
+func (*EquipmentIDHistoryDiffGetNotFound) equipmentIDHistoryDiffGetRes() {}
+
+// EquipmentIDHistoryGetNotFound is response for EquipmentIDHistoryGet operation.
+type EquipmentIDHistoryGetNotFound struct{}
+
+func (*EquipmentIDHistoryGetNotFound) equipmentIDHistoryGetRes() {}
+
+type EquipmentIDHistoryGetOKApplicationJSON []EquipmentRevision
+
+func (*EquipmentIDHistoryGetOKApplicationJSON) equipmentIDHistoryGetRes() {}
+
+// EquipmentIDMovePostBadRequest is response for EquipmentIDMovePost operation.
+type EquipmentIDMovePostBadRequest struct{}
+
+func (*EquipmentIDMovePostBadRequest) equipmentIDMovePostRes() {}
+
+// EquipmentIDMovePostNotFound is response for EquipmentIDMovePost operation.
+type EquipmentIDMovePostNotFound struct{}
+
+func (*EquipmentIDMovePostNotFound) equipmentIDMovePostRes() {}
+
+// Существительное B2MML Equipment.
+type EquipmentIDMovePostOKApplicationXML struct {
+	Data io.Reader
 }
 
-// SetBaseMaterial sets the value of BaseMaterial.
-func (s *MaterialCompositionType) SetBaseMaterial(val OptString) {
-	s.BaseMaterial = val
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentIDMovePostOKApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetAdditives sets the value of Additives.
-func (s *MaterialCompositionType) SetAdditives(val OptAdditivesType) {
-	s.Additives = val
+// EquipmentIDMovePostOKApplicationXMLHeaders wraps EquipmentIDMovePostOKApplicationXML with response headers.
+type EquipmentIDMovePostOKApplicationXMLHeaders struct {
+	ETag     string
+	Response EquipmentIDMovePostOKApplicationXML
 }
 
-// SetContaminants sets the value of Contaminants.
-func (s *MaterialCompositionType) SetContaminants(val OptContaminantsType) {
-	s.Contaminants = val
+// GetETag returns the value of ETag.
+func (s *EquipmentIDMovePostOKApplicationXMLHeaders) GetETag() string {
+	return s.ETag
 }
 
-// Ref: #/components/schemas/MaterialPropertiesType
-type MaterialPropertiesType struct {
-	PhysicalProperties   OptPhysicalPropertiesType   `json:"PhysicalProperties"`
-	ChemicalProperties   OptChemicalPropertiesType   `json:"ChemicalProperties"`
-	MechanicalProperties OptMechanicalPropertiesType `json:"MechanicalProperties"`
-	ThermalProperties    OptThermalPropertiesType    `json:"ThermalProperties"`
-	ElectricalProperties OptElectricalPropertiesType `json:"ElectricalProperties"`
+// GetResponse returns the value of Response.
+func (s *EquipmentIDMovePostOKApplicationXMLHeaders) GetResponse() EquipmentIDMovePostOKApplicationXML {
+	return s.Response
 }
 
-// GetPhysicalProperties returns the value of PhysicalProperties.
-func (s *MaterialPropertiesType) GetPhysicalProperties() OptPhysicalPropertiesType {
-	return s.PhysicalProperties
+// SetETag sets the value of ETag.
+func (s *EquipmentIDMovePostOKApplicationXMLHeaders) SetETag(val string) {
+	s.ETag = val
 }
 
-// GetChemicalProperties returns the value of ChemicalProperties.
-func (s *MaterialPropertiesType) GetChemicalProperties() OptChemicalPropertiesType {
-	return s.ChemicalProperties
+// SetResponse sets the value of Response.
+func (s *EquipmentIDMovePostOKApplicationXMLHeaders) SetResponse(val EquipmentIDMovePostOKApplicationXML) {
+	s.Response = val
 }
 
-// GetMechanicalProperties returns the value of MechanicalProperties.
-func (s *MaterialPropertiesType) GetMechanicalProperties() OptMechanicalPropertiesType {
-	return s.MechanicalProperties
+func (*EquipmentIDMovePostOKApplicationXMLHeaders) equipmentIDMovePostRes() {}
+
+// EquipmentIDMovePostPreconditionFailed is response for EquipmentIDMovePost operation.
+type EquipmentIDMovePostPreconditionFailed struct{}
+
+func (*EquipmentIDMovePostPreconditionFailed) equipmentIDMovePostRes() {}
+
+// EquipmentIDMovePostUnprocessableEntity is response for EquipmentIDMovePost operation.
+type EquipmentIDMovePostUnprocessableEntity struct{}
+
+func (*EquipmentIDMovePostUnprocessableEntity) equipmentIDMovePostRes() {}
+
+// EquipmentIDPropertiesGetBadRequest is response for EquipmentIDPropertiesGet operation.
+type EquipmentIDPropertiesGetBadRequest struct{}
+
+func (*EquipmentIDPropertiesGetBadRequest) equipmentIDPropertiesGetRes() {}
+
+// EquipmentIDPropertiesGetNotFound is response for EquipmentIDPropertiesGet operation.
+type EquipmentIDPropertiesGetNotFound struct{}
+
+func (*EquipmentIDPropertiesGetNotFound) equipmentIDPropertiesGetRes() {}
+
+type EquipmentIDPropertiesGetOKApplicationJSON []EffectiveProperty
+
+func (*EquipmentIDPropertiesGetOKApplicationJSON) equipmentIDPropertiesGetRes() {}
+
+// EquipmentIDPurgePostConflict is response for EquipmentIDPurgePost operation.
+type EquipmentIDPurgePostConflict struct{}
+
+func (*EquipmentIDPurgePostConflict) equipmentIDPurgePostRes() {}
+
+// EquipmentIDPurgePostNoContent is response for EquipmentIDPurgePost operation.
+type EquipmentIDPurgePostNoContent struct{}
+
+func (*EquipmentIDPurgePostNoContent) equipmentIDPurgePostRes() {}
+
+// EquipmentIDPurgePostNotFound is response for EquipmentIDPurgePost operation.
+type EquipmentIDPurgePostNotFound struct{}
+
+func (*EquipmentIDPurgePostNotFound) equipmentIDPurgePostRes() {}
+
+// EquipmentIDPutNotFound is response for EquipmentIDPut operation.
+type EquipmentIDPutNotFound struct{}
+
+func (*EquipmentIDPutNotFound) equipmentIDPutRes() {}
+
+// Существительное B2MML Equipment.
+type EquipmentIDPutOKApplicationXML struct {
+	Data io.Reader
 }
 
-// GetThermalProperties returns the value of ThermalProperties.
-func (s *MaterialPropertiesType) GetThermalProperties() OptThermalPropertiesType {
-	return s.ThermalProperties
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentIDPutOKApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetElectricalProperties returns the value of ElectricalProperties.
-func (s *MaterialPropertiesType) GetElectricalProperties() OptElectricalPropertiesType {
-	return s.ElectricalProperties
+// EquipmentIDPutOKApplicationXMLHeaders wraps EquipmentIDPutOKApplicationXML with response headers.
+type EquipmentIDPutOKApplicationXMLHeaders struct {
+	ETag     string
+	Response EquipmentIDPutOKApplicationXML
 }
 
-// SetPhysicalProperties sets the value of PhysicalProperties.
-func (s *MaterialPropertiesType) SetPhysicalProperties(val OptPhysicalPropertiesType) {
-	s.PhysicalProperties = val
+// GetETag returns the value of ETag.
+func (s *EquipmentIDPutOKApplicationXMLHeaders) GetETag() string {
+	return s.ETag
 }
 
-// SetChemicalProperties sets the value of ChemicalProperties.
-func (s *MaterialPropertiesType) SetChemicalProperties(val OptChemicalPropertiesType) {
-	s.ChemicalProperties = val
+// GetResponse returns the value of Response.
+func (s *EquipmentIDPutOKApplicationXMLHeaders) GetResponse() EquipmentIDPutOKApplicationXML {
+	return s.Response
 }
 
-// SetMechanicalProperties sets the value of MechanicalProperties.
-func (s *MaterialPropertiesType) SetMechanicalProperties(val OptMechanicalPropertiesType) {
-	s.MechanicalProperties = val
+// SetETag sets the value of ETag.
+func (s *EquipmentIDPutOKApplicationXMLHeaders) SetETag(val string) {
+	s.ETag = val
 }
 
-// SetThermalProperties sets the value of ThermalProperties.
-func (s *MaterialPropertiesType) SetThermalProperties(val OptThermalPropertiesType) {
-	s.ThermalProperties = val
+// SetResponse sets the value of Response.
+func (s *EquipmentIDPutOKApplicationXMLHeaders) SetResponse(val EquipmentIDPutOKApplicationXML) {
+	s.Response = val
 }
 
-// SetElectricalProperties sets the value of ElectricalProperties.
-func (s *MaterialPropertiesType) SetElectricalProperties(val OptElectricalPropertiesType) {
-	s.ElectricalProperties = val
+func (*EquipmentIDPutOKApplicationXMLHeaders) equipmentIDPutRes() {}
+
+// EquipmentIDPutPreconditionFailed is response for EquipmentIDPut operation.
+type EquipmentIDPutPreconditionFailed struct{}
+
+func (*EquipmentIDPutPreconditionFailed) equipmentIDPutRes() {}
+
+// Существительное B2MML Equipment.
+type EquipmentIDPutReqApplicationXML struct {
+	Data io.Reader
 }
 
-// Ref: #/components/schemas/MaterialSourceType
-type MaterialSourceType struct {
-	SourceID        OptString `json:"SourceID"`
-	SourceName      OptString `json:"SourceName"`
-	SourceLocation  OptString `json:"SourceLocation"`
-	SupplyChainStep OptString `json:"SupplyChainStep"`
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentIDPutReqApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetSourceID returns the value of SourceID.
-func (s *MaterialSourceType) GetSourceID() OptString {
-	return s.SourceID
+func (*EquipmentIDPutReqApplicationXML) equipmentIDPutReq() {}
+
+// EquipmentIDRestorePostConflict is response for EquipmentIDRestorePost operation.
+type EquipmentIDRestorePostConflict struct{}
+
+func (*EquipmentIDRestorePostConflict) equipmentIDRestorePostRes() {}
+
+// EquipmentIDRestorePostNotFound is response for EquipmentIDRestorePost operation.
+type EquipmentIDRestorePostNotFound struct{}
+
+func (*EquipmentIDRestorePostNotFound) equipmentIDRestorePostRes() {}
+
+// Существительное B2MML Equipment.
+type EquipmentIDRestorePostOKApplicationXML struct {
+	Data io.Reader
 }
 
-// GetSourceName returns the value of SourceName.
-func (s *MaterialSourceType) GetSourceName() OptString {
-	return s.SourceName
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentIDRestorePostOKApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetSourceLocation returns the value of SourceLocation.
-func (s *MaterialSourceType) GetSourceLocation() OptString {
-	return s.SourceLocation
+// EquipmentIDRestorePostOKApplicationXMLHeaders wraps EquipmentIDRestorePostOKApplicationXML with response headers.
+type EquipmentIDRestorePostOKApplicationXMLHeaders struct {
+	ETag     string
+	Response EquipmentIDRestorePostOKApplicationXML
 }
 
-// GetSupplyChainStep returns the value of SupplyChainStep.
-func (s *MaterialSourceType) GetSupplyChainStep() OptString {
-	return s.SupplyChainStep
+// GetETag returns the value of ETag.
+func (s *EquipmentIDRestorePostOKApplicationXMLHeaders) GetETag() string {
+	return s.ETag
 }
 
-// SetSourceID sets the value of SourceID.
-func (s *MaterialSourceType) SetSourceID(val OptString) {
-	s.SourceID = val
+// GetResponse returns the value of Response.
+func (s *EquipmentIDRestorePostOKApplicationXMLHeaders) GetResponse() EquipmentIDRestorePostOKApplicationXML {
+	return s.Response
 }
 
-// SetSourceName sets the value of SourceName.
-func (s *MaterialSourceType) SetSourceName(val OptString) {
-	s.SourceName = val
+// SetETag sets the value of ETag.
+func (s *EquipmentIDRestorePostOKApplicationXMLHeaders) SetETag(val string) {
+	s.ETag = val
 }
 
-// SetSourceLocation sets the value of SourceLocation.
-func (s *MaterialSourceType) SetSourceLocation(val OptString) {
-	s.SourceLocation = val
+// SetResponse sets the value of Response.
+func (s *EquipmentIDRestorePostOKApplicationXMLHeaders) SetResponse(val EquipmentIDRestorePostOKApplicationXML) {
+	s.Response = val
 }
 
-// SetSupplyChainStep sets the value of SupplyChainStep.
-func (s *MaterialSourceType) SetSupplyChainStep(val OptString) {
-	s.SupplyChainStep = val
+func (*EquipmentIDRestorePostOKApplicationXMLHeaders) equipmentIDRestorePostRes() {}
+
+// EquipmentIDTreeGetNotFound is response for EquipmentIDTreeGet operation.
+type EquipmentIDTreeGetNotFound struct{}
+
+func (*EquipmentIDTreeGetNotFound) equipmentIDTreeGetRes() {}
+
+// Существительное B2MML Equipment с EquipmentChild до глубины depth.
+type EquipmentIDTreeGetOKApplicationXML struct {
+	Data io.Reader
 }
 
-// Ref: #/components/schemas/MaterialType
-type MaterialType struct {
-	MaterialID                   OptString                      `json:"MaterialID"`
-	MaterialDescription          OptString                      `json:"MaterialDescription"`
-	MaterialTypeCode             OptString                      `json:"MaterialTypeCode"`
-	MaterialCategory             OptMaterialCategoryType        `json:"MaterialCategory"`
-	MaterialProperties           OptMaterialPropertiesType      `json:"MaterialProperties"`
-	MaterialComposition          OptMaterialCompositionType     `json:"MaterialComposition"`
-	MaterialSource               OptMaterialSourceType          `json:"MaterialSource"`
-	MaterialUsage                OptMaterialUsageType           `json:"MaterialUsage"`
-	MaterialHandlingInstructions OptString                      `json:"MaterialHandlingInstructions"`
-	RegulatoryInformation        OptRegulatoryInformationType   `json:"RegulatoryInformation"`
-	TraceabilityInformation      OptTraceabilityInformationType `json:"TraceabilityInformation"`
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentIDTreeGetOKApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetMaterialID returns the value of MaterialID.
-func (s *MaterialType) GetMaterialID() OptString {
-	return s.MaterialID
+func (*EquipmentIDTreeGetOKApplicationXML) equipmentIDTreeGetRes() {}
+
+// EquipmentImportsImportIdGetNotFound is response for EquipmentImportsImportIdGet operation.
+type EquipmentImportsImportIdGetNotFound struct{}
+
+func (*EquipmentImportsImportIdGetNotFound) equipmentImportsImportIdGetRes() {}
+
+// EquipmentImportsImportIdResumePostConflict is response for EquipmentImportsImportIdResumePost operation.
+type EquipmentImportsImportIdResumePostConflict struct{}
+
+func (*EquipmentImportsImportIdResumePostConflict) equipmentImportsImportIdResumePostRes() {}
+
+// EquipmentImportsImportIdResumePostNotFound is response for EquipmentImportsImportIdResumePost operation.
+type EquipmentImportsImportIdResumePostNotFound struct{}
+
+func (*EquipmentImportsImportIdResumePostNotFound) equipmentImportsImportIdResumePostRes() {}
+
+type EquipmentImportsPostReqApplicationXNdjson struct {
+	Data io.Reader
 }
 
-// GetMaterialDescription returns the value of MaterialDescription.
-func (s *MaterialType) GetMaterialDescription() OptString {
-	return s.MaterialDescription
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentImportsPostReqApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetMaterialTypeCode returns the value of MaterialTypeCode.
-func (s *MaterialType) GetMaterialTypeCode() OptString {
-	return s.MaterialTypeCode
+func (*EquipmentImportsPostReqApplicationXNdjson) equipmentImportsPostReq() {}
+
+type EquipmentImportsPostReqTextCsv struct {
+	Data io.Reader
 }
 
-// GetMaterialCategory returns the value of MaterialCategory.
-func (s *MaterialType) GetMaterialCategory() OptMaterialCategoryType {
-	return s.MaterialCategory
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentImportsPostReqTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetMaterialProperties returns the value of MaterialProperties.
-func (s *MaterialType) GetMaterialProperties() OptMaterialPropertiesType {
-	return s.MaterialProperties
+func (*EquipmentImportsPostReqTextCsv) equipmentImportsPostReq() {}
+
+// EquipmentImportsPostRequestEntityTooLarge is response for EquipmentImportsPost operation.
+type EquipmentImportsPostRequestEntityTooLarge struct{}
+
+func (*EquipmentImportsPostRequestEntityTooLarge) equipmentImportsPostRes() {}
+
+// Ref: #/components/schemas/EquipmentList
+type EquipmentList struct {
+	Items []EquipmentType `json:"items"`
+	// Курсор следующей страницы (отсутствует на последней
+	// странице).
+	NextCursor OptString `json:"next_cursor"`
+	// Курсор предыдущей страницы (отсутствует на первой
+	// странице).
+	PrevCursor OptString `json:"prev_cursor"`
 }
 
-// GetMaterialComposition returns the value of MaterialComposition.
-func (s *MaterialType) GetMaterialComposition() OptMaterialCompositionType {
-	return s.MaterialComposition
+// GetItems returns the value of Items.
+func (s *EquipmentList) GetItems() []EquipmentType {
+	return s.Items
 }
 
-// GetMaterialSource returns the value of MaterialSource.
-func (s *MaterialType) GetMaterialSource() OptMaterialSourceType {
-	return s.MaterialSource
+// GetNextCursor returns the value of NextCursor.
+func (s *EquipmentList) GetNextCursor() OptString {
+	return s.NextCursor
 }
 
-// GetMaterialUsage returns the value of MaterialUsage.
-func (s *MaterialType) GetMaterialUsage() OptMaterialUsageType {
-	return s.MaterialUsage
+// GetPrevCursor returns the value of PrevCursor.
+func (s *EquipmentList) GetPrevCursor() OptString {
+	return s.PrevCursor
 }
 
-// GetMaterialHandlingInstructions returns the value of MaterialHandlingInstructions.
-func (s *MaterialType) GetMaterialHandlingInstructions() OptString {
-	return s.MaterialHandlingInstructions
+// SetItems sets the value of Items.
+func (s *EquipmentList) SetItems(val []EquipmentType) {
+	s.Items = val
 }
 
-// GetRegulatoryInformation returns the value of RegulatoryInformation.
-func (s *MaterialType) GetRegulatoryInformation() OptRegulatoryInformationType {
-	return s.RegulatoryInformation
+// SetNextCursor sets the value of NextCursor.
+func (s *EquipmentList) SetNextCursor(val OptString) {
+	s.NextCursor = val
 }
 
-// GetTraceabilityInformation returns the value of TraceabilityInformation.
-func (s *MaterialType) GetTraceabilityInformation() OptTraceabilityInformationType {
-	return s.TraceabilityInformation
+// SetPrevCursor sets the value of PrevCursor.
+func (s *EquipmentList) SetPrevCursor(val OptString) {
+	s.PrevCursor = val
 }
 
-// SetMaterialID sets the value of MaterialID.
-func (s *MaterialType) SetMaterialID(val OptString) {
-	s.MaterialID = val
+func (*EquipmentList) equipmentGetRes()       {}
+func (*EquipmentList) equipmentQueryPostRes() {}
+
+// Ref: #/components/schemas/EquipmentMove
+type EquipmentMove struct {
+	// B2MML ID нового родителя; если не задан, оборудование
+	// переносится в корень.
+	ParentID OptString `json:"parent_id"`
 }
 
-// SetMaterialDescription sets the value of MaterialDescription.
-func (s *MaterialType) SetMaterialDescription(val OptString) {
-	s.MaterialDescription = val
+// GetParentID returns the value of ParentID.
+func (s *EquipmentMove) GetParentID() OptString {
+	return s.ParentID
 }
 
-// SetMaterialTypeCode sets the value of MaterialTypeCode.
-func (s *MaterialType) SetMaterialTypeCode(val OptString) {
-	s.MaterialTypeCode = val
+// SetParentID sets the value of ParentID.
+func (s *EquipmentMove) SetParentID(val OptString) {
+	s.ParentID = val
 }
 
-// SetMaterialCategory sets the value of MaterialCategory.
-func (s *MaterialType) SetMaterialCategory(val OptMaterialCategoryType) {
-	s.MaterialCategory = val
+// EquipmentPostConflict is response for EquipmentPost operation.
+type EquipmentPostConflict struct{}
+
+func (*EquipmentPostConflict) equipmentPostRes() {}
+
+// Существительное B2MML Equipment.
+type EquipmentPostCreatedApplicationXML struct {
+	Data io.Reader
 }
 
-// SetMaterialProperties sets the value of MaterialProperties.
-func (s *MaterialType) SetMaterialProperties(val OptMaterialPropertiesType) {
-	s.MaterialProperties = val
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentPostCreatedApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetMaterialComposition sets the value of MaterialComposition.
-func (s *MaterialType) SetMaterialComposition(val OptMaterialCompositionType) {
-	s.MaterialComposition = val
+// EquipmentPostCreatedApplicationXMLHeaders wraps EquipmentPostCreatedApplicationXML with response headers.
+type EquipmentPostCreatedApplicationXMLHeaders struct {
+	ETag     string
+	Response EquipmentPostCreatedApplicationXML
 }
 
-// SetMaterialSource sets the value of MaterialSource.
-func (s *MaterialType) SetMaterialSource(val OptMaterialSourceType) {
-	s.MaterialSource = val
+// GetETag returns the value of ETag.
+func (s *EquipmentPostCreatedApplicationXMLHeaders) GetETag() string {
+	return s.ETag
 }
 
-// SetMaterialUsage sets the value of MaterialUsage.
-func (s *MaterialType) SetMaterialUsage(val OptMaterialUsageType) {
-	s.MaterialUsage = val
+// GetResponse returns the value of Response.
+func (s *EquipmentPostCreatedApplicationXMLHeaders) GetResponse() EquipmentPostCreatedApplicationXML {
+	return s.Response
 }
 
-// SetMaterialHandlingInstructions sets the value of MaterialHandlingInstructions.
-func (s *MaterialType) SetMaterialHandlingInstructions(val OptString) {
-	s.MaterialHandlingInstructions = val
+// SetETag sets the value of ETag.
+func (s *EquipmentPostCreatedApplicationXMLHeaders) SetETag(val string) {
+	s.ETag = val
 }
 
-// SetRegulatoryInformation sets the value of RegulatoryInformation.
-func (s *MaterialType) SetRegulatoryInformation(val OptRegulatoryInformationType) {
-	s.RegulatoryInformation = val
+// SetResponse sets the value of Response.
+func (s *EquipmentPostCreatedApplicationXMLHeaders) SetResponse(val EquipmentPostCreatedApplicationXML) {
+	s.Response = val
 }
 
-// SetTraceabilityInformation sets the value of TraceabilityInformation.
-func (s *MaterialType) SetTraceabilityInformation(val OptTraceabilityInformationType) {
-	s.TraceabilityInformation = val
+func (*EquipmentPostCreatedApplicationXMLHeaders) equipmentPostRes() {}
+
+// EquipmentPostNotFound is response for EquipmentPost operation.
+type EquipmentPostNotFound struct{}
+
+func (*EquipmentPostNotFound) equipmentPostRes() {}
+
+// Существительное B2MML Equipment.
+type EquipmentPostReqApplicationXML struct {
+	Data io.Reader
 }
 
-// Ref: #/components/schemas/MaterialUsageType
-type MaterialUsageType struct {
-	IntendedUse       OptString  `json:"IntendedUse"`
-	ApplicationMethod OptString  `json:"ApplicationMethod"`
-	QuantityRequired  OptFloat64 `json:"QuantityRequired"`
-	UnitOfMeasure     OptString  `json:"UnitOfMeasure"`
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EquipmentPostReqApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetIntendedUse returns the value of IntendedUse.
-func (s *MaterialUsageType) GetIntendedUse() OptString {
-	return s.IntendedUse
+func (*EquipmentPostReqApplicationXML) equipmentPostReq() {}
+
+// Ref: #/components/schemas/EquipmentQuery
+type EquipmentQuery struct {
+	Status         []EquipmentQueryStatusItem `json:"status"`
+	EquipmentLevel []string                   `json:"equipment_level"`
+	HierarchyScope []string                   `json:"hierarchy_scope"`
+	// B2MML ID класса оборудования.
+	Class OptString `json:"class"`
+	// Учитывать оборудование подклассов.
+	IncludeSubclasses OptBool `json:"include_subclasses"`
+	// Оборудование, действующее в указанный момент.
+	EffectiveAt OptDateTime `json:"effective_at"`
+	// Начало окна, с которым пересекается период действия.
+	EffectiveFrom OptDateTime `json:"effective_from"`
+	// Конец окна, с которым пересекается период действия.
+	EffectiveTo OptDateTime            `json:"effective_to"`
+	Properties  []PropertyPredicate    `json:"properties"`
+	Sort        OptEquipmentQuerySort  `json:"sort"`
+	Order       OptEquipmentQueryOrder `json:"order"`
+	// Поля EquipmentType, которые нужно вернуть (по умолчанию все).
+	Fields []string  `json:"fields"`
+	Limit  OptInt32  `json:"limit"`
+	Cursor OptString `json:"cursor"`
 }
 
-// GetApplicationMethod returns the value of ApplicationMethod.
-func (s *MaterialUsageType) GetApplicationMethod() OptString {
-	return s.ApplicationMethod
+// GetStatus returns the value of Status.
+func (s *EquipmentQuery) GetStatus() []EquipmentQueryStatusItem {
+	return s.Status
 }
 
-// GetQuantityRequired returns the value of QuantityRequired.
-func (s *MaterialUsageType) GetQuantityRequired() OptFloat64 {
-	return s.QuantityRequired
+// GetEquipmentLevel returns the value of EquipmentLevel.
+func (s *EquipmentQuery) GetEquipmentLevel() []string {
+	return s.EquipmentLevel
 }
 
-// GetUnitOfMeasure returns the value of UnitOfMeasure.
-func (s *MaterialUsageType) GetUnitOfMeasure() OptString {
-	return s.UnitOfMeasure
+// GetHierarchyScope returns the value of HierarchyScope.
+func (s *EquipmentQuery) GetHierarchyScope() []string {
+	return s.HierarchyScope
 }
 
-// SetIntendedUse sets the value of IntendedUse.
-func (s *MaterialUsageType) SetIntendedUse(val OptString) {
-	s.IntendedUse = val
+// GetClass returns the value of Class.
+func (s *EquipmentQuery) GetClass() OptString {
+	return s.Class
 }
 
-// SetApplicationMethod sets the value of ApplicationMethod.
-func (s *MaterialUsageType) SetApplicationMethod(val OptString) {
-	s.ApplicationMethod = val
+// GetIncludeSubclasses returns the value of IncludeSubclasses.
+func (s *EquipmentQuery) GetIncludeSubclasses() OptBool {
+	return s.IncludeSubclasses
 }
 
-// SetQuantityRequired sets the value of QuantityRequired.
-func (s *MaterialUsageType) SetQuantityRequired(val OptFloat64) {
-	s.QuantityRequired = val
+// GetEffectiveAt returns the value of EffectiveAt.
+func (s *EquipmentQuery) GetEffectiveAt() OptDateTime {
+	return s.EffectiveAt
 }
 
-// SetUnitOfMeasure sets the value of UnitOfMeasure.
-func (s *MaterialUsageType) SetUnitOfMeasure(val OptString) {
-	s.UnitOfMeasure = val
+// GetEffectiveFrom returns the value of EffectiveFrom.
+func (s *EquipmentQuery) GetEffectiveFrom() OptDateTime {
+	return s.EffectiveFrom
 }
 
-// MaterialsPostCreated is response for MaterialsPost operation.
-type MaterialsPostCreated struct{}
+// GetEffectiveTo returns the value of EffectiveTo.
+func (s *EquipmentQuery) GetEffectiveTo() OptDateTime {
+	return s.EffectiveTo
+}
 
-// Ref: #/components/schemas/MechanicalPropertiesType
-type MechanicalPropertiesType struct {
-	TensileStrength OptFloat64 `json:"TensileStrength"`
-	YieldStrength   OptFloat64 `json:"YieldStrength"`
-	Elongation      OptFloat64 `json:"Elongation"`
-	Hardness        OptString  `json:"Hardness"`
+// GetProperties returns the value of Properties.
+func (s *EquipmentQuery) GetProperties() []PropertyPredicate {
+	return s.Properties
 }
 
-// GetTensileStrength returns the value of TensileStrength.
-func (s *MechanicalPropertiesType) GetTensileStrength() OptFloat64 {
-	return s.TensileStrength
+// GetSort returns the value of Sort.
+func (s *EquipmentQuery) GetSort() OptEquipmentQuerySort {
+	return s.Sort
 }
 
-// GetYieldStrength returns the value of YieldStrength.
-func (s *MechanicalPropertiesType) GetYieldStrength() OptFloat64 {
-	return s.YieldStrength
+// GetOrder returns the value of Order.
+func (s *EquipmentQuery) GetOrder() OptEquipmentQueryOrder {
+	return s.Order
 }
 
-// GetElongation returns the value of Elongation.
-func (s *MechanicalPropertiesType) GetElongation() OptFloat64 {
-	return s.Elongation
+// GetFields returns the value of Fields.
+func (s *EquipmentQuery) GetFields() []string {
+	return s.Fields
 }
 
-// GetHardness returns the value of Hardness.
-func (s *MechanicalPropertiesType) GetHardness() OptString {
-	return s.Hardness
+// GetLimit returns the value of Limit.
+func (s *EquipmentQuery) GetLimit() OptInt32 {
+	return s.Limit
 }
 
-// SetTensileStrength sets the value of TensileStrength.
-func (s *MechanicalPropertiesType) SetTensileStrength(val OptFloat64) {
-	s.TensileStrength = val
+// GetCursor returns the value of Cursor.
+func (s *EquipmentQuery) GetCursor() OptString {
+	return s.Cursor
 }
 
-// SetYieldStrength sets the value of YieldStrength.
-func (s *MechanicalPropertiesType) SetYieldStrength(val OptFloat64) {
-	s.YieldStrength = val
+// SetStatus sets the value of Status.
+func (s *EquipmentQuery) SetStatus(val []EquipmentQueryStatusItem) {
+	s.Status = val
 }
 
-// SetElongation sets the value of Elongation.
-func (s *MechanicalPropertiesType) SetElongation(val OptFloat64) {
-	s.Elongation = val
+// SetEquipmentLevel sets the value of EquipmentLevel.
+func (s *EquipmentQuery) SetEquipmentLevel(val []string) {
+	s.EquipmentLevel = val
 }
 
-// SetHardness sets the value of Hardness.
-func (s *MechanicalPropertiesType) SetHardness(val OptString) {
-	s.Hardness = val
+// SetHierarchyScope sets the value of HierarchyScope.
+func (s *EquipmentQuery) SetHierarchyScope(val []string) {
+	s.HierarchyScope = val
 }
 
-// Ref: #/components/schemas/MetricType
-type MetricType struct {
-	MetricName    OptString   `json:"MetricName"`
-	Value         OptFloat64  `json:"Value"`
-	UnitOfMeasure OptString   `json:"UnitOfMeasure"`
-	Timestamp     OptDateTime `json:"Timestamp"`
+// SetClass sets the value of Class.
+func (s *EquipmentQuery) SetClass(val OptString) {
+	s.Class = val
 }
 
-// GetMetricName returns the value of MetricName.
-func (s *MetricType) GetMetricName() OptString {
-	return s.MetricName
+// SetIncludeSubclasses sets the value of IncludeSubclasses.
+func (s *EquipmentQuery) SetIncludeSubclasses(val OptBool) {
+	s.IncludeSubclasses = val
 }
 
-// GetValue returns the value of Value.
-func (s *MetricType) GetValue() OptFloat64 {
-	return s.Value
+// SetEffectiveAt sets the value of EffectiveAt.
+func (s *EquipmentQuery) SetEffectiveAt(val OptDateTime) {
+	s.EffectiveAt = val
 }
 
-// GetUnitOfMeasure returns the value of UnitOfMeasure.
-func (s *MetricType) GetUnitOfMeasure() OptString {
-	return s.UnitOfMeasure
+// SetEffectiveFrom sets the value of EffectiveFrom.
+func (s *EquipmentQuery) SetEffectiveFrom(val OptDateTime) {
+	s.EffectiveFrom = val
 }
 
-// GetTimestamp returns the value of Timestamp.
-func (s *MetricType) GetTimestamp() OptDateTime {
-	return s.Timestamp
+// SetEffectiveTo sets the value of EffectiveTo.
+func (s *EquipmentQuery) SetEffectiveTo(val OptDateTime) {
+	s.EffectiveTo = val
 }
 
-// SetMetricName sets the value of MetricName.
-func (s *MetricType) SetMetricName(val OptString) {
-	s.MetricName = val
+// SetProperties sets the value of Properties.
+func (s *EquipmentQuery) SetProperties(val []PropertyPredicate) {
+	s.Properties = val
 }
 
-// SetValue sets the value of Value.
-func (s *MetricType) SetValue(val OptFloat64) {
-	s.Value = val
+// SetSort sets the value of Sort.
+func (s *EquipmentQuery) SetSort(val OptEquipmentQuerySort) {
+	s.Sort = val
 }
 
-// SetUnitOfMeasure sets the value of UnitOfMeasure.
-func (s *MetricType) SetUnitOfMeasure(val OptString) {
-	s.UnitOfMeasure = val
+// SetOrder sets the value of Order.
+func (s *EquipmentQuery) SetOrder(val OptEquipmentQueryOrder) {
+	s.Order = val
 }
 
-// SetTimestamp sets the value of Timestamp.
-func (s *MetricType) SetTimestamp(val OptDateTime) {
-	s.Timestamp = val
+// SetFields sets the value of Fields.
+func (s *EquipmentQuery) SetFields(val []string) {
+	s.Fields = val
 }
 
-// NewOptAdditivesType returns new OptAdditivesType with value set to v.
-func NewOptAdditivesType(v AdditivesType) OptAdditivesType {
-	return OptAdditivesType{
-		Value: v,
-		Set:   true,
-	}
+// SetLimit sets the value of Limit.
+func (s *EquipmentQuery) SetLimit(val OptInt32) {
+	s.Limit = val
 }
 
-// OptAdditivesType is optional AdditivesType.
-type OptAdditivesType struct {
-	Value AdditivesType
-	Set   bool
+// SetCursor sets the value of Cursor.
+func (s *EquipmentQuery) SetCursor(val OptString) {
+	s.Cursor = val
 }
 
-// IsSet returns true if OptAdditivesType was set.
-func (o OptAdditivesType) IsSet() bool { return o.Set }
+type EquipmentQueryOrder string
 
-// Reset unsets value.
-func (o *OptAdditivesType) Reset() {
-	var v AdditivesType
-	o.Value = v
-	o.Set = false
-}
-
-// SetTo sets value to v.
-func (o *OptAdditivesType) SetTo(v AdditivesType) {
-	o.Set = true
-	o.Value = v
-}
+const (
+	EquipmentQueryOrderAsc  EquipmentQueryOrder = "asc"
+	EquipmentQueryOrderDesc EquipmentQueryOrder = "desc"
+)
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptAdditivesType) Get() (v AdditivesType, ok bool) {
-	if !o.Set {
-		return v, false
+// AllValues returns all EquipmentQueryOrder values.
+func (EquipmentQueryOrder) AllValues() []EquipmentQueryOrder {
+	return []EquipmentQueryOrder{
+		EquipmentQueryOrderAsc,
+		EquipmentQueryOrderDesc,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptAdditivesType) Or(d AdditivesType) AdditivesType {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s EquipmentQueryOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case EquipmentQueryOrderAsc:
+		return []byte(s), nil
+	case EquipmentQueryOrderDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptBatchRecordsType returns new OptBatchRecordsType with value set to v.
-func NewOptBatchRecordsType(v BatchRecordsType) OptBatchRecordsType {
-	return OptBatchRecordsType{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EquipmentQueryOrder) UnmarshalText(data []byte) error {
+	switch EquipmentQueryOrder(data) {
+	case EquipmentQueryOrderAsc:
+		*s = EquipmentQueryOrderAsc
+		return nil
+	case EquipmentQueryOrderDesc:
+		*s = EquipmentQueryOrderDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptBatchRecordsType is optional BatchRecordsType.
-type OptBatchRecordsType struct {
-	Value BatchRecordsType
-	Set   bool
-}
+// EquipmentQueryPostBadRequest is response for EquipmentQueryPost operation.
+type EquipmentQueryPostBadRequest struct{}
 
-// IsSet returns true if OptBatchRecordsType was set.
-func (o OptBatchRecordsType) IsSet() bool { return o.Set }
+func (*EquipmentQueryPostBadRequest) equipmentQueryPostRes() {}
 
-// Reset unsets value.
-func (o *OptBatchRecordsType) Reset() {
-	var v BatchRecordsType
-	o.Value = v
-	o.Set = false
-}
+// EquipmentQueryPostNotFound is response for EquipmentQueryPost operation.
+type EquipmentQueryPostNotFound struct{}
 
-// SetTo sets value to v.
-func (o *OptBatchRecordsType) SetTo(v BatchRecordsType) {
-	o.Set = true
-	o.Value = v
-}
+func (*EquipmentQueryPostNotFound) equipmentQueryPostRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBatchRecordsType) Get() (v BatchRecordsType, ok bool) {
-	if !o.Set {
-		return v, false
+type EquipmentQuerySort string
+
+const (
+	EquipmentQuerySortCreatedAt          EquipmentQuerySort = "created_at"
+	EquipmentQuerySortUpdatedAt          EquipmentQuerySort = "updated_at"
+	EquipmentQuerySortExternalID         EquipmentQuerySort = "external_id"
+	EquipmentQuerySortEffectiveStartDate EquipmentQuerySort = "effective_start_date"
+	EquipmentQuerySortEquipmentLevel     EquipmentQuerySort = "equipment_level"
+	EquipmentQuerySortOperatingStatus    EquipmentQuerySort = "operating_status"
+)
+
+// AllValues returns all EquipmentQuerySort values.
+func (EquipmentQuerySort) AllValues() []EquipmentQuerySort {
+	return []EquipmentQuerySort{
+		EquipmentQuerySortCreatedAt,
+		EquipmentQuerySortUpdatedAt,
+		EquipmentQuerySortExternalID,
+		EquipmentQuerySortEffectiveStartDate,
+		EquipmentQuerySortEquipmentLevel,
+		EquipmentQuerySortOperatingStatus,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBatchRecordsType) Or(d BatchRecordsType) BatchRecordsType {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s EquipmentQuerySort) MarshalText() ([]byte, error) {
+	switch s {
+	case EquipmentQuerySortCreatedAt:
+		return []byte(s), nil
+	case EquipmentQuerySortUpdatedAt:
+		return []byte(s), nil
+	case EquipmentQuerySortExternalID:
+		return []byte(s), nil
+	case EquipmentQuerySortEffectiveStartDate:
+		return []byte(s), nil
+	case EquipmentQuerySortEquipmentLevel:
+		return []byte(s), nil
+	case EquipmentQuerySortOperatingStatus:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptBatchTypeBatchStatus returns new OptBatchTypeBatchStatus with value set to v.
-func NewOptBatchTypeBatchStatus(v BatchTypeBatchStatus) OptBatchTypeBatchStatus {
-	return OptBatchTypeBatchStatus{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EquipmentQuerySort) UnmarshalText(data []byte) error {
+	switch EquipmentQuerySort(data) {
+	case EquipmentQuerySortCreatedAt:
+		*s = EquipmentQuerySortCreatedAt
+		return nil
+	case EquipmentQuerySortUpdatedAt:
+		*s = EquipmentQuerySortUpdatedAt
+		return nil
+	case EquipmentQuerySortExternalID:
+		*s = EquipmentQuerySortExternalID
+		return nil
+	case EquipmentQuerySortEffectiveStartDate:
+		*s = EquipmentQuerySortEffectiveStartDate
+		return nil
+	case EquipmentQuerySortEquipmentLevel:
+		*s = EquipmentQuerySortEquipmentLevel
+		return nil
+	case EquipmentQuerySortOperatingStatus:
+		*s = EquipmentQuerySortOperatingStatus
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptBatchTypeBatchStatus is optional BatchTypeBatchStatus.
-type OptBatchTypeBatchStatus struct {
-	Value BatchTypeBatchStatus
-	Set   bool
-}
+type EquipmentQueryStatusItem string
 
-// IsSet returns true if OptBatchTypeBatchStatus was set.
-func (o OptBatchTypeBatchStatus) IsSet() bool { return o.Set }
+const (
+	EquipmentQueryStatusItemActive      EquipmentQueryStatusItem = "active"
+	EquipmentQueryStatusItemInactive    EquipmentQueryStatusItem = "inactive"
+	EquipmentQueryStatusItemMaintenance EquipmentQueryStatusItem = "maintenance"
+)
 
-// Reset unsets value.
-func (o *OptBatchTypeBatchStatus) Reset() {
-	var v BatchTypeBatchStatus
-	o.Value = v
-	o.Set = false
+// AllValues returns all EquipmentQueryStatusItem values.
+func (EquipmentQueryStatusItem) AllValues() []EquipmentQueryStatusItem {
+	return []EquipmentQueryStatusItem{
+		EquipmentQueryStatusItemActive,
+		EquipmentQueryStatusItemInactive,
+		EquipmentQueryStatusItemMaintenance,
+	}
 }
 
-// SetTo sets value to v.
-func (o *OptBatchTypeBatchStatus) SetTo(v BatchTypeBatchStatus) {
-	o.Set = true
-	o.Value = v
+// MarshalText implements encoding.TextMarshaler.
+func (s EquipmentQueryStatusItem) MarshalText() ([]byte, error) {
+	switch s {
+	case EquipmentQueryStatusItemActive:
+		return []byte(s), nil
+	case EquipmentQueryStatusItemInactive:
+		return []byte(s), nil
+	case EquipmentQueryStatusItemMaintenance:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBatchTypeBatchStatus) Get() (v BatchTypeBatchStatus, ok bool) {
-	if !o.Set {
-		return v, false
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EquipmentQueryStatusItem) UnmarshalText(data []byte) error {
+	switch EquipmentQueryStatusItem(data) {
+	case EquipmentQueryStatusItemActive:
+		*s = EquipmentQueryStatusItemActive
+		return nil
+	case EquipmentQueryStatusItemInactive:
+		*s = EquipmentQueryStatusItemInactive
+		return nil
+	case EquipmentQueryStatusItemMaintenance:
+		*s = EquipmentQueryStatusItemMaintenance
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBatchTypeBatchStatus) Or(d BatchTypeBatchStatus) BatchTypeBatchStatus {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/components/schemas/EquipmentRevision
+type EquipmentRevision struct {
+	Revision      int32                      `json:"revision"`
+	RecordVersion int64                      `json:"record_version"`
+	Operation     EquipmentRevisionOperation `json:"operation"`
+	ChangedAt     time.Time                  `json:"changed_at"`
+	ChangedBy     OptString                  `json:"changed_by"`
+	Reason        OptString                  `json:"reason"`
 }
 
-// NewOptCertificationDetailsType returns new OptCertificationDetailsType with value set to v.
-func NewOptCertificationDetailsType(v CertificationDetailsType) OptCertificationDetailsType {
-	return OptCertificationDetailsType{
-		Value: v,
-		Set:   true,
-	}
+// GetRevision returns the value of Revision.
+func (s *EquipmentRevision) GetRevision() int32 {
+	return s.Revision
 }
 
-// OptCertificationDetailsType is optional CertificationDetailsType.
-type OptCertificationDetailsType struct {
-	Value CertificationDetailsType
-	Set   bool
+// GetRecordVersion returns the value of RecordVersion.
+func (s *EquipmentRevision) GetRecordVersion() int64 {
+	return s.RecordVersion
 }
 
-// IsSet returns true if OptCertificationDetailsType was set.
-func (o OptCertificationDetailsType) IsSet() bool { return o.Set }
+// GetOperation returns the value of Operation.
+func (s *EquipmentRevision) GetOperation() EquipmentRevisionOperation {
+	return s.Operation
+}
 
-// Reset unsets value.
-func (o *OptCertificationDetailsType) Reset() {
-	var v CertificationDetailsType
-	o.Value = v
-	o.Set = false
+// GetChangedAt returns the value of ChangedAt.
+func (s *EquipmentRevision) GetChangedAt() time.Time {
+	return s.ChangedAt
 }
 
-// SetTo sets value to v.
-func (o *OptCertificationDetailsType) SetTo(v CertificationDetailsType) {
-	o.Set = true
-	o.Value = v
+// GetChangedBy returns the value of ChangedBy.
+func (s *EquipmentRevision) GetChangedBy() OptString {
+	return s.ChangedBy
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCertificationDetailsType) Get() (v CertificationDetailsType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetReason returns the value of Reason.
+func (s *EquipmentRevision) GetReason() OptString {
+	return s.Reason
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCertificationDetailsType) Or(d CertificationDetailsType) CertificationDetailsType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetRevision sets the value of Revision.
+func (s *EquipmentRevision) SetRevision(val int32) {
+	s.Revision = val
 }
 
-// NewOptChemicalPropertiesType returns new OptChemicalPropertiesType with value set to v.
-func NewOptChemicalPropertiesType(v ChemicalPropertiesType) OptChemicalPropertiesType {
-	return OptChemicalPropertiesType{
-		Value: v,
-		Set:   true,
-	}
+// SetRecordVersion sets the value of RecordVersion.
+func (s *EquipmentRevision) SetRecordVersion(val int64) {
+	s.RecordVersion = val
 }
 
-// OptChemicalPropertiesType is optional ChemicalPropertiesType.
-type OptChemicalPropertiesType struct {
-	Value ChemicalPropertiesType
-	Set   bool
+// SetOperation sets the value of Operation.
+func (s *EquipmentRevision) SetOperation(val EquipmentRevisionOperation) {
+	s.Operation = val
 }
 
-// IsSet returns true if OptChemicalPropertiesType was set.
-func (o OptChemicalPropertiesType) IsSet() bool { return o.Set }
+// SetChangedAt sets the value of ChangedAt.
+func (s *EquipmentRevision) SetChangedAt(val time.Time) {
+	s.ChangedAt = val
+}
 
-// Reset unsets value.
-func (o *OptChemicalPropertiesType) Reset() {
-	var v ChemicalPropertiesType
-	o.Value = v
-	o.Set = false
+// SetChangedBy sets the value of ChangedBy.
+func (s *EquipmentRevision) SetChangedBy(val OptString) {
+	s.ChangedBy = val
 }
 
-// SetTo sets value to v.
-func (o *OptChemicalPropertiesType) SetTo(v ChemicalPropertiesType) {
-	o.Set = true
-	o.Value = v
+// SetReason sets the value of Reason.
+func (s *EquipmentRevision) SetReason(val OptString) {
+	s.Reason = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptChemicalPropertiesType) Get() (v ChemicalPropertiesType, ok bool) {
-	if !o.Set {
-		return v, false
+type EquipmentRevisionOperation string
+
+const (
+	EquipmentRevisionOperationCreated  EquipmentRevisionOperation = "created"
+	EquipmentRevisionOperationUpdated  EquipmentRevisionOperation = "updated"
+	EquipmentRevisionOperationMoved    EquipmentRevisionOperation = "moved"
+	EquipmentRevisionOperationDeleted  EquipmentRevisionOperation = "deleted"
+	EquipmentRevisionOperationRestored EquipmentRevisionOperation = "restored"
+)
+
+// AllValues returns all EquipmentRevisionOperation values.
+func (EquipmentRevisionOperation) AllValues() []EquipmentRevisionOperation {
+	return []EquipmentRevisionOperation{
+		EquipmentRevisionOperationCreated,
+		EquipmentRevisionOperationUpdated,
+		EquipmentRevisionOperationMoved,
+		EquipmentRevisionOperationDeleted,
+		EquipmentRevisionOperationRestored,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptChemicalPropertiesType) Or(d ChemicalPropertiesType) ChemicalPropertiesType {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s EquipmentRevisionOperation) MarshalText() ([]byte, error) {
+	switch s {
+	case EquipmentRevisionOperationCreated:
+		return []byte(s), nil
+	case EquipmentRevisionOperationUpdated:
+		return []byte(s), nil
+	case EquipmentRevisionOperationMoved:
+		return []byte(s), nil
+	case EquipmentRevisionOperationDeleted:
+		return []byte(s), nil
+	case EquipmentRevisionOperationRestored:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptContaminantsType returns new OptContaminantsType with value set to v.
-func NewOptContaminantsType(v ContaminantsType) OptContaminantsType {
-	return OptContaminantsType{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EquipmentRevisionOperation) UnmarshalText(data []byte) error {
+	switch EquipmentRevisionOperation(data) {
+	case EquipmentRevisionOperationCreated:
+		*s = EquipmentRevisionOperationCreated
+		return nil
+	case EquipmentRevisionOperationUpdated:
+		*s = EquipmentRevisionOperationUpdated
+		return nil
+	case EquipmentRevisionOperationMoved:
+		*s = EquipmentRevisionOperationMoved
+		return nil
+	case EquipmentRevisionOperationDeleted:
+		*s = EquipmentRevisionOperationDeleted
+		return nil
+	case EquipmentRevisionOperationRestored:
+		*s = EquipmentRevisionOperationRestored
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptContaminantsType is optional ContaminantsType.
-type OptContaminantsType struct {
-	Value ContaminantsType
-	Set   bool
+// Ref: #/components/schemas/EquipmentTreeNode
+type EquipmentTreeNode struct {
+	Equipment EquipmentType       `json:"equipment"`
+	Children  []EquipmentTreeNode `json:"children"`
 }
 
-// IsSet returns true if OptContaminantsType was set.
-func (o OptContaminantsType) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptContaminantsType) Reset() {
-	var v ContaminantsType
-	o.Value = v
-	o.Set = false
+// GetEquipment returns the value of Equipment.
+func (s *EquipmentTreeNode) GetEquipment() EquipmentType {
+	return s.Equipment
 }
 
-// SetTo sets value to v.
-func (o *OptContaminantsType) SetTo(v ContaminantsType) {
-	o.Set = true
-	o.Value = v
+// GetChildren returns the value of Children.
+func (s *EquipmentTreeNode) GetChildren() []EquipmentTreeNode {
+	return s.Children
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptContaminantsType) Get() (v ContaminantsType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetEquipment sets the value of Equipment.
+func (s *EquipmentTreeNode) SetEquipment(val EquipmentType) {
+	s.Equipment = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptContaminantsType) Or(d ContaminantsType) ContaminantsType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetChildren sets the value of Children.
+func (s *EquipmentTreeNode) SetChildren(val []EquipmentTreeNode) {
+	s.Children = val
 }
 
-// NewOptCoordinatesType returns new OptCoordinatesType with value set to v.
-func NewOptCoordinatesType(v CoordinatesType) OptCoordinatesType {
-	return OptCoordinatesType{
-		Value: v,
-		Set:   true,
-	}
-}
+func (*EquipmentTreeNode) equipmentIDTreeGetRes() {}
 
-// OptCoordinatesType is optional CoordinatesType.
-type OptCoordinatesType struct {
-	Value CoordinatesType
-	Set   bool
+// Ref: #/components/schemas/EquipmentType
+type EquipmentType struct {
+	EquipmentID        OptString                       `json:"EquipmentID"`
+	EquipmentType      OptString                       `json:"EquipmentType"`
+	Description        OptString                       `json:"Description"`
+	OperatingStatus    OptEquipmentTypeOperatingStatus `json:"OperatingStatus"`
+	Manufacturer       OptString                       `json:"Manufacturer"`
+	Model              OptString                       `json:"Model"`
+	SerialNumber       OptString                       `json:"SerialNumber"`
+	InstallationDate   OptDateTime                     `json:"InstallationDate"`
+	Location           OptLocationType                 `json:"Location"`
+	MaintenanceHistory OptMaintenanceHistoryType       `json:"MaintenanceHistory"`
+	PerformanceData    OptPerformanceDataType          `json:"PerformanceData"`
+	// Свойства оборудования (B2MML EquipmentProperty), кроме
+	// паспортных данных.
+	// Значение проверяется по типу данных и ограничениям
+	// определения свойства в классе.
+	Properties []PropertyValue `json:"Properties"`
+	// Существительное B2MML Equipment в каноническом JSON: элементы
+	// - ключи по
+	// именам элементов (повторяющиеся - массивы), атрибуты -
+	// ключи с префиксом @
+	// (@languageID, @unitCode), текст элемента с атрибутами - ключ #text.
+	// В ответе содержит те же данные, что XML, но без EquipmentChild:
+	// вложенное
+	// оборудование есть только в узлах дерева /equipment/{id}/tree и
+	// в XML.
+	// В запросе задаёт данные, классы и
+	// свойства оборудования (EquipmentType и Description не
+	// используются);
+	// OperatingStatus и значения свойств применяются поверх него.
+	B2MML jx.Raw `json:"B2MML"`
 }
 
-// IsSet returns true if OptCoordinatesType was set.
-func (o OptCoordinatesType) IsSet() bool { return o.Set }
+// GetEquipmentID returns the value of EquipmentID.
+func (s *EquipmentType) GetEquipmentID() OptString {
+	return s.EquipmentID
+}
 
-// Reset unsets value.
-func (o *OptCoordinatesType) Reset() {
-	var v CoordinatesType
-	o.Value = v
-	o.Set = false
+// GetEquipmentType returns the value of EquipmentType.
+func (s *EquipmentType) GetEquipmentType() OptString {
+	return s.EquipmentType
 }
 
-// SetTo sets value to v.
-func (o *OptCoordinatesType) SetTo(v CoordinatesType) {
-	o.Set = true
-	o.Value = v
+// GetDescription returns the value of Description.
+func (s *EquipmentType) GetDescription() OptString {
+	return s.Description
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCoordinatesType) Get() (v CoordinatesType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetOperatingStatus returns the value of OperatingStatus.
+func (s *EquipmentType) GetOperatingStatus() OptEquipmentTypeOperatingStatus {
+	return s.OperatingStatus
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCoordinatesType) Or(d CoordinatesType) CoordinatesType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetManufacturer returns the value of Manufacturer.
+func (s *EquipmentType) GetManufacturer() OptString {
+	return s.Manufacturer
 }
 
-// NewOptDate returns new OptDate with value set to v.
-func NewOptDate(v time.Time) OptDate {
-	return OptDate{
-		Value: v,
-		Set:   true,
-	}
+// GetModel returns the value of Model.
+func (s *EquipmentType) GetModel() OptString {
+	return s.Model
 }
 
-// OptDate is optional time.Time.
-type OptDate struct {
-	Value time.Time
-	Set   bool
+// GetSerialNumber returns the value of SerialNumber.
+func (s *EquipmentType) GetSerialNumber() OptString {
+	return s.SerialNumber
 }
 
-// IsSet returns true if OptDate was set.
-func (o OptDate) IsSet() bool { return o.Set }
+// GetInstallationDate returns the value of InstallationDate.
+func (s *EquipmentType) GetInstallationDate() OptDateTime {
+	return s.InstallationDate
+}
 
-// Reset unsets value.
-func (o *OptDate) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// GetLocation returns the value of Location.
+func (s *EquipmentType) GetLocation() OptLocationType {
+	return s.Location
 }
 
-// SetTo sets value to v.
-func (o *OptDate) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// GetMaintenanceHistory returns the value of MaintenanceHistory.
+func (s *EquipmentType) GetMaintenanceHistory() OptMaintenanceHistoryType {
+	return s.MaintenanceHistory
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDate) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetPerformanceData returns the value of PerformanceData.
+func (s *EquipmentType) GetPerformanceData() OptPerformanceDataType {
+	return s.PerformanceData
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDate) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetProperties returns the value of Properties.
+func (s *EquipmentType) GetProperties() []PropertyValue {
+	return s.Properties
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// GetB2MML returns the value of B2MML.
+func (s *EquipmentType) GetB2MML() jx.Raw {
+	return s.B2MML
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// SetEquipmentID sets the value of EquipmentID.
+func (s *EquipmentType) SetEquipmentID(val OptString) {
+	s.EquipmentID = val
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// SetEquipmentType sets the value of EquipmentType.
+func (s *EquipmentType) SetEquipmentType(val OptString) {
+	s.EquipmentType = val
+}
 
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// SetDescription sets the value of Description.
+func (s *EquipmentType) SetDescription(val OptString) {
+	s.Description = val
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// SetOperatingStatus sets the value of OperatingStatus.
+func (s *EquipmentType) SetOperatingStatus(val OptEquipmentTypeOperatingStatus) {
+	s.OperatingStatus = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetManufacturer sets the value of Manufacturer.
+func (s *EquipmentType) SetManufacturer(val OptString) {
+	s.Manufacturer = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetModel sets the value of Model.
+func (s *EquipmentType) SetModel(val OptString) {
+	s.Model = val
 }
 
-// NewOptElectricalPropertiesType returns new OptElectricalPropertiesType with value set to v.
-func NewOptElectricalPropertiesType(v ElectricalPropertiesType) OptElectricalPropertiesType {
-	return OptElectricalPropertiesType{
-		Value: v,
-		Set:   true,
-	}
+// SetSerialNumber sets the value of SerialNumber.
+func (s *EquipmentType) SetSerialNumber(val OptString) {
+	s.SerialNumber = val
 }
 
-// OptElectricalPropertiesType is optional ElectricalPropertiesType.
-type OptElectricalPropertiesType struct {
-	Value ElectricalPropertiesType
-	Set   bool
+// SetInstallationDate sets the value of InstallationDate.
+func (s *EquipmentType) SetInstallationDate(val OptDateTime) {
+	s.InstallationDate = val
 }
 
-// IsSet returns true if OptElectricalPropertiesType was set.
-func (o OptElectricalPropertiesType) IsSet() bool { return o.Set }
+// SetLocation sets the value of Location.
+func (s *EquipmentType) SetLocation(val OptLocationType) {
+	s.Location = val
+}
 
-// Reset unsets value.
-func (o *OptElectricalPropertiesType) Reset() {
-	var v ElectricalPropertiesType
-	o.Value = v
-	o.Set = false
+// SetMaintenanceHistory sets the value of MaintenanceHistory.
+func (s *EquipmentType) SetMaintenanceHistory(val OptMaintenanceHistoryType) {
+	s.MaintenanceHistory = val
 }
 
-// SetTo sets value to v.
-func (o *OptElectricalPropertiesType) SetTo(v ElectricalPropertiesType) {
-	o.Set = true
-	o.Value = v
+// SetPerformanceData sets the value of PerformanceData.
+func (s *EquipmentType) SetPerformanceData(val OptPerformanceDataType) {
+	s.PerformanceData = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptElectricalPropertiesType) Get() (v ElectricalPropertiesType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetProperties sets the value of Properties.
+func (s *EquipmentType) SetProperties(val []PropertyValue) {
+	s.Properties = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptElectricalPropertiesType) Or(d ElectricalPropertiesType) ElectricalPropertiesType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetB2MML sets the value of B2MML.
+func (s *EquipmentType) SetB2MML(val jx.Raw) {
+	s.B2MML = val
 }
 
-// NewOptFloat64 returns new OptFloat64 with value set to v.
-func NewOptFloat64(v float64) OptFloat64 {
-	return OptFloat64{
-		Value: v,
-		Set:   true,
-	}
+func (*EquipmentType) equipmentIDPutReq() {}
+func (*EquipmentType) equipmentPostReq()  {}
+
+// EquipmentTypeHeaders wraps EquipmentType with response headers.
+type EquipmentTypeHeaders struct {
+	ETag     string
+	Response EquipmentType
 }
 
-// OptFloat64 is optional float64.
-type OptFloat64 struct {
-	Value float64
-	Set   bool
+// GetETag returns the value of ETag.
+func (s *EquipmentTypeHeaders) GetETag() string {
+	return s.ETag
 }
 
-// IsSet returns true if OptFloat64 was set.
-func (o OptFloat64) IsSet() bool { return o.Set }
+// GetResponse returns the value of Response.
+func (s *EquipmentTypeHeaders) GetResponse() EquipmentType {
+	return s.Response
+}
 
-// Reset unsets value.
-func (o *OptFloat64) Reset() {
-	var v float64
-	o.Value = v
-	o.Set = false
+// SetETag sets the value of ETag.
+func (s *EquipmentTypeHeaders) SetETag(val string) {
+	s.ETag = val
 }
 
-// SetTo sets value to v.
-func (o *OptFloat64) SetTo(v float64) {
-	o.Set = true
-	o.Value = v
+// SetResponse sets the value of Response.
+func (s *EquipmentTypeHeaders) SetResponse(val EquipmentType) {
+	s.Response = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFloat64) Get() (v float64, ok bool) {
-	if !o.Set {
-		return v, false
+func (*EquipmentTypeHeaders) equipmentIDGetRes()         {}
+func (*EquipmentTypeHeaders) equipmentIDMovePostRes()    {}
+func (*EquipmentTypeHeaders) equipmentIDPutRes()         {}
+func (*EquipmentTypeHeaders) equipmentIDRestorePostRes() {}
+func (*EquipmentTypeHeaders) equipmentPostRes()          {}
+
+type EquipmentTypeOperatingStatus string
+
+const (
+	EquipmentTypeOperatingStatusActive      EquipmentTypeOperatingStatus = "active"
+	EquipmentTypeOperatingStatusInactive    EquipmentTypeOperatingStatus = "inactive"
+	EquipmentTypeOperatingStatusMaintenance EquipmentTypeOperatingStatus = "maintenance"
+)
+
+// AllValues returns all EquipmentTypeOperatingStatus values.
+func (EquipmentTypeOperatingStatus) AllValues() []EquipmentTypeOperatingStatus {
+	return []EquipmentTypeOperatingStatus{
+		EquipmentTypeOperatingStatusActive,
+		EquipmentTypeOperatingStatusInactive,
+		EquipmentTypeOperatingStatusMaintenance,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFloat64) Or(d float64) float64 {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s EquipmentTypeOperatingStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case EquipmentTypeOperatingStatusActive:
+		return []byte(s), nil
+	case EquipmentTypeOperatingStatusInactive:
+		return []byte(s), nil
+	case EquipmentTypeOperatingStatusMaintenance:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptHierarchyScopeType returns new OptHierarchyScopeType with value set to v.
-func NewOptHierarchyScopeType(v HierarchyScopeType) OptHierarchyScopeType {
-	return OptHierarchyScopeType{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EquipmentTypeOperatingStatus) UnmarshalText(data []byte) error {
+	switch EquipmentTypeOperatingStatus(data) {
+	case EquipmentTypeOperatingStatusActive:
+		*s = EquipmentTypeOperatingStatusActive
+		return nil
+	case EquipmentTypeOperatingStatusInactive:
+		*s = EquipmentTypeOperatingStatusInactive
+		return nil
+	case EquipmentTypeOperatingStatusMaintenance:
+		*s = EquipmentTypeOperatingStatusMaintenance
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptHierarchyScopeType is optional HierarchyScopeType.
-type OptHierarchyScopeType struct {
-	Value HierarchyScopeType
-	Set   bool
-}
+// EventsStreamGetBadRequest is response for EventsStreamGet operation.
+type EventsStreamGetBadRequest struct{}
 
-// IsSet returns true if OptHierarchyScopeType was set.
-func (o OptHierarchyScopeType) IsSet() bool { return o.Set }
+func (*EventsStreamGetBadRequest) eventsStreamGetRes() {}
 
-// Reset unsets value.
-func (o *OptHierarchyScopeType) Reset() {
-	var v HierarchyScopeType
-	o.Value = v
-	o.Set = false
-}
+// EventsStreamGetNotFound is response for EventsStreamGet operation.
+type EventsStreamGetNotFound struct{}
 
-// SetTo sets value to v.
-func (o *OptHierarchyScopeType) SetTo(v HierarchyScopeType) {
-	o.Set = true
-	o.Value = v
-}
+func (*EventsStreamGetNotFound) eventsStreamGetRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptHierarchyScopeType) Get() (v HierarchyScopeType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+type EventsStreamGetOK struct {
+	Data io.Reader
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptHierarchyScopeType) Or(d HierarchyScopeType) HierarchyScopeType {
-	if v, ok := o.Get(); ok {
-		return v
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EventsStreamGetOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
-	return d
+	return s.Data.Read(p)
 }
 
-// NewOptLocationType returns new OptLocationType with value set to v.
-func NewOptLocationType(v LocationType) OptLocationType {
-	return OptLocationType{
-		Value: v,
-		Set:   true,
-	}
+func (*EventsStreamGetOK) eventsStreamGetRes() {}
+
+// EventsStreamGetServiceUnavailable is response for EventsStreamGet operation.
+type EventsStreamGetServiceUnavailable struct{}
+
+func (*EventsStreamGetServiceUnavailable) eventsStreamGetRes() {}
+
+// Изменение поля; old отсутствует у добавленного поля, new
+// - у удалённого.
+// Ref: #/components/schemas/FieldChange
+type FieldChange struct {
+	// Имя поля; поля свойств имеют вид properties.<id>.<поле>.
+	Field string    `json:"field"`
+	Old   OptString `json:"old"`
+	New   OptString `json:"new"`
 }
 
-// OptLocationType is optional LocationType.
-type OptLocationType struct {
-	Value LocationType
-	Set   bool
+// GetField returns the value of Field.
+func (s *FieldChange) GetField() string {
+	return s.Field
 }
 
-// IsSet returns true if OptLocationType was set.
-func (o OptLocationType) IsSet() bool { return o.Set }
+// GetOld returns the value of Old.
+func (s *FieldChange) GetOld() OptString {
+	return s.Old
+}
 
-// Reset unsets value.
-func (o *OptLocationType) Reset() {
-	var v LocationType
-	o.Value = v
-	o.Set = false
+// GetNew returns the value of New.
+func (s *FieldChange) GetNew() OptString {
+	return s.New
 }
 
-// SetTo sets value to v.
-func (o *OptLocationType) SetTo(v LocationType) {
-	o.Set = true
-	o.Value = v
+// SetField sets the value of Field.
+func (s *FieldChange) SetField(val string) {
+	s.Field = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptLocationType) Get() (v LocationType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetOld sets the value of Old.
+func (s *FieldChange) SetOld(val OptString) {
+	s.Old = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptLocationType) Or(d LocationType) LocationType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetNew sets the value of New.
+func (s *FieldChange) SetNew(val OptString) {
+	s.New = val
 }
 
-// NewOptMaintenanceHistoryType returns new OptMaintenanceHistoryType with value set to v.
-func NewOptMaintenanceHistoryType(v MaintenanceHistoryType) OptMaintenanceHistoryType {
-	return OptMaintenanceHistoryType{
-		Value: v,
-		Set:   true,
+// Ref: #/components/schemas/HierarchyScopeType
+type HierarchyScopeType map[string]jx.Raw
+
+func (s *HierarchyScopeType) init() HierarchyScopeType {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
+	return m
 }
 
-// OptMaintenanceHistoryType is optional MaintenanceHistoryType.
-type OptMaintenanceHistoryType struct {
-	Value MaintenanceHistoryType
-	Set   bool
+// Ref: #/components/schemas/ImportJob
+type ImportJob struct {
+	ID uuid.UUID `json:"id"`
+	// Pending - ожидает обработчика, running - выполняется, completed -
+	// все
+	// строки обработаны, failed - прервано ошибкой (см. error) и
+	// может быть продолжено.
+	Status ImportJobStatus `json:"status"`
+	Format ImportJobFormat `json:"format"`
+	// Сопоставление колонок полям оборудования.
+	Mapping   OptImportJobMapping `json:"mapping"`
+	DryRun    bool                `json:"dry_run"`
+	ChunkSize int32               `json:"chunk_size"`
+	// Число обработанных строк источника.
+	RowsProcessed int64 `json:"rows_processed"`
+	// Число строк, создавших оборудование (при dry_run -
+	// прошедших проверку).
+	RowsImported int64 `json:"rows_imported"`
+	ErrorCount   int64 `json:"error_count"`
+	// Первые 1000 ошибок строк.
+	RowErrors []ImportRowError `json:"row_errors"`
+	// Ошибка, прервавшая задание.
+	Error      OptString   `json:"error"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  OptDateTime `json:"updated_at"`
+	FinishedAt OptDateTime `json:"finished_at"`
 }
 
-// IsSet returns true if OptMaintenanceHistoryType was set.
-func (o OptMaintenanceHistoryType) IsSet() bool { return o.Set }
+// GetID returns the value of ID.
+func (s *ImportJob) GetID() uuid.UUID {
+	return s.ID
+}
 
-// Reset unsets value.
-func (o *OptMaintenanceHistoryType) Reset() {
-	var v MaintenanceHistoryType
-	o.Value = v
-	o.Set = false
+// GetStatus returns the value of Status.
+func (s *ImportJob) GetStatus() ImportJobStatus {
+	return s.Status
 }
 
-// SetTo sets value to v.
-func (o *OptMaintenanceHistoryType) SetTo(v MaintenanceHistoryType) {
-	o.Set = true
-	o.Value = v
+// GetFormat returns the value of Format.
+func (s *ImportJob) GetFormat() ImportJobFormat {
+	return s.Format
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMaintenanceHistoryType) Get() (v MaintenanceHistoryType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetMapping returns the value of Mapping.
+func (s *ImportJob) GetMapping() OptImportJobMapping {
+	return s.Mapping
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMaintenanceHistoryType) Or(d MaintenanceHistoryType) MaintenanceHistoryType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetDryRun returns the value of DryRun.
+func (s *ImportJob) GetDryRun() bool {
+	return s.DryRun
 }
 
-// NewOptMaterialCategoryType returns new OptMaterialCategoryType with value set to v.
-func NewOptMaterialCategoryType(v MaterialCategoryType) OptMaterialCategoryType {
-	return OptMaterialCategoryType{
-		Value: v,
-		Set:   true,
-	}
+// GetChunkSize returns the value of ChunkSize.
+func (s *ImportJob) GetChunkSize() int32 {
+	return s.ChunkSize
 }
 
-// OptMaterialCategoryType is optional MaterialCategoryType.
-type OptMaterialCategoryType struct {
-	Value MaterialCategoryType
-	Set   bool
+// GetRowsProcessed returns the value of RowsProcessed.
+func (s *ImportJob) GetRowsProcessed() int64 {
+	return s.RowsProcessed
 }
 
-// IsSet returns true if OptMaterialCategoryType was set.
-func (o OptMaterialCategoryType) IsSet() bool { return o.Set }
+// GetRowsImported returns the value of RowsImported.
+func (s *ImportJob) GetRowsImported() int64 {
+	return s.RowsImported
+}
 
-// Reset unsets value.
-func (o *OptMaterialCategoryType) Reset() {
-	var v MaterialCategoryType
-	o.Value = v
-	o.Set = false
+// GetErrorCount returns the value of ErrorCount.
+func (s *ImportJob) GetErrorCount() int64 {
+	return s.ErrorCount
 }
 
-// SetTo sets value to v.
-func (o *OptMaterialCategoryType) SetTo(v MaterialCategoryType) {
-	o.Set = true
-	o.Value = v
+// GetRowErrors returns the value of RowErrors.
+func (s *ImportJob) GetRowErrors() []ImportRowError {
+	return s.RowErrors
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMaterialCategoryType) Get() (v MaterialCategoryType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetError returns the value of Error.
+func (s *ImportJob) GetError() OptString {
+	return s.Error
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMaterialCategoryType) Or(d MaterialCategoryType) MaterialCategoryType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ImportJob) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// NewOptMaterialCompositionType returns new OptMaterialCompositionType with value set to v.
-func NewOptMaterialCompositionType(v MaterialCompositionType) OptMaterialCompositionType {
-	return OptMaterialCompositionType{
-		Value: v,
-		Set:   true,
-	}
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ImportJob) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// OptMaterialCompositionType is optional MaterialCompositionType.
-type OptMaterialCompositionType struct {
-	Value MaterialCompositionType
-	Set   bool
+// GetFinishedAt returns the value of FinishedAt.
+func (s *ImportJob) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
 }
 
-// IsSet returns true if OptMaterialCompositionType was set.
-func (o OptMaterialCompositionType) IsSet() bool { return o.Set }
+// SetID sets the value of ID.
+func (s *ImportJob) SetID(val uuid.UUID) {
+	s.ID = val
+}
 
-// Reset unsets value.
-func (o *OptMaterialCompositionType) Reset() {
-	var v MaterialCompositionType
-	o.Value = v
-	o.Set = false
+// SetStatus sets the value of Status.
+func (s *ImportJob) SetStatus(val ImportJobStatus) {
+	s.Status = val
 }
 
-// SetTo sets value to v.
-func (o *OptMaterialCompositionType) SetTo(v MaterialCompositionType) {
-	o.Set = true
-	o.Value = v
+// SetFormat sets the value of Format.
+func (s *ImportJob) SetFormat(val ImportJobFormat) {
+	s.Format = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMaterialCompositionType) Get() (v MaterialCompositionType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetMapping sets the value of Mapping.
+func (s *ImportJob) SetMapping(val OptImportJobMapping) {
+	s.Mapping = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMaterialCompositionType) Or(d MaterialCompositionType) MaterialCompositionType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetDryRun sets the value of DryRun.
+func (s *ImportJob) SetDryRun(val bool) {
+	s.DryRun = val
 }
 
-// NewOptMaterialPropertiesType returns new OptMaterialPropertiesType with value set to v.
-func NewOptMaterialPropertiesType(v MaterialPropertiesType) OptMaterialPropertiesType {
-	return OptMaterialPropertiesType{
-		Value: v,
-		Set:   true,
-	}
+// SetChunkSize sets the value of ChunkSize.
+func (s *ImportJob) SetChunkSize(val int32) {
+	s.ChunkSize = val
 }
 
-// OptMaterialPropertiesType is optional MaterialPropertiesType.
-type OptMaterialPropertiesType struct {
-	Value MaterialPropertiesType
-	Set   bool
+// SetRowsProcessed sets the value of RowsProcessed.
+func (s *ImportJob) SetRowsProcessed(val int64) {
+	s.RowsProcessed = val
 }
 
-// IsSet returns true if OptMaterialPropertiesType was set.
-func (o OptMaterialPropertiesType) IsSet() bool { return o.Set }
+// SetRowsImported sets the value of RowsImported.
+func (s *ImportJob) SetRowsImported(val int64) {
+	s.RowsImported = val
+}
 
-// Reset unsets value.
-func (o *OptMaterialPropertiesType) Reset() {
-	var v MaterialPropertiesType
-	o.Value = v
-	o.Set = false
+// SetErrorCount sets the value of ErrorCount.
+func (s *ImportJob) SetErrorCount(val int64) {
+	s.ErrorCount = val
 }
 
-// SetTo sets value to v.
-func (o *OptMaterialPropertiesType) SetTo(v MaterialPropertiesType) {
-	o.Set = true
-	o.Value = v
+// SetRowErrors sets the value of RowErrors.
+func (s *ImportJob) SetRowErrors(val []ImportRowError) {
+	s.RowErrors = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMaterialPropertiesType) Get() (v MaterialPropertiesType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetError sets the value of Error.
+func (s *ImportJob) SetError(val OptString) {
+	s.Error = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMaterialPropertiesType) Or(d MaterialPropertiesType) MaterialPropertiesType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ImportJob) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// NewOptMaterialSourceType returns new OptMaterialSourceType with value set to v.
-func NewOptMaterialSourceType(v MaterialSourceType) OptMaterialSourceType {
-	return OptMaterialSourceType{
-		Value: v,
-		Set:   true,
-	}
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ImportJob) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// OptMaterialSourceType is optional MaterialSourceType.
-type OptMaterialSourceType struct {
-	Value MaterialSourceType
-	Set   bool
+// SetFinishedAt sets the value of FinishedAt.
+func (s *ImportJob) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
 }
 
-// IsSet returns true if OptMaterialSourceType was set.
-func (o OptMaterialSourceType) IsSet() bool { return o.Set }
+func (*ImportJob) equipmentImportsImportIdGetRes()        {}
+func (*ImportJob) equipmentImportsImportIdResumePostRes() {}
+func (*ImportJob) equipmentImportsPostRes()               {}
 
-// Reset unsets value.
-func (o *OptMaterialSourceType) Reset() {
-	var v MaterialSourceType
-	o.Value = v
-	o.Set = false
-}
+type ImportJobFormat string
 
-// SetTo sets value to v.
-func (o *OptMaterialSourceType) SetTo(v MaterialSourceType) {
-	o.Set = true
-	o.Value = v
-}
+const (
+	ImportJobFormatCsv   ImportJobFormat = "csv"
+	ImportJobFormatJsonl ImportJobFormat = "jsonl"
+)
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMaterialSourceType) Get() (v MaterialSourceType, ok bool) {
-	if !o.Set {
-		return v, false
+// AllValues returns all ImportJobFormat values.
+func (ImportJobFormat) AllValues() []ImportJobFormat {
+	return []ImportJobFormat{
+		ImportJobFormatCsv,
+		ImportJobFormatJsonl,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMaterialSourceType) Or(d MaterialSourceType) MaterialSourceType {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s ImportJobFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case ImportJobFormatCsv:
+		return []byte(s), nil
+	case ImportJobFormatJsonl:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptMaterialUsageType returns new OptMaterialUsageType with value set to v.
-func NewOptMaterialUsageType(v MaterialUsageType) OptMaterialUsageType {
-	return OptMaterialUsageType{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ImportJobFormat) UnmarshalText(data []byte) error {
+	switch ImportJobFormat(data) {
+	case ImportJobFormatCsv:
+		*s = ImportJobFormatCsv
+		return nil
+	case ImportJobFormatJsonl:
+		*s = ImportJobFormatJsonl
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptMaterialUsageType is optional MaterialUsageType.
-type OptMaterialUsageType struct {
-	Value MaterialUsageType
-	Set   bool
-}
-
-// IsSet returns true if OptMaterialUsageType was set.
-func (o OptMaterialUsageType) IsSet() bool { return o.Set }
+// Сопоставление колонок полям оборудования.
+type ImportJobMapping map[string]string
 
-// Reset unsets value.
-func (o *OptMaterialUsageType) Reset() {
-	var v MaterialUsageType
-	o.Value = v
-	o.Set = false
+func (s *ImportJobMapping) init() ImportJobMapping {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
 }
 
-// SetTo sets value to v.
-func (o *OptMaterialUsageType) SetTo(v MaterialUsageType) {
-	o.Set = true
-	o.Value = v
-}
+// Pending - ожидает обработчика, running - выполняется, completed -
+// все
+// строки обработаны, failed - прервано ошибкой (см. error) и
+// может быть продолжено.
+type ImportJobStatus string
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMaterialUsageType) Get() (v MaterialUsageType, ok bool) {
-	if !o.Set {
-		return v, false
+const (
+	ImportJobStatusPending   ImportJobStatus = "pending"
+	ImportJobStatusRunning   ImportJobStatus = "running"
+	ImportJobStatusCompleted ImportJobStatus = "completed"
+	ImportJobStatusFailed    ImportJobStatus = "failed"
+)
+
+// AllValues returns all ImportJobStatus values.
+func (ImportJobStatus) AllValues() []ImportJobStatus {
+	return []ImportJobStatus{
+		ImportJobStatusPending,
+		ImportJobStatusRunning,
+		ImportJobStatusCompleted,
+		ImportJobStatusFailed,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMaterialUsageType) Or(d MaterialUsageType) MaterialUsageType {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s ImportJobStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ImportJobStatusPending:
+		return []byte(s), nil
+	case ImportJobStatusRunning:
+		return []byte(s), nil
+	case ImportJobStatusCompleted:
+		return []byte(s), nil
+	case ImportJobStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptMechanicalPropertiesType returns new OptMechanicalPropertiesType with value set to v.
-func NewOptMechanicalPropertiesType(v MechanicalPropertiesType) OptMechanicalPropertiesType {
-	return OptMechanicalPropertiesType{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ImportJobStatus) UnmarshalText(data []byte) error {
+	switch ImportJobStatus(data) {
+	case ImportJobStatusPending:
+		*s = ImportJobStatusPending
+		return nil
+	case ImportJobStatusRunning:
+		*s = ImportJobStatusRunning
+		return nil
+	case ImportJobStatusCompleted:
+		*s = ImportJobStatusCompleted
+		return nil
+	case ImportJobStatusFailed:
+		*s = ImportJobStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptMechanicalPropertiesType is optional MechanicalPropertiesType.
-type OptMechanicalPropertiesType struct {
-	Value MechanicalPropertiesType
-	Set   bool
+// Ref: #/components/schemas/ImportRowError
+type ImportRowError struct {
+	// Номер строки данных с 1.
+	Row int64 `json:"row"`
+	// Номер строки файла.
+	Line        OptInt64  `json:"line"`
+	EquipmentID OptString `json:"equipment_id"`
+	// Колонка источника, к которой относится ошибка.
+	Column  OptString `json:"column"`
+	Message string    `json:"message"`
 }
 
-// IsSet returns true if OptMechanicalPropertiesType was set.
-func (o OptMechanicalPropertiesType) IsSet() bool { return o.Set }
+// GetRow returns the value of Row.
+func (s *ImportRowError) GetRow() int64 {
+	return s.Row
+}
 
-// Reset unsets value.
-func (o *OptMechanicalPropertiesType) Reset() {
-	var v MechanicalPropertiesType
-	o.Value = v
-	o.Set = false
+// GetLine returns the value of Line.
+func (s *ImportRowError) GetLine() OptInt64 {
+	return s.Line
 }
 
-// SetTo sets value to v.
-func (o *OptMechanicalPropertiesType) SetTo(v MechanicalPropertiesType) {
-	o.Set = true
-	o.Value = v
+// GetEquipmentID returns the value of EquipmentID.
+func (s *ImportRowError) GetEquipmentID() OptString {
+	return s.EquipmentID
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMechanicalPropertiesType) Get() (v MechanicalPropertiesType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetColumn returns the value of Column.
+func (s *ImportRowError) GetColumn() OptString {
+	return s.Column
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMechanicalPropertiesType) Or(d MechanicalPropertiesType) MechanicalPropertiesType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetMessage returns the value of Message.
+func (s *ImportRowError) GetMessage() string {
+	return s.Message
 }
 
-// NewOptPerformanceDataType returns new OptPerformanceDataType with value set to v.
-func NewOptPerformanceDataType(v PerformanceDataType) OptPerformanceDataType {
-	return OptPerformanceDataType{
-		Value: v,
-		Set:   true,
-	}
+// SetRow sets the value of Row.
+func (s *ImportRowError) SetRow(val int64) {
+	s.Row = val
 }
 
-// OptPerformanceDataType is optional PerformanceDataType.
-type OptPerformanceDataType struct {
-	Value PerformanceDataType
-	Set   bool
+// SetLine sets the value of Line.
+func (s *ImportRowError) SetLine(val OptInt64) {
+	s.Line = val
 }
 
-// IsSet returns true if OptPerformanceDataType was set.
-func (o OptPerformanceDataType) IsSet() bool { return o.Set }
+// SetEquipmentID sets the value of EquipmentID.
+func (s *ImportRowError) SetEquipmentID(val OptString) {
+	s.EquipmentID = val
+}
 
-// Reset unsets value.
-func (o *OptPerformanceDataType) Reset() {
-	var v PerformanceDataType
-	o.Value = v
-	o.Set = false
+// SetColumn sets the value of Column.
+func (s *ImportRowError) SetColumn(val OptString) {
+	s.Column = val
 }
 
-// SetTo sets value to v.
-func (o *OptPerformanceDataType) SetTo(v PerformanceDataType) {
-	o.Set = true
-	o.Value = v
+// SetMessage sets the value of Message.
+func (s *ImportRowError) SetMessage(val string) {
+	s.Message = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptPerformanceDataType) Get() (v PerformanceDataType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// Ref: #/components/schemas/LocationType
+type LocationType struct {
+	SiteID      OptString          `json:"SiteID"`
+	BuildingID  OptString          `json:"BuildingID"`
+	FloorID     OptString          `json:"FloorID"`
+	AreaID      OptString          `json:"AreaID"`
+	Coordinates OptCoordinatesType `json:"Coordinates"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptPerformanceDataType) Or(d PerformanceDataType) PerformanceDataType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetSiteID returns the value of SiteID.
+func (s *LocationType) GetSiteID() OptString {
+	return s.SiteID
 }
 
-// NewOptPhysicalPropertiesType returns new OptPhysicalPropertiesType with value set to v.
-func NewOptPhysicalPropertiesType(v PhysicalPropertiesType) OptPhysicalPropertiesType {
-	return OptPhysicalPropertiesType{
-		Value: v,
-		Set:   true,
-	}
+// GetBuildingID returns the value of BuildingID.
+func (s *LocationType) GetBuildingID() OptString {
+	return s.BuildingID
 }
 
-// OptPhysicalPropertiesType is optional PhysicalPropertiesType.
-type OptPhysicalPropertiesType struct {
-	Value PhysicalPropertiesType
-	Set   bool
+// GetFloorID returns the value of FloorID.
+func (s *LocationType) GetFloorID() OptString {
+	return s.FloorID
 }
 
-// IsSet returns true if OptPhysicalPropertiesType was set.
-func (o OptPhysicalPropertiesType) IsSet() bool { return o.Set }
+// GetAreaID returns the value of AreaID.
+func (s *LocationType) GetAreaID() OptString {
+	return s.AreaID
+}
 
-// Reset unsets value.
-func (o *OptPhysicalPropertiesType) Reset() {
-	var v PhysicalPropertiesType
-	o.Value = v
-	o.Set = false
+// GetCoordinates returns the value of Coordinates.
+func (s *LocationType) GetCoordinates() OptCoordinatesType {
+	return s.Coordinates
 }
 
-// SetTo sets value to v.
-func (o *OptPhysicalPropertiesType) SetTo(v PhysicalPropertiesType) {
-	o.Set = true
-	o.Value = v
+// SetSiteID sets the value of SiteID.
+func (s *LocationType) SetSiteID(val OptString) {
+	s.SiteID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptPhysicalPropertiesType) Get() (v PhysicalPropertiesType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetBuildingID sets the value of BuildingID.
+func (s *LocationType) SetBuildingID(val OptString) {
+	s.BuildingID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptPhysicalPropertiesType) Or(d PhysicalPropertiesType) PhysicalPropertiesType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetFloorID sets the value of FloorID.
+func (s *LocationType) SetFloorID(val OptString) {
+	s.FloorID = val
 }
 
-// NewOptRecordTypeRecordData returns new OptRecordTypeRecordData with value set to v.
-func NewOptRecordTypeRecordData(v RecordTypeRecordData) OptRecordTypeRecordData {
-	return OptRecordTypeRecordData{
-		Value: v,
-		Set:   true,
-	}
+// SetAreaID sets the value of AreaID.
+func (s *LocationType) SetAreaID(val OptString) {
+	s.AreaID = val
 }
 
-// OptRecordTypeRecordData is optional RecordTypeRecordData.
-type OptRecordTypeRecordData struct {
-	Value RecordTypeRecordData
-	Set   bool
+// SetCoordinates sets the value of Coordinates.
+func (s *LocationType) SetCoordinates(val OptCoordinatesType) {
+	s.Coordinates = val
 }
 
-// IsSet returns true if OptRecordTypeRecordData was set.
-func (o OptRecordTypeRecordData) IsSet() bool { return o.Set }
+// Ref: #/components/schemas/MaintenanceEventType
+type MaintenanceEventType struct {
+	EventID       OptString   `json:"EventID"`
+	EventDate     OptDateTime `json:"EventDate"`
+	Description   OptString   `json:"Description"`
+	PerformedBy   OptString   `json:"PerformedBy"`
+	PartsReplaced OptString   `json:"PartsReplaced"`
+	DowntimeHours OptFloat64  `json:"DowntimeHours"`
+}
 
-// Reset unsets value.
-func (o *OptRecordTypeRecordData) Reset() {
-	var v RecordTypeRecordData
-	o.Value = v
-	o.Set = false
+// GetEventID returns the value of EventID.
+func (s *MaintenanceEventType) GetEventID() OptString {
+	return s.EventID
 }
 
-// SetTo sets value to v.
-func (o *OptRecordTypeRecordData) SetTo(v RecordTypeRecordData) {
-	o.Set = true
-	o.Value = v
+// GetEventDate returns the value of EventDate.
+func (s *MaintenanceEventType) GetEventDate() OptDateTime {
+	return s.EventDate
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptRecordTypeRecordData) Get() (v RecordTypeRecordData, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetDescription returns the value of Description.
+func (s *MaintenanceEventType) GetDescription() OptString {
+	return s.Description
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptRecordTypeRecordData) Or(d RecordTypeRecordData) RecordTypeRecordData {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetPerformedBy returns the value of PerformedBy.
+func (s *MaintenanceEventType) GetPerformedBy() OptString {
+	return s.PerformedBy
 }
 
-// NewOptRegulatoryInformationType returns new OptRegulatoryInformationType with value set to v.
-func NewOptRegulatoryInformationType(v RegulatoryInformationType) OptRegulatoryInformationType {
+// GetPartsReplaced returns the value of PartsReplaced.
+func (s *MaintenanceEventType) GetPartsReplaced() OptString {
+	return s.PartsReplaced
+}
+
+// GetDowntimeHours returns the value of DowntimeHours.
+func (s *MaintenanceEventType) GetDowntimeHours() OptFloat64 {
+	return s.DowntimeHours
+}
+
+// SetEventID sets the value of EventID.
+func (s *MaintenanceEventType) SetEventID(val OptString) {
+	s.EventID = val
+}
+
+// SetEventDate sets the value of EventDate.
+func (s *MaintenanceEventType) SetEventDate(val OptDateTime) {
+	s.EventDate = val
+}
+
+// SetDescription sets the value of Description.
+func (s *MaintenanceEventType) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetPerformedBy sets the value of PerformedBy.
+func (s *MaintenanceEventType) SetPerformedBy(val OptString) {
+	s.PerformedBy = val
+}
+
+// SetPartsReplaced sets the value of PartsReplaced.
+func (s *MaintenanceEventType) SetPartsReplaced(val OptString) {
+	s.PartsReplaced = val
+}
+
+// SetDowntimeHours sets the value of DowntimeHours.
+func (s *MaintenanceEventType) SetDowntimeHours(val OptFloat64) {
+	s.DowntimeHours = val
+}
+
+// Ref: #/components/schemas/MaintenanceHistoryType
+type MaintenanceHistoryType struct {
+	MaintenanceEvent []MaintenanceEventType `json:"MaintenanceEvent"`
+}
+
+// GetMaintenanceEvent returns the value of MaintenanceEvent.
+func (s *MaintenanceHistoryType) GetMaintenanceEvent() []MaintenanceEventType {
+	return s.MaintenanceEvent
+}
+
+// SetMaintenanceEvent sets the value of MaintenanceEvent.
+func (s *MaintenanceHistoryType) SetMaintenanceEvent(val []MaintenanceEventType) {
+	s.MaintenanceEvent = val
+}
+
+// Ref: #/components/schemas/MaterialCategoryType
+type MaterialCategoryType struct {
+	CategoryCode        OptString `json:"CategoryCode"`
+	CategoryDescription OptString `json:"CategoryDescription"`
+}
+
+// GetCategoryCode returns the value of CategoryCode.
+func (s *MaterialCategoryType) GetCategoryCode() OptString {
+	return s.CategoryCode
+}
+
+// GetCategoryDescription returns the value of CategoryDescription.
+func (s *MaterialCategoryType) GetCategoryDescription() OptString {
+	return s.CategoryDescription
+}
+
+// SetCategoryCode sets the value of CategoryCode.
+func (s *MaterialCategoryType) SetCategoryCode(val OptString) {
+	s.CategoryCode = val
+}
+
+// SetCategoryDescription sets the value of CategoryDescription.
+func (s *MaterialCategoryType) SetCategoryDescription(val OptString) {
+	s.CategoryDescription = val
+}
+
+// Ref: #/components/schemas/MaterialCompositionType
+type MaterialCompositionType struct {
+	BaseMaterial OptString           `json:"BaseMaterial"`
+	Additives    OptAdditivesType    `json:"Additives"`
+	Contaminants OptContaminantsType `json:"Contaminants"`
+}
+
+// GetBaseMaterial returns the value of BaseMaterial.
+func (s *MaterialCompositionType) GetBaseMaterial() OptString {
+	return s.BaseMaterial
+}
+
+// GetAdditives returns the value of Additives.
+func (s *MaterialCompositionType) GetAdditives() OptAdditivesType {
+	return s.Additives
+}
+
+// GetContaminants returns the value of Contaminants.
+func (s *MaterialCompositionType) GetContaminants() OptContaminantsType {
+	return s.Contaminants
+}
+
+// SetBaseMaterial sets the value of BaseMaterial.
+func (s *MaterialCompositionType) SetBaseMaterial(val OptString) {
+	s.BaseMaterial = val
+}
+
+// SetAdditives sets the value of Additives.
+func (s *MaterialCompositionType) SetAdditives(val OptAdditivesType) {
+	s.Additives = val
+}
+
+// SetContaminants sets the value of Contaminants.
+func (s *MaterialCompositionType) SetContaminants(val OptContaminantsType) {
+	s.Contaminants = val
+}
+
+// Ref: #/components/schemas/MaterialPropertiesType
+type MaterialPropertiesType struct {
+	PhysicalProperties   OptPhysicalPropertiesType   `json:"PhysicalProperties"`
+	ChemicalProperties   OptChemicalPropertiesType   `json:"ChemicalProperties"`
+	MechanicalProperties OptMechanicalPropertiesType `json:"MechanicalProperties"`
+	ThermalProperties    OptThermalPropertiesType    `json:"ThermalProperties"`
+	ElectricalProperties OptElectricalPropertiesType `json:"ElectricalProperties"`
+}
+
+// GetPhysicalProperties returns the value of PhysicalProperties.
+func (s *MaterialPropertiesType) GetPhysicalProperties() OptPhysicalPropertiesType {
+	return s.PhysicalProperties
+}
+
+// GetChemicalProperties returns the value of ChemicalProperties.
+func (s *MaterialPropertiesType) GetChemicalProperties() OptChemicalPropertiesType {
+	return s.ChemicalProperties
+}
+
+// GetMechanicalProperties returns the value of MechanicalProperties.
+func (s *MaterialPropertiesType) GetMechanicalProperties() OptMechanicalPropertiesType {
+	return s.MechanicalProperties
+}
+
+// GetThermalProperties returns the value of ThermalProperties.
+func (s *MaterialPropertiesType) GetThermalProperties() OptThermalPropertiesType {
+	return s.ThermalProperties
+}
+
+// GetElectricalProperties returns the value of ElectricalProperties.
+func (s *MaterialPropertiesType) GetElectricalProperties() OptElectricalPropertiesType {
+	return s.ElectricalProperties
+}
+
+// SetPhysicalProperties sets the value of PhysicalProperties.
+func (s *MaterialPropertiesType) SetPhysicalProperties(val OptPhysicalPropertiesType) {
+	s.PhysicalProperties = val
+}
+
+// SetChemicalProperties sets the value of ChemicalProperties.
+func (s *MaterialPropertiesType) SetChemicalProperties(val OptChemicalPropertiesType) {
+	s.ChemicalProperties = val
+}
+
+// SetMechanicalProperties sets the value of MechanicalProperties.
+func (s *MaterialPropertiesType) SetMechanicalProperties(val OptMechanicalPropertiesType) {
+	s.MechanicalProperties = val
+}
+
+// SetThermalProperties sets the value of ThermalProperties.
+func (s *MaterialPropertiesType) SetThermalProperties(val OptThermalPropertiesType) {
+	s.ThermalProperties = val
+}
+
+// SetElectricalProperties sets the value of ElectricalProperties.
+func (s *MaterialPropertiesType) SetElectricalProperties(val OptElectricalPropertiesType) {
+	s.ElectricalProperties = val
+}
+
+// Ref: #/components/schemas/MaterialSourceType
+type MaterialSourceType struct {
+	SourceID        OptString `json:"SourceID"`
+	SourceName      OptString `json:"SourceName"`
+	SourceLocation  OptString `json:"SourceLocation"`
+	SupplyChainStep OptString `json:"SupplyChainStep"`
+}
+
+// GetSourceID returns the value of SourceID.
+func (s *MaterialSourceType) GetSourceID() OptString {
+	return s.SourceID
+}
+
+// GetSourceName returns the value of SourceName.
+func (s *MaterialSourceType) GetSourceName() OptString {
+	return s.SourceName
+}
+
+// GetSourceLocation returns the value of SourceLocation.
+func (s *MaterialSourceType) GetSourceLocation() OptString {
+	return s.SourceLocation
+}
+
+// GetSupplyChainStep returns the value of SupplyChainStep.
+func (s *MaterialSourceType) GetSupplyChainStep() OptString {
+	return s.SupplyChainStep
+}
+
+// SetSourceID sets the value of SourceID.
+func (s *MaterialSourceType) SetSourceID(val OptString) {
+	s.SourceID = val
+}
+
+// SetSourceName sets the value of SourceName.
+func (s *MaterialSourceType) SetSourceName(val OptString) {
+	s.SourceName = val
+}
+
+// SetSourceLocation sets the value of SourceLocation.
+func (s *MaterialSourceType) SetSourceLocation(val OptString) {
+	s.SourceLocation = val
+}
+
+// SetSupplyChainStep sets the value of SupplyChainStep.
+func (s *MaterialSourceType) SetSupplyChainStep(val OptString) {
+	s.SupplyChainStep = val
+}
+
+// Ref: #/components/schemas/MaterialType
+type MaterialType struct {
+	MaterialID                   OptString                      `json:"MaterialID"`
+	MaterialDescription          OptString                      `json:"MaterialDescription"`
+	MaterialTypeCode             OptString                      `json:"MaterialTypeCode"`
+	MaterialCategory             OptMaterialCategoryType        `json:"MaterialCategory"`
+	MaterialProperties           OptMaterialPropertiesType      `json:"MaterialProperties"`
+	MaterialComposition          OptMaterialCompositionType     `json:"MaterialComposition"`
+	MaterialSource               OptMaterialSourceType          `json:"MaterialSource"`
+	MaterialUsage                OptMaterialUsageType           `json:"MaterialUsage"`
+	MaterialHandlingInstructions OptString                      `json:"MaterialHandlingInstructions"`
+	RegulatoryInformation        OptRegulatoryInformationType   `json:"RegulatoryInformation"`
+	TraceabilityInformation      OptTraceabilityInformationType `json:"TraceabilityInformation"`
+}
+
+// GetMaterialID returns the value of MaterialID.
+func (s *MaterialType) GetMaterialID() OptString {
+	return s.MaterialID
+}
+
+// GetMaterialDescription returns the value of MaterialDescription.
+func (s *MaterialType) GetMaterialDescription() OptString {
+	return s.MaterialDescription
+}
+
+// GetMaterialTypeCode returns the value of MaterialTypeCode.
+func (s *MaterialType) GetMaterialTypeCode() OptString {
+	return s.MaterialTypeCode
+}
+
+// GetMaterialCategory returns the value of MaterialCategory.
+func (s *MaterialType) GetMaterialCategory() OptMaterialCategoryType {
+	return s.MaterialCategory
+}
+
+// GetMaterialProperties returns the value of MaterialProperties.
+func (s *MaterialType) GetMaterialProperties() OptMaterialPropertiesType {
+	return s.MaterialProperties
+}
+
+// GetMaterialComposition returns the value of MaterialComposition.
+func (s *MaterialType) GetMaterialComposition() OptMaterialCompositionType {
+	return s.MaterialComposition
+}
+
+// GetMaterialSource returns the value of MaterialSource.
+func (s *MaterialType) GetMaterialSource() OptMaterialSourceType {
+	return s.MaterialSource
+}
+
+// GetMaterialUsage returns the value of MaterialUsage.
+func (s *MaterialType) GetMaterialUsage() OptMaterialUsageType {
+	return s.MaterialUsage
+}
+
+// GetMaterialHandlingInstructions returns the value of MaterialHandlingInstructions.
+func (s *MaterialType) GetMaterialHandlingInstructions() OptString {
+	return s.MaterialHandlingInstructions
+}
+
+// GetRegulatoryInformation returns the value of RegulatoryInformation.
+func (s *MaterialType) GetRegulatoryInformation() OptRegulatoryInformationType {
+	return s.RegulatoryInformation
+}
+
+// GetTraceabilityInformation returns the value of TraceabilityInformation.
+func (s *MaterialType) GetTraceabilityInformation() OptTraceabilityInformationType {
+	return s.TraceabilityInformation
+}
+
+// SetMaterialID sets the value of MaterialID.
+func (s *MaterialType) SetMaterialID(val OptString) {
+	s.MaterialID = val
+}
+
+// SetMaterialDescription sets the value of MaterialDescription.
+func (s *MaterialType) SetMaterialDescription(val OptString) {
+	s.MaterialDescription = val
+}
+
+// SetMaterialTypeCode sets the value of MaterialTypeCode.
+func (s *MaterialType) SetMaterialTypeCode(val OptString) {
+	s.MaterialTypeCode = val
+}
+
+// SetMaterialCategory sets the value of MaterialCategory.
+func (s *MaterialType) SetMaterialCategory(val OptMaterialCategoryType) {
+	s.MaterialCategory = val
+}
+
+// SetMaterialProperties sets the value of MaterialProperties.
+func (s *MaterialType) SetMaterialProperties(val OptMaterialPropertiesType) {
+	s.MaterialProperties = val
+}
+
+// SetMaterialComposition sets the value of MaterialComposition.
+func (s *MaterialType) SetMaterialComposition(val OptMaterialCompositionType) {
+	s.MaterialComposition = val
+}
+
+// SetMaterialSource sets the value of MaterialSource.
+func (s *MaterialType) SetMaterialSource(val OptMaterialSourceType) {
+	s.MaterialSource = val
+}
+
+// SetMaterialUsage sets the value of MaterialUsage.
+func (s *MaterialType) SetMaterialUsage(val OptMaterialUsageType) {
+	s.MaterialUsage = val
+}
+
+// SetMaterialHandlingInstructions sets the value of MaterialHandlingInstructions.
+func (s *MaterialType) SetMaterialHandlingInstructions(val OptString) {
+	s.MaterialHandlingInstructions = val
+}
+
+// SetRegulatoryInformation sets the value of RegulatoryInformation.
+func (s *MaterialType) SetRegulatoryInformation(val OptRegulatoryInformationType) {
+	s.RegulatoryInformation = val
+}
+
+// SetTraceabilityInformation sets the value of TraceabilityInformation.
+func (s *MaterialType) SetTraceabilityInformation(val OptTraceabilityInformationType) {
+	s.TraceabilityInformation = val
+}
+
+// Ref: #/components/schemas/MaterialUsageType
+type MaterialUsageType struct {
+	IntendedUse       OptString  `json:"IntendedUse"`
+	ApplicationMethod OptString  `json:"ApplicationMethod"`
+	QuantityRequired  OptFloat64 `json:"QuantityRequired"`
+	UnitOfMeasure     OptString  `json:"UnitOfMeasure"`
+}
+
+// GetIntendedUse returns the value of IntendedUse.
+func (s *MaterialUsageType) GetIntendedUse() OptString {
+	return s.IntendedUse
+}
+
+// GetApplicationMethod returns the value of ApplicationMethod.
+func (s *MaterialUsageType) GetApplicationMethod() OptString {
+	return s.ApplicationMethod
+}
+
+// GetQuantityRequired returns the value of QuantityRequired.
+func (s *MaterialUsageType) GetQuantityRequired() OptFloat64 {
+	return s.QuantityRequired
+}
+
+// GetUnitOfMeasure returns the value of UnitOfMeasure.
+func (s *MaterialUsageType) GetUnitOfMeasure() OptString {
+	return s.UnitOfMeasure
+}
+
+// SetIntendedUse sets the value of IntendedUse.
+func (s *MaterialUsageType) SetIntendedUse(val OptString) {
+	s.IntendedUse = val
+}
+
+// SetApplicationMethod sets the value of ApplicationMethod.
+func (s *MaterialUsageType) SetApplicationMethod(val OptString) {
+	s.ApplicationMethod = val
+}
+
+// SetQuantityRequired sets the value of QuantityRequired.
+func (s *MaterialUsageType) SetQuantityRequired(val OptFloat64) {
+	s.QuantityRequired = val
+}
+
+// SetUnitOfMeasure sets the value of UnitOfMeasure.
+func (s *MaterialUsageType) SetUnitOfMeasure(val OptString) {
+	s.UnitOfMeasure = val
+}
+
+// MaterialsPostCreated is response for MaterialsPost operation.
+type MaterialsPostCreated struct{}
+
+// Ref: #/components/schemas/MechanicalPropertiesType
+type MechanicalPropertiesType struct {
+	TensileStrength OptFloat64 `json:"TensileStrength"`
+	YieldStrength   OptFloat64 `json:"YieldStrength"`
+	Elongation      OptFloat64 `json:"Elongation"`
+	Hardness        OptString  `json:"Hardness"`
+}
+
+// GetTensileStrength returns the value of TensileStrength.
+func (s *MechanicalPropertiesType) GetTensileStrength() OptFloat64 {
+	return s.TensileStrength
+}
+
+// GetYieldStrength returns the value of YieldStrength.
+func (s *MechanicalPropertiesType) GetYieldStrength() OptFloat64 {
+	return s.YieldStrength
+}
+
+// GetElongation returns the value of Elongation.
+func (s *MechanicalPropertiesType) GetElongation() OptFloat64 {
+	return s.Elongation
+}
+
+// GetHardness returns the value of Hardness.
+func (s *MechanicalPropertiesType) GetHardness() OptString {
+	return s.Hardness
+}
+
+// SetTensileStrength sets the value of TensileStrength.
+func (s *MechanicalPropertiesType) SetTensileStrength(val OptFloat64) {
+	s.TensileStrength = val
+}
+
+// SetYieldStrength sets the value of YieldStrength.
+func (s *MechanicalPropertiesType) SetYieldStrength(val OptFloat64) {
+	s.YieldStrength = val
+}
+
+// SetElongation sets the value of Elongation.
+func (s *MechanicalPropertiesType) SetElongation(val OptFloat64) {
+	s.Elongation = val
+}
+
+// SetHardness sets the value of Hardness.
+func (s *MechanicalPropertiesType) SetHardness(val OptString) {
+	s.Hardness = val
+}
+
+// Ref: #/components/schemas/MetricType
+type MetricType struct {
+	MetricName    OptString   `json:"MetricName"`
+	Value         OptFloat64  `json:"Value"`
+	UnitOfMeasure OptString   `json:"UnitOfMeasure"`
+	Timestamp     OptDateTime `json:"Timestamp"`
+}
+
+// GetMetricName returns the value of MetricName.
+func (s *MetricType) GetMetricName() OptString {
+	return s.MetricName
+}
+
+// GetValue returns the value of Value.
+func (s *MetricType) GetValue() OptFloat64 {
+	return s.Value
+}
+
+// GetUnitOfMeasure returns the value of UnitOfMeasure.
+func (s *MetricType) GetUnitOfMeasure() OptString {
+	return s.UnitOfMeasure
+}
+
+// GetTimestamp returns the value of Timestamp.
+func (s *MetricType) GetTimestamp() OptDateTime {
+	return s.Timestamp
+}
+
+// SetMetricName sets the value of MetricName.
+func (s *MetricType) SetMetricName(val OptString) {
+	s.MetricName = val
+}
+
+// SetValue sets the value of Value.
+func (s *MetricType) SetValue(val OptFloat64) {
+	s.Value = val
+}
+
+// SetUnitOfMeasure sets the value of UnitOfMeasure.
+func (s *MetricType) SetUnitOfMeasure(val OptString) {
+	s.UnitOfMeasure = val
+}
+
+// SetTimestamp sets the value of Timestamp.
+func (s *MetricType) SetTimestamp(val OptDateTime) {
+	s.Timestamp = val
+}
+
+// NewOptAdditivesType returns new OptAdditivesType with value set to v.
+func NewOptAdditivesType(v AdditivesType) OptAdditivesType {
+	return OptAdditivesType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAdditivesType is optional AdditivesType.
+type OptAdditivesType struct {
+	Value AdditivesType
+	Set   bool
+}
+
+// IsSet returns true if OptAdditivesType was set.
+func (o OptAdditivesType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAdditivesType) Reset() {
+	var v AdditivesType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAdditivesType) SetTo(v AdditivesType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAdditivesType) Get() (v AdditivesType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAdditivesType) Or(d AdditivesType) AdditivesType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBatchRecordsType returns new OptBatchRecordsType with value set to v.
+func NewOptBatchRecordsType(v BatchRecordsType) OptBatchRecordsType {
+	return OptBatchRecordsType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBatchRecordsType is optional BatchRecordsType.
+type OptBatchRecordsType struct {
+	Value BatchRecordsType
+	Set   bool
+}
+
+// IsSet returns true if OptBatchRecordsType was set.
+func (o OptBatchRecordsType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBatchRecordsType) Reset() {
+	var v BatchRecordsType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBatchRecordsType) SetTo(v BatchRecordsType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBatchRecordsType) Get() (v BatchRecordsType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBatchRecordsType) Or(d BatchRecordsType) BatchRecordsType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBatchTypeBatchStatus returns new OptBatchTypeBatchStatus with value set to v.
+func NewOptBatchTypeBatchStatus(v BatchTypeBatchStatus) OptBatchTypeBatchStatus {
+	return OptBatchTypeBatchStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBatchTypeBatchStatus is optional BatchTypeBatchStatus.
+type OptBatchTypeBatchStatus struct {
+	Value BatchTypeBatchStatus
+	Set   bool
+}
+
+// IsSet returns true if OptBatchTypeBatchStatus was set.
+func (o OptBatchTypeBatchStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBatchTypeBatchStatus) Reset() {
+	var v BatchTypeBatchStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBatchTypeBatchStatus) SetTo(v BatchTypeBatchStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBatchTypeBatchStatus) Get() (v BatchTypeBatchStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBatchTypeBatchStatus) Or(d BatchTypeBatchStatus) BatchTypeBatchStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCertificationDetailsType returns new OptCertificationDetailsType with value set to v.
+func NewOptCertificationDetailsType(v CertificationDetailsType) OptCertificationDetailsType {
+	return OptCertificationDetailsType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCertificationDetailsType is optional CertificationDetailsType.
+type OptCertificationDetailsType struct {
+	Value CertificationDetailsType
+	Set   bool
+}
+
+// IsSet returns true if OptCertificationDetailsType was set.
+func (o OptCertificationDetailsType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCertificationDetailsType) Reset() {
+	var v CertificationDetailsType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCertificationDetailsType) SetTo(v CertificationDetailsType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCertificationDetailsType) Get() (v CertificationDetailsType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCertificationDetailsType) Or(d CertificationDetailsType) CertificationDetailsType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptChemicalPropertiesType returns new OptChemicalPropertiesType with value set to v.
+func NewOptChemicalPropertiesType(v ChemicalPropertiesType) OptChemicalPropertiesType {
+	return OptChemicalPropertiesType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptChemicalPropertiesType is optional ChemicalPropertiesType.
+type OptChemicalPropertiesType struct {
+	Value ChemicalPropertiesType
+	Set   bool
+}
+
+// IsSet returns true if OptChemicalPropertiesType was set.
+func (o OptChemicalPropertiesType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptChemicalPropertiesType) Reset() {
+	var v ChemicalPropertiesType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptChemicalPropertiesType) SetTo(v ChemicalPropertiesType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptChemicalPropertiesType) Get() (v ChemicalPropertiesType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptChemicalPropertiesType) Or(d ChemicalPropertiesType) ChemicalPropertiesType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptContaminantsType returns new OptContaminantsType with value set to v.
+func NewOptContaminantsType(v ContaminantsType) OptContaminantsType {
+	return OptContaminantsType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptContaminantsType is optional ContaminantsType.
+type OptContaminantsType struct {
+	Value ContaminantsType
+	Set   bool
+}
+
+// IsSet returns true if OptContaminantsType was set.
+func (o OptContaminantsType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptContaminantsType) Reset() {
+	var v ContaminantsType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptContaminantsType) SetTo(v ContaminantsType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptContaminantsType) Get() (v ContaminantsType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptContaminantsType) Or(d ContaminantsType) ContaminantsType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCoordinatesType returns new OptCoordinatesType with value set to v.
+func NewOptCoordinatesType(v CoordinatesType) OptCoordinatesType {
+	return OptCoordinatesType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCoordinatesType is optional CoordinatesType.
+type OptCoordinatesType struct {
+	Value CoordinatesType
+	Set   bool
+}
+
+// IsSet returns true if OptCoordinatesType was set.
+func (o OptCoordinatesType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCoordinatesType) Reset() {
+	var v CoordinatesType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCoordinatesType) SetTo(v CoordinatesType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCoordinatesType) Get() (v CoordinatesType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCoordinatesType) Or(d CoordinatesType) CoordinatesType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDeletePolicy returns new OptDeletePolicy with value set to v.
+func NewOptDeletePolicy(v DeletePolicy) OptDeletePolicy {
+	return OptDeletePolicy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDeletePolicy is optional DeletePolicy.
+type OptDeletePolicy struct {
+	Value DeletePolicy
+	Set   bool
+}
+
+// IsSet returns true if OptDeletePolicy was set.
+func (o OptDeletePolicy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDeletePolicy) Reset() {
+	var v DeletePolicy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDeletePolicy) SetTo(v DeletePolicy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDeletePolicy) Get() (v DeletePolicy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDeletePolicy) Or(d DeletePolicy) DeletePolicy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptElectricalPropertiesType returns new OptElectricalPropertiesType with value set to v.
+func NewOptElectricalPropertiesType(v ElectricalPropertiesType) OptElectricalPropertiesType {
+	return OptElectricalPropertiesType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptElectricalPropertiesType is optional ElectricalPropertiesType.
+type OptElectricalPropertiesType struct {
+	Value ElectricalPropertiesType
+	Set   bool
+}
+
+// IsSet returns true if OptElectricalPropertiesType was set.
+func (o OptElectricalPropertiesType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptElectricalPropertiesType) Reset() {
+	var v ElectricalPropertiesType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptElectricalPropertiesType) SetTo(v ElectricalPropertiesType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptElectricalPropertiesType) Get() (v ElectricalPropertiesType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptElectricalPropertiesType) Or(d ElectricalPropertiesType) ElectricalPropertiesType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEquipmentExportGetFormat returns new OptEquipmentExportGetFormat with value set to v.
+func NewOptEquipmentExportGetFormat(v EquipmentExportGetFormat) OptEquipmentExportGetFormat {
+	return OptEquipmentExportGetFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEquipmentExportGetFormat is optional EquipmentExportGetFormat.
+type OptEquipmentExportGetFormat struct {
+	Value EquipmentExportGetFormat
+	Set   bool
+}
+
+// IsSet returns true if OptEquipmentExportGetFormat was set.
+func (o OptEquipmentExportGetFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEquipmentExportGetFormat) Reset() {
+	var v EquipmentExportGetFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEquipmentExportGetFormat) SetTo(v EquipmentExportGetFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEquipmentExportGetFormat) Get() (v EquipmentExportGetFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEquipmentExportGetFormat) Or(d EquipmentExportGetFormat) EquipmentExportGetFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEquipmentGetStatus returns new OptEquipmentGetStatus with value set to v.
+func NewOptEquipmentGetStatus(v EquipmentGetStatus) OptEquipmentGetStatus {
+	return OptEquipmentGetStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEquipmentGetStatus is optional EquipmentGetStatus.
+type OptEquipmentGetStatus struct {
+	Value EquipmentGetStatus
+	Set   bool
+}
+
+// IsSet returns true if OptEquipmentGetStatus was set.
+func (o OptEquipmentGetStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEquipmentGetStatus) Reset() {
+	var v EquipmentGetStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEquipmentGetStatus) SetTo(v EquipmentGetStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEquipmentGetStatus) Get() (v EquipmentGetStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEquipmentGetStatus) Or(d EquipmentGetStatus) EquipmentGetStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEquipmentQueryOrder returns new OptEquipmentQueryOrder with value set to v.
+func NewOptEquipmentQueryOrder(v EquipmentQueryOrder) OptEquipmentQueryOrder {
+	return OptEquipmentQueryOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEquipmentQueryOrder is optional EquipmentQueryOrder.
+type OptEquipmentQueryOrder struct {
+	Value EquipmentQueryOrder
+	Set   bool
+}
+
+// IsSet returns true if OptEquipmentQueryOrder was set.
+func (o OptEquipmentQueryOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEquipmentQueryOrder) Reset() {
+	var v EquipmentQueryOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEquipmentQueryOrder) SetTo(v EquipmentQueryOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEquipmentQueryOrder) Get() (v EquipmentQueryOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEquipmentQueryOrder) Or(d EquipmentQueryOrder) EquipmentQueryOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEquipmentQuerySort returns new OptEquipmentQuerySort with value set to v.
+func NewOptEquipmentQuerySort(v EquipmentQuerySort) OptEquipmentQuerySort {
+	return OptEquipmentQuerySort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEquipmentQuerySort is optional EquipmentQuerySort.
+type OptEquipmentQuerySort struct {
+	Value EquipmentQuerySort
+	Set   bool
+}
+
+// IsSet returns true if OptEquipmentQuerySort was set.
+func (o OptEquipmentQuerySort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEquipmentQuerySort) Reset() {
+	var v EquipmentQuerySort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEquipmentQuerySort) SetTo(v EquipmentQuerySort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEquipmentQuerySort) Get() (v EquipmentQuerySort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEquipmentQuerySort) Or(d EquipmentQuerySort) EquipmentQuerySort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEquipmentRevision returns new OptEquipmentRevision with value set to v.
+func NewOptEquipmentRevision(v EquipmentRevision) OptEquipmentRevision {
+	return OptEquipmentRevision{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEquipmentRevision is optional EquipmentRevision.
+type OptEquipmentRevision struct {
+	Value EquipmentRevision
+	Set   bool
+}
+
+// IsSet returns true if OptEquipmentRevision was set.
+func (o OptEquipmentRevision) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEquipmentRevision) Reset() {
+	var v EquipmentRevision
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEquipmentRevision) SetTo(v EquipmentRevision) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEquipmentRevision) Get() (v EquipmentRevision, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEquipmentRevision) Or(d EquipmentRevision) EquipmentRevision {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEquipmentTypeOperatingStatus returns new OptEquipmentTypeOperatingStatus with value set to v.
+func NewOptEquipmentTypeOperatingStatus(v EquipmentTypeOperatingStatus) OptEquipmentTypeOperatingStatus {
+	return OptEquipmentTypeOperatingStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEquipmentTypeOperatingStatus is optional EquipmentTypeOperatingStatus.
+type OptEquipmentTypeOperatingStatus struct {
+	Value EquipmentTypeOperatingStatus
+	Set   bool
+}
+
+// IsSet returns true if OptEquipmentTypeOperatingStatus was set.
+func (o OptEquipmentTypeOperatingStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEquipmentTypeOperatingStatus) Reset() {
+	var v EquipmentTypeOperatingStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEquipmentTypeOperatingStatus) SetTo(v EquipmentTypeOperatingStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEquipmentTypeOperatingStatus) Get() (v EquipmentTypeOperatingStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEquipmentTypeOperatingStatus) Or(d EquipmentTypeOperatingStatus) EquipmentTypeOperatingStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptHierarchyScopeType returns new OptHierarchyScopeType with value set to v.
+func NewOptHierarchyScopeType(v HierarchyScopeType) OptHierarchyScopeType {
+	return OptHierarchyScopeType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptHierarchyScopeType is optional HierarchyScopeType.
+type OptHierarchyScopeType struct {
+	Value HierarchyScopeType
+	Set   bool
+}
+
+// IsSet returns true if OptHierarchyScopeType was set.
+func (o OptHierarchyScopeType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptHierarchyScopeType) Reset() {
+	var v HierarchyScopeType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptHierarchyScopeType) SetTo(v HierarchyScopeType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptHierarchyScopeType) Get() (v HierarchyScopeType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptHierarchyScopeType) Or(d HierarchyScopeType) HierarchyScopeType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptImportJobMapping returns new OptImportJobMapping with value set to v.
+func NewOptImportJobMapping(v ImportJobMapping) OptImportJobMapping {
+	return OptImportJobMapping{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptImportJobMapping is optional ImportJobMapping.
+type OptImportJobMapping struct {
+	Value ImportJobMapping
+	Set   bool
+}
+
+// IsSet returns true if OptImportJobMapping was set.
+func (o OptImportJobMapping) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptImportJobMapping) Reset() {
+	var v ImportJobMapping
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptImportJobMapping) SetTo(v ImportJobMapping) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptImportJobMapping) Get() (v ImportJobMapping, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptImportJobMapping) Or(d ImportJobMapping) ImportJobMapping {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLocationType returns new OptLocationType with value set to v.
+func NewOptLocationType(v LocationType) OptLocationType {
+	return OptLocationType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLocationType is optional LocationType.
+type OptLocationType struct {
+	Value LocationType
+	Set   bool
+}
+
+// IsSet returns true if OptLocationType was set.
+func (o OptLocationType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLocationType) Reset() {
+	var v LocationType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLocationType) SetTo(v LocationType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLocationType) Get() (v LocationType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLocationType) Or(d LocationType) LocationType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMaintenanceHistoryType returns new OptMaintenanceHistoryType with value set to v.
+func NewOptMaintenanceHistoryType(v MaintenanceHistoryType) OptMaintenanceHistoryType {
+	return OptMaintenanceHistoryType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMaintenanceHistoryType is optional MaintenanceHistoryType.
+type OptMaintenanceHistoryType struct {
+	Value MaintenanceHistoryType
+	Set   bool
+}
+
+// IsSet returns true if OptMaintenanceHistoryType was set.
+func (o OptMaintenanceHistoryType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMaintenanceHistoryType) Reset() {
+	var v MaintenanceHistoryType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMaintenanceHistoryType) SetTo(v MaintenanceHistoryType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMaintenanceHistoryType) Get() (v MaintenanceHistoryType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMaintenanceHistoryType) Or(d MaintenanceHistoryType) MaintenanceHistoryType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMaterialCategoryType returns new OptMaterialCategoryType with value set to v.
+func NewOptMaterialCategoryType(v MaterialCategoryType) OptMaterialCategoryType {
+	return OptMaterialCategoryType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMaterialCategoryType is optional MaterialCategoryType.
+type OptMaterialCategoryType struct {
+	Value MaterialCategoryType
+	Set   bool
+}
+
+// IsSet returns true if OptMaterialCategoryType was set.
+func (o OptMaterialCategoryType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMaterialCategoryType) Reset() {
+	var v MaterialCategoryType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMaterialCategoryType) SetTo(v MaterialCategoryType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMaterialCategoryType) Get() (v MaterialCategoryType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMaterialCategoryType) Or(d MaterialCategoryType) MaterialCategoryType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMaterialCompositionType returns new OptMaterialCompositionType with value set to v.
+func NewOptMaterialCompositionType(v MaterialCompositionType) OptMaterialCompositionType {
+	return OptMaterialCompositionType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMaterialCompositionType is optional MaterialCompositionType.
+type OptMaterialCompositionType struct {
+	Value MaterialCompositionType
+	Set   bool
+}
+
+// IsSet returns true if OptMaterialCompositionType was set.
+func (o OptMaterialCompositionType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMaterialCompositionType) Reset() {
+	var v MaterialCompositionType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMaterialCompositionType) SetTo(v MaterialCompositionType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMaterialCompositionType) Get() (v MaterialCompositionType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMaterialCompositionType) Or(d MaterialCompositionType) MaterialCompositionType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMaterialPropertiesType returns new OptMaterialPropertiesType with value set to v.
+func NewOptMaterialPropertiesType(v MaterialPropertiesType) OptMaterialPropertiesType {
+	return OptMaterialPropertiesType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMaterialPropertiesType is optional MaterialPropertiesType.
+type OptMaterialPropertiesType struct {
+	Value MaterialPropertiesType
+	Set   bool
+}
+
+// IsSet returns true if OptMaterialPropertiesType was set.
+func (o OptMaterialPropertiesType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMaterialPropertiesType) Reset() {
+	var v MaterialPropertiesType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMaterialPropertiesType) SetTo(v MaterialPropertiesType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMaterialPropertiesType) Get() (v MaterialPropertiesType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMaterialPropertiesType) Or(d MaterialPropertiesType) MaterialPropertiesType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMaterialSourceType returns new OptMaterialSourceType with value set to v.
+func NewOptMaterialSourceType(v MaterialSourceType) OptMaterialSourceType {
+	return OptMaterialSourceType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMaterialSourceType is optional MaterialSourceType.
+type OptMaterialSourceType struct {
+	Value MaterialSourceType
+	Set   bool
+}
+
+// IsSet returns true if OptMaterialSourceType was set.
+func (o OptMaterialSourceType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMaterialSourceType) Reset() {
+	var v MaterialSourceType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMaterialSourceType) SetTo(v MaterialSourceType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMaterialSourceType) Get() (v MaterialSourceType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMaterialSourceType) Or(d MaterialSourceType) MaterialSourceType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMaterialUsageType returns new OptMaterialUsageType with value set to v.
+func NewOptMaterialUsageType(v MaterialUsageType) OptMaterialUsageType {
+	return OptMaterialUsageType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMaterialUsageType is optional MaterialUsageType.
+type OptMaterialUsageType struct {
+	Value MaterialUsageType
+	Set   bool
+}
+
+// IsSet returns true if OptMaterialUsageType was set.
+func (o OptMaterialUsageType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMaterialUsageType) Reset() {
+	var v MaterialUsageType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMaterialUsageType) SetTo(v MaterialUsageType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMaterialUsageType) Get() (v MaterialUsageType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMaterialUsageType) Or(d MaterialUsageType) MaterialUsageType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMechanicalPropertiesType returns new OptMechanicalPropertiesType with value set to v.
+func NewOptMechanicalPropertiesType(v MechanicalPropertiesType) OptMechanicalPropertiesType {
+	return OptMechanicalPropertiesType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMechanicalPropertiesType is optional MechanicalPropertiesType.
+type OptMechanicalPropertiesType struct {
+	Value MechanicalPropertiesType
+	Set   bool
+}
+
+// IsSet returns true if OptMechanicalPropertiesType was set.
+func (o OptMechanicalPropertiesType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMechanicalPropertiesType) Reset() {
+	var v MechanicalPropertiesType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMechanicalPropertiesType) SetTo(v MechanicalPropertiesType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMechanicalPropertiesType) Get() (v MechanicalPropertiesType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMechanicalPropertiesType) Or(d MechanicalPropertiesType) MechanicalPropertiesType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPerformanceDataType returns new OptPerformanceDataType with value set to v.
+func NewOptPerformanceDataType(v PerformanceDataType) OptPerformanceDataType {
+	return OptPerformanceDataType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPerformanceDataType is optional PerformanceDataType.
+type OptPerformanceDataType struct {
+	Value PerformanceDataType
+	Set   bool
+}
+
+// IsSet returns true if OptPerformanceDataType was set.
+func (o OptPerformanceDataType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPerformanceDataType) Reset() {
+	var v PerformanceDataType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPerformanceDataType) SetTo(v PerformanceDataType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPerformanceDataType) Get() (v PerformanceDataType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPerformanceDataType) Or(d PerformanceDataType) PerformanceDataType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPhysicalPropertiesType returns new OptPhysicalPropertiesType with value set to v.
+func NewOptPhysicalPropertiesType(v PhysicalPropertiesType) OptPhysicalPropertiesType {
+	return OptPhysicalPropertiesType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPhysicalPropertiesType is optional PhysicalPropertiesType.
+type OptPhysicalPropertiesType struct {
+	Value PhysicalPropertiesType
+	Set   bool
+}
+
+// IsSet returns true if OptPhysicalPropertiesType was set.
+func (o OptPhysicalPropertiesType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPhysicalPropertiesType) Reset() {
+	var v PhysicalPropertiesType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPhysicalPropertiesType) SetTo(v PhysicalPropertiesType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPhysicalPropertiesType) Get() (v PhysicalPropertiesType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPhysicalPropertiesType) Or(d PhysicalPropertiesType) PhysicalPropertiesType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPropertyPredicateType returns new OptPropertyPredicateType with value set to v.
+func NewOptPropertyPredicateType(v PropertyPredicateType) OptPropertyPredicateType {
+	return OptPropertyPredicateType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPropertyPredicateType is optional PropertyPredicateType.
+type OptPropertyPredicateType struct {
+	Value PropertyPredicateType
+	Set   bool
+}
+
+// IsSet returns true if OptPropertyPredicateType was set.
+func (o OptPropertyPredicateType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPropertyPredicateType) Reset() {
+	var v PropertyPredicateType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPropertyPredicateType) SetTo(v PropertyPredicateType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPropertyPredicateType) Get() (v PropertyPredicateType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPropertyPredicateType) Or(d PropertyPredicateType) PropertyPredicateType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRecordTypeRecordData returns new OptRecordTypeRecordData with value set to v.
+func NewOptRecordTypeRecordData(v RecordTypeRecordData) OptRecordTypeRecordData {
+	return OptRecordTypeRecordData{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRecordTypeRecordData is optional RecordTypeRecordData.
+type OptRecordTypeRecordData struct {
+	Value RecordTypeRecordData
+	Set   bool
+}
+
+// IsSet returns true if OptRecordTypeRecordData was set.
+func (o OptRecordTypeRecordData) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRecordTypeRecordData) Reset() {
+	var v RecordTypeRecordData
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRecordTypeRecordData) SetTo(v RecordTypeRecordData) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRecordTypeRecordData) Get() (v RecordTypeRecordData, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRecordTypeRecordData) Or(d RecordTypeRecordData) RecordTypeRecordData {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRegulatoryInformationType returns new OptRegulatoryInformationType with value set to v.
+func NewOptRegulatoryInformationType(v RegulatoryInformationType) OptRegulatoryInformationType {
 	return OptRegulatoryInformationType{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptRegulatoryInformationType is optional RegulatoryInformationType.
-type OptRegulatoryInformationType struct {
-	Value RegulatoryInformationType
-	Set   bool
+// OptRegulatoryInformationType is optional RegulatoryInformationType.
+type OptRegulatoryInformationType struct {
+	Value RegulatoryInformationType
+	Set   bool
+}
+
+// IsSet returns true if OptRegulatoryInformationType was set.
+func (o OptRegulatoryInformationType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRegulatoryInformationType) Reset() {
+	var v RegulatoryInformationType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRegulatoryInformationType) SetTo(v RegulatoryInformationType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRegulatoryInformationType) Get() (v RegulatoryInformationType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRegulatoryInformationType) Or(d RegulatoryInformationType) RegulatoryInformationType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptResourceLocationType returns new OptResourceLocationType with value set to v.
+func NewOptResourceLocationType(v ResourceLocationType) OptResourceLocationType {
+	return OptResourceLocationType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptResourceLocationType is optional ResourceLocationType.
+type OptResourceLocationType struct {
+	Value ResourceLocationType
+	Set   bool
+}
+
+// IsSet returns true if OptResourceLocationType was set.
+func (o OptResourceLocationType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptResourceLocationType) Reset() {
+	var v ResourceLocationType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptResourceLocationType) SetTo(v ResourceLocationType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptResourceLocationType) Get() (v ResourceLocationType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptResourceLocationType) Or(d ResourceLocationType) ResourceLocationType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSpatialDefinitionType returns new OptSpatialDefinitionType with value set to v.
+func NewOptSpatialDefinitionType(v SpatialDefinitionType) OptSpatialDefinitionType {
+	return OptSpatialDefinitionType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSpatialDefinitionType is optional SpatialDefinitionType.
+type OptSpatialDefinitionType struct {
+	Value SpatialDefinitionType
+	Set   bool
+}
+
+// IsSet returns true if OptSpatialDefinitionType was set.
+func (o OptSpatialDefinitionType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSpatialDefinitionType) Reset() {
+	var v SpatialDefinitionType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSpatialDefinitionType) SetTo(v SpatialDefinitionType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSpatialDefinitionType) Get() (v SpatialDefinitionType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSpatialDefinitionType) Or(d SpatialDefinitionType) SpatialDefinitionType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptThermalPropertiesType returns new OptThermalPropertiesType with value set to v.
+func NewOptThermalPropertiesType(v ThermalPropertiesType) OptThermalPropertiesType {
+	return OptThermalPropertiesType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptThermalPropertiesType is optional ThermalPropertiesType.
+type OptThermalPropertiesType struct {
+	Value ThermalPropertiesType
+	Set   bool
+}
+
+// IsSet returns true if OptThermalPropertiesType was set.
+func (o OptThermalPropertiesType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptThermalPropertiesType) Reset() {
+	var v ThermalPropertiesType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptThermalPropertiesType) SetTo(v ThermalPropertiesType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptThermalPropertiesType) Get() (v ThermalPropertiesType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptThermalPropertiesType) Or(d ThermalPropertiesType) ThermalPropertiesType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTraceabilityInformationType returns new OptTraceabilityInformationType with value set to v.
+func NewOptTraceabilityInformationType(v TraceabilityInformationType) OptTraceabilityInformationType {
+	return OptTraceabilityInformationType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTraceabilityInformationType is optional TraceabilityInformationType.
+type OptTraceabilityInformationType struct {
+	Value TraceabilityInformationType
+	Set   bool
+}
+
+// IsSet returns true if OptTraceabilityInformationType was set.
+func (o OptTraceabilityInformationType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTraceabilityInformationType) Reset() {
+	var v TraceabilityInformationType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTraceabilityInformationType) SetTo(v TraceabilityInformationType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTraceabilityInformationType) Get() (v TraceabilityInformationType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTraceabilityInformationType) Or(d TraceabilityInformationType) TraceabilityInformationType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptWebhookDeliveryStatus returns new OptWebhookDeliveryStatus with value set to v.
+func NewOptWebhookDeliveryStatus(v WebhookDeliveryStatus) OptWebhookDeliveryStatus {
+	return OptWebhookDeliveryStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptWebhookDeliveryStatus is optional WebhookDeliveryStatus.
+type OptWebhookDeliveryStatus struct {
+	Value WebhookDeliveryStatus
+	Set   bool
+}
+
+// IsSet returns true if OptWebhookDeliveryStatus was set.
+func (o OptWebhookDeliveryStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptWebhookDeliveryStatus) Reset() {
+	var v WebhookDeliveryStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptWebhookDeliveryStatus) SetTo(v WebhookDeliveryStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptWebhookDeliveryStatus) Get() (v WebhookDeliveryStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptWebhookDeliveryStatus) Or(d WebhookDeliveryStatus) WebhookDeliveryStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptWebhookEvent returns new OptWebhookEvent with value set to v.
+func NewOptWebhookEvent(v WebhookEvent) OptWebhookEvent {
+	return OptWebhookEvent{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptWebhookEvent is optional WebhookEvent.
+type OptWebhookEvent struct {
+	Value WebhookEvent
+	Set   bool
+}
+
+// IsSet returns true if OptWebhookEvent was set.
+func (o OptWebhookEvent) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptWebhookEvent) Reset() {
+	var v WebhookEvent
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptWebhookEvent) SetTo(v WebhookEvent) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptWebhookEvent) Get() (v WebhookEvent, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptWebhookEvent) Or(d WebhookEvent) WebhookEvent {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/PerformanceDataType
+type PerformanceDataType struct {
+	Metric []MetricType `json:"Metric"`
+}
+
+// GetMetric returns the value of Metric.
+func (s *PerformanceDataType) GetMetric() []MetricType {
+	return s.Metric
+}
+
+// SetMetric sets the value of Metric.
+func (s *PerformanceDataType) SetMetric(val []MetricType) {
+	s.Metric = val
+}
+
+// Ref: #/components/schemas/PersonPropertyType
+type PersonPropertyType struct {
+	ID                       string               `json:"ID"`
+	Description              []string             `json:"Description"`
+	Value                    []ValueType          `json:"Value"`
+	PersonPropertyChild      []PersonPropertyType `json:"PersonPropertyChild"`
+	PersonnelClassPropertyID OptString            `json:"PersonnelClassPropertyID"`
+}
+
+// GetID returns the value of ID.
+func (s *PersonPropertyType) GetID() string {
+	return s.ID
+}
+
+// GetDescription returns the value of Description.
+func (s *PersonPropertyType) GetDescription() []string {
+	return s.Description
+}
+
+// GetValue returns the value of Value.
+func (s *PersonPropertyType) GetValue() []ValueType {
+	return s.Value
+}
+
+// GetPersonPropertyChild returns the value of PersonPropertyChild.
+func (s *PersonPropertyType) GetPersonPropertyChild() []PersonPropertyType {
+	return s.PersonPropertyChild
+}
+
+// GetPersonnelClassPropertyID returns the value of PersonnelClassPropertyID.
+func (s *PersonPropertyType) GetPersonnelClassPropertyID() OptString {
+	return s.PersonnelClassPropertyID
+}
+
+// SetID sets the value of ID.
+func (s *PersonPropertyType) SetID(val string) {
+	s.ID = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PersonPropertyType) SetDescription(val []string) {
+	s.Description = val
+}
+
+// SetValue sets the value of Value.
+func (s *PersonPropertyType) SetValue(val []ValueType) {
+	s.Value = val
+}
+
+// SetPersonPropertyChild sets the value of PersonPropertyChild.
+func (s *PersonPropertyType) SetPersonPropertyChild(val []PersonPropertyType) {
+	s.PersonPropertyChild = val
+}
+
+// SetPersonnelClassPropertyID sets the value of PersonnelClassPropertyID.
+func (s *PersonPropertyType) SetPersonnelClassPropertyID(val OptString) {
+	s.PersonnelClassPropertyID = val
+}
+
+// Ref: #/components/schemas/PersonType
+type PersonType struct {
+	ID                  string                   `json:"ID"`
+	Version             OptString                `json:"Version"`
+	Description         []string                 `json:"Description"`
+	PublishedDate       OptDateTime              `json:"PublishedDate"`
+	EffectiveStartDate  OptDateTime              `json:"EffectiveStartDate"`
+	EffectiveEndDate    OptDateTime              `json:"EffectiveEndDate"`
+	HierarchyScope      OptHierarchyScopeType    `json:"HierarchyScope"`
+	PersonName          OptString                `json:"PersonName"`
+	SpatialDefinition   OptSpatialDefinitionType `json:"SpatialDefinition"`
+	OperationalLocation OptResourceLocationType  `json:"OperationalLocation"`
+	PersonProperty      []PersonPropertyType     `json:"PersonProperty"`
+	PersonnelClassID    []string                 `json:"PersonnelClassID"`
+	TestSpecificationID []string                 `json:"TestSpecificationID"`
+	// Существительное B2MML Person в каноническом JSON: элементы -
+	// ключи по
+	// именам элементов (повторяющиеся - массивы), атрибуты -
+	// ключи с префиксом @
+	// (@languageID, @unitCode), текст элемента с атрибутами - ключ #text.
+	// В ответе содержит те же данные, что XML; в запросе
+	// заменяет остальные поля.
+	B2MML jx.Raw `json:"B2MML"`
+}
+
+// GetID returns the value of ID.
+func (s *PersonType) GetID() string {
+	return s.ID
+}
+
+// GetVersion returns the value of Version.
+func (s *PersonType) GetVersion() OptString {
+	return s.Version
+}
+
+// GetDescription returns the value of Description.
+func (s *PersonType) GetDescription() []string {
+	return s.Description
+}
+
+// GetPublishedDate returns the value of PublishedDate.
+func (s *PersonType) GetPublishedDate() OptDateTime {
+	return s.PublishedDate
+}
+
+// GetEffectiveStartDate returns the value of EffectiveStartDate.
+func (s *PersonType) GetEffectiveStartDate() OptDateTime {
+	return s.EffectiveStartDate
+}
+
+// GetEffectiveEndDate returns the value of EffectiveEndDate.
+func (s *PersonType) GetEffectiveEndDate() OptDateTime {
+	return s.EffectiveEndDate
+}
+
+// GetHierarchyScope returns the value of HierarchyScope.
+func (s *PersonType) GetHierarchyScope() OptHierarchyScopeType {
+	return s.HierarchyScope
+}
+
+// GetPersonName returns the value of PersonName.
+func (s *PersonType) GetPersonName() OptString {
+	return s.PersonName
+}
+
+// GetSpatialDefinition returns the value of SpatialDefinition.
+func (s *PersonType) GetSpatialDefinition() OptSpatialDefinitionType {
+	return s.SpatialDefinition
+}
+
+// GetOperationalLocation returns the value of OperationalLocation.
+func (s *PersonType) GetOperationalLocation() OptResourceLocationType {
+	return s.OperationalLocation
+}
+
+// GetPersonProperty returns the value of PersonProperty.
+func (s *PersonType) GetPersonProperty() []PersonPropertyType {
+	return s.PersonProperty
+}
+
+// GetPersonnelClassID returns the value of PersonnelClassID.
+func (s *PersonType) GetPersonnelClassID() []string {
+	return s.PersonnelClassID
+}
+
+// GetTestSpecificationID returns the value of TestSpecificationID.
+func (s *PersonType) GetTestSpecificationID() []string {
+	return s.TestSpecificationID
+}
+
+// GetB2MML returns the value of B2MML.
+func (s *PersonType) GetB2MML() jx.Raw {
+	return s.B2MML
+}
+
+// SetID sets the value of ID.
+func (s *PersonType) SetID(val string) {
+	s.ID = val
+}
+
+// SetVersion sets the value of Version.
+func (s *PersonType) SetVersion(val OptString) {
+	s.Version = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PersonType) SetDescription(val []string) {
+	s.Description = val
+}
+
+// SetPublishedDate sets the value of PublishedDate.
+func (s *PersonType) SetPublishedDate(val OptDateTime) {
+	s.PublishedDate = val
+}
+
+// SetEffectiveStartDate sets the value of EffectiveStartDate.
+func (s *PersonType) SetEffectiveStartDate(val OptDateTime) {
+	s.EffectiveStartDate = val
+}
+
+// SetEffectiveEndDate sets the value of EffectiveEndDate.
+func (s *PersonType) SetEffectiveEndDate(val OptDateTime) {
+	s.EffectiveEndDate = val
+}
+
+// SetHierarchyScope sets the value of HierarchyScope.
+func (s *PersonType) SetHierarchyScope(val OptHierarchyScopeType) {
+	s.HierarchyScope = val
+}
+
+// SetPersonName sets the value of PersonName.
+func (s *PersonType) SetPersonName(val OptString) {
+	s.PersonName = val
+}
+
+// SetSpatialDefinition sets the value of SpatialDefinition.
+func (s *PersonType) SetSpatialDefinition(val OptSpatialDefinitionType) {
+	s.SpatialDefinition = val
+}
+
+// SetOperationalLocation sets the value of OperationalLocation.
+func (s *PersonType) SetOperationalLocation(val OptResourceLocationType) {
+	s.OperationalLocation = val
+}
+
+// SetPersonProperty sets the value of PersonProperty.
+func (s *PersonType) SetPersonProperty(val []PersonPropertyType) {
+	s.PersonProperty = val
+}
+
+// SetPersonnelClassID sets the value of PersonnelClassID.
+func (s *PersonType) SetPersonnelClassID(val []string) {
+	s.PersonnelClassID = val
+}
+
+// SetTestSpecificationID sets the value of TestSpecificationID.
+func (s *PersonType) SetTestSpecificationID(val []string) {
+	s.TestSpecificationID = val
+}
+
+// SetB2MML sets the value of B2MML.
+func (s *PersonType) SetB2MML(val jx.Raw) {
+	s.B2MML = val
+}
+
+func (*PersonType) personsPostReq() {}
+func (*PersonType) personsPostRes() {}
+
+// Ref: #/components/schemas/PersonnelClassPropertyType
+type PersonnelClassPropertyType struct {
+	ID                          string                       `json:"ID"`
+	Description                 []string                     `json:"Description"`
+	PropertyType                OptString                    `json:"PropertyType"`
+	Value                       []ValueType                  `json:"Value"`
+	PersonnelClassPropertyChild []PersonnelClassPropertyType `json:"PersonnelClassPropertyChild"`
+}
+
+// GetID returns the value of ID.
+func (s *PersonnelClassPropertyType) GetID() string {
+	return s.ID
+}
+
+// GetDescription returns the value of Description.
+func (s *PersonnelClassPropertyType) GetDescription() []string {
+	return s.Description
+}
+
+// GetPropertyType returns the value of PropertyType.
+func (s *PersonnelClassPropertyType) GetPropertyType() OptString {
+	return s.PropertyType
+}
+
+// GetValue returns the value of Value.
+func (s *PersonnelClassPropertyType) GetValue() []ValueType {
+	return s.Value
+}
+
+// GetPersonnelClassPropertyChild returns the value of PersonnelClassPropertyChild.
+func (s *PersonnelClassPropertyType) GetPersonnelClassPropertyChild() []PersonnelClassPropertyType {
+	return s.PersonnelClassPropertyChild
+}
+
+// SetID sets the value of ID.
+func (s *PersonnelClassPropertyType) SetID(val string) {
+	s.ID = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PersonnelClassPropertyType) SetDescription(val []string) {
+	s.Description = val
+}
+
+// SetPropertyType sets the value of PropertyType.
+func (s *PersonnelClassPropertyType) SetPropertyType(val OptString) {
+	s.PropertyType = val
+}
+
+// SetValue sets the value of Value.
+func (s *PersonnelClassPropertyType) SetValue(val []ValueType) {
+	s.Value = val
+}
+
+// SetPersonnelClassPropertyChild sets the value of PersonnelClassPropertyChild.
+func (s *PersonnelClassPropertyType) SetPersonnelClassPropertyChild(val []PersonnelClassPropertyType) {
+	s.PersonnelClassPropertyChild = val
+}
+
+// Ref: #/components/schemas/PersonnelClassType
+type PersonnelClassType struct {
+	ID                     string                       `json:"ID"`
+	Version                OptString                    `json:"Version"`
+	Description            []string                     `json:"Description"`
+	PublishedDate          OptDateTime                  `json:"PublishedDate"`
+	EffectiveStartDate     OptDateTime                  `json:"EffectiveStartDate"`
+	EffectiveEndDate       OptDateTime                  `json:"EffectiveEndDate"`
+	HierarchyScope         OptHierarchyScopeType        `json:"HierarchyScope"`
+	PersonnelClassBaseID   []string                     `json:"PersonnelClassBaseID"`
+	PersonnelClassProperty []PersonnelClassPropertyType `json:"PersonnelClassProperty"`
+	PersonSourceID         []string                     `json:"PersonSourceID"`
+	TestSpecificationID    []string                     `json:"TestSpecificationID"`
+}
+
+// GetID returns the value of ID.
+func (s *PersonnelClassType) GetID() string {
+	return s.ID
+}
+
+// GetVersion returns the value of Version.
+func (s *PersonnelClassType) GetVersion() OptString {
+	return s.Version
+}
+
+// GetDescription returns the value of Description.
+func (s *PersonnelClassType) GetDescription() []string {
+	return s.Description
+}
+
+// GetPublishedDate returns the value of PublishedDate.
+func (s *PersonnelClassType) GetPublishedDate() OptDateTime {
+	return s.PublishedDate
+}
+
+// GetEffectiveStartDate returns the value of EffectiveStartDate.
+func (s *PersonnelClassType) GetEffectiveStartDate() OptDateTime {
+	return s.EffectiveStartDate
+}
+
+// GetEffectiveEndDate returns the value of EffectiveEndDate.
+func (s *PersonnelClassType) GetEffectiveEndDate() OptDateTime {
+	return s.EffectiveEndDate
+}
+
+// GetHierarchyScope returns the value of HierarchyScope.
+func (s *PersonnelClassType) GetHierarchyScope() OptHierarchyScopeType {
+	return s.HierarchyScope
+}
+
+// GetPersonnelClassBaseID returns the value of PersonnelClassBaseID.
+func (s *PersonnelClassType) GetPersonnelClassBaseID() []string {
+	return s.PersonnelClassBaseID
+}
+
+// GetPersonnelClassProperty returns the value of PersonnelClassProperty.
+func (s *PersonnelClassType) GetPersonnelClassProperty() []PersonnelClassPropertyType {
+	return s.PersonnelClassProperty
+}
+
+// GetPersonSourceID returns the value of PersonSourceID.
+func (s *PersonnelClassType) GetPersonSourceID() []string {
+	return s.PersonSourceID
+}
+
+// GetTestSpecificationID returns the value of TestSpecificationID.
+func (s *PersonnelClassType) GetTestSpecificationID() []string {
+	return s.TestSpecificationID
+}
+
+// SetID sets the value of ID.
+func (s *PersonnelClassType) SetID(val string) {
+	s.ID = val
+}
+
+// SetVersion sets the value of Version.
+func (s *PersonnelClassType) SetVersion(val OptString) {
+	s.Version = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PersonnelClassType) SetDescription(val []string) {
+	s.Description = val
+}
+
+// SetPublishedDate sets the value of PublishedDate.
+func (s *PersonnelClassType) SetPublishedDate(val OptDateTime) {
+	s.PublishedDate = val
+}
+
+// SetEffectiveStartDate sets the value of EffectiveStartDate.
+func (s *PersonnelClassType) SetEffectiveStartDate(val OptDateTime) {
+	s.EffectiveStartDate = val
+}
+
+// SetEffectiveEndDate sets the value of EffectiveEndDate.
+func (s *PersonnelClassType) SetEffectiveEndDate(val OptDateTime) {
+	s.EffectiveEndDate = val
+}
+
+// SetHierarchyScope sets the value of HierarchyScope.
+func (s *PersonnelClassType) SetHierarchyScope(val OptHierarchyScopeType) {
+	s.HierarchyScope = val
+}
+
+// SetPersonnelClassBaseID sets the value of PersonnelClassBaseID.
+func (s *PersonnelClassType) SetPersonnelClassBaseID(val []string) {
+	s.PersonnelClassBaseID = val
+}
+
+// SetPersonnelClassProperty sets the value of PersonnelClassProperty.
+func (s *PersonnelClassType) SetPersonnelClassProperty(val []PersonnelClassPropertyType) {
+	s.PersonnelClassProperty = val
+}
+
+// SetPersonSourceID sets the value of PersonSourceID.
+func (s *PersonnelClassType) SetPersonSourceID(val []string) {
+	s.PersonSourceID = val
+}
+
+// SetTestSpecificationID sets the value of TestSpecificationID.
+func (s *PersonnelClassType) SetTestSpecificationID(val []string) {
+	s.TestSpecificationID = val
+}
+
+// PersonnelClassesPostCreated is response for PersonnelClassesPost operation.
+type PersonnelClassesPostCreated struct{}
+
+// PersonnelInformationPostCreated is response for PersonnelInformationPost operation.
+type PersonnelInformationPostCreated struct{}
+
+// Ref: #/components/schemas/PersonnelInformationType
+type PersonnelInformationType struct {
+	ID                OptString               `json:"ID"`
+	Description       []string                `json:"Description"`
+	HierarchyScope    OptHierarchyScopeType   `json:"HierarchyScope"`
+	PublishedDate     OptDateTime             `json:"PublishedDate"`
+	Person            []PersonType            `json:"Person"`
+	PersonnelClass    []PersonnelClassType    `json:"PersonnelClass"`
+	TestSpecification []TestSpecificationType `json:"TestSpecification"`
+}
+
+// GetID returns the value of ID.
+func (s *PersonnelInformationType) GetID() OptString {
+	return s.ID
+}
+
+// GetDescription returns the value of Description.
+func (s *PersonnelInformationType) GetDescription() []string {
+	return s.Description
+}
+
+// GetHierarchyScope returns the value of HierarchyScope.
+func (s *PersonnelInformationType) GetHierarchyScope() OptHierarchyScopeType {
+	return s.HierarchyScope
+}
+
+// GetPublishedDate returns the value of PublishedDate.
+func (s *PersonnelInformationType) GetPublishedDate() OptDateTime {
+	return s.PublishedDate
+}
+
+// GetPerson returns the value of Person.
+func (s *PersonnelInformationType) GetPerson() []PersonType {
+	return s.Person
+}
+
+// GetPersonnelClass returns the value of PersonnelClass.
+func (s *PersonnelInformationType) GetPersonnelClass() []PersonnelClassType {
+	return s.PersonnelClass
+}
+
+// GetTestSpecification returns the value of TestSpecification.
+func (s *PersonnelInformationType) GetTestSpecification() []TestSpecificationType {
+	return s.TestSpecification
+}
+
+// SetID sets the value of ID.
+func (s *PersonnelInformationType) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PersonnelInformationType) SetDescription(val []string) {
+	s.Description = val
+}
+
+// SetHierarchyScope sets the value of HierarchyScope.
+func (s *PersonnelInformationType) SetHierarchyScope(val OptHierarchyScopeType) {
+	s.HierarchyScope = val
+}
+
+// SetPublishedDate sets the value of PublishedDate.
+func (s *PersonnelInformationType) SetPublishedDate(val OptDateTime) {
+	s.PublishedDate = val
+}
+
+// SetPerson sets the value of Person.
+func (s *PersonnelInformationType) SetPerson(val []PersonType) {
+	s.Person = val
+}
+
+// SetPersonnelClass sets the value of PersonnelClass.
+func (s *PersonnelInformationType) SetPersonnelClass(val []PersonnelClassType) {
+	s.PersonnelClass = val
+}
+
+// SetTestSpecification sets the value of TestSpecification.
+func (s *PersonnelInformationType) SetTestSpecification(val []TestSpecificationType) {
+	s.TestSpecification = val
+}
+
+// PersonsPostConflict is response for PersonsPost operation.
+type PersonsPostConflict struct{}
+
+func (*PersonsPostConflict) personsPostRes() {}
+
+// Существительное B2MML Person.
+type PersonsPostCreatedApplicationXML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s PersonsPostCreatedApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*PersonsPostCreatedApplicationXML) personsPostRes() {}
+
+// Существительное B2MML Person.
+type PersonsPostReqApplicationXML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s PersonsPostReqApplicationXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*PersonsPostReqApplicationXML) personsPostReq() {}
+
+// Ref: #/components/schemas/PhysicalPropertiesType
+type PhysicalPropertiesType struct {
+	Density      OptFloat64 `json:"Density"`
+	Viscosity    OptFloat64 `json:"Viscosity"`
+	ParticleSize OptFloat64 `json:"ParticleSize"`
+	SurfaceArea  OptFloat64 `json:"SurfaceArea"`
+}
+
+// GetDensity returns the value of Density.
+func (s *PhysicalPropertiesType) GetDensity() OptFloat64 {
+	return s.Density
 }
 
-// IsSet returns true if OptRegulatoryInformationType was set.
-func (o OptRegulatoryInformationType) IsSet() bool { return o.Set }
+// GetViscosity returns the value of Viscosity.
+func (s *PhysicalPropertiesType) GetViscosity() OptFloat64 {
+	return s.Viscosity
+}
 
-// Reset unsets value.
-func (o *OptRegulatoryInformationType) Reset() {
-	var v RegulatoryInformationType
-	o.Value = v
-	o.Set = false
+// GetParticleSize returns the value of ParticleSize.
+func (s *PhysicalPropertiesType) GetParticleSize() OptFloat64 {
+	return s.ParticleSize
 }
 
-// SetTo sets value to v.
-func (o *OptRegulatoryInformationType) SetTo(v RegulatoryInformationType) {
-	o.Set = true
-	o.Value = v
+// GetSurfaceArea returns the value of SurfaceArea.
+func (s *PhysicalPropertiesType) GetSurfaceArea() OptFloat64 {
+	return s.SurfaceArea
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptRegulatoryInformationType) Get() (v RegulatoryInformationType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetDensity sets the value of Density.
+func (s *PhysicalPropertiesType) SetDensity(val OptFloat64) {
+	s.Density = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptRegulatoryInformationType) Or(d RegulatoryInformationType) RegulatoryInformationType {
-	if v, ok := o.Get(); ok {
-		return v
+// SetViscosity sets the value of Viscosity.
+func (s *PhysicalPropertiesType) SetViscosity(val OptFloat64) {
+	s.Viscosity = val
+}
+
+// SetParticleSize sets the value of ParticleSize.
+func (s *PhysicalPropertiesType) SetParticleSize(val OptFloat64) {
+	s.ParticleSize = val
+}
+
+// SetSurfaceArea sets the value of SurfaceArea.
+func (s *PhysicalPropertiesType) SetSurfaceArea(val OptFloat64) {
+	s.SurfaceArea = val
+}
+
+// Ref: #/components/schemas/PropertyPredicate
+type PropertyPredicate struct {
+	// ID свойства оборудования, например POWER_RATING.
+	ID    string                   `json:"id"`
+	Op    PropertyPredicateOp      `json:"op"`
+	Value OptString                `json:"value"`
+	Type  OptPropertyPredicateType `json:"type"`
+	// Единица измерения значения, например kW. Для числовых
+	// условий значения
+	// в совместимых единицах (hp, W, KWT...) переводятся в эту
+	// единицу перед сравнением.
+	Unit OptString `json:"unit"`
+}
+
+// GetID returns the value of ID.
+func (s *PropertyPredicate) GetID() string {
+	return s.ID
+}
+
+// GetOp returns the value of Op.
+func (s *PropertyPredicate) GetOp() PropertyPredicateOp {
+	return s.Op
+}
+
+// GetValue returns the value of Value.
+func (s *PropertyPredicate) GetValue() OptString {
+	return s.Value
+}
+
+// GetType returns the value of Type.
+func (s *PropertyPredicate) GetType() OptPropertyPredicateType {
+	return s.Type
+}
+
+// GetUnit returns the value of Unit.
+func (s *PropertyPredicate) GetUnit() OptString {
+	return s.Unit
+}
+
+// SetID sets the value of ID.
+func (s *PropertyPredicate) SetID(val string) {
+	s.ID = val
+}
+
+// SetOp sets the value of Op.
+func (s *PropertyPredicate) SetOp(val PropertyPredicateOp) {
+	s.Op = val
+}
+
+// SetValue sets the value of Value.
+func (s *PropertyPredicate) SetValue(val OptString) {
+	s.Value = val
+}
+
+// SetType sets the value of Type.
+func (s *PropertyPredicate) SetType(val OptPropertyPredicateType) {
+	s.Type = val
+}
+
+// SetUnit sets the value of Unit.
+func (s *PropertyPredicate) SetUnit(val OptString) {
+	s.Unit = val
+}
+
+type PropertyPredicateOp string
+
+const (
+	PropertyPredicateOpEq     PropertyPredicateOp = "eq"
+	PropertyPredicateOpNe     PropertyPredicateOp = "ne"
+	PropertyPredicateOpLt     PropertyPredicateOp = "lt"
+	PropertyPredicateOpLte    PropertyPredicateOp = "lte"
+	PropertyPredicateOpGt     PropertyPredicateOp = "gt"
+	PropertyPredicateOpGte    PropertyPredicateOp = "gte"
+	PropertyPredicateOpLike   PropertyPredicateOp = "like"
+	PropertyPredicateOpExists PropertyPredicateOp = "exists"
+)
+
+// AllValues returns all PropertyPredicateOp values.
+func (PropertyPredicateOp) AllValues() []PropertyPredicateOp {
+	return []PropertyPredicateOp{
+		PropertyPredicateOpEq,
+		PropertyPredicateOpNe,
+		PropertyPredicateOpLt,
+		PropertyPredicateOpLte,
+		PropertyPredicateOpGt,
+		PropertyPredicateOpGte,
+		PropertyPredicateOpLike,
+		PropertyPredicateOpExists,
 	}
-	return d
 }
 
-// NewOptResourceLocationType returns new OptResourceLocationType with value set to v.
-func NewOptResourceLocationType(v ResourceLocationType) OptResourceLocationType {
-	return OptResourceLocationType{
-		Value: v,
-		Set:   true,
+// MarshalText implements encoding.TextMarshaler.
+func (s PropertyPredicateOp) MarshalText() ([]byte, error) {
+	switch s {
+	case PropertyPredicateOpEq:
+		return []byte(s), nil
+	case PropertyPredicateOpNe:
+		return []byte(s), nil
+	case PropertyPredicateOpLt:
+		return []byte(s), nil
+	case PropertyPredicateOpLte:
+		return []byte(s), nil
+	case PropertyPredicateOpGt:
+		return []byte(s), nil
+	case PropertyPredicateOpGte:
+		return []byte(s), nil
+	case PropertyPredicateOpLike:
+		return []byte(s), nil
+	case PropertyPredicateOpExists:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
 }
 
-// OptResourceLocationType is optional ResourceLocationType.
-type OptResourceLocationType struct {
-	Value ResourceLocationType
-	Set   bool
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PropertyPredicateOp) UnmarshalText(data []byte) error {
+	switch PropertyPredicateOp(data) {
+	case PropertyPredicateOpEq:
+		*s = PropertyPredicateOpEq
+		return nil
+	case PropertyPredicateOpNe:
+		*s = PropertyPredicateOpNe
+		return nil
+	case PropertyPredicateOpLt:
+		*s = PropertyPredicateOpLt
+		return nil
+	case PropertyPredicateOpLte:
+		*s = PropertyPredicateOpLte
+		return nil
+	case PropertyPredicateOpGt:
+		*s = PropertyPredicateOpGt
+		return nil
+	case PropertyPredicateOpGte:
+		*s = PropertyPredicateOpGte
+		return nil
+	case PropertyPredicateOpLike:
+		*s = PropertyPredicateOpLike
+		return nil
+	case PropertyPredicateOpExists:
+		*s = PropertyPredicateOpExists
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// IsSet returns true if OptResourceLocationType was set.
-func (o OptResourceLocationType) IsSet() bool { return o.Set }
+type PropertyPredicateType string
 
-// Reset unsets value.
-func (o *OptResourceLocationType) Reset() {
-	var v ResourceLocationType
-	o.Value = v
-	o.Set = false
-}
+const (
+	PropertyPredicateTypeString   PropertyPredicateType = "string"
+	PropertyPredicateTypeNumber   PropertyPredicateType = "number"
+	PropertyPredicateTypeDatetime PropertyPredicateType = "datetime"
+	PropertyPredicateTypeBoolean  PropertyPredicateType = "boolean"
+)
 
-// SetTo sets value to v.
-func (o *OptResourceLocationType) SetTo(v ResourceLocationType) {
-	o.Set = true
-	o.Value = v
+// AllValues returns all PropertyPredicateType values.
+func (PropertyPredicateType) AllValues() []PropertyPredicateType {
+	return []PropertyPredicateType{
+		PropertyPredicateTypeString,
+		PropertyPredicateTypeNumber,
+		PropertyPredicateTypeDatetime,
+		PropertyPredicateTypeBoolean,
+	}
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptResourceLocationType) Get() (v ResourceLocationType, ok bool) {
-	if !o.Set {
-		return v, false
+// MarshalText implements encoding.TextMarshaler.
+func (s PropertyPredicateType) MarshalText() ([]byte, error) {
+	switch s {
+	case PropertyPredicateTypeString:
+		return []byte(s), nil
+	case PropertyPredicateTypeNumber:
+		return []byte(s), nil
+	case PropertyPredicateTypeDatetime:
+		return []byte(s), nil
+	case PropertyPredicateTypeBoolean:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptResourceLocationType) Or(d ResourceLocationType) ResourceLocationType {
-	if v, ok := o.Get(); ok {
-		return v
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PropertyPredicateType) UnmarshalText(data []byte) error {
+	switch PropertyPredicateType(data) {
+	case PropertyPredicateTypeString:
+		*s = PropertyPredicateTypeString
+		return nil
+	case PropertyPredicateTypeNumber:
+		*s = PropertyPredicateTypeNumber
+		return nil
+	case PropertyPredicateTypeDatetime:
+		*s = PropertyPredicateTypeDatetime
+		return nil
+	case PropertyPredicateTypeBoolean:
+		*s = PropertyPredicateTypeBoolean
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
-	return d
 }
 
-// NewOptSpatialDefinitionType returns new OptSpatialDefinitionType with value set to v.
-func NewOptSpatialDefinitionType(v SpatialDefinitionType) OptSpatialDefinitionType {
-	return OptSpatialDefinitionType{
-		Value: v,
-		Set:   true,
+// Ref: #/components/schemas/PropertyValue
+type PropertyValue struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+	// String, integer, float, boolean, datetime, duration (ISO 8601),
+	// enumeration или quantity (число с обязательной единицей
+	// измерения).
+	// Принимаются также имена типов B2MML/xsd (dateTime, decimal, unsignedInt..
+	// .).
+	// Если не указан, берётся из определения свойства в
+	// классе.
+	DataType OptString `json:"data_type"`
+	Unit     OptString `json:"unit"`
+}
+
+// GetID returns the value of ID.
+func (s *PropertyValue) GetID() string {
+	return s.ID
+}
+
+// GetValue returns the value of Value.
+func (s *PropertyValue) GetValue() string {
+	return s.Value
+}
+
+// GetDataType returns the value of DataType.
+func (s *PropertyValue) GetDataType() OptString {
+	return s.DataType
+}
+
+// GetUnit returns the value of Unit.
+func (s *PropertyValue) GetUnit() OptString {
+	return s.Unit
+}
+
+// SetID sets the value of ID.
+func (s *PropertyValue) SetID(val string) {
+	s.ID = val
+}
+
+// SetValue sets the value of Value.
+func (s *PropertyValue) SetValue(val string) {
+	s.Value = val
+}
+
+// SetDataType sets the value of DataType.
+func (s *PropertyValue) SetDataType(val OptString) {
+	s.DataType = val
+}
+
+// SetUnit sets the value of Unit.
+func (s *PropertyValue) SetUnit(val OptString) {
+	s.Unit = val
+}
+
+// Ref: #/components/schemas/RecordType
+type RecordType struct {
+	RecordID   OptString               `json:"RecordID"`
+	RecordType OptString               `json:"RecordType"`
+	RecordData OptRecordTypeRecordData `json:"RecordData"`
+}
+
+// GetRecordID returns the value of RecordID.
+func (s *RecordType) GetRecordID() OptString {
+	return s.RecordID
+}
+
+// GetRecordType returns the value of RecordType.
+func (s *RecordType) GetRecordType() OptString {
+	return s.RecordType
+}
+
+// GetRecordData returns the value of RecordData.
+func (s *RecordType) GetRecordData() OptRecordTypeRecordData {
+	return s.RecordData
+}
+
+// SetRecordID sets the value of RecordID.
+func (s *RecordType) SetRecordID(val OptString) {
+	s.RecordID = val
+}
+
+// SetRecordType sets the value of RecordType.
+func (s *RecordType) SetRecordType(val OptString) {
+	s.RecordType = val
+}
+
+// SetRecordData sets the value of RecordData.
+func (s *RecordType) SetRecordData(val OptRecordTypeRecordData) {
+	s.RecordData = val
+}
+
+type RecordTypeRecordData map[string]jx.Raw
+
+func (s *RecordTypeRecordData) init() RecordTypeRecordData {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
+	return m
+}
+
+// Ref: #/components/schemas/RegulatoryInformationType
+type RegulatoryInformationType struct {
+	RegulatoryStatus     OptString                   `json:"RegulatoryStatus"`
+	ComplianceStandards  OptString                   `json:"ComplianceStandards"`
+	CertificationDetails OptCertificationDetailsType `json:"CertificationDetails"`
+}
+
+// GetRegulatoryStatus returns the value of RegulatoryStatus.
+func (s *RegulatoryInformationType) GetRegulatoryStatus() OptString {
+	return s.RegulatoryStatus
+}
+
+// GetComplianceStandards returns the value of ComplianceStandards.
+func (s *RegulatoryInformationType) GetComplianceStandards() OptString {
+	return s.ComplianceStandards
 }
 
-// OptSpatialDefinitionType is optional SpatialDefinitionType.
-type OptSpatialDefinitionType struct {
-	Value SpatialDefinitionType
-	Set   bool
+// GetCertificationDetails returns the value of CertificationDetails.
+func (s *RegulatoryInformationType) GetCertificationDetails() OptCertificationDetailsType {
+	return s.CertificationDetails
 }
 
-// IsSet returns true if OptSpatialDefinitionType was set.
-func (o OptSpatialDefinitionType) IsSet() bool { return o.Set }
+// SetRegulatoryStatus sets the value of RegulatoryStatus.
+func (s *RegulatoryInformationType) SetRegulatoryStatus(val OptString) {
+	s.RegulatoryStatus = val
+}
 
-// Reset unsets value.
-func (o *OptSpatialDefinitionType) Reset() {
-	var v SpatialDefinitionType
-	o.Value = v
-	o.Set = false
+// SetComplianceStandards sets the value of ComplianceStandards.
+func (s *RegulatoryInformationType) SetComplianceStandards(val OptString) {
+	s.ComplianceStandards = val
 }
 
-// SetTo sets value to v.
-func (o *OptSpatialDefinitionType) SetTo(v SpatialDefinitionType) {
-	o.Set = true
-	o.Value = v
+// SetCertificationDetails sets the value of CertificationDetails.
+func (s *RegulatoryInformationType) SetCertificationDetails(val OptCertificationDetailsType) {
+	s.CertificationDetails = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptSpatialDefinitionType) Get() (v SpatialDefinitionType, ok bool) {
-	if !o.Set {
-		return v, false
+// Ref: #/components/schemas/ResourceLocationType
+type ResourceLocationType map[string]jx.Raw
+
+func (s *ResourceLocationType) init() ResourceLocationType {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
-	return o.Value, true
+	return m
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptSpatialDefinitionType) Or(d SpatialDefinitionType) SpatialDefinitionType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/components/schemas/SchemaViolation
+type SchemaViolation struct {
+	// XPath элемента или атрибута, например
+	// /SyncEquipment/DataArea/Equipment[2]/ID;
+	// для канонического JSON - путь от поля B2MML
+	// (/B2MML/EquipmentProperty[2]/ID).
+	Path    string              `json:"path"`
+	Code    SchemaViolationCode `json:"code"`
+	Message string              `json:"message"`
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetPath returns the value of Path.
+func (s *SchemaViolation) GetPath() string {
+	return s.Path
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetCode returns the value of Code.
+func (s *SchemaViolation) GetCode() SchemaViolationCode {
+	return s.Code
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// GetMessage returns the value of Message.
+func (s *SchemaViolation) GetMessage() string {
+	return s.Message
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// SetPath sets the value of Path.
+func (s *SchemaViolation) SetPath(val string) {
+	s.Path = val
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetCode sets the value of Code.
+func (s *SchemaViolation) SetCode(val SchemaViolationCode) {
+	s.Code = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetMessage sets the value of Message.
+func (s *SchemaViolation) SetMessage(val string) {
+	s.Message = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
+type SchemaViolationCode string
+
+const (
+	SchemaViolationCodeRequired    SchemaViolationCode = "required"
+	SchemaViolationCodeEnumeration SchemaViolationCode = "enumeration"
+	SchemaViolationCodeCardinality SchemaViolationCode = "cardinality"
+	SchemaViolationCodeFormat      SchemaViolationCode = "format"
+	SchemaViolationCodeUnexpected  SchemaViolationCode = "unexpected"
+)
+
+// AllValues returns all SchemaViolationCode values.
+func (SchemaViolationCode) AllValues() []SchemaViolationCode {
+	return []SchemaViolationCode{
+		SchemaViolationCodeRequired,
+		SchemaViolationCodeEnumeration,
+		SchemaViolationCodeCardinality,
+		SchemaViolationCodeFormat,
+		SchemaViolationCodeUnexpected,
 	}
-	return d
 }
 
-// NewOptThermalPropertiesType returns new OptThermalPropertiesType with value set to v.
-func NewOptThermalPropertiesType(v ThermalPropertiesType) OptThermalPropertiesType {
-	return OptThermalPropertiesType{
-		Value: v,
-		Set:   true,
+// MarshalText implements encoding.TextMarshaler.
+func (s SchemaViolationCode) MarshalText() ([]byte, error) {
+	switch s {
+	case SchemaViolationCodeRequired:
+		return []byte(s), nil
+	case SchemaViolationCodeEnumeration:
+		return []byte(s), nil
+	case SchemaViolationCodeCardinality:
+		return []byte(s), nil
+	case SchemaViolationCodeFormat:
+		return []byte(s), nil
+	case SchemaViolationCodeUnexpected:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
 }
 
-// OptThermalPropertiesType is optional ThermalPropertiesType.
-type OptThermalPropertiesType struct {
-	Value ThermalPropertiesType
-	Set   bool
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SchemaViolationCode) UnmarshalText(data []byte) error {
+	switch SchemaViolationCode(data) {
+	case SchemaViolationCodeRequired:
+		*s = SchemaViolationCodeRequired
+		return nil
+	case SchemaViolationCodeEnumeration:
+		*s = SchemaViolationCodeEnumeration
+		return nil
+	case SchemaViolationCodeCardinality:
+		*s = SchemaViolationCodeCardinality
+		return nil
+	case SchemaViolationCodeFormat:
+		*s = SchemaViolationCodeFormat
+		return nil
+	case SchemaViolationCodeUnexpected:
+		*s = SchemaViolationCodeUnexpected
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// IsSet returns true if OptThermalPropertiesType was set.
-func (o OptThermalPropertiesType) IsSet() bool { return o.Set }
+// SearchGetBadRequest is response for SearchGet operation.
+type SearchGetBadRequest struct{}
 
-// Reset unsets value.
-func (o *OptThermalPropertiesType) Reset() {
-	var v ThermalPropertiesType
-	o.Value = v
-	o.Set = false
-}
+func (*SearchGetBadRequest) searchGetRes() {}
 
-// SetTo sets value to v.
-func (o *OptThermalPropertiesType) SetTo(v ThermalPropertiesType) {
-	o.Set = true
-	o.Value = v
-}
+type SearchGetKindItem string
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptThermalPropertiesType) Get() (v ThermalPropertiesType, ok bool) {
-	if !o.Set {
-		return v, false
+const (
+	SearchGetKindItemEquipment      SearchGetKindItem = "equipment"
+	SearchGetKindItemEquipmentClass SearchGetKindItem = "equipment_class"
+	SearchGetKindItemPhysicalAsset  SearchGetKindItem = "physical_asset"
+)
+
+// AllValues returns all SearchGetKindItem values.
+func (SearchGetKindItem) AllValues() []SearchGetKindItem {
+	return []SearchGetKindItem{
+		SearchGetKindItemEquipment,
+		SearchGetKindItemEquipmentClass,
+		SearchGetKindItemPhysicalAsset,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptThermalPropertiesType) Or(d ThermalPropertiesType) ThermalPropertiesType {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchGetKindItem) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchGetKindItemEquipment:
+		return []byte(s), nil
+	case SearchGetKindItemEquipmentClass:
+		return []byte(s), nil
+	case SearchGetKindItemPhysicalAsset:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptTraceabilityInformationType returns new OptTraceabilityInformationType with value set to v.
-func NewOptTraceabilityInformationType(v TraceabilityInformationType) OptTraceabilityInformationType {
-	return OptTraceabilityInformationType{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchGetKindItem) UnmarshalText(data []byte) error {
+	switch SearchGetKindItem(data) {
+	case SearchGetKindItemEquipment:
+		*s = SearchGetKindItemEquipment
+		return nil
+	case SearchGetKindItemEquipmentClass:
+		*s = SearchGetKindItemEquipmentClass
+		return nil
+	case SearchGetKindItemPhysicalAsset:
+		*s = SearchGetKindItemPhysicalAsset
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptTraceabilityInformationType is optional TraceabilityInformationType.
-type OptTraceabilityInformationType struct {
-	Value TraceabilityInformationType
-	Set   bool
+// Ref: #/components/schemas/SearchHit
+type SearchHit struct {
+	Kind SearchHitKind `json:"kind"`
+	// Внешний идентификатор найденного объекта.
+	ID string `json:"id"`
+	// Оборудование, к которому привязан физический актив.
+	EquipmentID OptString `json:"equipment_id"`
+	Title       OptString `json:"title"`
+	// HTML-фрагмент текста, совпадения выделены тегом mark.
+	Headline string  `json:"headline"`
+	Rank     float32 `json:"rank"`
 }
 
-// IsSet returns true if OptTraceabilityInformationType was set.
-func (o OptTraceabilityInformationType) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptTraceabilityInformationType) Reset() {
-	var v TraceabilityInformationType
-	o.Value = v
-	o.Set = false
+// GetKind returns the value of Kind.
+func (s *SearchHit) GetKind() SearchHitKind {
+	return s.Kind
 }
 
-// SetTo sets value to v.
-func (o *OptTraceabilityInformationType) SetTo(v TraceabilityInformationType) {
-	o.Set = true
-	o.Value = v
+// GetID returns the value of ID.
+func (s *SearchHit) GetID() string {
+	return s.ID
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptTraceabilityInformationType) Get() (v TraceabilityInformationType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetEquipmentID returns the value of EquipmentID.
+func (s *SearchHit) GetEquipmentID() OptString {
+	return s.EquipmentID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptTraceabilityInformationType) Or(d TraceabilityInformationType) TraceabilityInformationType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetTitle returns the value of Title.
+func (s *SearchHit) GetTitle() OptString {
+	return s.Title
 }
 
-// Ref: #/components/schemas/PerformanceDataType
-type PerformanceDataType struct {
-	Metric []MetricType `json:"Metric"`
+// GetHeadline returns the value of Headline.
+func (s *SearchHit) GetHeadline() string {
+	return s.Headline
 }
 
-// GetMetric returns the value of Metric.
-func (s *PerformanceDataType) GetMetric() []MetricType {
-	return s.Metric
+// GetRank returns the value of Rank.
+func (s *SearchHit) GetRank() float32 {
+	return s.Rank
 }
 
-// SetMetric sets the value of Metric.
-func (s *PerformanceDataType) SetMetric(val []MetricType) {
-	s.Metric = val
+// SetKind sets the value of Kind.
+func (s *SearchHit) SetKind(val SearchHitKind) {
+	s.Kind = val
 }
 
-// Ref: #/components/schemas/PersonPropertyType
-type PersonPropertyType struct {
-	ID                       string               `json:"ID"`
-	Description              []string             `json:"Description"`
-	Value                    []ValueType          `json:"Value"`
-	PersonPropertyChild      []PersonPropertyType `json:"PersonPropertyChild"`
-	PersonnelClassPropertyID OptString            `json:"PersonnelClassPropertyID"`
+// SetID sets the value of ID.
+func (s *SearchHit) SetID(val string) {
+	s.ID = val
 }
 
-// GetID returns the value of ID.
-func (s *PersonPropertyType) GetID() string {
-	return s.ID
+// SetEquipmentID sets the value of EquipmentID.
+func (s *SearchHit) SetEquipmentID(val OptString) {
+	s.EquipmentID = val
 }
 
-// GetDescription returns the value of Description.
-func (s *PersonPropertyType) GetDescription() []string {
-	return s.Description
+// SetTitle sets the value of Title.
+func (s *SearchHit) SetTitle(val OptString) {
+	s.Title = val
 }
 
-// GetValue returns the value of Value.
-func (s *PersonPropertyType) GetValue() []ValueType {
-	return s.Value
+// SetHeadline sets the value of Headline.
+func (s *SearchHit) SetHeadline(val string) {
+	s.Headline = val
 }
 
-// GetPersonPropertyChild returns the value of PersonPropertyChild.
-func (s *PersonPropertyType) GetPersonPropertyChild() []PersonPropertyType {
-	return s.PersonPropertyChild
+// SetRank sets the value of Rank.
+func (s *SearchHit) SetRank(val float32) {
+	s.Rank = val
 }
 
-// GetPersonnelClassPropertyID returns the value of PersonnelClassPropertyID.
-func (s *PersonPropertyType) GetPersonnelClassPropertyID() OptString {
-	return s.PersonnelClassPropertyID
-}
+type SearchHitKind string
 
-// SetID sets the value of ID.
-func (s *PersonPropertyType) SetID(val string) {
-	s.ID = val
-}
+const (
+	SearchHitKindEquipment      SearchHitKind = "equipment"
+	SearchHitKindEquipmentClass SearchHitKind = "equipment_class"
+	SearchHitKindPhysicalAsset  SearchHitKind = "physical_asset"
+)
 
-// SetDescription sets the value of Description.
-func (s *PersonPropertyType) SetDescription(val []string) {
-	s.Description = val
+// AllValues returns all SearchHitKind values.
+func (SearchHitKind) AllValues() []SearchHitKind {
+	return []SearchHitKind{
+		SearchHitKindEquipment,
+		SearchHitKindEquipmentClass,
+		SearchHitKindPhysicalAsset,
+	}
 }
 
-// SetValue sets the value of Value.
-func (s *PersonPropertyType) SetValue(val []ValueType) {
-	s.Value = val
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchHitKind) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchHitKindEquipment:
+		return []byte(s), nil
+	case SearchHitKindEquipmentClass:
+		return []byte(s), nil
+	case SearchHitKindPhysicalAsset:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetPersonPropertyChild sets the value of PersonPropertyChild.
-func (s *PersonPropertyType) SetPersonPropertyChild(val []PersonPropertyType) {
-	s.PersonPropertyChild = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchHitKind) UnmarshalText(data []byte) error {
+	switch SearchHitKind(data) {
+	case SearchHitKindEquipment:
+		*s = SearchHitKindEquipment
+		return nil
+	case SearchHitKindEquipmentClass:
+		*s = SearchHitKindEquipmentClass
+		return nil
+	case SearchHitKindPhysicalAsset:
+		*s = SearchHitKindPhysicalAsset
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetPersonnelClassPropertyID sets the value of PersonnelClassPropertyID.
-func (s *PersonPropertyType) SetPersonnelClassPropertyID(val OptString) {
-	s.PersonnelClassPropertyID = val
+// Ref: #/components/schemas/SearchResults
+type SearchResults struct {
+	Items []SearchHit `json:"items"`
 }
 
-// Ref: #/components/schemas/PersonType
-type PersonType struct {
-	ID                  string                   `json:"ID"`
-	Version             OptString                `json:"Version"`
-	Description         []string                 `json:"Description"`
-	PublishedDate       OptDateTime              `json:"PublishedDate"`
-	EffectiveStartDate  OptDateTime              `json:"EffectiveStartDate"`
-	EffectiveEndDate    OptDateTime              `json:"EffectiveEndDate"`
-	HierarchyScope      OptHierarchyScopeType    `json:"HierarchyScope"`
-	PersonName          OptString                `json:"PersonName"`
-	SpatialDefinition   OptSpatialDefinitionType `json:"SpatialDefinition"`
-	OperationalLocation OptResourceLocationType  `json:"OperationalLocation"`
-	PersonProperty      []PersonPropertyType     `json:"PersonProperty"`
-	PersonnelClassID    []string                 `json:"PersonnelClassID"`
-	TestSpecificationID []string                 `json:"TestSpecificationID"`
+// GetItems returns the value of Items.
+func (s *SearchResults) GetItems() []SearchHit {
+	return s.Items
 }
 
-// GetID returns the value of ID.
-func (s *PersonType) GetID() string {
-	return s.ID
+// SetItems sets the value of Items.
+func (s *SearchResults) SetItems(val []SearchHit) {
+	s.Items = val
 }
 
-// GetVersion returns the value of Version.
-func (s *PersonType) GetVersion() OptString {
-	return s.Version
-}
+func (*SearchResults) searchGetRes() {}
 
-// GetDescription returns the value of Description.
-func (s *PersonType) GetDescription() []string {
-	return s.Description
+// Ref: #/components/schemas/SiteUnits
+type SiteUnits struct {
+	SiteID OptString `json:"site_id"`
+	// Предпочтительная единица для каждого вида величины,
+	// например power - kW.
+	Units SiteUnitsUnits `json:"units"`
 }
 
-// GetPublishedDate returns the value of PublishedDate.
-func (s *PersonType) GetPublishedDate() OptDateTime {
-	return s.PublishedDate
+// GetSiteID returns the value of SiteID.
+func (s *SiteUnits) GetSiteID() OptString {
+	return s.SiteID
 }
 
-// GetEffectiveStartDate returns the value of EffectiveStartDate.
-func (s *PersonType) GetEffectiveStartDate() OptDateTime {
-	return s.EffectiveStartDate
+// GetUnits returns the value of Units.
+func (s *SiteUnits) GetUnits() SiteUnitsUnits {
+	return s.Units
 }
 
-// GetEffectiveEndDate returns the value of EffectiveEndDate.
-func (s *PersonType) GetEffectiveEndDate() OptDateTime {
-	return s.EffectiveEndDate
+// SetSiteID sets the value of SiteID.
+func (s *SiteUnits) SetSiteID(val OptString) {
+	s.SiteID = val
 }
 
-// GetHierarchyScope returns the value of HierarchyScope.
-func (s *PersonType) GetHierarchyScope() OptHierarchyScopeType {
-	return s.HierarchyScope
+// SetUnits sets the value of Units.
+func (s *SiteUnits) SetUnits(val SiteUnitsUnits) {
+	s.Units = val
 }
 
-// GetPersonName returns the value of PersonName.
-func (s *PersonType) GetPersonName() OptString {
-	return s.PersonName
+func (*SiteUnits) sitesIDUnitsGetRes() {}
+func (*SiteUnits) sitesIDUnitsPutRes() {}
+
+// Предпочтительная единица для каждого вида величины,
+// например power - kW.
+type SiteUnitsUnits map[string]string
+
+func (s *SiteUnitsUnits) init() SiteUnitsUnits {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
 }
 
-// GetSpatialDefinition returns the value of SpatialDefinition.
-func (s *PersonType) GetSpatialDefinition() OptSpatialDefinitionType {
-	return s.SpatialDefinition
+// SitesIDUnitsGetNotFound is response for SitesIDUnitsGet operation.
+type SitesIDUnitsGetNotFound struct{}
+
+func (*SitesIDUnitsGetNotFound) sitesIDUnitsGetRes() {}
+
+// SitesIDUnitsGetUnprocessableEntity is response for SitesIDUnitsGet operation.
+type SitesIDUnitsGetUnprocessableEntity struct{}
+
+func (*SitesIDUnitsGetUnprocessableEntity) sitesIDUnitsGetRes() {}
+
+// SitesIDUnitsPutBadRequest is response for SitesIDUnitsPut operation.
+type SitesIDUnitsPutBadRequest struct{}
+
+func (*SitesIDUnitsPutBadRequest) sitesIDUnitsPutRes() {}
+
+// SitesIDUnitsPutNotFound is response for SitesIDUnitsPut operation.
+type SitesIDUnitsPutNotFound struct{}
+
+func (*SitesIDUnitsPutNotFound) sitesIDUnitsPutRes() {}
+
+// SitesIDUnitsPutUnprocessableEntity is response for SitesIDUnitsPut operation.
+type SitesIDUnitsPutUnprocessableEntity struct{}
+
+func (*SitesIDUnitsPutUnprocessableEntity) sitesIDUnitsPutRes() {}
+
+// Ref: #/components/schemas/SpatialDefinitionType
+type SpatialDefinitionType map[string]jx.Raw
+
+func (s *SpatialDefinitionType) init() SpatialDefinitionType {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// GetOperationalLocation returns the value of OperationalLocation.
-func (s *PersonType) GetOperationalLocation() OptResourceLocationType {
-	return s.OperationalLocation
+// Ref: #/components/schemas/TestSpecificationType
+type TestSpecificationType struct {
+	ID              OptString `json:"ID"`
+	Description     OptString `json:"Description"`
+	AdditionalProps TestSpecificationTypeAdditional
 }
 
-// GetPersonProperty returns the value of PersonProperty.
-func (s *PersonType) GetPersonProperty() []PersonPropertyType {
-	return s.PersonProperty
+// GetID returns the value of ID.
+func (s *TestSpecificationType) GetID() OptString {
+	return s.ID
 }
 
-// GetPersonnelClassID returns the value of PersonnelClassID.
-func (s *PersonType) GetPersonnelClassID() []string {
-	return s.PersonnelClassID
+// GetDescription returns the value of Description.
+func (s *TestSpecificationType) GetDescription() OptString {
+	return s.Description
 }
 
-// GetTestSpecificationID returns the value of TestSpecificationID.
-func (s *PersonType) GetTestSpecificationID() []string {
-	return s.TestSpecificationID
+// GetAdditionalProps returns the value of AdditionalProps.
+func (s *TestSpecificationType) GetAdditionalProps() TestSpecificationTypeAdditional {
+	return s.AdditionalProps
 }
 
 // SetID sets the value of ID.
-func (s *PersonType) SetID(val string) {
+func (s *TestSpecificationType) SetID(val OptString) {
 	s.ID = val
 }
 
-// SetVersion sets the value of Version.
-func (s *PersonType) SetVersion(val OptString) {
-	s.Version = val
-}
-
 // SetDescription sets the value of Description.
-func (s *PersonType) SetDescription(val []string) {
+func (s *TestSpecificationType) SetDescription(val OptString) {
 	s.Description = val
 }
 
-// SetPublishedDate sets the value of PublishedDate.
-func (s *PersonType) SetPublishedDate(val OptDateTime) {
-	s.PublishedDate = val
+// SetAdditionalProps sets the value of AdditionalProps.
+func (s *TestSpecificationType) SetAdditionalProps(val TestSpecificationTypeAdditional) {
+	s.AdditionalProps = val
 }
 
-// SetEffectiveStartDate sets the value of EffectiveStartDate.
-func (s *PersonType) SetEffectiveStartDate(val OptDateTime) {
-	s.EffectiveStartDate = val
+type TestSpecificationTypeAdditional map[string]jx.Raw
+
+func (s *TestSpecificationTypeAdditional) init() TestSpecificationTypeAdditional {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetEffectiveEndDate sets the value of EffectiveEndDate.
-func (s *PersonType) SetEffectiveEndDate(val OptDateTime) {
-	s.EffectiveEndDate = val
+// Ref: #/components/schemas/ThermalPropertiesType
+type ThermalPropertiesType struct {
+	MeltingPoint        OptFloat64 `json:"MeltingPoint"`
+	BoilingPoint        OptFloat64 `json:"BoilingPoint"`
+	ThermalConductivity OptFloat64 `json:"ThermalConductivity"`
+	SpecificHeat        OptFloat64 `json:"SpecificHeat"`
 }
 
-// SetHierarchyScope sets the value of HierarchyScope.
-func (s *PersonType) SetHierarchyScope(val OptHierarchyScopeType) {
-	s.HierarchyScope = val
+// GetMeltingPoint returns the value of MeltingPoint.
+func (s *ThermalPropertiesType) GetMeltingPoint() OptFloat64 {
+	return s.MeltingPoint
 }
 
-// SetPersonName sets the value of PersonName.
-func (s *PersonType) SetPersonName(val OptString) {
-	s.PersonName = val
+// GetBoilingPoint returns the value of BoilingPoint.
+func (s *ThermalPropertiesType) GetBoilingPoint() OptFloat64 {
+	return s.BoilingPoint
 }
 
-// SetSpatialDefinition sets the value of SpatialDefinition.
-func (s *PersonType) SetSpatialDefinition(val OptSpatialDefinitionType) {
-	s.SpatialDefinition = val
+// GetThermalConductivity returns the value of ThermalConductivity.
+func (s *ThermalPropertiesType) GetThermalConductivity() OptFloat64 {
+	return s.ThermalConductivity
 }
 
-// SetOperationalLocation sets the value of OperationalLocation.
-func (s *PersonType) SetOperationalLocation(val OptResourceLocationType) {
-	s.OperationalLocation = val
+// GetSpecificHeat returns the value of SpecificHeat.
+func (s *ThermalPropertiesType) GetSpecificHeat() OptFloat64 {
+	return s.SpecificHeat
 }
 
-// SetPersonProperty sets the value of PersonProperty.
-func (s *PersonType) SetPersonProperty(val []PersonPropertyType) {
-	s.PersonProperty = val
+// SetMeltingPoint sets the value of MeltingPoint.
+func (s *ThermalPropertiesType) SetMeltingPoint(val OptFloat64) {
+	s.MeltingPoint = val
 }
 
-// SetPersonnelClassID sets the value of PersonnelClassID.
-func (s *PersonType) SetPersonnelClassID(val []string) {
-	s.PersonnelClassID = val
+// SetBoilingPoint sets the value of BoilingPoint.
+func (s *ThermalPropertiesType) SetBoilingPoint(val OptFloat64) {
+	s.BoilingPoint = val
 }
 
-// SetTestSpecificationID sets the value of TestSpecificationID.
-func (s *PersonType) SetTestSpecificationID(val []string) {
-	s.TestSpecificationID = val
+// SetThermalConductivity sets the value of ThermalConductivity.
+func (s *ThermalPropertiesType) SetThermalConductivity(val OptFloat64) {
+	s.ThermalConductivity = val
 }
 
-// Ref: #/components/schemas/PersonnelClassPropertyType
-type PersonnelClassPropertyType struct {
-	ID                          string                       `json:"ID"`
-	Description                 []string                     `json:"Description"`
-	PropertyType                OptString                    `json:"PropertyType"`
-	Value                       []ValueType                  `json:"Value"`
-	PersonnelClassPropertyChild []PersonnelClassPropertyType `json:"PersonnelClassPropertyChild"`
+// SetSpecificHeat sets the value of SpecificHeat.
+func (s *ThermalPropertiesType) SetSpecificHeat(val OptFloat64) {
+	s.SpecificHeat = val
 }
 
-// GetID returns the value of ID.
-func (s *PersonnelClassPropertyType) GetID() string {
-	return s.ID
+// Ref: #/components/schemas/TraceHistoryType
+type TraceHistoryType struct {
+	Timestamp        OptDateTime `json:"Timestamp"`
+	Location         OptString   `json:"Location"`
+	Action           OptString   `json:"Action"`
+	ResponsibleParty OptString   `json:"ResponsibleParty"`
 }
 
-// GetDescription returns the value of Description.
-func (s *PersonnelClassPropertyType) GetDescription() []string {
-	return s.Description
+// GetTimestamp returns the value of Timestamp.
+func (s *TraceHistoryType) GetTimestamp() OptDateTime {
+	return s.Timestamp
 }
 
-// GetPropertyType returns the value of PropertyType.
-func (s *PersonnelClassPropertyType) GetPropertyType() OptString {
-	return s.PropertyType
+// GetLocation returns the value of Location.
+func (s *TraceHistoryType) GetLocation() OptString {
+	return s.Location
 }
 
-// GetValue returns the value of Value.
-func (s *PersonnelClassPropertyType) GetValue() []ValueType {
-	return s.Value
+// GetAction returns the value of Action.
+func (s *TraceHistoryType) GetAction() OptString {
+	return s.Action
 }
 
-// GetPersonnelClassPropertyChild returns the value of PersonnelClassPropertyChild.
-func (s *PersonnelClassPropertyType) GetPersonnelClassPropertyChild() []PersonnelClassPropertyType {
-	return s.PersonnelClassPropertyChild
+// GetResponsibleParty returns the value of ResponsibleParty.
+func (s *TraceHistoryType) GetResponsibleParty() OptString {
+	return s.ResponsibleParty
 }
 
-// SetID sets the value of ID.
-func (s *PersonnelClassPropertyType) SetID(val string) {
-	s.ID = val
+// SetTimestamp sets the value of Timestamp.
+func (s *TraceHistoryType) SetTimestamp(val OptDateTime) {
+	s.Timestamp = val
 }
 
-// SetDescription sets the value of Description.
-func (s *PersonnelClassPropertyType) SetDescription(val []string) {
-	s.Description = val
+// SetLocation sets the value of Location.
+func (s *TraceHistoryType) SetLocation(val OptString) {
+	s.Location = val
 }
 
-// SetPropertyType sets the value of PropertyType.
-func (s *PersonnelClassPropertyType) SetPropertyType(val OptString) {
-	s.PropertyType = val
+// SetAction sets the value of Action.
+func (s *TraceHistoryType) SetAction(val OptString) {
+	s.Action = val
 }
 
-// SetValue sets the value of Value.
-func (s *PersonnelClassPropertyType) SetValue(val []ValueType) {
-	s.Value = val
+// SetResponsibleParty sets the value of ResponsibleParty.
+func (s *TraceHistoryType) SetResponsibleParty(val OptString) {
+	s.ResponsibleParty = val
+}
+
+// Ref: #/components/schemas/TraceabilityInformationType
+type TraceabilityInformationType struct {
+	BatchNumber    OptString          `json:"BatchNumber"`
+	LotNumber      OptString          `json:"LotNumber"`
+	ProductionDate OptDate            `json:"ProductionDate"`
+	ExpirationDate OptDate            `json:"ExpirationDate"`
+	TraceHistory   []TraceHistoryType `json:"TraceHistory"`
 }
 
-// SetPersonnelClassPropertyChild sets the value of PersonnelClassPropertyChild.
-func (s *PersonnelClassPropertyType) SetPersonnelClassPropertyChild(val []PersonnelClassPropertyType) {
-	s.PersonnelClassPropertyChild = val
+// GetBatchNumber returns the value of BatchNumber.
+func (s *TraceabilityInformationType) GetBatchNumber() OptString {
+	return s.BatchNumber
 }
 
-// Ref: #/components/schemas/PersonnelClassType
-type PersonnelClassType struct {
-	ID                     string                       `json:"ID"`
-	Version                OptString                    `json:"Version"`
-	Description            []string                     `json:"Description"`
-	PublishedDate          OptDateTime                  `json:"PublishedDate"`
-	EffectiveStartDate     OptDateTime                  `json:"EffectiveStartDate"`
-	EffectiveEndDate       OptDateTime                  `json:"EffectiveEndDate"`
-	HierarchyScope         OptHierarchyScopeType        `json:"HierarchyScope"`
-	PersonnelClassBaseID   []string                     `json:"PersonnelClassBaseID"`
-	PersonnelClassProperty []PersonnelClassPropertyType `json:"PersonnelClassProperty"`
-	PersonSourceID         []string                     `json:"PersonSourceID"`
-	TestSpecificationID    []string                     `json:"TestSpecificationID"`
+// GetLotNumber returns the value of LotNumber.
+func (s *TraceabilityInformationType) GetLotNumber() OptString {
+	return s.LotNumber
 }
 
-// GetID returns the value of ID.
-func (s *PersonnelClassType) GetID() string {
-	return s.ID
+// GetProductionDate returns the value of ProductionDate.
+func (s *TraceabilityInformationType) GetProductionDate() OptDate {
+	return s.ProductionDate
 }
 
-// GetVersion returns the value of Version.
-func (s *PersonnelClassType) GetVersion() OptString {
-	return s.Version
+// GetExpirationDate returns the value of ExpirationDate.
+func (s *TraceabilityInformationType) GetExpirationDate() OptDate {
+	return s.ExpirationDate
 }
 
-// GetDescription returns the value of Description.
-func (s *PersonnelClassType) GetDescription() []string {
-	return s.Description
+// GetTraceHistory returns the value of TraceHistory.
+func (s *TraceabilityInformationType) GetTraceHistory() []TraceHistoryType {
+	return s.TraceHistory
 }
 
-// GetPublishedDate returns the value of PublishedDate.
-func (s *PersonnelClassType) GetPublishedDate() OptDateTime {
-	return s.PublishedDate
+// SetBatchNumber sets the value of BatchNumber.
+func (s *TraceabilityInformationType) SetBatchNumber(val OptString) {
+	s.BatchNumber = val
 }
 
-// GetEffectiveStartDate returns the value of EffectiveStartDate.
-func (s *PersonnelClassType) GetEffectiveStartDate() OptDateTime {
-	return s.EffectiveStartDate
+// SetLotNumber sets the value of LotNumber.
+func (s *TraceabilityInformationType) SetLotNumber(val OptString) {
+	s.LotNumber = val
 }
 
-// GetEffectiveEndDate returns the value of EffectiveEndDate.
-func (s *PersonnelClassType) GetEffectiveEndDate() OptDateTime {
-	return s.EffectiveEndDate
+// SetProductionDate sets the value of ProductionDate.
+func (s *TraceabilityInformationType) SetProductionDate(val OptDate) {
+	s.ProductionDate = val
 }
 
-// GetHierarchyScope returns the value of HierarchyScope.
-func (s *PersonnelClassType) GetHierarchyScope() OptHierarchyScopeType {
-	return s.HierarchyScope
+// SetExpirationDate sets the value of ExpirationDate.
+func (s *TraceabilityInformationType) SetExpirationDate(val OptDate) {
+	s.ExpirationDate = val
 }
 
-// GetPersonnelClassBaseID returns the value of PersonnelClassBaseID.
-func (s *PersonnelClassType) GetPersonnelClassBaseID() []string {
-	return s.PersonnelClassBaseID
+// SetTraceHistory sets the value of TraceHistory.
+func (s *TraceabilityInformationType) SetTraceHistory(val []TraceHistoryType) {
+	s.TraceHistory = val
 }
 
-// GetPersonnelClassProperty returns the value of PersonnelClassProperty.
-func (s *PersonnelClassType) GetPersonnelClassProperty() []PersonnelClassPropertyType {
-	return s.PersonnelClassProperty
+// Ref: #/components/schemas/UnitConversion
+type UnitConversion struct {
+	Value       float64 `json:"value"`
+	Unit        string  `json:"unit"`
+	SourceValue float64 `json:"source_value"`
+	SourceUnit  string  `json:"source_unit"`
 }
 
-// GetPersonSourceID returns the value of PersonSourceID.
-func (s *PersonnelClassType) GetPersonSourceID() []string {
-	return s.PersonSourceID
+// GetValue returns the value of Value.
+func (s *UnitConversion) GetValue() float64 {
+	return s.Value
 }
 
-// GetTestSpecificationID returns the value of TestSpecificationID.
-func (s *PersonnelClassType) GetTestSpecificationID() []string {
-	return s.TestSpecificationID
+// GetUnit returns the value of Unit.
+func (s *UnitConversion) GetUnit() string {
+	return s.Unit
 }
 
-// SetID sets the value of ID.
-func (s *PersonnelClassType) SetID(val string) {
-	s.ID = val
+// GetSourceValue returns the value of SourceValue.
+func (s *UnitConversion) GetSourceValue() float64 {
+	return s.SourceValue
 }
 
-// SetVersion sets the value of Version.
-func (s *PersonnelClassType) SetVersion(val OptString) {
-	s.Version = val
+// GetSourceUnit returns the value of SourceUnit.
+func (s *UnitConversion) GetSourceUnit() string {
+	return s.SourceUnit
 }
 
-// SetDescription sets the value of Description.
-func (s *PersonnelClassType) SetDescription(val []string) {
-	s.Description = val
+// SetValue sets the value of Value.
+func (s *UnitConversion) SetValue(val float64) {
+	s.Value = val
 }
 
-// SetPublishedDate sets the value of PublishedDate.
-func (s *PersonnelClassType) SetPublishedDate(val OptDateTime) {
-	s.PublishedDate = val
+// SetUnit sets the value of Unit.
+func (s *UnitConversion) SetUnit(val string) {
+	s.Unit = val
 }
 
-// SetEffectiveStartDate sets the value of EffectiveStartDate.
-func (s *PersonnelClassType) SetEffectiveStartDate(val OptDateTime) {
-	s.EffectiveStartDate = val
+// SetSourceValue sets the value of SourceValue.
+func (s *UnitConversion) SetSourceValue(val float64) {
+	s.SourceValue = val
 }
 
-// SetEffectiveEndDate sets the value of EffectiveEndDate.
-func (s *PersonnelClassType) SetEffectiveEndDate(val OptDateTime) {
-	s.EffectiveEndDate = val
+// SetSourceUnit sets the value of SourceUnit.
+func (s *UnitConversion) SetSourceUnit(val string) {
+	s.SourceUnit = val
 }
 
-// SetHierarchyScope sets the value of HierarchyScope.
-func (s *PersonnelClassType) SetHierarchyScope(val OptHierarchyScopeType) {
-	s.HierarchyScope = val
+func (*UnitConversion) unitsConvertGetRes() {}
+
+// Ref: #/components/schemas/UnitOfMeasure
+type UnitOfMeasure struct {
+	// Обозначение UCUM.
+	Code string `json:"code"`
+	// Код UN/ECE Recommendation 20.
+	UneceCode OptString `json:"unece_code"`
+	Name      string    `json:"name"`
+	// Вид величины (power, pressure...).
+	Kind string `json:"kind"`
+	// Размерность в базовых величинах SI, например L2.M.T-3.
+	Dimension string `json:"dimension"`
 }
 
-// SetPersonnelClassBaseID sets the value of PersonnelClassBaseID.
-func (s *PersonnelClassType) SetPersonnelClassBaseID(val []string) {
-	s.PersonnelClassBaseID = val
+// GetCode returns the value of Code.
+func (s *UnitOfMeasure) GetCode() string {
+	return s.Code
 }
 
-// SetPersonnelClassProperty sets the value of PersonnelClassProperty.
-func (s *PersonnelClassType) SetPersonnelClassProperty(val []PersonnelClassPropertyType) {
-	s.PersonnelClassProperty = val
+// GetUneceCode returns the value of UneceCode.
+func (s *UnitOfMeasure) GetUneceCode() OptString {
+	return s.UneceCode
 }
 
-// SetPersonSourceID sets the value of PersonSourceID.
-func (s *PersonnelClassType) SetPersonSourceID(val []string) {
-	s.PersonSourceID = val
+// GetName returns the value of Name.
+func (s *UnitOfMeasure) GetName() string {
+	return s.Name
 }
 
-// SetTestSpecificationID sets the value of TestSpecificationID.
-func (s *PersonnelClassType) SetTestSpecificationID(val []string) {
-	s.TestSpecificationID = val
+// GetKind returns the value of Kind.
+func (s *UnitOfMeasure) GetKind() string {
+	return s.Kind
 }
 
-// PersonnelClassesPostCreated is response for PersonnelClassesPost operation.
-type PersonnelClassesPostCreated struct{}
+// GetDimension returns the value of Dimension.
+func (s *UnitOfMeasure) GetDimension() string {
+	return s.Dimension
+}
 
-// PersonnelInformationPostCreated is response for PersonnelInformationPost operation.
-type PersonnelInformationPostCreated struct{}
+// SetCode sets the value of Code.
+func (s *UnitOfMeasure) SetCode(val string) {
+	s.Code = val
+}
 
-// Ref: #/components/schemas/PersonnelInformationType
-type PersonnelInformationType struct {
-	ID                OptString               `json:"ID"`
-	Description       []string                `json:"Description"`
-	HierarchyScope    OptHierarchyScopeType   `json:"HierarchyScope"`
-	PublishedDate     OptDateTime             `json:"PublishedDate"`
-	Person            []PersonType            `json:"Person"`
-	PersonnelClass    []PersonnelClassType    `json:"PersonnelClass"`
-	TestSpecification []TestSpecificationType `json:"TestSpecification"`
+// SetUneceCode sets the value of UneceCode.
+func (s *UnitOfMeasure) SetUneceCode(val OptString) {
+	s.UneceCode = val
 }
 
-// GetID returns the value of ID.
-func (s *PersonnelInformationType) GetID() OptString {
-	return s.ID
+// SetName sets the value of Name.
+func (s *UnitOfMeasure) SetName(val string) {
+	s.Name = val
 }
 
-// GetDescription returns the value of Description.
-func (s *PersonnelInformationType) GetDescription() []string {
-	return s.Description
+// SetKind sets the value of Kind.
+func (s *UnitOfMeasure) SetKind(val string) {
+	s.Kind = val
 }
 
-// GetHierarchyScope returns the value of HierarchyScope.
-func (s *PersonnelInformationType) GetHierarchyScope() OptHierarchyScopeType {
-	return s.HierarchyScope
+// SetDimension sets the value of Dimension.
+func (s *UnitOfMeasure) SetDimension(val string) {
+	s.Dimension = val
 }
 
-// GetPublishedDate returns the value of PublishedDate.
-func (s *PersonnelInformationType) GetPublishedDate() OptDateTime {
-	return s.PublishedDate
+// UnitsConvertGetBadRequest is response for UnitsConvertGet operation.
+type UnitsConvertGetBadRequest struct{}
+
+func (*UnitsConvertGetBadRequest) unitsConvertGetRes() {}
+
+// Отклонённый документ (RFC 9457 problem details).
+// Ref: #/components/schemas/ValidationProblem
+type ValidationProblem struct {
+	Type       OptString         `json:"type"`
+	Title      string            `json:"title"`
+	Status     int               `json:"status"`
+	Detail     OptString         `json:"detail"`
+	Violations []SchemaViolation `json:"violations"`
 }
 
-// GetPerson returns the value of Person.
-func (s *PersonnelInformationType) GetPerson() []PersonType {
-	return s.Person
+// GetType returns the value of Type.
+func (s *ValidationProblem) GetType() OptString {
+	return s.Type
 }
 
-// GetPersonnelClass returns the value of PersonnelClass.
-func (s *PersonnelInformationType) GetPersonnelClass() []PersonnelClassType {
-	return s.PersonnelClass
+// GetTitle returns the value of Title.
+func (s *ValidationProblem) GetTitle() string {
+	return s.Title
 }
 
-// GetTestSpecification returns the value of TestSpecification.
-func (s *PersonnelInformationType) GetTestSpecification() []TestSpecificationType {
-	return s.TestSpecification
+// GetStatus returns the value of Status.
+func (s *ValidationProblem) GetStatus() int {
+	return s.Status
 }
 
-// SetID sets the value of ID.
-func (s *PersonnelInformationType) SetID(val OptString) {
-	s.ID = val
+// GetDetail returns the value of Detail.
+func (s *ValidationProblem) GetDetail() OptString {
+	return s.Detail
 }
 
-// SetDescription sets the value of Description.
-func (s *PersonnelInformationType) SetDescription(val []string) {
-	s.Description = val
+// GetViolations returns the value of Violations.
+func (s *ValidationProblem) GetViolations() []SchemaViolation {
+	return s.Violations
 }
 
-// SetHierarchyScope sets the value of HierarchyScope.
-func (s *PersonnelInformationType) SetHierarchyScope(val OptHierarchyScopeType) {
-	s.HierarchyScope = val
+// SetType sets the value of Type.
+func (s *ValidationProblem) SetType(val OptString) {
+	s.Type = val
 }
 
-// SetPublishedDate sets the value of PublishedDate.
-func (s *PersonnelInformationType) SetPublishedDate(val OptDateTime) {
-	s.PublishedDate = val
+// SetTitle sets the value of Title.
+func (s *ValidationProblem) SetTitle(val string) {
+	s.Title = val
 }
 
-// SetPerson sets the value of Person.
-func (s *PersonnelInformationType) SetPerson(val []PersonType) {
-	s.Person = val
+// SetStatus sets the value of Status.
+func (s *ValidationProblem) SetStatus(val int) {
+	s.Status = val
 }
 
-// SetPersonnelClass sets the value of PersonnelClass.
-func (s *PersonnelInformationType) SetPersonnelClass(val []PersonnelClassType) {
-	s.PersonnelClass = val
+// SetDetail sets the value of Detail.
+func (s *ValidationProblem) SetDetail(val OptString) {
+	s.Detail = val
 }
 
-// SetTestSpecification sets the value of TestSpecification.
-func (s *PersonnelInformationType) SetTestSpecification(val []TestSpecificationType) {
-	s.TestSpecification = val
+// SetViolations sets the value of Violations.
+func (s *ValidationProblem) SetViolations(val []SchemaViolation) {
+	s.Violations = val
 }
 
-// PersonsPostCreated is response for PersonsPost operation.
-type PersonsPostCreated struct{}
+func (*ValidationProblem) b2mmlPostRes()            {}
+func (*ValidationProblem) equipmentIDPutRes()       {}
+func (*ValidationProblem) equipmentImportsPostRes() {}
+func (*ValidationProblem) equipmentPostRes()        {}
+func (*ValidationProblem) personsPostRes()          {}
 
-// Ref: #/components/schemas/PhysicalPropertiesType
-type PhysicalPropertiesType struct {
-	Density      OptFloat64 `json:"Density"`
-	Viscosity    OptFloat64 `json:"Viscosity"`
-	ParticleSize OptFloat64 `json:"ParticleSize"`
-	SurfaceArea  OptFloat64 `json:"SurfaceArea"`
+// Ref: #/components/schemas/ValueType
+type ValueType struct {
+	Value         OptString `json:"value"`
+	UnitOfMeasure OptString `json:"unitOfMeasure"`
 }
 
-// GetDensity returns the value of Density.
-func (s *PhysicalPropertiesType) GetDensity() OptFloat64 {
-	return s.Density
+// GetValue returns the value of Value.
+func (s *ValueType) GetValue() OptString {
+	return s.Value
 }
 
-// GetViscosity returns the value of Viscosity.
-func (s *PhysicalPropertiesType) GetViscosity() OptFloat64 {
-	return s.Viscosity
+// GetUnitOfMeasure returns the value of UnitOfMeasure.
+func (s *ValueType) GetUnitOfMeasure() OptString {
+	return s.UnitOfMeasure
 }
 
-// GetParticleSize returns the value of ParticleSize.
-func (s *PhysicalPropertiesType) GetParticleSize() OptFloat64 {
-	return s.ParticleSize
+// SetValue sets the value of Value.
+func (s *ValueType) SetValue(val OptString) {
+	s.Value = val
 }
 
-// GetSurfaceArea returns the value of SurfaceArea.
-func (s *PhysicalPropertiesType) GetSurfaceArea() OptFloat64 {
-	return s.SurfaceArea
+// SetUnitOfMeasure sets the value of UnitOfMeasure.
+func (s *ValueType) SetUnitOfMeasure(val OptString) {
+	s.UnitOfMeasure = val
 }
 
-// SetDensity sets the value of Density.
-func (s *PhysicalPropertiesType) SetDensity(val OptFloat64) {
-	s.Density = val
+// Ref: #/components/schemas/Webhook
+type Webhook struct {
+	ID OptUUID `json:"id"`
+	// Абсолютный http(s) адрес получателя.
+	URL string `json:"url"`
+	// Ключ подписи HMAC; возвращается только при создании.
+	Secret OptString `json:"secret"`
+	// Фильтр типов событий: точный тип (equipment.status_changed),
+	// префикс
+	// (equipment.*) или *. Пустой список - все события.
+	EventTypes  []string    `json:"event_types"`
+	Description OptString   `json:"description"`
+	Active      OptBool     `json:"active"`
+	CreatedAt   OptDateTime `json:"created_at"`
+	UpdatedAt   OptDateTime `json:"updated_at"`
 }
 
-// SetViscosity sets the value of Viscosity.
-func (s *PhysicalPropertiesType) SetViscosity(val OptFloat64) {
-	s.Viscosity = val
+// GetID returns the value of ID.
+func (s *Webhook) GetID() OptUUID {
+	return s.ID
 }
 
-// SetParticleSize sets the value of ParticleSize.
-func (s *PhysicalPropertiesType) SetParticleSize(val OptFloat64) {
-	s.ParticleSize = val
+// GetURL returns the value of URL.
+func (s *Webhook) GetURL() string {
+	return s.URL
 }
 
-// SetSurfaceArea sets the value of SurfaceArea.
-func (s *PhysicalPropertiesType) SetSurfaceArea(val OptFloat64) {
-	s.SurfaceArea = val
+// GetSecret returns the value of Secret.
+func (s *Webhook) GetSecret() OptString {
+	return s.Secret
 }
 
-// Ref: #/components/schemas/RecordType
-type RecordType struct {
-	RecordID   OptString               `json:"RecordID"`
-	RecordType OptString               `json:"RecordType"`
-	RecordData OptRecordTypeRecordData `json:"RecordData"`
+// GetEventTypes returns the value of EventTypes.
+func (s *Webhook) GetEventTypes() []string {
+	return s.EventTypes
 }
 
-// GetRecordID returns the value of RecordID.
-func (s *RecordType) GetRecordID() OptString {
-	return s.RecordID
+// GetDescription returns the value of Description.
+func (s *Webhook) GetDescription() OptString {
+	return s.Description
 }
 
-// GetRecordType returns the value of RecordType.
-func (s *RecordType) GetRecordType() OptString {
-	return s.RecordType
+// GetActive returns the value of Active.
+func (s *Webhook) GetActive() OptBool {
+	return s.Active
 }
 
-// GetRecordData returns the value of RecordData.
-func (s *RecordType) GetRecordData() OptRecordTypeRecordData {
-	return s.RecordData
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Webhook) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// SetRecordID sets the value of RecordID.
-func (s *RecordType) SetRecordID(val OptString) {
-	s.RecordID = val
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Webhook) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// SetRecordType sets the value of RecordType.
-func (s *RecordType) SetRecordType(val OptString) {
-	s.RecordType = val
+// SetID sets the value of ID.
+func (s *Webhook) SetID(val OptUUID) {
+	s.ID = val
 }
 
-// SetRecordData sets the value of RecordData.
-func (s *RecordType) SetRecordData(val OptRecordTypeRecordData) {
-	s.RecordData = val
+// SetURL sets the value of URL.
+func (s *Webhook) SetURL(val string) {
+	s.URL = val
 }
 
-type RecordTypeRecordData map[string]jx.Raw
+// SetSecret sets the value of Secret.
+func (s *Webhook) SetSecret(val OptString) {
+	s.Secret = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *Webhook) SetEventTypes(val []string) {
+	s.EventTypes = val
+}
 
-func (s *RecordTypeRecordData) init() RecordTypeRecordData {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetDescription sets the value of Description.
+func (s *Webhook) SetDescription(val OptString) {
+	s.Description = val
 }
 
-// Ref: #/components/schemas/RegulatoryInformationType
-type RegulatoryInformationType struct {
-	RegulatoryStatus     OptString                   `json:"RegulatoryStatus"`
-	ComplianceStandards  OptString                   `json:"ComplianceStandards"`
-	CertificationDetails OptCertificationDetailsType `json:"CertificationDetails"`
+// SetActive sets the value of Active.
+func (s *Webhook) SetActive(val OptBool) {
+	s.Active = val
 }
 
-// GetRegulatoryStatus returns the value of RegulatoryStatus.
-func (s *RegulatoryInformationType) GetRegulatoryStatus() OptString {
-	return s.RegulatoryStatus
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Webhook) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetComplianceStandards returns the value of ComplianceStandards.
-func (s *RegulatoryInformationType) GetComplianceStandards() OptString {
-	return s.ComplianceStandards
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Webhook) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// GetCertificationDetails returns the value of CertificationDetails.
-func (s *RegulatoryInformationType) GetCertificationDetails() OptCertificationDetailsType {
-	return s.CertificationDetails
+func (*Webhook) webhooksIDGetRes() {}
+func (*Webhook) webhooksIDPutRes() {}
+func (*Webhook) webhooksPostRes()  {}
+
+// Ref: #/components/schemas/WebhookDelivery
+type WebhookDelivery struct {
+	ID             uuid.UUID             `json:"id"`
+	EventID        uuid.UUID             `json:"event_id"`
+	EventType      string                `json:"event_type"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int32                 `json:"attempts"`
+	NextAttemptAt  OptDateTime           `json:"next_attempt_at"`
+	LastError      OptString             `json:"last_error"`
+	ResponseStatus OptInt                `json:"response_status"`
+	CreatedAt      time.Time             `json:"created_at"`
+	DeliveredAt    OptDateTime           `json:"delivered_at"`
+	Payload        OptWebhookEvent       `json:"payload"`
 }
 
-// SetRegulatoryStatus sets the value of RegulatoryStatus.
-func (s *RegulatoryInformationType) SetRegulatoryStatus(val OptString) {
-	s.RegulatoryStatus = val
+// GetID returns the value of ID.
+func (s *WebhookDelivery) GetID() uuid.UUID {
+	return s.ID
 }
 
-// SetComplianceStandards sets the value of ComplianceStandards.
-func (s *RegulatoryInformationType) SetComplianceStandards(val OptString) {
-	s.ComplianceStandards = val
+// GetEventID returns the value of EventID.
+func (s *WebhookDelivery) GetEventID() uuid.UUID {
+	return s.EventID
 }
 
-// SetCertificationDetails sets the value of CertificationDetails.
-func (s *RegulatoryInformationType) SetCertificationDetails(val OptCertificationDetailsType) {
-	s.CertificationDetails = val
+// GetEventType returns the value of EventType.
+func (s *WebhookDelivery) GetEventType() string {
+	return s.EventType
 }
 
-// Ref: #/components/schemas/ResourceLocationType
-type ResourceLocationType map[string]jx.Raw
+// GetStatus returns the value of Status.
+func (s *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	return s.Status
+}
 
-func (s *ResourceLocationType) init() ResourceLocationType {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDelivery) GetAttempts() int32 {
+	return s.Attempts
 }
 
-// Ref: #/components/schemas/SpatialDefinitionType
-type SpatialDefinitionType map[string]jx.Raw
+// GetNextAttemptAt returns the value of NextAttemptAt.
+func (s *WebhookDelivery) GetNextAttemptAt() OptDateTime {
+	return s.NextAttemptAt
+}
 
-func (s *SpatialDefinitionType) init() SpatialDefinitionType {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// GetLastError returns the value of LastError.
+func (s *WebhookDelivery) GetLastError() OptString {
+	return s.LastError
 }
 
-// Ref: #/components/schemas/TestSpecificationType
-type TestSpecificationType struct {
-	ID              OptString `json:"ID"`
-	Description     OptString `json:"Description"`
-	AdditionalProps TestSpecificationTypeAdditional
+// GetResponseStatus returns the value of ResponseStatus.
+func (s *WebhookDelivery) GetResponseStatus() OptInt {
+	return s.ResponseStatus
 }
 
-// GetID returns the value of ID.
-func (s *TestSpecificationType) GetID() OptString {
-	return s.ID
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookDelivery) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// GetDescription returns the value of Description.
-func (s *TestSpecificationType) GetDescription() OptString {
-	return s.Description
+// GetDeliveredAt returns the value of DeliveredAt.
+func (s *WebhookDelivery) GetDeliveredAt() OptDateTime {
+	return s.DeliveredAt
 }
 
-// GetAdditionalProps returns the value of AdditionalProps.
-func (s *TestSpecificationType) GetAdditionalProps() TestSpecificationTypeAdditional {
-	return s.AdditionalProps
+// GetPayload returns the value of Payload.
+func (s *WebhookDelivery) GetPayload() OptWebhookEvent {
+	return s.Payload
 }
 
 // SetID sets the value of ID.
-func (s *TestSpecificationType) SetID(val OptString) {
+func (s *WebhookDelivery) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
-// SetDescription sets the value of Description.
-func (s *TestSpecificationType) SetDescription(val OptString) {
-	s.Description = val
+// SetEventID sets the value of EventID.
+func (s *WebhookDelivery) SetEventID(val uuid.UUID) {
+	s.EventID = val
 }
 
-// SetAdditionalProps sets the value of AdditionalProps.
-func (s *TestSpecificationType) SetAdditionalProps(val TestSpecificationTypeAdditional) {
-	s.AdditionalProps = val
+// SetEventType sets the value of EventType.
+func (s *WebhookDelivery) SetEventType(val string) {
+	s.EventType = val
 }
 
-type TestSpecificationTypeAdditional map[string]jx.Raw
+// SetStatus sets the value of Status.
+func (s *WebhookDelivery) SetStatus(val WebhookDeliveryStatus) {
+	s.Status = val
+}
 
-func (s *TestSpecificationTypeAdditional) init() TestSpecificationTypeAdditional {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDelivery) SetAttempts(val int32) {
+	s.Attempts = val
 }
 
-// Ref: #/components/schemas/ThermalPropertiesType
-type ThermalPropertiesType struct {
-	MeltingPoint        OptFloat64 `json:"MeltingPoint"`
-	BoilingPoint        OptFloat64 `json:"BoilingPoint"`
-	ThermalConductivity OptFloat64 `json:"ThermalConductivity"`
-	SpecificHeat        OptFloat64 `json:"SpecificHeat"`
+// SetNextAttemptAt sets the value of NextAttemptAt.
+func (s *WebhookDelivery) SetNextAttemptAt(val OptDateTime) {
+	s.NextAttemptAt = val
 }
 
-// GetMeltingPoint returns the value of MeltingPoint.
-func (s *ThermalPropertiesType) GetMeltingPoint() OptFloat64 {
-	return s.MeltingPoint
+// SetLastError sets the value of LastError.
+func (s *WebhookDelivery) SetLastError(val OptString) {
+	s.LastError = val
 }
 
-// GetBoilingPoint returns the value of BoilingPoint.
-func (s *ThermalPropertiesType) GetBoilingPoint() OptFloat64 {
-	return s.BoilingPoint
+// SetResponseStatus sets the value of ResponseStatus.
+func (s *WebhookDelivery) SetResponseStatus(val OptInt) {
+	s.ResponseStatus = val
 }
 
-// GetThermalConductivity returns the value of ThermalConductivity.
-func (s *ThermalPropertiesType) GetThermalConductivity() OptFloat64 {
-	return s.ThermalConductivity
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookDelivery) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// GetSpecificHeat returns the value of SpecificHeat.
-func (s *ThermalPropertiesType) GetSpecificHeat() OptFloat64 {
-	return s.SpecificHeat
+// SetDeliveredAt sets the value of DeliveredAt.
+func (s *WebhookDelivery) SetDeliveredAt(val OptDateTime) {
+	s.DeliveredAt = val
 }
 
-// SetMeltingPoint sets the value of MeltingPoint.
-func (s *ThermalPropertiesType) SetMeltingPoint(val OptFloat64) {
-	s.MeltingPoint = val
+// SetPayload sets the value of Payload.
+func (s *WebhookDelivery) SetPayload(val OptWebhookEvent) {
+	s.Payload = val
 }
 
-// SetBoilingPoint sets the value of BoilingPoint.
-func (s *ThermalPropertiesType) SetBoilingPoint(val OptFloat64) {
-	s.BoilingPoint = val
+func (*WebhookDelivery) webhooksIDDeliveriesDeliveryIdReplayPostRes() {}
+
+// Ref: #/components/schemas/WebhookDeliveryStatus
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+// AllValues returns all WebhookDeliveryStatus values.
+func (WebhookDeliveryStatus) AllValues() []WebhookDeliveryStatus {
+	return []WebhookDeliveryStatus{
+		WebhookDeliveryStatusPending,
+		WebhookDeliveryStatusDelivered,
+		WebhookDeliveryStatusDead,
+	}
 }
 
-// SetThermalConductivity sets the value of ThermalConductivity.
-func (s *ThermalPropertiesType) SetThermalConductivity(val OptFloat64) {
-	s.ThermalConductivity = val
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookDeliveryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookDeliveryStatusPending:
+		return []byte(s), nil
+	case WebhookDeliveryStatusDelivered:
+		return []byte(s), nil
+	case WebhookDeliveryStatusDead:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetSpecificHeat sets the value of SpecificHeat.
-func (s *ThermalPropertiesType) SetSpecificHeat(val OptFloat64) {
-	s.SpecificHeat = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookDeliveryStatus) UnmarshalText(data []byte) error {
+	switch WebhookDeliveryStatus(data) {
+	case WebhookDeliveryStatusPending:
+		*s = WebhookDeliveryStatusPending
+		return nil
+	case WebhookDeliveryStatusDelivered:
+		*s = WebhookDeliveryStatusDelivered
+		return nil
+	case WebhookDeliveryStatusDead:
+		*s = WebhookDeliveryStatusDead
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// Ref: #/components/schemas/TraceHistoryType
-type TraceHistoryType struct {
-	Timestamp        OptDateTime `json:"Timestamp"`
-	Location         OptString   `json:"Location"`
-	Action           OptString   `json:"Action"`
-	ResponsibleParty OptString   `json:"ResponsibleParty"`
+// Тело запроса webhook.
+// Ref: #/components/schemas/WebhookEvent
+type WebhookEvent struct {
+	// Идентификатор события; одинаков во всех повторах
+	// доставки.
+	ID            uuid.UUID `json:"id"`
+	Type          string    `json:"type"`
+	AggregateType OptString `json:"aggregate_type"`
+	AggregateID   OptString `json:"aggregate_id"`
+	OccurredAt    time.Time `json:"occurred_at"`
+	// Поля доменного события.
+	Data WebhookEventData `json:"data"`
 }
 
-// GetTimestamp returns the value of Timestamp.
-func (s *TraceHistoryType) GetTimestamp() OptDateTime {
-	return s.Timestamp
+// GetID returns the value of ID.
+func (s *WebhookEvent) GetID() uuid.UUID {
+	return s.ID
 }
 
-// GetLocation returns the value of Location.
-func (s *TraceHistoryType) GetLocation() OptString {
-	return s.Location
+// GetType returns the value of Type.
+func (s *WebhookEvent) GetType() string {
+	return s.Type
 }
 
-// GetAction returns the value of Action.
-func (s *TraceHistoryType) GetAction() OptString {
-	return s.Action
+// GetAggregateType returns the value of AggregateType.
+func (s *WebhookEvent) GetAggregateType() OptString {
+	return s.AggregateType
 }
 
-// GetResponsibleParty returns the value of ResponsibleParty.
-func (s *TraceHistoryType) GetResponsibleParty() OptString {
-	return s.ResponsibleParty
+// GetAggregateID returns the value of AggregateID.
+func (s *WebhookEvent) GetAggregateID() OptString {
+	return s.AggregateID
 }
 
-// SetTimestamp sets the value of Timestamp.
-func (s *TraceHistoryType) SetTimestamp(val OptDateTime) {
-	s.Timestamp = val
+// GetOccurredAt returns the value of OccurredAt.
+func (s *WebhookEvent) GetOccurredAt() time.Time {
+	return s.OccurredAt
 }
 
-// SetLocation sets the value of Location.
-func (s *TraceHistoryType) SetLocation(val OptString) {
-	s.Location = val
+// GetData returns the value of Data.
+func (s *WebhookEvent) GetData() WebhookEventData {
+	return s.Data
 }
 
-// SetAction sets the value of Action.
-func (s *TraceHistoryType) SetAction(val OptString) {
-	s.Action = val
+// SetID sets the value of ID.
+func (s *WebhookEvent) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// SetResponsibleParty sets the value of ResponsibleParty.
-func (s *TraceHistoryType) SetResponsibleParty(val OptString) {
-	s.ResponsibleParty = val
+// SetType sets the value of Type.
+func (s *WebhookEvent) SetType(val string) {
+	s.Type = val
 }
 
-// Ref: #/components/schemas/TraceabilityInformationType
-type TraceabilityInformationType struct {
-	BatchNumber    OptString          `json:"BatchNumber"`
-	LotNumber      OptString          `json:"LotNumber"`
-	ProductionDate OptDate            `json:"ProductionDate"`
-	ExpirationDate OptDate            `json:"ExpirationDate"`
-	TraceHistory   []TraceHistoryType `json:"TraceHistory"`
+// SetAggregateType sets the value of AggregateType.
+func (s *WebhookEvent) SetAggregateType(val OptString) {
+	s.AggregateType = val
 }
 
-// GetBatchNumber returns the value of BatchNumber.
-func (s *TraceabilityInformationType) GetBatchNumber() OptString {
-	return s.BatchNumber
+// SetAggregateID sets the value of AggregateID.
+func (s *WebhookEvent) SetAggregateID(val OptString) {
+	s.AggregateID = val
 }
 
-// GetLotNumber returns the value of LotNumber.
-func (s *TraceabilityInformationType) GetLotNumber() OptString {
-	return s.LotNumber
+// SetOccurredAt sets the value of OccurredAt.
+func (s *WebhookEvent) SetOccurredAt(val time.Time) {
+	s.OccurredAt = val
 }
 
-// GetProductionDate returns the value of ProductionDate.
-func (s *TraceabilityInformationType) GetProductionDate() OptDate {
-	return s.ProductionDate
+// SetData sets the value of Data.
+func (s *WebhookEvent) SetData(val WebhookEventData) {
+	s.Data = val
 }
 
-// GetExpirationDate returns the value of ExpirationDate.
-func (s *TraceabilityInformationType) GetExpirationDate() OptDate {
-	return s.ExpirationDate
+// Поля доменного события.
+type WebhookEventData map[string]jx.Raw
+
+func (s *WebhookEventData) init() WebhookEventData {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// GetTraceHistory returns the value of TraceHistory.
-func (s *TraceabilityInformationType) GetTraceHistory() []TraceHistoryType {
-	return s.TraceHistory
+// Ref: #/components/schemas/WebhookPingResult
+type WebhookPingResult struct {
+	Delivered bool `json:"delivered"`
+	// HTTP статус ответа получателя; отсутствует, если ответа
+	// нет.
+	StatusCode OptInt    `json:"status_code"`
+	Error      OptString `json:"error"`
+	DurationMs int64     `json:"duration_ms"`
 }
 
-// SetBatchNumber sets the value of BatchNumber.
-func (s *TraceabilityInformationType) SetBatchNumber(val OptString) {
-	s.BatchNumber = val
+// GetDelivered returns the value of Delivered.
+func (s *WebhookPingResult) GetDelivered() bool {
+	return s.Delivered
 }
 
-// SetLotNumber sets the value of LotNumber.
-func (s *TraceabilityInformationType) SetLotNumber(val OptString) {
-	s.LotNumber = val
+// GetStatusCode returns the value of StatusCode.
+func (s *WebhookPingResult) GetStatusCode() OptInt {
+	return s.StatusCode
 }
 
-// SetProductionDate sets the value of ProductionDate.
-func (s *TraceabilityInformationType) SetProductionDate(val OptDate) {
-	s.ProductionDate = val
+// GetError returns the value of Error.
+func (s *WebhookPingResult) GetError() OptString {
+	return s.Error
 }
 
-// SetExpirationDate sets the value of ExpirationDate.
-func (s *TraceabilityInformationType) SetExpirationDate(val OptDate) {
-	s.ExpirationDate = val
+// GetDurationMs returns the value of DurationMs.
+func (s *WebhookPingResult) GetDurationMs() int64 {
+	return s.DurationMs
 }
 
-// SetTraceHistory sets the value of TraceHistory.
-func (s *TraceabilityInformationType) SetTraceHistory(val []TraceHistoryType) {
-	s.TraceHistory = val
+// SetDelivered sets the value of Delivered.
+func (s *WebhookPingResult) SetDelivered(val bool) {
+	s.Delivered = val
 }
 
-// Ref: #/components/schemas/ValueType
-type ValueType struct {
-	Value         OptString `json:"value"`
-	UnitOfMeasure OptString `json:"unitOfMeasure"`
+// SetStatusCode sets the value of StatusCode.
+func (s *WebhookPingResult) SetStatusCode(val OptInt) {
+	s.StatusCode = val
 }
 
-// GetValue returns the value of Value.
-func (s *ValueType) GetValue() OptString {
-	return s.Value
+// SetError sets the value of Error.
+func (s *WebhookPingResult) SetError(val OptString) {
+	s.Error = val
 }
 
-// GetUnitOfMeasure returns the value of UnitOfMeasure.
-func (s *ValueType) GetUnitOfMeasure() OptString {
-	return s.UnitOfMeasure
+// SetDurationMs sets the value of DurationMs.
+func (s *WebhookPingResult) SetDurationMs(val int64) {
+	s.DurationMs = val
 }
 
-// SetValue sets the value of Value.
-func (s *ValueType) SetValue(val OptString) {
-	s.Value = val
+func (*WebhookPingResult) webhooksIDPingPostRes() {}
+
+// WebhooksIDDeleteNoContent is response for WebhooksIDDelete operation.
+type WebhooksIDDeleteNoContent struct{}
+
+func (*WebhooksIDDeleteNoContent) webhooksIDDeleteRes() {}
+
+// WebhooksIDDeleteNotFound is response for WebhooksIDDelete operation.
+type WebhooksIDDeleteNotFound struct{}
+
+func (*WebhooksIDDeleteNotFound) webhooksIDDeleteRes() {}
+
+// WebhooksIDDeliveriesDeliveryIdReplayPostConflict is response for WebhooksIDDeliveriesDeliveryIdReplayPost operation.
+type WebhooksIDDeliveriesDeliveryIdReplayPostConflict struct{}
+
+func (*WebhooksIDDeliveriesDeliveryIdReplayPostConflict) webhooksIDDeliveriesDeliveryIdReplayPostRes() {
 }
 
-// SetUnitOfMeasure sets the value of UnitOfMeasure.
-func (s *ValueType) SetUnitOfMeasure(val OptString) {
-	s.UnitOfMeasure = val
+// WebhooksIDDeliveriesDeliveryIdReplayPostNotFound is response for WebhooksIDDeliveriesDeliveryIdReplayPost operation.
+type WebhooksIDDeliveriesDeliveryIdReplayPostNotFound struct{}
+
+func (*WebhooksIDDeliveriesDeliveryIdReplayPostNotFound) webhooksIDDeliveriesDeliveryIdReplayPostRes() {
 }
+
+// WebhooksIDDeliveriesGetNotFound is response for WebhooksIDDeliveriesGet operation.
+type WebhooksIDDeliveriesGetNotFound struct{}
+
+func (*WebhooksIDDeliveriesGetNotFound) webhooksIDDeliveriesGetRes() {}
+
+type WebhooksIDDeliveriesGetOKApplicationJSON []WebhookDelivery
+
+func (*WebhooksIDDeliveriesGetOKApplicationJSON) webhooksIDDeliveriesGetRes() {}
+
+// WebhooksIDGetNotFound is response for WebhooksIDGet operation.
+type WebhooksIDGetNotFound struct{}
+
+func (*WebhooksIDGetNotFound) webhooksIDGetRes() {}
+
+// WebhooksIDPingPostNotFound is response for WebhooksIDPingPost operation.
+type WebhooksIDPingPostNotFound struct{}
+
+func (*WebhooksIDPingPostNotFound) webhooksIDPingPostRes() {}
+
+// WebhooksIDPutBadRequest is response for WebhooksIDPut operation.
+type WebhooksIDPutBadRequest struct{}
+
+func (*WebhooksIDPutBadRequest) webhooksIDPutRes() {}
+
+// WebhooksIDPutNotFound is response for WebhooksIDPut operation.
+type WebhooksIDPutNotFound struct{}
+
+func (*WebhooksIDPutNotFound) webhooksIDPutRes() {}
+
+// WebhooksPostBadRequest is response for WebhooksPost operation.
+type WebhooksPostBadRequest struct{}
+
+func (*WebhooksPostBadRequest) webhooksPostRes() {}
@@ -8,6 +8,78 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// B2mmlMasterDataProfileGet implements GET /b2mml/master-data-profile operation.
+	//
+	// Документ `MasterDataProfile`, загруженный из `B2MML_PROFILE`. Записи
+	// `TransactionProfile` с `ApplicationID` сервера задают каналы (`ChannelTopic`)
+	// для документов своих `SupportedAction`: `InformationReceiver` - входящие,
+	// `InformationSender` - исходящие `SyncXxx` по доменным событиям.
+	// Каналы:
+	// `http(s)://` (исходящие), `file:///папка` (тема - подпапка),
+	// `bus://имя`
+	// (шина в процессе).
+	//
+	// GET /b2mml/master-data-profile
+	B2mmlMasterDataProfileGet(ctx context.Context) (B2mmlMasterDataProfileGetRes, error)
+	// B2mmlPost implements POST /b2mml operation.
+	//
+	// Принимает документы ISA-95 B2MML `Get`, `Process` и `Sync` с
+	// существительными
+	// `Equipment`, `EquipmentClass` и `EquipmentInformation`.
+	// На `Get<Noun>` отвечает `Show<Noun>` с сохранёнными объектами.
+	// Выборку задают
+	// существительные с `ID` в DataArea и выражения `Get/Expression` -
+	// путь к
+	// `Equipment` или `EquipmentClass` с предикатами равенства,
+	// объединёнными `and`
+	// (`ID`, `EquipmentLevel`, `EquipmentClassID`, `HierarchyScope/EquipmentID`),
+	// например `/GetEquipment/DataArea/Equipment[EquipmentLevel='Unit']`. Размер
+	// страницы задаёт атрибут `maxItems`, следующую страницу -
+	// `recordSetReferenceId`
+	// из ответа `Show`.
+	// Process и Sync применяются в одной транзакции.
+	// Код действия задаётся атрибутом `actionCode` в
+	// ActionCriteria/ActionExpression
+	// (Add, Change, Replace, Delete) и относится ко всему DataArea; выражение
+	// XPath
+	// не вычисляется. По умолчанию Process выполняет Add, Sync -
+	// Replace.
+	// На Process отвечает `Acknowledge<Noun>` с кодом Accepted или Rejected в
+	// ResponseCriteria (атрибут `acknowledgeCode` глагола: Always по умолчанию,
+	// OnError, Never). На Sync отвечает `ConfirmBOD` (Sender/ConfirmationCode:
+	// Always по умолчанию, OnError, Never).
+	// На `GetTransactionProfile` отвечает `ShowTransactionProfile` с
+	// поддерживаемыми
+	// сочетаниями глагола и существительного; `SupportedAction` с
+	// `TransactionVerb`
+	// и/или `TransactionNoun` в запросе ограничивают ответ.
+	// Документы других
+	// сочетаний отклоняются с кодом 400.
+	// Перед применением документ проверяется по схеме B2MML:
+	// допустимые элементы и
+	// атрибуты, порядок элементов, кратность, обязательные
+	// элементы и атрибуты,
+	// перечисления, форматы идентификаторов и дат. DataArea
+	// должен содержать глагол и существительное из
+	// имени документа. Отклонённый документ возвращается с
+	// кодом 400 и документом
+	// `ShowErrorMessage` (ErrorMessage на каждое нарушение: ThrownFrom - XPath,
+	// ErrorCode - код нарушения) или, если клиент предпочитает JSON
+	// в `Accept`,
+	// `application/problem+json`.
+	//
+	// POST /b2mml
+	B2mmlPost(ctx context.Context, req B2mmlPostReq) (B2mmlPostRes, error)
+	// B2mmlTransactionProfileGet implements GET /b2mml/transaction-profile operation.
+	//
+	// Существительное B2MML `TransactionProfile` с поддерживаемыми
+	// сервером
+	// сочетаниями глагола и существительного (`SupportedAction`);
+	// то же, что
+	// `ShowTransactionProfile` в ответ на `GetTransactionProfile`.
+	//
+	// GET /b2mml/transaction-profile
+	B2mmlTransactionProfileGet(ctx context.Context) (B2mmlTransactionProfileGetOK, error)
 	// BatchesGet implements GET /batches operation.
 	//
 	// Получить список батчей.
@@ -20,18 +92,209 @@ type Handler interface {
 	//
 	// POST /batches
 	BatchesPost(ctx context.Context, req *BatchType) error
+	// EquipmentExportGet implements GET /equipment/export operation.
+	//
+	// Ответ потоковый и обслуживается отдельным handler в
+	// обход ogen.
+	// Оборудование читается серверным курсором в одной
+	// транзакции только для
+	// чтения и выгружается в порядке обхода иерархии:
+	// родитель раньше потомков.
+	// CSV и JSON Lines содержат эффективные свойства (с учётом
+	// значений классов)
+	// и классы оборудования; с колонками по умолчанию файл
+	// загружается обратно
+	// через POST /equipment/imports. Формат b2mml - один документ
+	// SyncEquipmentInformation: оборудование вложено в ближайшего
+	// выгруженного
+	// предка (EquipmentChild), в конце перечислены классы
+	// выгруженного оборудования.
+	// При `Accept-Encoding: gzip` ответ сжимается. Ошибка после начала
+	// ответа
+	// обрывает поток.
+	//
+	// GET /equipment/export
+	EquipmentExportGet(ctx context.Context, params EquipmentExportGetParams) (EquipmentExportGetRes, error)
 	// EquipmentGet implements GET /equipment operation.
 	//
-	// Получить список оборудования.
+	// Keyset-пагинация: для перехода по страницам передайте в
+	// `cursor`
+	// значение `next_cursor` или `prev_cursor` из предыдущего ответа.
 	//
 	// GET /equipment
-	EquipmentGet(ctx context.Context) ([]EquipmentType, error)
+	EquipmentGet(ctx context.Context, params EquipmentGetParams) (EquipmentGetRes, error)
+	// EquipmentIDDelete implements DELETE /equipment/{id} operation.
+	//
+	// Оборудование помечается удалённым (soft delete) и остаётся
+	// доступным через
+	// `GET /equipment?deleted=true`, `POST /equipment/{id}/restore` и
+	// `POST /equipment/{id}/purge`. Если передан If-Match, удаление
+	// выполняется
+	// только при совпадении версии.
+	//
+	// DELETE /equipment/{id}
+	EquipmentIDDelete(ctx context.Context, params EquipmentIDDeleteParams) (EquipmentIDDeleteRes, error)
+	// EquipmentIDGet implements GET /equipment/{id} operation.
+	//
+	// Получить оборудование по ID.
+	//
+	// GET /equipment/{id}
+	EquipmentIDGet(ctx context.Context, params EquipmentIDGetParams) (EquipmentIDGetRes, error)
+	// EquipmentIDHistoryDiffGet implements GET /equipment/{id}/history/diff operation.
+	//
+	// Сравнить две ревизии оборудования по полям.
+	//
+	// GET /equipment/{id}/history/diff
+	EquipmentIDHistoryDiffGet(ctx context.Context, params EquipmentIDHistoryDiffGetParams) (EquipmentIDHistoryDiffGetRes, error)
+	// EquipmentIDHistoryGet implements GET /equipment/{id}/history operation.
+	//
+	// Ревизии от новых к старым. Ревизия записывается при
+	// каждом изменении записи
+	// оборудования, его свойств или классов. Автор и
+	// причина берутся из заголовков
+	// `X-CMMS-Actor` и `X-CMMS-Change-Reason` изменяющего запроса.
+	// История доступна и для удалённого оборудования.
+	//
+	// GET /equipment/{id}/history
+	EquipmentIDHistoryGet(ctx context.Context, params EquipmentIDHistoryGetParams) (EquipmentIDHistoryGetRes, error)
+	// EquipmentIDMovePost implements POST /equipment/{id}/move operation.
+	//
+	// Перенос запрещён под само оборудование или его
+	// потомка, а также под оборудование,
+	// уровень ISA-95 которого не выше уровня переносимого.
+	// Если передан If-Match,
+	// перенос выполняется только при совпадении версии.
+	//
+	// POST /equipment/{id}/move
+	EquipmentIDMovePost(ctx context.Context, req *EquipmentMove, params EquipmentIDMovePostParams) (EquipmentIDMovePostRes, error)
+	// EquipmentIDPropertiesGet implements GET /equipment/{id}/properties operation.
+	//
+	// Свойства, определённые классами оборудования и их
+	// родительскими классами
+	// (определение дочернего класса переопределяет
+	// родительское), вместе со значениями
+	// оборудования. Для каждого свойства указано, задано ли
+	// значение оборудованием
+	// (overridden), унаследовано из определения класса (inherited),
+	// отсутствует (missing)
+	// или свойство не определено ни одним классом (local).
+	// Числовые значения с единицей измерения можно
+	// перевести в нужные единицы:
+	// `unit` задаёт единицы (по одной на вид величины), `site_units` -
+	// предпочтительные
+	// единицы площадки, к которой относится оборудование.
+	//
+	// GET /equipment/{id}/properties
+	EquipmentIDPropertiesGet(ctx context.Context, params EquipmentIDPropertiesGetParams) (EquipmentIDPropertiesGetRes, error)
+	// EquipmentIDPurgePost implements POST /equipment/{id}/purge operation.
+	//
+	// Удаляет из хранилища удалённое оборудование вместе с
+	// потомками, удалёнными
+	// каскадно с ним. История изменений сохраняется.
+	//
+	// POST /equipment/{id}/purge
+	EquipmentIDPurgePost(ctx context.Context, params EquipmentIDPurgePostParams) (EquipmentIDPurgePostRes, error)
+	// EquipmentIDPut implements PUT /equipment/{id} operation.
+	//
+	// Оптимистичная блокировка: заголовок If-Match должен
+	// содержать ETag,
+	// полученный при чтении оборудования (или `*` для
+	// безусловного обновления).
+	// Существительное B2MML Equipment (XML или поле B2MML в JSON) заменяет
+	// данные, классы и свойства оборудования.
+	//
+	// PUT /equipment/{id}
+	EquipmentIDPut(ctx context.Context, req EquipmentIDPutReq, params EquipmentIDPutParams) (EquipmentIDPutRes, error)
+	// EquipmentIDRestorePost implements POST /equipment/{id}/restore operation.
+	//
+	// Вместе с оборудованием восстанавливаются потомки,
+	// удалённые каскадно с ним.
+	// Оборудование возвращается в конец списка детей
+	// прежнего родителя; если
+	// родитель окончательно удалён - в корень иерархии.
+	//
+	// POST /equipment/{id}/restore
+	EquipmentIDRestorePost(ctx context.Context, params EquipmentIDRestorePostParams) (EquipmentIDRestorePostRes, error)
+	// EquipmentIDTreeGet implements GET /equipment/{id}/tree operation.
+	//
+	// Получить дерево дочернего оборудования.
+	//
+	// GET /equipment/{id}/tree
+	EquipmentIDTreeGet(ctx context.Context, params EquipmentIDTreeGetParams) (EquipmentIDTreeGetRes, error)
+	// EquipmentImportsImportIdGet implements GET /equipment/imports/{importId} operation.
+	//
+	// Получить задание импорта с отчётом об ошибках строк.
+	//
+	// GET /equipment/imports/{importId}
+	EquipmentImportsImportIdGet(ctx context.Context, params EquipmentImportsImportIdGetParams) (EquipmentImportsImportIdGetRes, error)
+	// EquipmentImportsImportIdResumePost implements POST /equipment/imports/{importId}/resume operation.
+	//
+	// Задание возвращается в очередь и продолжается с
+	// первой незафиксированной
+	// строки; задание dry_run проверяется заново.
+	//
+	// POST /equipment/imports/{importId}/resume
+	EquipmentImportsImportIdResumePost(ctx context.Context, params EquipmentImportsImportIdResumePostParams) (EquipmentImportsImportIdResumePostRes, error)
+	// EquipmentImportsPost implements POST /equipment/imports operation.
+	//
+	// Формат источника задаётся Content-Type: text/csv (первая строка
+	// - имена
+	// колонок) или application/x-ndjson (строка - плоский объект
+	// колонок).
+	// Колонка импортируется в поле с её именем, если mapping не
+	// задаёт другое:
+	// EquipmentID (обязательно), EquipmentType (классы через ";", первый -
+	// основной), Description, OperatingStatus, EquipmentLevel, ParentID,
+	// Manufacturer, Model, SerialNumber, InstallationDate, Properties.<ID> и
+	// Properties.<ID>.Unit. Колонка неизвестного поля - ошибка
+	// задания.
+	// Задание выполняется в фоне; строки с ошибками
+	// пропускаются и попадают
+	// в отчёт (GET /equipment/imports/{importId}). Строки фиксируются
+	// пачками
+	// по chunk_size, прерванное задание продолжается с первой
+	// незафиксированной
+	// строки. dry_run проверяет строки без сохранения
+	// оборудования.
+	//
+	// POST /equipment/imports
+	EquipmentImportsPost(ctx context.Context, req EquipmentImportsPostReq, params EquipmentImportsPostParams) (EquipmentImportsPostRes, error)
 	// EquipmentPost implements POST /equipment operation.
 	//
-	// Добавить оборудование.
+	// Тело - EquipmentType (JSON) или существительное B2MML Equipment (XML);
+	// ответ выбирается по Accept.
 	//
 	// POST /equipment
-	EquipmentPost(ctx context.Context, req *EquipmentType) error
+	EquipmentPost(ctx context.Context, req EquipmentPostReq) (EquipmentPostRes, error)
+	// EquipmentQueryPost implements POST /equipment/query operation.
+	//
+	// Фильтры объединяются через И, значения внутри одного
+	// фильтра - через ИЛИ.
+	// Условия на свойства сравнивают значения с
+	// приведением к типу `type`
+	// (по умолчанию number для числовых значений, иначе string).
+	// Пагинация как у GET /equipment: курсор действителен только
+	// для той же сортировки.
+	//
+	// POST /equipment/query
+	EquipmentQueryPost(ctx context.Context, req *EquipmentQuery) (EquipmentQueryPostRes, error)
+	// EventsStreamGet implements GET /events/stream operation.
+	//
+	// Ответ потоковый и обслуживается отдельным handler в
+	// обход ogen.
+	// Каждое событие передаётся с `id` - номером события в outbox,
+	//  `event` -
+	// типом события и `data` - JSON конвертом `{id, type, aggregate_type,
+	// aggregate_id, occurred_at, data}`, как в теле webhook. При
+	// переподключении
+	// клиент передаёт последний полученный `id` в заголовке
+	// `Last-Event-ID`
+	// (или параметре `last_event_id`), и поток продолжается с
+	// пропущенных событий,
+	// пока они хранятся в outbox.
+	//
+	// GET /events/stream
+	EventsStreamGet(ctx context.Context, params EventsStreamGetParams) (EventsStreamGetRes, error)
 	// MaterialsGet implements GET /materials operation.
 	//
 	// Получить список материалов.
@@ -73,13 +336,107 @@ type Handler interface {
 	// Получить список персон (Person).
 	//
 	// GET /persons
-	PersonsGet(ctx context.Context) ([]PersonType, error)
+	PersonsGet(ctx context.Context, params PersonsGetParams) ([]PersonType, error)
 	// PersonsPost implements POST /persons operation.
 	//
-	// Добавить персону.
+	// Тело - PersonType (JSON) или существительное B2MML Person (XML);
+	// ответ выбирается по Accept.
 	//
 	// POST /persons
-	PersonsPost(ctx context.Context, req *PersonType) error
+	PersonsPost(ctx context.Context, req PersonsPostReq) (PersonsPostRes, error)
+	// SearchGet implements GET /search operation.
+	//
+	// Ищет по внешним ID, описаниям (в том числе B2MML Description на
+	// разных языках)
+	// и значениям свойств оборудования. Все слова запроса
+	// обязательны и совпадают
+	// по началу слова. Результаты упорядочены по
+	// релевантности.
+	//
+	// GET /search
+	SearchGet(ctx context.Context, params SearchGetParams) (SearchGetRes, error)
+	// SitesIDUnitsGet implements GET /sites/{id}/units operation.
+	//
+	// Получить предпочтительные единицы площадки.
+	//
+	// GET /sites/{id}/units
+	SitesIDUnitsGet(ctx context.Context, params SitesIDUnitsGetParams) (SitesIDUnitsGetRes, error)
+	// SitesIDUnitsPut implements PUT /sites/{id}/units operation.
+	//
+	// Заменить предпочтительные единицы площадки.
+	//
+	// PUT /sites/{id}/units
+	SitesIDUnitsPut(ctx context.Context, req *SiteUnits, params SitesIDUnitsPutParams) (SitesIDUnitsPutRes, error)
+	// UnitsConvertGet implements GET /units/convert operation.
+	//
+	// Перевести значение в другую единицу измерения.
+	//
+	// GET /units/convert
+	UnitsConvertGet(ctx context.Context, params UnitsConvertGetParams) (UnitsConvertGetRes, error)
+	// UnitsGet implements GET /units operation.
+	//
+	// Единицы с кодами UN/ECE Recommendation 20 и их обозначения UCUM.
+	// Значения
+	// свойств могут использовать и другие выражения UCUM
+	// (например kg/m3).
+	//
+	// GET /units
+	UnitsGet(ctx context.Context, params UnitsGetParams) ([]UnitOfMeasure, error)
+	// WebhooksGet implements GET /webhooks operation.
+	//
+	// Получить список подписок на события.
+	//
+	// GET /webhooks
+	WebhooksGet(ctx context.Context) ([]Webhook, error)
+	// WebhooksIDDelete implements DELETE /webhooks/{id} operation.
+	//
+	// Удалить подписку вместе с её доставками.
+	//
+	// DELETE /webhooks/{id}
+	WebhooksIDDelete(ctx context.Context, params WebhooksIDDeleteParams) (WebhooksIDDeleteRes, error)
+	// WebhooksIDDeliveriesDeliveryIdReplayPost implements POST /webhooks/{id}/deliveries/{deliveryId}/replay operation.
+	//
+	// Доставка возвращается в очередь со сброшенным
+	// счётчиком попыток.
+	//
+	// POST /webhooks/{id}/deliveries/{deliveryId}/replay
+	WebhooksIDDeliveriesDeliveryIdReplayPost(ctx context.Context, params WebhooksIDDeliveriesDeliveryIdReplayPostParams) (WebhooksIDDeliveriesDeliveryIdReplayPostRes, error)
+	// WebhooksIDDeliveriesGet implements GET /webhooks/{id}/deliveries operation.
+	//
+	// Status=dead возвращает dead letter - доставки, исчерпавшие
+	// попытки.
+	//
+	// GET /webhooks/{id}/deliveries
+	WebhooksIDDeliveriesGet(ctx context.Context, params WebhooksIDDeliveriesGetParams) (WebhooksIDDeliveriesGetRes, error)
+	// WebhooksIDGet implements GET /webhooks/{id} operation.
+	//
+	// Получить подписку на события.
+	//
+	// GET /webhooks/{id}
+	WebhooksIDGet(ctx context.Context, params WebhooksIDGetParams) (WebhooksIDGetRes, error)
+	// WebhooksIDPingPost implements POST /webhooks/{id}/ping operation.
+	//
+	// Событие отправляется синхронно, не сохраняется и не
+	// повторяется.
+	//
+	// POST /webhooks/{id}/ping
+	WebhooksIDPingPost(ctx context.Context, params WebhooksIDPingPostParams) (WebhooksIDPingPostRes, error)
+	// WebhooksIDPut implements PUT /webhooks/{id} operation.
+	//
+	// Secret не меняется; поле secret в запросе игнорируется.
+	//
+	// PUT /webhooks/{id}
+	WebhooksIDPut(ctx context.Context, req *Webhook, params WebhooksIDPutParams) (WebhooksIDPutRes, error)
+	// WebhooksPost implements POST /webhooks operation.
+	//
+	// События доставляются POST-запросом с JSON телом (WebhookEvent).
+	// Тело подписывается HMAC-SHA256 по secret: заголовок X-CMMS-Signature
+	// содержит sha256=<hex> от строки "<X-CMMS-Timestamp>.<тело>".
+	// Если secret не задан, он генерируется и возвращается
+	// только в ответе на создание.
+	//
+	// POST /webhooks
+	WebhooksPost(ctx context.Context, req *Webhook) (WebhooksPostRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
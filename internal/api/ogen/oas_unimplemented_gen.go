@@ -13,6 +13,87 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// B2mmlMasterDataProfileGet implements GET /b2mml/master-data-profile operation.
+//
+// Документ `MasterDataProfile`, загруженный из `B2MML_PROFILE`. Записи
+// `TransactionProfile` с `ApplicationID` сервера задают каналы (`ChannelTopic`)
+// для документов своих `SupportedAction`: `InformationReceiver` - входящие,
+// `InformationSender` - исходящие `SyncXxx` по доменным событиям.
+// Каналы:
+// `http(s)://` (исходящие), `file:///папка` (тема - подпапка),
+// `bus://имя`
+// (шина в процессе).
+//
+// GET /b2mml/master-data-profile
+func (UnimplementedHandler) B2mmlMasterDataProfileGet(ctx context.Context) (r B2mmlMasterDataProfileGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// B2mmlPost implements POST /b2mml operation.
+//
+// Принимает документы ISA-95 B2MML `Get`, `Process` и `Sync` с
+// существительными
+// `Equipment`, `EquipmentClass` и `EquipmentInformation`.
+// На `Get<Noun>` отвечает `Show<Noun>` с сохранёнными объектами.
+// Выборку задают
+// существительные с `ID` в DataArea и выражения `Get/Expression` -
+// путь к
+// `Equipment` или `EquipmentClass` с предикатами равенства,
+// объединёнными `and`
+// (`ID`, `EquipmentLevel`, `EquipmentClassID`, `HierarchyScope/EquipmentID`),
+// например `/GetEquipment/DataArea/Equipment[EquipmentLevel='Unit']`. Размер
+// страницы задаёт атрибут `maxItems`, следующую страницу -
+// `recordSetReferenceId`
+// из ответа `Show`.
+// Process и Sync применяются в одной транзакции.
+// Код действия задаётся атрибутом `actionCode` в
+// ActionCriteria/ActionExpression
+// (Add, Change, Replace, Delete) и относится ко всему DataArea; выражение
+// XPath
+// не вычисляется. По умолчанию Process выполняет Add, Sync -
+// Replace.
+// На Process отвечает `Acknowledge<Noun>` с кодом Accepted или Rejected в
+// ResponseCriteria (атрибут `acknowledgeCode` глагола: Always по умолчанию,
+// OnError, Never). На Sync отвечает `ConfirmBOD` (Sender/ConfirmationCode:
+// Always по умолчанию, OnError, Never).
+// На `GetTransactionProfile` отвечает `ShowTransactionProfile` с
+// поддерживаемыми
+// сочетаниями глагола и существительного; `SupportedAction` с
+// `TransactionVerb`
+// и/или `TransactionNoun` в запросе ограничивают ответ.
+// Документы других
+// сочетаний отклоняются с кодом 400.
+// Перед применением документ проверяется по схеме B2MML:
+// допустимые элементы и
+// атрибуты, порядок элементов, кратность, обязательные
+// элементы и атрибуты,
+// перечисления, форматы идентификаторов и дат. DataArea
+// должен содержать глагол и существительное из
+// имени документа. Отклонённый документ возвращается с
+// кодом 400 и документом
+// `ShowErrorMessage` (ErrorMessage на каждое нарушение: ThrownFrom - XPath,
+// ErrorCode - код нарушения) или, если клиент предпочитает JSON
+// в `Accept`,
+// `application/problem+json`.
+//
+// POST /b2mml
+func (UnimplementedHandler) B2mmlPost(ctx context.Context, req B2mmlPostReq) (r B2mmlPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// B2mmlTransactionProfileGet implements GET /b2mml/transaction-profile operation.
+//
+// Существительное B2MML `TransactionProfile` с поддерживаемыми
+// сервером
+// сочетаниями глагола и существительного (`SupportedAction`);
+// то же, что
+// `ShowTransactionProfile` в ответ на `GetTransactionProfile`.
+//
+// GET /b2mml/transaction-profile
+func (UnimplementedHandler) B2mmlTransactionProfileGet(ctx context.Context) (r B2mmlTransactionProfileGetOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // BatchesGet implements GET /batches operation.
 //
 // Получить список батчей.
@@ -31,22 +112,263 @@ func (UnimplementedHandler) BatchesPost(ctx context.Context, req *BatchType) err
 	return ht.ErrNotImplemented
 }
 
+// EquipmentExportGet implements GET /equipment/export operation.
+//
+// Ответ потоковый и обслуживается отдельным handler в
+// обход ogen.
+// Оборудование читается серверным курсором в одной
+// транзакции только для
+// чтения и выгружается в порядке обхода иерархии:
+// родитель раньше потомков.
+// CSV и JSON Lines содержат эффективные свойства (с учётом
+// значений классов)
+// и классы оборудования; с колонками по умолчанию файл
+// загружается обратно
+// через POST /equipment/imports. Формат b2mml - один документ
+// SyncEquipmentInformation: оборудование вложено в ближайшего
+// выгруженного
+// предка (EquipmentChild), в конце перечислены классы
+// выгруженного оборудования.
+// При `Accept-Encoding: gzip` ответ сжимается. Ошибка после начала
+// ответа
+// обрывает поток.
+//
+// GET /equipment/export
+func (UnimplementedHandler) EquipmentExportGet(ctx context.Context, params EquipmentExportGetParams) (r EquipmentExportGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // EquipmentGet implements GET /equipment operation.
 //
-// Получить список оборудования.
+// Keyset-пагинация: для перехода по страницам передайте в
+// `cursor`
+// значение `next_cursor` или `prev_cursor` из предыдущего ответа.
 //
 // GET /equipment
-func (UnimplementedHandler) EquipmentGet(ctx context.Context) (r []EquipmentType, _ error) {
+func (UnimplementedHandler) EquipmentGet(ctx context.Context, params EquipmentGetParams) (r EquipmentGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentIDDelete implements DELETE /equipment/{id} operation.
+//
+// Оборудование помечается удалённым (soft delete) и остаётся
+// доступным через
+// `GET /equipment?deleted=true`, `POST /equipment/{id}/restore` и
+// `POST /equipment/{id}/purge`. Если передан If-Match, удаление
+// выполняется
+// только при совпадении версии.
+//
+// DELETE /equipment/{id}
+func (UnimplementedHandler) EquipmentIDDelete(ctx context.Context, params EquipmentIDDeleteParams) (r EquipmentIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentIDGet implements GET /equipment/{id} operation.
+//
+// Получить оборудование по ID.
+//
+// GET /equipment/{id}
+func (UnimplementedHandler) EquipmentIDGet(ctx context.Context, params EquipmentIDGetParams) (r EquipmentIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentIDHistoryDiffGet implements GET /equipment/{id}/history/diff operation.
+//
+// Сравнить две ревизии оборудования по полям.
+//
+// GET /equipment/{id}/history/diff
+func (UnimplementedHandler) EquipmentIDHistoryDiffGet(ctx context.Context, params EquipmentIDHistoryDiffGetParams) (r EquipmentIDHistoryDiffGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentIDHistoryGet implements GET /equipment/{id}/history operation.
+//
+// Ревизии от новых к старым. Ревизия записывается при
+// каждом изменении записи
+// оборудования, его свойств или классов. Автор и
+// причина берутся из заголовков
+// `X-CMMS-Actor` и `X-CMMS-Change-Reason` изменяющего запроса.
+// История доступна и для удалённого оборудования.
+//
+// GET /equipment/{id}/history
+func (UnimplementedHandler) EquipmentIDHistoryGet(ctx context.Context, params EquipmentIDHistoryGetParams) (r EquipmentIDHistoryGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentIDMovePost implements POST /equipment/{id}/move operation.
+//
+// Перенос запрещён под само оборудование или его
+// потомка, а также под оборудование,
+// уровень ISA-95 которого не выше уровня переносимого.
+// Если передан If-Match,
+// перенос выполняется только при совпадении версии.
+//
+// POST /equipment/{id}/move
+func (UnimplementedHandler) EquipmentIDMovePost(ctx context.Context, req *EquipmentMove, params EquipmentIDMovePostParams) (r EquipmentIDMovePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentIDPropertiesGet implements GET /equipment/{id}/properties operation.
+//
+// Свойства, определённые классами оборудования и их
+// родительскими классами
+// (определение дочернего класса переопределяет
+// родительское), вместе со значениями
+// оборудования. Для каждого свойства указано, задано ли
+// значение оборудованием
+// (overridden), унаследовано из определения класса (inherited),
+// отсутствует (missing)
+// или свойство не определено ни одним классом (local).
+// Числовые значения с единицей измерения можно
+// перевести в нужные единицы:
+// `unit` задаёт единицы (по одной на вид величины), `site_units` -
+// предпочтительные
+// единицы площадки, к которой относится оборудование.
+//
+// GET /equipment/{id}/properties
+func (UnimplementedHandler) EquipmentIDPropertiesGet(ctx context.Context, params EquipmentIDPropertiesGetParams) (r EquipmentIDPropertiesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentIDPurgePost implements POST /equipment/{id}/purge operation.
+//
+// Удаляет из хранилища удалённое оборудование вместе с
+// потомками, удалёнными
+// каскадно с ним. История изменений сохраняется.
+//
+// POST /equipment/{id}/purge
+func (UnimplementedHandler) EquipmentIDPurgePost(ctx context.Context, params EquipmentIDPurgePostParams) (r EquipmentIDPurgePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentIDPut implements PUT /equipment/{id} operation.
+//
+// Оптимистичная блокировка: заголовок If-Match должен
+// содержать ETag,
+// полученный при чтении оборудования (или `*` для
+// безусловного обновления).
+// Существительное B2MML Equipment (XML или поле B2MML в JSON) заменяет
+// данные, классы и свойства оборудования.
+//
+// PUT /equipment/{id}
+func (UnimplementedHandler) EquipmentIDPut(ctx context.Context, req EquipmentIDPutReq, params EquipmentIDPutParams) (r EquipmentIDPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentIDRestorePost implements POST /equipment/{id}/restore operation.
+//
+// Вместе с оборудованием восстанавливаются потомки,
+// удалённые каскадно с ним.
+// Оборудование возвращается в конец списка детей
+// прежнего родителя; если
+// родитель окончательно удалён - в корень иерархии.
+//
+// POST /equipment/{id}/restore
+func (UnimplementedHandler) EquipmentIDRestorePost(ctx context.Context, params EquipmentIDRestorePostParams) (r EquipmentIDRestorePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentIDTreeGet implements GET /equipment/{id}/tree operation.
+//
+// Получить дерево дочернего оборудования.
+//
+// GET /equipment/{id}/tree
+func (UnimplementedHandler) EquipmentIDTreeGet(ctx context.Context, params EquipmentIDTreeGetParams) (r EquipmentIDTreeGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentImportsImportIdGet implements GET /equipment/imports/{importId} operation.
+//
+// Получить задание импорта с отчётом об ошибках строк.
+//
+// GET /equipment/imports/{importId}
+func (UnimplementedHandler) EquipmentImportsImportIdGet(ctx context.Context, params EquipmentImportsImportIdGetParams) (r EquipmentImportsImportIdGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentImportsImportIdResumePost implements POST /equipment/imports/{importId}/resume operation.
+//
+// Задание возвращается в очередь и продолжается с
+// первой незафиксированной
+// строки; задание dry_run проверяется заново.
+//
+// POST /equipment/imports/{importId}/resume
+func (UnimplementedHandler) EquipmentImportsImportIdResumePost(ctx context.Context, params EquipmentImportsImportIdResumePostParams) (r EquipmentImportsImportIdResumePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentImportsPost implements POST /equipment/imports operation.
+//
+// Формат источника задаётся Content-Type: text/csv (первая строка
+// - имена
+// колонок) или application/x-ndjson (строка - плоский объект
+// колонок).
+// Колонка импортируется в поле с её именем, если mapping не
+// задаёт другое:
+// EquipmentID (обязательно), EquipmentType (классы через ";", первый -
+// основной), Description, OperatingStatus, EquipmentLevel, ParentID,
+// Manufacturer, Model, SerialNumber, InstallationDate, Properties.<ID> и
+// Properties.<ID>.Unit. Колонка неизвестного поля - ошибка
+// задания.
+// Задание выполняется в фоне; строки с ошибками
+// пропускаются и попадают
+// в отчёт (GET /equipment/imports/{importId}). Строки фиксируются
+// пачками
+// по chunk_size, прерванное задание продолжается с первой
+// незафиксированной
+// строки. dry_run проверяет строки без сохранения
+// оборудования.
+//
+// POST /equipment/imports
+func (UnimplementedHandler) EquipmentImportsPost(ctx context.Context, req EquipmentImportsPostReq, params EquipmentImportsPostParams) (r EquipmentImportsPostRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // EquipmentPost implements POST /equipment operation.
 //
-// Добавить оборудование.
+// Тело - EquipmentType (JSON) или существительное B2MML Equipment (XML);
+// ответ выбирается по Accept.
 //
 // POST /equipment
-func (UnimplementedHandler) EquipmentPost(ctx context.Context, req *EquipmentType) error {
-	return ht.ErrNotImplemented
+func (UnimplementedHandler) EquipmentPost(ctx context.Context, req EquipmentPostReq) (r EquipmentPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EquipmentQueryPost implements POST /equipment/query operation.
+//
+// Фильтры объединяются через И, значения внутри одного
+// фильтра - через ИЛИ.
+// Условия на свойства сравнивают значения с
+// приведением к типу `type`
+// (по умолчанию number для числовых значений, иначе string).
+// Пагинация как у GET /equipment: курсор действителен только
+// для той же сортировки.
+//
+// POST /equipment/query
+func (UnimplementedHandler) EquipmentQueryPost(ctx context.Context, req *EquipmentQuery) (r EquipmentQueryPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EventsStreamGet implements GET /events/stream operation.
+//
+// Ответ потоковый и обслуживается отдельным handler в
+// обход ogen.
+// Каждое событие передаётся с `id` - номером события в outbox,
+//
+//	`event` -
+//
+// типом события и `data` - JSON конвертом `{id, type, aggregate_type,
+// aggregate_id, occurred_at, data}`, как в теле webhook. При
+// переподключении
+// клиент передаёт последний полученный `id` в заголовке
+// `Last-Event-ID`
+// (или параметре `last_event_id`), и поток продолжается с
+// пропущенных событий,
+// пока они хранятся в outbox.
+//
+// GET /events/stream
+func (UnimplementedHandler) EventsStreamGet(ctx context.Context, params EventsStreamGetParams) (r EventsStreamGetRes, _ error) {
+	return r, ht.ErrNotImplemented
 }
 
 // MaterialsGet implements GET /materials operation.
@@ -108,15 +430,148 @@ func (UnimplementedHandler) PersonnelInformationPost(ctx context.Context, req *P
 // Получить список персон (Person).
 //
 // GET /persons
-func (UnimplementedHandler) PersonsGet(ctx context.Context) (r []PersonType, _ error) {
+func (UnimplementedHandler) PersonsGet(ctx context.Context, params PersonsGetParams) (r []PersonType, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // PersonsPost implements POST /persons operation.
 //
-// Добавить персону.
+// Тело - PersonType (JSON) или существительное B2MML Person (XML);
+// ответ выбирается по Accept.
 //
 // POST /persons
-func (UnimplementedHandler) PersonsPost(ctx context.Context, req *PersonType) error {
-	return ht.ErrNotImplemented
+func (UnimplementedHandler) PersonsPost(ctx context.Context, req PersonsPostReq) (r PersonsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchGet implements GET /search operation.
+//
+// Ищет по внешним ID, описаниям (в том числе B2MML Description на
+// разных языках)
+// и значениям свойств оборудования. Все слова запроса
+// обязательны и совпадают
+// по началу слова. Результаты упорядочены по
+// релевантности.
+//
+// GET /search
+func (UnimplementedHandler) SearchGet(ctx context.Context, params SearchGetParams) (r SearchGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SitesIDUnitsGet implements GET /sites/{id}/units operation.
+//
+// Получить предпочтительные единицы площадки.
+//
+// GET /sites/{id}/units
+func (UnimplementedHandler) SitesIDUnitsGet(ctx context.Context, params SitesIDUnitsGetParams) (r SitesIDUnitsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SitesIDUnitsPut implements PUT /sites/{id}/units operation.
+//
+// Заменить предпочтительные единицы площадки.
+//
+// PUT /sites/{id}/units
+func (UnimplementedHandler) SitesIDUnitsPut(ctx context.Context, req *SiteUnits, params SitesIDUnitsPutParams) (r SitesIDUnitsPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnitsConvertGet implements GET /units/convert operation.
+//
+// Перевести значение в другую единицу измерения.
+//
+// GET /units/convert
+func (UnimplementedHandler) UnitsConvertGet(ctx context.Context, params UnitsConvertGetParams) (r UnitsConvertGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnitsGet implements GET /units operation.
+//
+// Единицы с кодами UN/ECE Recommendation 20 и их обозначения UCUM.
+// Значения
+// свойств могут использовать и другие выражения UCUM
+// (например kg/m3).
+//
+// GET /units
+func (UnimplementedHandler) UnitsGet(ctx context.Context, params UnitsGetParams) (r []UnitOfMeasure, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhooksGet implements GET /webhooks operation.
+//
+// Получить список подписок на события.
+//
+// GET /webhooks
+func (UnimplementedHandler) WebhooksGet(ctx context.Context) (r []Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhooksIDDelete implements DELETE /webhooks/{id} operation.
+//
+// Удалить подписку вместе с её доставками.
+//
+// DELETE /webhooks/{id}
+func (UnimplementedHandler) WebhooksIDDelete(ctx context.Context, params WebhooksIDDeleteParams) (r WebhooksIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhooksIDDeliveriesDeliveryIdReplayPost implements POST /webhooks/{id}/deliveries/{deliveryId}/replay operation.
+//
+// Доставка возвращается в очередь со сброшенным
+// счётчиком попыток.
+//
+// POST /webhooks/{id}/deliveries/{deliveryId}/replay
+func (UnimplementedHandler) WebhooksIDDeliveriesDeliveryIdReplayPost(ctx context.Context, params WebhooksIDDeliveriesDeliveryIdReplayPostParams) (r WebhooksIDDeliveriesDeliveryIdReplayPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhooksIDDeliveriesGet implements GET /webhooks/{id}/deliveries operation.
+//
+// Status=dead возвращает dead letter - доставки, исчерпавшие
+// попытки.
+//
+// GET /webhooks/{id}/deliveries
+func (UnimplementedHandler) WebhooksIDDeliveriesGet(ctx context.Context, params WebhooksIDDeliveriesGetParams) (r WebhooksIDDeliveriesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhooksIDGet implements GET /webhooks/{id} operation.
+//
+// Получить подписку на события.
+//
+// GET /webhooks/{id}
+func (UnimplementedHandler) WebhooksIDGet(ctx context.Context, params WebhooksIDGetParams) (r WebhooksIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhooksIDPingPost implements POST /webhooks/{id}/ping operation.
+//
+// Событие отправляется синхронно, не сохраняется и не
+// повторяется.
+//
+// POST /webhooks/{id}/ping
+func (UnimplementedHandler) WebhooksIDPingPost(ctx context.Context, params WebhooksIDPingPostParams) (r WebhooksIDPingPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhooksIDPut implements PUT /webhooks/{id} operation.
+//
+// Secret не меняется; поле secret в запросе игнорируется.
+//
+// PUT /webhooks/{id}
+func (UnimplementedHandler) WebhooksIDPut(ctx context.Context, req *Webhook, params WebhooksIDPutParams) (r WebhooksIDPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WebhooksPost implements POST /webhooks operation.
+//
+// События доставляются POST-запросом с JSON телом (WebhookEvent).
+// Тело подписывается HMAC-SHA256 по secret: заголовок X-CMMS-Signature
+// содержит sha256=<hex> от строки "<X-CMMS-Timestamp>.<тело>".
+// Если secret не задан, он генерируется и возвращается
+// только в ответе на создание.
+//
+// POST /webhooks
+func (UnimplementedHandler) WebhooksPost(ctx context.Context, req *Webhook) (r WebhooksPostRes, _ error) {
+	return r, ht.ErrNotImplemented
 }
@@ -0,0 +1,314 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// B2MMLTransport транспорт канала сообщений B2MML (HTTP, папка, шина в процессе)
+type B2MMLTransport interface {
+	// Send отправляет документ в тему канала
+	Send(ctx context.Context, topic string, document []byte) error
+	// Listen принимает документы темы до отмены ctx и передаёт их receive.
+	// Ответ receive (nil - без ответа) возвращается отправителю, если транспорт
+	// это поддерживает. Транспорт без входящих документов возвращает
+	// model.ErrB2MMLChannelInboundUnsupported
+	Listen(ctx context.Context, topic string, receive func(ctx context.Context, document []byte) []byte) error
+}
+
+// B2MMLTransportOpener открывает транспорт канала по ChannelURI профиля
+type B2MMLTransportOpener func(uri string) (B2MMLTransport, error)
+
+// B2MMLChannelRoute маршрут документов существительного через канал профиля
+type B2MMLChannelRoute struct {
+	Verb string
+	Noun string
+	// Inbound документы принимаются из канала (InformationReceiver),
+	// иначе отправляются в него (InformationSender)
+	Inbound    bool
+	ChannelURI string
+	Topic      string
+}
+
+// LoadMasterDataProfile читает документ MasterDataProfile и проверяет его по схеме
+func LoadMasterDataProfile(r io.Reader) (*b2mml.MasterDataProfileType, error) {
+	document, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read master data profile: %w", err)
+	}
+	var profile b2mml.MasterDataProfileType
+	if err := b2mml.Validate(bytes.NewReader(document), &profile); err != nil {
+		return nil, fmt.Errorf("%w: %w", model.ErrB2MMLMalformed, err)
+	}
+	if err := xml.Unmarshal(document, &profile); err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrB2MMLMalformed, err)
+	}
+	return &profile, nil
+}
+
+// B2MMLChannels маршрутизирует сообщения B2MML по каналам из MasterDataProfile.
+//
+// Маршруты берутся из TransactionProfile с ApplicationID сервера (LogicalID):
+// каждое SupportedAction с InformationReceiver принимает документы своего
+// глагола и существительного из всех ChannelTopic записи, с InformationSender -
+// отправляет их туда. Входящие документы обрабатывает B2MMLProcessor, документы
+// других существительных отклоняются. Исходящие документы SyncXxx строятся из
+// доменных событий outbox (B2MMLChannels - получатель EventSink)
+type B2MMLChannels struct {
+	profile    *b2mml.MasterDataProfileType
+	routes     []B2MMLChannelRoute
+	transports map[string]B2MMLTransport
+	processor  *B2MMLProcessor
+	get        *GetEquipmentInformationUseCase
+}
+
+// NewB2MMLChannels строит маршруты профиля и открывает транспорты его каналов
+func NewB2MMLChannels(
+	profile *b2mml.MasterDataProfileType,
+	processor *B2MMLProcessor,
+	get *GetEquipmentInformationUseCase,
+	open B2MMLTransportOpener,
+) (*B2MMLChannels, error) {
+	c := &B2MMLChannels{
+		profile:    profile,
+		transports: map[string]B2MMLTransport{},
+		processor:  processor,
+		get:        get,
+	}
+	for _, entry := range profile.TransactionProfile {
+		if entry == nil || entry.ApplicationID.String() != processor.cfg.LogicalID || entry.TransactionProfile == nil {
+			continue
+		}
+		for _, action := range entry.TransactionProfile.SupportedAction {
+			if action == nil || action.TransactionVerb == nil || action.TransactionVerb.TransactionVerb1Type == nil ||
+				action.TransactionNoun == nil || action.TransactionNoun.TransactionNoun1Type == nil {
+				continue
+			}
+			verb := strings.TrimSpace(action.TransactionVerb.Value)
+			noun := strings.TrimSpace(action.TransactionNoun.Value)
+			receiver := action.InformationReceiver != nil && *action.InformationReceiver
+			sender := action.InformationSender != nil && *action.InformationSender
+			if receiver && !processor.supports(verb, noun) {
+				return nil, fmt.Errorf("%w: %s%s in master data profile", model.ErrB2MMLUnsupportedMessage, verb, noun)
+			}
+			if sender && (verb != b2mmlVerbSync || noun == b2mmlNounTransactionProfile) {
+				return nil, fmt.Errorf("%w: outbound %s%s in master data profile", model.ErrB2MMLUnsupportedMessage, verb, noun)
+			}
+			for _, channel := range entry.ChannelTopic {
+				if channel == nil {
+					continue
+				}
+				uri := strings.TrimSpace(channel.ChannelURI)
+				if _, ok := c.transports[uri]; !ok {
+					transport, err := open(uri)
+					if err != nil {
+						return nil, fmt.Errorf("open b2mml channel %s: %w", uri, err)
+					}
+					c.transports[uri] = transport
+				}
+				route := B2MMLChannelRoute{Verb: verb, Noun: noun, ChannelURI: uri, Topic: strings.TrimSpace(channel.Topic)}
+				if receiver {
+					route.Inbound = true
+					c.routes = append(c.routes, route)
+				}
+				if sender {
+					route.Inbound = false
+					c.routes = append(c.routes, route)
+				}
+			}
+		}
+	}
+	return c, nil
+}
+
+// Profile возвращает загруженный MasterDataProfile
+func (c *B2MMLChannels) Profile() *b2mml.MasterDataProfileType {
+	return c.profile
+}
+
+// Routes возвращает маршруты сервера
+func (c *B2MMLChannels) Routes() []B2MMLChannelRoute {
+	return c.routes
+}
+
+// b2mmlTopic тема канала
+type b2mmlTopic struct {
+	uri, topic string
+}
+
+// Run принимает документы из каналов входящих маршрутов до отмены ctx
+func (c *B2MMLChannels) Run(ctx context.Context) {
+	inbound := map[b2mmlTopic][]string{}
+	var topics []b2mmlTopic
+	for _, r := range c.routes {
+		if !r.Inbound {
+			continue
+		}
+		key := b2mmlTopic{r.ChannelURI, r.Topic}
+		if _, ok := inbound[key]; !ok {
+			topics = append(topics, key)
+		}
+		inbound[key] = append(inbound[key], r.Verb+r.Noun)
+	}
+
+	var wg sync.WaitGroup
+	for _, key := range topics {
+		names := inbound[key]
+		wg.Go(func() {
+			receive := func(ctx context.Context, document []byte) []byte {
+				return c.receive(ctx, key, names, document)
+			}
+			err := c.transports[key.uri].Listen(ctx, key.topic, receive)
+			switch {
+			case errors.Is(err, model.ErrB2MMLChannelInboundUnsupported):
+				log.Printf("B2MML channel %s: %v, inbound documents are accepted at POST /api/v1/b2mml", key.uri, err)
+			case err != nil && ctx.Err() == nil:
+				log.Printf("B2MML channel %s topic %q stopped: %v", key.uri, key.topic, err)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// receive обрабатывает документ из темы канала, объявленной для документов
+// names, и возвращает закодированный ответ
+func (c *B2MMLChannels) receive(ctx context.Context, key b2mmlTopic, names []string, document []byte) []byte {
+	var (
+		root  struct{ XMLName xml.Name }
+		reply *B2MMLReply
+		err   error
+	)
+	// Документ, который не разбирается, отклонит Process
+	if xml.Unmarshal(document, &root) == nil && !slices.Contains(names, root.XMLName.Local) {
+		err = fmt.Errorf("%w: %s is not routed to channel %s topic %q",
+			model.ErrB2MMLUnsupportedMessage, root.XMLName.Local, key.uri, key.topic)
+	} else {
+		reply, err = c.processor.Process(ctx, bytes.NewReader(document))
+	}
+
+	var doc any
+	switch {
+	case err != nil:
+		log.Printf("B2MML channel %s topic %q: document rejected: %v", key.uri, key.topic, err)
+		doc = c.processor.ErrorDocument(err)
+	case reply.Err != nil:
+		log.Printf("B2MML channel %s topic %q: document not applied: %v", key.uri, key.topic, reply.Err)
+		doc = reply.Document
+	default:
+		doc = reply.Document
+	}
+	if doc == nil {
+		return nil
+	}
+	body, err := encodeB2MMLDocument(doc)
+	if err != nil {
+		log.Printf("B2MML channel %s topic %q: encode reply: %v", key.uri, key.topic, err)
+		return nil
+	}
+	return body
+}
+
+func (c *B2MMLChannels) Name() string {
+	return "b2mml"
+}
+
+// Deliver отправляет изменение оборудования или класса документом SyncXxx в
+// каналы исходящих маршрутов его существительного. Удалённое оборудование
+// передаётся с кодом Delete, остальные события - текущим состоянием с кодом
+// Replace; BODID документа - идентификатор события
+func (c *B2MMLChannels) Deliver(ctx context.Context, event *repository.OutboxEvent) error {
+	if !slices.ContainsFunc(c.routes, func(r B2MMLChannelRoute) bool { return !r.Inbound }) {
+		return nil
+	}
+
+	var (
+		equipment []*b2mml.EquipmentType
+		classes   []*b2mml.EquipmentClassType
+		action    = b2mml.ActionCodeReplace
+	)
+	switch {
+	case event.AggregateType == model.AggregateTypeEquipment &&
+		(event.EventType == model.EventTypeEquipmentDeleted || event.EventType == model.EventTypeEquipmentPurged):
+		action = b2mml.ActionCodeDelete
+		equipment = []*b2mml.EquipmentType{{ID: &b2mml.IdentifierType{Value: event.AggregateID}}}
+	case event.AggregateType == model.AggregateTypeEquipment:
+		result, err := c.get.Execute(ctx, GetEquipmentInformationInput{Equipment: true, EquipmentIDs: []string{event.AggregateID}})
+		if err != nil {
+			return err
+		}
+		for _, e := range result.Equipment {
+			equipment = append(equipment, e.ToB2MML())
+		}
+	case event.AggregateType == model.AggregateTypeEquipmentClass:
+		result, err := c.get.Execute(ctx, GetEquipmentInformationInput{Classes: true, ClassIDs: []string{event.AggregateID}})
+		if err != nil {
+			return err
+		}
+		for _, ec := range result.EquipmentClasses {
+			classes = append(classes, ec.ToB2MML())
+		}
+	}
+	if len(equipment) == 0 && len(classes) == 0 {
+		return nil
+	}
+
+	var errs []error
+	for _, r := range c.routes {
+		if r.Inbound {
+			continue
+		}
+		data := &b2mml.EquipmentDataArea{}
+		switch {
+		case r.Noun == b2mmlNounEquipment && len(equipment) > 0:
+			data.Equipment = equipment
+		case r.Noun == b2mmlNounEquipmentClass && len(classes) > 0:
+			data.EquipmentClass = classes
+		case r.Noun == b2mmlNounEquipmentInformation:
+			data.EquipmentInformation = []*b2mml.EquipmentInformationType{{Equipment: equipment, EquipmentClass: classes}}
+		default:
+			continue
+		}
+		code := b2mml.TransActionCodeType(action)
+		data.Sync = &b2mml.TransSyncType{ActionCriteria: []*b2mml.TransActionCriteriaType{{
+			ActionExpression: []*b2mml.TransExpressionType{{
+				ActionCodeAttr:       &code,
+				TransExpression1Type: &b2mml.TransExpression1Type{},
+			}},
+		}}}
+
+		area := c.processor.applicationArea()
+		area.BODID = &b2mml.IdentifierType{Value: event.EventID.String()}
+		area.CreationDateTime = &b2mml.DateTimeType{Value: event.OccurredAt.UTC().Format(time.RFC3339)}
+		body, err := encodeB2MMLDocument(b2mml.NewBOD(r.Verb+r.Noun, area, data))
+		if err != nil {
+			return err
+		}
+		if err := c.transports[r.ChannelURI].Send(ctx, r.Topic, body); err != nil {
+			errs = append(errs, fmt.Errorf("%s topic %q: %w", r.ChannelURI, r.Topic, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// encodeB2MMLDocument кодирует документ B2MML в XML с заголовком
+func encodeB2MMLDocument(doc any) ([]byte, error) {
+	var body bytes.Buffer
+	body.WriteString(xml.Header)
+	if err := xml.NewEncoder(&body).Encode(doc); err != nil {
+		return nil, err
+	}
+	return body.Bytes(), nil
+}
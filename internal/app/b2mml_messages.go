@@ -0,0 +1,505 @@
+package app
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+)
+
+// B2MMLProcessorConfig параметры обработки сообщений B2MML
+type B2MMLProcessorConfig struct {
+	// LogicalID идентификатор сервера в Sender ответных документов
+	LogicalID string
+}
+
+// B2MMLReply результат обработки сообщения B2MML
+type B2MMLReply struct {
+	// Document ответный документ ShowXxx, AcknowledgeXxx или ConfirmBOD;
+	// nil, если отправитель не запросил ответ
+	Document any
+	// Err причина отклонения сообщения; nil - сообщение применено
+	Err error
+}
+
+// B2MMLProcessor обрабатывает сообщения B2MML (BOD) Get, Process и Sync с
+// существительными Equipment, EquipmentClass и EquipmentInformation и
+// GetTransactionProfile. Обработчики документов регистрируются по глаголу и
+// существительному (см. register); по ним же строится профиль транзакций, а
+// документы без обработчика отклоняются как неподдерживаемые.
+//
+// На Get отвечает ShowXxx с сохранёнными объектами: выборку задают
+// существительные с ID в DataArea и выражения Get/Expression (см. parseGetExpression),
+// страницу - атрибуты maxItems и recordSetReferenceId.
+//
+// Код действия берётся из ActionExpression глагола (выражение XPath не
+// вычисляется, действие относится ко всему DataArea); по умолчанию Process
+// выполняет Add, Sync - Replace. На Process отвечает AcknowledgeXxx по
+// acknowledgeCode глагола, на Sync - ConfirmBOD по Sender/ConfirmationCode;
+// без кода ответ отправляется всегда
+type B2MMLProcessor struct {
+	apply  *ApplyEquipmentInformationUseCase
+	get    *GetEquipmentInformationUseCase
+	cfg    B2MMLProcessorConfig
+	routes []b2mmlRoute
+}
+
+// NewB2MMLProcessor создаёт обработчик сообщений B2MML
+func NewB2MMLProcessor(
+	apply *ApplyEquipmentInformationUseCase,
+	get *GetEquipmentInformationUseCase,
+	cfg B2MMLProcessorConfig,
+) *B2MMLProcessor {
+	cfg.LogicalID = cmp.Or(cfg.LogicalID, "go-cmms")
+	p := &B2MMLProcessor{apply: apply, get: get, cfg: cfg}
+	for _, noun := range []string{b2mmlNounEquipment, b2mmlNounEquipmentClass, b2mmlNounEquipmentInformation} {
+		p.register(b2mmlVerbGet, noun, p.processEquipment)
+		p.register(b2mmlVerbProcess, noun, p.processEquipment)
+		p.register(b2mmlVerbSync, noun, p.processEquipment)
+	}
+	p.register(b2mmlVerbGet, b2mmlNounTransactionProfile, p.showTransactionProfile)
+	return p
+}
+
+// Глаголы и существительные поддерживаемых сообщений
+const (
+	b2mmlVerbGet     = "Get"
+	b2mmlVerbProcess = "Process"
+	b2mmlVerbSync    = "Sync"
+
+	b2mmlNounEquipment            = "Equipment"
+	b2mmlNounEquipmentClass       = "EquipmentClass"
+	b2mmlNounEquipmentInformation = "EquipmentInformation"
+	b2mmlNounTransactionProfile   = "TransactionProfile"
+)
+
+// Process разбирает сообщение из r и применяет его.
+// Ошибка возвращается для некорректного или неподдерживаемого сообщения;
+// отклонение применения передаётся в B2MMLReply.Err вместе с ответным документом
+func (p *B2MMLProcessor) Process(ctx context.Context, r io.Reader) (*B2MMLReply, error) {
+	document, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrB2MMLMalformed, err)
+	}
+	var root struct{ XMLName xml.Name }
+	if err := xml.Unmarshal(document, &root); err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrB2MMLMalformed, err)
+	}
+	if root.XMLName.Space != "" && root.XMLName.Space != b2mml.Namespace {
+		return nil, fmt.Errorf("%w: namespace %q", model.ErrB2MMLUnsupportedMessage, root.XMLName.Space)
+	}
+	route, ok := p.route(root.XMLName.Local)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", model.ErrB2MMLUnsupportedMessage, root.XMLName.Local)
+	}
+	return route.handle(ctx, document, route.verb, route.noun)
+}
+
+// processEquipment обрабатывает документы с существительными оборудования
+func (p *B2MMLProcessor) processEquipment(ctx context.Context, document []byte, verb, noun string) (*B2MMLReply, error) {
+	var bod b2mml.BOD[b2mml.EquipmentDataArea]
+	if err := decodeB2MML(document, &bod); err != nil {
+		return nil, err
+	}
+
+	data := bod.DataArea
+	elements := append(verbElements(data.Verb),
+		dataAreaElement{b2mmlNounEquipmentInformation, len(data.EquipmentInformation) > 0},
+		dataAreaElement{b2mmlNounEquipmentClass, len(data.EquipmentClass) > 0},
+		dataAreaElement{b2mmlNounEquipment, len(data.Equipment) > 0},
+	)
+	if violations := dataAreaViolations(bod.XMLName.Local, verb, noun, elements); len(violations) > 0 {
+		return nil, fmt.Errorf("%w: %w", model.ErrB2MMLMalformed, &b2mml.ValidationError{Violations: violations})
+	}
+	if verb == b2mmlVerbGet {
+		return p.show(ctx, &bod, noun)
+	}
+
+	action, err := b2mmlAction(verb, data.ActionCodes())
+	if err != nil {
+		return nil, err
+	}
+
+	input := ApplyEquipmentInformationInput{Action: action}
+	switch noun {
+	case b2mmlNounEquipment:
+		input.Equipment = data.Equipment
+	case b2mmlNounEquipmentClass:
+		input.EquipmentClasses = data.EquipmentClass
+	case b2mmlNounEquipmentInformation:
+		for _, info := range data.EquipmentInformation {
+			if info == nil {
+				continue
+			}
+			input.EquipmentClasses = append(input.EquipmentClasses, info.EquipmentClass...)
+			input.Equipment = append(input.Equipment, info.Equipment...)
+		}
+	}
+	if slices.Contains(input.Equipment, nil) || slices.Contains(input.EquipmentClasses, nil) {
+		return nil, fmt.Errorf("%w: empty %s element", model.ErrB2MMLMalformed, noun)
+	}
+
+	_, applyErr := p.apply.Execute(ctx, input)
+	reply := &B2MMLReply{Err: applyErr}
+
+	code := b2mml.ResponseCodeAlways
+	switch {
+	case verb == b2mmlVerbProcess && data.Process.AcknowledgeCodeAttr != nil:
+		code = *data.Process.AcknowledgeCodeAttr
+	case verb == b2mmlVerbSync && bod.ApplicationArea != nil && bod.ApplicationArea.Sender != nil &&
+		bod.ApplicationArea.Sender.ConfirmationCode != nil:
+		code = bod.ApplicationArea.Sender.ConfirmationCode.Value
+	}
+	switch strings.TrimSpace(code) {
+	case b2mml.ResponseCodeNever:
+		return reply, nil
+	case b2mml.ResponseCodeOnError:
+		if applyErr == nil {
+			return reply, nil
+		}
+	}
+
+	criteria := b2mml.NewResponseCriteria(b2mml.ActionCodeAccepted)
+	if applyErr != nil {
+		criteria = b2mml.NewResponseCriteria(b2mml.ActionCodeRejected, applyErr.Error())
+	}
+
+	if verb == b2mmlVerbProcess {
+		ack := &b2mml.EquipmentDataArea{
+			Verb: b2mml.Verb{Acknowledge: &b2mml.TransAcknowledgeType{
+				OriginalApplicationArea: bod.ApplicationArea,
+				ResponseCriteria:        []*b2mml.TransResponseCriteriaType{criteria},
+			}},
+			EquipmentInformation: data.EquipmentInformation,
+			EquipmentClass:       data.EquipmentClass,
+			Equipment:            data.Equipment,
+		}
+		reply.Document = b2mml.NewBOD("Acknowledge"+noun, p.applicationArea(), ack)
+		return reply, nil
+	}
+
+	description := fmt.Sprintf("%s applied: %d equipment class(es), %d equipment",
+		action, len(input.EquipmentClasses), len(input.Equipment))
+	if applyErr != nil {
+		description = applyErr.Error()
+	}
+	confirm := &b2mml.ConfirmBODDataArea{
+		Confirm: &b2mml.TransConfirmType{
+			OriginalApplicationArea: bod.ApplicationArea,
+			ResponseCriteria:        []*b2mml.TransResponseCriteriaType{criteria},
+		},
+		BOD: []*b2mml.BODType{{
+			FreeFormTextGroup:       &b2mml.FreeFormTextGroup{Description: []*b2mml.TextType{{Value: description}}},
+			OriginalApplicationArea: bod.ApplicationArea,
+		}},
+	}
+	reply.Document = b2mml.NewBOD("ConfirmBOD", p.applicationArea(), confirm)
+	return reply, nil
+}
+
+// applicationArea создаёт ApplicationArea ответного документа
+func (p *B2MMLProcessor) applicationArea() *b2mml.TransApplicationAreaType {
+	return &b2mml.TransApplicationAreaType{
+		Sender:           &b2mml.TransSenderType{LogicalID: &b2mml.IdentifierType{Value: p.cfg.LogicalID}},
+		CreationDateTime: &b2mml.DateTimeType{Value: time.Now().UTC().Format(time.RFC3339)},
+		BODID:            &b2mml.IdentifierType{Value: uuid.NewString()},
+	}
+}
+
+// ErrorDocument создаёт документ ShowErrorMessage для сообщения, отклонённого
+// Process с ошибкой err: ErrorMessage на каждое нарушение схемы (ThrownFrom -
+// XPath нарушения, ErrorCode - код) или одно ErrorMessage с описанием err
+func (p *B2MMLProcessor) ErrorDocument(err error) any {
+	now := &b2mml.DateTimeType{Value: time.Now().UTC().Format(time.RFC3339)}
+	var messages []*b2mml.ErrorMessageType
+	var verr *b2mml.ValidationError
+	if errors.As(err, &verr) {
+		for _, v := range verr.Violations {
+			messages = append(messages, &b2mml.ErrorMessageType{
+				ErrorDescription: []string{v.Message},
+				ThrownFrom:       v.Path,
+				TimeStamp:        now,
+				ErrorCode:        &v.Code,
+			})
+		}
+	} else {
+		messages = append(messages, &b2mml.ErrorMessageType{
+			ErrorDescription: []string{err.Error()},
+			ThrownFrom:       "/",
+			TimeStamp:        now,
+		})
+	}
+	data := &b2mml.ErrorMessageDataArea{
+		Verb: b2mml.Verb{Show: &b2mml.TransShowType{
+			ResponseCriteria: []*b2mml.TransResponseCriteriaType{
+				b2mml.NewResponseCriteria(b2mml.ActionCodeRejected, err.Error()),
+			},
+		}},
+		ErrorMessage: messages,
+	}
+	return b2mml.NewBOD("ShowErrorMessage", p.applicationArea(), data)
+}
+
+// decodeB2MML проверяет документ по схеме и разбирает его в bod
+func decodeB2MML[D any](document []byte, bod *b2mml.BOD[D]) error {
+	if err := b2mml.Validate(bytes.NewReader(document), bod); err != nil {
+		return fmt.Errorf("%w: %w", model.ErrB2MMLMalformed, err)
+	}
+	if err := xml.Unmarshal(document, bod); err != nil {
+		return fmt.Errorf("%w: %v", model.ErrB2MMLMalformed, err)
+	}
+	return nil
+}
+
+// dataAreaElement элемент DataArea и его наличие в документе
+type dataAreaElement struct {
+	name string
+	ok   bool
+}
+
+// verbElements возвращает глаголы DataArea
+func verbElements(v b2mml.Verb) []dataAreaElement {
+	return []dataAreaElement{
+		{b2mmlVerbGet, v.Get != nil},
+		{"Show", v.Show != nil},
+		{b2mmlVerbProcess, v.Process != nil},
+		{"Acknowledge", v.Acknowledge != nil},
+		{b2mmlVerbSync, v.Sync != nil},
+	}
+}
+
+// dataAreaViolations проверяет, что среди элементов DataArea есть глагол из
+// имени документа root и нет других глаголов и существительных
+func dataAreaViolations(root, verb, noun string, elements []dataAreaElement) []b2mml.Violation {
+	path := "/" + root + "/DataArea/"
+	var violations []b2mml.Violation
+	for _, p := range elements {
+		switch {
+		case p.name == verb && !p.ok:
+			violations = append(violations, b2mml.Violation{
+				Path: path + p.name, Code: b2mml.ViolationRequired,
+				Message: fmt.Sprintf("element %s is required in %s", p.name, root),
+			})
+		case p.ok && p.name != verb && p.name != noun:
+			violations = append(violations, b2mml.Violation{
+				Path: path + p.name, Code: b2mml.ViolationUnexpected,
+				Message: fmt.Sprintf("element %s is not allowed in %s", p.name, root),
+			})
+		}
+	}
+	return violations
+}
+
+// b2mmlAction возвращает код действия сообщения. Все ActionExpression
+// должны задавать одно действие
+func b2mmlAction(verb string, codes []string) (string, error) {
+	action := b2mml.ActionCodeAdd
+	if verb == b2mmlVerbSync {
+		action = b2mml.ActionCodeReplace
+	}
+	for i, code := range codes {
+		code = strings.TrimSpace(code)
+		if i > 0 && code != action {
+			return "", fmt.Errorf("%w: mixed action codes %v", model.ErrB2MMLUnsupportedAction, codes)
+		}
+		action = code
+	}
+	switch action {
+	case b2mml.ActionCodeAdd, b2mml.ActionCodeChange, b2mml.ActionCodeReplace, b2mml.ActionCodeDelete:
+		return action, nil
+	}
+	return "", fmt.Errorf("%w: %q", model.ErrB2MMLUnsupportedAction, action)
+}
+
+// show выбирает объекты по документу Get и возвращает ShowXxx
+func (p *B2MMLProcessor) show(ctx context.Context, bod *b2mml.BOD[b2mml.EquipmentDataArea], noun string) (*B2MMLReply, error) {
+	data := bod.DataArea
+	var filter getFilter
+	for _, e := range data.Equipment {
+		filter.addEquipmentID(e.ID.String())
+	}
+	for _, c := range data.EquipmentClass {
+		filter.classIDs = append(filter.classIDs, c.ID.String())
+	}
+	for _, info := range data.EquipmentInformation {
+		if info == nil {
+			continue
+		}
+		for _, e := range info.Equipment {
+			filter.addEquipmentID(e.ID.String())
+		}
+		for _, c := range info.EquipmentClass {
+			filter.classIDs = append(filter.classIDs, c.ID.String())
+		}
+	}
+	for _, expr := range data.Get.Expression {
+		if err := filter.parseExpression(expr); err != nil {
+			return nil, err
+		}
+	}
+	if filter.queried && len(filter.equipmentIDs) > 0 {
+		return nil, fmt.Errorf("%w: equipment filter cannot be combined with equipment IDs", model.ErrB2MMLUnsupportedExpression)
+	}
+	if slices.Contains(filter.equipmentIDs, "") || slices.Contains(filter.classIDs, "") {
+		return nil, fmt.Errorf("%w: empty %s ID", model.ErrB2MMLMalformed, noun)
+	}
+
+	input := GetEquipmentInformationInput{
+		EquipmentIDs: filter.equipmentIDs,
+		Query:        filter.query,
+		ClassIDs:     filter.classIDs,
+	}
+	if data.Get.MaxItemsAttr != nil {
+		input.Query.Limit = *data.Get.MaxItemsAttr
+	}
+	if data.Get.RecordSetReferenceIDAttr != nil {
+		input.Query.Cursor = *data.Get.RecordSetReferenceIDAttr
+	}
+	switch noun {
+	case b2mmlNounEquipment:
+		input.Equipment = true
+	case b2mmlNounEquipmentClass:
+		input.Classes = true
+	case b2mmlNounEquipmentInformation:
+		// Запрос только классов не выбирает оборудование
+		classesOnly := len(filter.classIDs) > 0 && len(filter.equipmentIDs) == 0 && !filter.queried
+		input.Equipment = !classesOnly
+		input.Classes = len(filter.classIDs) > 0
+		input.EquipmentClasses = true
+	}
+
+	result, err := p.get.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int32(len(result.Equipment) + len(result.EquipmentClasses))
+	complete := result.NextCursor == ""
+	show := &b2mml.TransShowType{
+		RecordSetCountAttr:             &count,
+		RecordSetCompleteIndicatorAttr: &complete,
+		OriginalApplicationArea:        bod.ApplicationArea,
+		ResponseCriteria:               []*b2mml.TransResponseCriteriaType{b2mml.NewResponseCriteria(b2mml.ActionCodeAccepted)},
+	}
+	if !complete {
+		show.RecordSetReferenceIDAttr = &result.NextCursor
+	}
+
+	var equipment []*b2mml.EquipmentType
+	for _, e := range result.Equipment {
+		equipment = append(equipment, e.ToB2MML())
+	}
+	var classes []*b2mml.EquipmentClassType
+	for _, c := range result.EquipmentClasses {
+		classes = append(classes, c.ToB2MML())
+	}
+
+	reply := &b2mml.EquipmentDataArea{Verb: b2mml.Verb{Show: show}}
+	switch noun {
+	case b2mmlNounEquipment:
+		reply.Equipment = equipment
+	case b2mmlNounEquipmentClass:
+		reply.EquipmentClass = classes
+	case b2mmlNounEquipmentInformation:
+		reply.EquipmentInformation = []*b2mml.EquipmentInformationType{{
+			Equipment:      equipment,
+			EquipmentClass: classes,
+		}}
+	}
+	return &B2MMLReply{Document: b2mml.NewBOD("Show"+noun, p.applicationArea(), reply)}, nil
+}
+
+// getFilter выборка объектов документа Get
+type getFilter struct {
+	equipmentIDs []string
+	classIDs     []string
+	query        QueryEquipmentInput
+	// queried задан фильтр оборудования по полям
+	queried bool
+}
+
+// addEquipmentID добавляет оборудование, запрошенное по ID
+func (f *getFilter) addEquipmentID(id string) {
+	f.equipmentIDs = append(f.equipmentIDs, id)
+}
+
+var (
+	// getExpressionPattern путь XPath с предикатами в последнем шаге
+	getExpressionPattern = regexp.MustCompile(`^/{0,2}(?:[A-Za-z]+/{1,2})*([A-Za-z]+)((?:\[[^\]]*\])*)$`)
+	// getPredicatePattern предикат шага
+	getPredicatePattern = regexp.MustCompile(`\[([^\]]*)\]`)
+	// getTermPattern условие предиката: путь = 'значение'
+	getTermPattern = regexp.MustCompile(`^\s*([A-Za-z]+(?:/[A-Za-z]+)?)\s*=\s*(?:'([^']*)'|"([^"]*)")\s*$`)
+	// getAndPattern разделитель условий предиката
+	getAndPattern = regexp.MustCompile(`\s+and\s+`)
+)
+
+// parseExpression разбирает выражение Get/Expression. Поддерживается
+// подмножество XPath: путь к существительному, последний шаг которого -
+// Equipment или EquipmentClass (для других существительных - все объекты), с
+// предикатами из условий равенства, объединённых and:
+//   - Equipment: ID, EquipmentLevel, EquipmentClassID, HierarchyScope/EquipmentID
+//   - EquipmentClass: ID
+//
+// Например /GetEquipment/DataArea/Equipment[EquipmentLevel='Unit' and EquipmentClassID='PUMP']
+func (f *getFilter) parseExpression(expr string) error {
+	m := getExpressionPattern.FindStringSubmatch(strings.TrimSpace(expr))
+	if m == nil {
+		return fmt.Errorf("%w: %q", model.ErrB2MMLUnsupportedExpression, expr)
+	}
+	step, predicates := m[1], getPredicatePattern.FindAllStringSubmatch(m[2], -1)
+	if step != b2mmlNounEquipment && step != b2mmlNounEquipmentClass {
+		if len(predicates) > 0 {
+			return fmt.Errorf("%w: predicates on %s", model.ErrB2MMLUnsupportedExpression, step)
+		}
+		return nil
+	}
+
+	queried := false
+	for _, predicate := range predicates {
+		for _, term := range getAndPattern.Split(predicate[1], -1) {
+			t := getTermPattern.FindStringSubmatch(term)
+			if t == nil {
+				return fmt.Errorf("%w: %q", model.ErrB2MMLUnsupportedExpression, term)
+			}
+			name, value := t[1], t[2]+t[3]
+			switch {
+			case step == b2mmlNounEquipmentClass && name == "ID":
+				f.classIDs = append(f.classIDs, value)
+			case step == b2mmlNounEquipment && name == "ID":
+				f.addEquipmentID(value)
+			case step == b2mmlNounEquipment && name == "EquipmentLevel":
+				f.query.Levels = append(f.query.Levels, value)
+				queried = true
+			case step == b2mmlNounEquipment && name == "EquipmentClassID":
+				if f.query.ClassID != "" && f.query.ClassID != value {
+					return fmt.Errorf("%w: several equipment classes", model.ErrB2MMLUnsupportedExpression)
+				}
+				f.query.ClassID = value
+				queried = true
+			case step == b2mmlNounEquipment && name == "HierarchyScope/EquipmentID":
+				f.query.HierarchyScopeIDs = append(f.query.HierarchyScopeIDs, value)
+				queried = true
+			default:
+				return fmt.Errorf("%w: %s/%s", model.ErrB2MMLUnsupportedExpression, step, name)
+			}
+		}
+	}
+	if queried && f.queried {
+		return fmt.Errorf("%w: several equipment filters", model.ErrB2MMLUnsupportedExpression)
+	}
+	f.queried = f.queried || queried
+	return nil
+}
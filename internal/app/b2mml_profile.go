@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+)
+
+// b2mmlHandler обрабатывает проверенный по имени документ B2MML
+type b2mmlHandler func(ctx context.Context, document []byte, verb, noun string) (*B2MMLReply, error)
+
+// b2mmlRoute обработчик документов одного глагола и существительного
+type b2mmlRoute struct {
+	verb   string
+	noun   string
+	handle b2mmlHandler
+}
+
+// register добавляет обработчик документов verb+noun
+func (p *B2MMLProcessor) register(verb, noun string, handle b2mmlHandler) {
+	p.routes = append(p.routes, b2mmlRoute{verb: verb, noun: noun, handle: handle})
+}
+
+// route возвращает обработчик документа с корневым элементом name
+func (p *B2MMLProcessor) route(name string) (b2mmlRoute, bool) {
+	for _, r := range p.routes {
+		if r.verb+r.noun == name {
+			return r, true
+		}
+	}
+	return b2mmlRoute{}, false
+}
+
+// supports сообщает, что для глагола verb и существительного noun есть обработчик
+func (p *B2MMLProcessor) supports(verb, noun string) bool {
+	return slices.ContainsFunc(p.routes, func(r b2mmlRoute) bool {
+		return r.verb == verb && r.noun == noun
+	})
+}
+
+// TransactionProfile возвращает профиль транзакций сервера: поддерживаемые
+// сочетания глагола и существительного в порядке регистрации обработчиков
+func (p *B2MMLProcessor) TransactionProfile() *b2mml.TransactionProfileType {
+	return p.transactionProfile(nil)
+}
+
+// transactionProfile строит профиль из действий, подходящих под один из
+// фильтров; пустой список фильтров выбирает все действия
+func (p *B2MMLProcessor) transactionProfile(filters []*b2mml.SupportedActionType) *b2mml.TransactionProfileType {
+	profile := &b2mml.TransactionProfileType{
+		ReleaseIDAttr: b2mml.ReleaseID,
+		ID:            &b2mml.IdentifierType{Value: p.cfg.LogicalID},
+		Description:   []*b2mml.DescriptionType{{Value: "B2MML transactions supported by " + p.cfg.LogicalID}},
+	}
+	for _, r := range p.routes {
+		if len(filters) > 0 && !matchesSupportedAction(filters, r.verb, r.noun) {
+			continue
+		}
+		receiver, provider, user := true, r.verb == b2mmlVerbGet, r.verb != b2mmlVerbGet
+		profile.SupportedAction = append(profile.SupportedAction, &b2mml.SupportedActionType{
+			ReleaseIDAttr:       b2mml.ReleaseID,
+			ID:                  &b2mml.IdentifierType{Value: r.verb + r.noun},
+			TransactionVerb:     &b2mml.TransactionVerbType{TransactionVerb1Type: &b2mml.TransactionVerb1Type{Value: r.verb}},
+			TransactionNoun:     &b2mml.TransactionNounType{TransactionNoun1Type: &b2mml.TransactionNoun1Type{Value: r.noun}},
+			InformationReceiver: &receiver,
+			InformationProvider: &provider,
+			InformationUser:     &user,
+		})
+	}
+	return profile
+}
+
+// matchesSupportedAction сообщает, что действие verb+noun подходит под один
+// из фильтров; незаданные в фильтре глагол или существительное подходят к любому
+func matchesSupportedAction(filters []*b2mml.SupportedActionType, verb, noun string) bool {
+	for _, f := range filters {
+		if f == nil {
+			continue
+		}
+		if f.TransactionVerb != nil && f.TransactionVerb.TransactionVerb1Type != nil &&
+			f.TransactionVerb.Value != "" && f.TransactionVerb.Value != verb {
+			continue
+		}
+		if f.TransactionNoun != nil && f.TransactionNoun.TransactionNoun1Type != nil &&
+			f.TransactionNoun.Value != "" && f.TransactionNoun.Value != noun {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// showTransactionProfile отвечает на GetTransactionProfile. SupportedAction
+// в TransactionProfile запроса ограничивают ответ подходящими действиями
+func (p *B2MMLProcessor) showTransactionProfile(_ context.Context, document []byte, verb, noun string) (*B2MMLReply, error) {
+	var bod b2mml.BOD[b2mml.TransactionProfileDataArea]
+	if err := decodeB2MML(document, &bod); err != nil {
+		return nil, err
+	}
+	data := bod.DataArea
+	elements := append(verbElements(data.Verb),
+		dataAreaElement{b2mmlNounTransactionProfile, len(data.TransactionProfile) > 0})
+	if violations := dataAreaViolations(bod.XMLName.Local, verb, noun, elements); len(violations) > 0 {
+		return nil, fmt.Errorf("%w: %w", model.ErrB2MMLMalformed, &b2mml.ValidationError{Violations: violations})
+	}
+
+	var filters []*b2mml.SupportedActionType
+	for _, profile := range data.TransactionProfile {
+		if profile != nil {
+			filters = append(filters, profile.SupportedAction...)
+		}
+	}
+	profile := p.transactionProfile(filters)
+
+	count := int32(1)
+	complete := true
+	show := &b2mml.TransShowType{
+		RecordSetCountAttr:             &count,
+		RecordSetCompleteIndicatorAttr: &complete,
+		OriginalApplicationArea:        bod.ApplicationArea,
+		ResponseCriteria:               []*b2mml.TransResponseCriteriaType{b2mml.NewResponseCriteria(b2mml.ActionCodeAccepted)},
+	}
+	reply := &b2mml.TransactionProfileDataArea{
+		Verb:               b2mml.Verb{Show: show},
+		TransactionProfile: []*b2mml.TransactionProfileType{profile},
+	}
+	return &B2MMLReply{Document: b2mml.NewBOD("Show"+noun, p.applicationArea(), reply)}, nil
+}
@@ -2,21 +2,34 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
 	"github.com/grnsv/go-cmms/internal/domain/repository"
 )
 
 // ListEquipmentInput входные параметры для ListEquipment
 type ListEquipmentInput struct {
 	Limit  int32
-	Offset int32
+	Cursor string
+	// Status фильтр по статусу эксплуатации (необязательный)
+	Status string
+	// ClassID фильтр по классу оборудования (необязательный)
+	ClassID string
+	// Deleted вернуть удалённое оборудование вместо активного; несовместим
+	// с фильтрами по статусу и классу
+	Deleted bool
 }
 
 // ListEquipmentOutput выходные данные для ListEquipment
 type ListEquipmentOutput struct {
-	Count int
-	// Items []*Equipment // TODO: когда будет полная реализация репозитория
+	Items      []*model.Equipment
+	Count      int
+	NextCursor string
+	PrevCursor string
 }
 
 // ListEquipmentUseCase use case для получения списка оборудования
@@ -33,38 +46,85 @@ func NewListEquipmentUseCase(equipmentRepo repository.EquipmentRepository) *List
 
 // Execute выполняет use case
 func (uc *ListEquipmentUseCase) Execute(ctx context.Context, input ListEquipmentInput) (*ListEquipmentOutput, error) {
-	// Заглушка: в реальности будет работать с репозиторием
-	if input.Limit <= 0 {
-		input.Limit = 10
+	page := repository.PageRequest{
+		Limit:  normalizeLimit(input.Limit),
+		Cursor: input.Cursor,
 	}
-	if input.Limit > 100 {
-		input.Limit = 100
+
+	var (
+		result *repository.Page[*model.Equipment]
+		err    error
+	)
+	switch {
+	case input.Deleted && (input.Status != "" || input.ClassID != ""):
+		return nil, fmt.Errorf("%w: deleted cannot be combined with status or class", ErrInvalidEquipmentQuery)
+	case input.Deleted:
+		result, err = uc.equipmentRepo.ListDeleted(ctx, page)
+	case input.Status != "" && input.ClassID != "":
+		query := QueryEquipmentInput{
+			Statuses: []string{input.Status},
+			ClassID:  input.ClassID,
+			Limit:    input.Limit,
+			Cursor:   input.Cursor,
+		}
+		q, queryErr := query.toQuery(nil)
+		if queryErr != nil {
+			return nil, queryErr
+		}
+		result, err = uc.equipmentRepo.Query(ctx, q)
+	case input.Status != "":
+		status, parseErr := model.ParseOperatingStatus(input.Status)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		result, err = uc.equipmentRepo.ListByStatus(ctx, status, page)
+	case input.ClassID != "":
+		classID, idErr := model.NewEquipmentClassID(input.ClassID)
+		if idErr != nil {
+			return nil, idErr
+		}
+		result, err = uc.equipmentRepo.ListByClass(ctx, classID, page)
+	default:
+		result, err = uc.equipmentRepo.List(ctx, page)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return &ListEquipmentOutput{
-		Count: 0,
+		Items:      result.Items,
+		Count:      len(result.Items),
+		NextCursor: result.NextCursor,
+		PrevCursor: result.PrevCursor,
 	}, nil
 }
 
 // GetEquipmentByIDInput входные параметры для GetEquipmentByID
 type GetEquipmentByIDInput struct {
 	ExternalID string
+	// AsOf момент, на который нужно состояние оборудования; nil - текущее
+	AsOf *time.Time
+	// Children загружает дочернее оборудование до maxTreeDepth уровней
+	// (для представления B2MML); иначе оборудование возвращается без детей
+	Children bool
 }
 
 // GetEquipmentByIDOutput выходные данные для GetEquipmentByID
 type GetEquipmentByIDOutput struct {
-	// Equipment *Equipment // TODO: когда будет полная реализация репозитория
+	Equipment *model.Equipment
 }
 
 // GetEquipmentByIDUseCase use case для получения оборудования по ID
 type GetEquipmentByIDUseCase struct {
 	equipmentRepo repository.EquipmentRepository
+	historyRepo   repository.EquipmentHistoryRepository
 }
 
 // NewGetEquipmentByIDUseCase создаёт новый use case
-func NewGetEquipmentByIDUseCase(equipmentRepo repository.EquipmentRepository) *GetEquipmentByIDUseCase {
+func NewGetEquipmentByIDUseCase(equipmentRepo repository.EquipmentRepository, historyRepo repository.EquipmentHistoryRepository) *GetEquipmentByIDUseCase {
 	return &GetEquipmentByIDUseCase{
 		equipmentRepo: equipmentRepo,
+		historyRepo:   historyRepo,
 	}
 }
 
@@ -74,51 +134,283 @@ func (uc *GetEquipmentByIDUseCase) Execute(ctx context.Context, input GetEquipme
 		return nil, fmt.Errorf("external_id is required")
 	}
 
-	// equipment, err := uc.equipmentRepo.GetByExternalID(ctx, input.ExternalID)
-	// if err != nil {
-	//     return nil, err
-	// }
+	var (
+		equipment *model.Equipment
+		err       error
+	)
+	switch {
+	case input.Children && input.AsOf != nil:
+		equipment, err = uc.historyRepo.GetSubtreeAsOf(ctx, input.ExternalID, maxTreeDepth, *input.AsOf)
+	case input.Children:
+		equipment, err = uc.equipmentRepo.GetSubtree(ctx, input.ExternalID, maxTreeDepth)
+	case input.AsOf != nil:
+		equipment, err = uc.historyRepo.GetAsOf(ctx, input.ExternalID, *input.AsOf)
+	default:
+		equipment, err = uc.equipmentRepo.GetByExternalID(ctx, input.ExternalID)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	return &GetEquipmentByIDOutput{}, nil
+	return &GetEquipmentByIDOutput{Equipment: equipment}, nil
 }
 
 // CreateEquipmentInput входные параметры для CreateEquipment
 type CreateEquipmentInput struct {
-	ExternalID string
-	Version    string
-	Status     string
+	ExternalID  string
+	Version     string
+	Status      string
+	ClassID     string
+	Description string
+	Properties  []PropertyInput
+	// Data существительное B2MML Equipment (XML или канонический JSON). Если
+	// задано, данные, классы и свойства берутся из него, а ClassID и Description
+	// не используются; Status и Properties применяются поверх
+	Data *b2mml.EquipmentType
 }
 
 // CreateEquipmentOutput выходные данные для CreateEquipment
 type CreateEquipmentOutput struct {
-	ID string
-	// Equipment *Equipment
+	ID        string
+	Equipment *model.Equipment
 }
 
 // CreateEquipmentUseCase use case для создания оборудования
 type CreateEquipmentUseCase struct {
-	equipmentRepo repository.EquipmentRepository
+	uow repository.UnitOfWork
 }
 
 // NewCreateEquipmentUseCase создаёт новый use case
-func NewCreateEquipmentUseCase(equipmentRepo repository.EquipmentRepository) *CreateEquipmentUseCase {
+func NewCreateEquipmentUseCase(uow repository.UnitOfWork) *CreateEquipmentUseCase {
 	return &CreateEquipmentUseCase{
-		equipmentRepo: equipmentRepo,
+		uow: uow,
 	}
 }
 
 // Execute выполняет use case
 func (uc *CreateEquipmentUseCase) Execute(ctx context.Context, input CreateEquipmentInput) (*CreateEquipmentOutput, error) {
-	if input.ExternalID == "" {
-		return nil, fmt.Errorf("external_id is required")
+	id, err := model.NewEquipmentID(input.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	var status model.OperatingStatus
+	if input.Status != "" {
+		if status, err = model.ParseOperatingStatus(input.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Data != nil {
+		return uc.createFromB2MML(ctx, id, status, input)
 	}
 
-	// equipment := model.NewEquipment(...)
-	// if err := uc.equipmentRepo.Create(ctx, equipment); err != nil {
-	//     return nil, err
-	// }
+	data := &b2mml.EquipmentType{ID: &b2mml.IdentifierType{Value: input.ExternalID}}
+	if input.Version != "" {
+		data.Version = &b2mml.IdentifierType{Value: input.Version}
+	}
+	if input.Description != "" {
+		data.Description = []*b2mml.DescriptionType{{Value: input.Description}}
+	}
+	if input.ClassID != "" {
+		data.EquipmentClassID = []*b2mml.IdentifierType{{Value: input.ClassID}}
+	}
+
+	var equipment *model.Equipment
+	err = uc.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		_, err := tx.Equipment().GetByExternalID(ctx, input.ExternalID)
+		switch {
+		case err == nil:
+			return model.ErrEquipmentAlreadyExists
+		case !errors.Is(err, model.ErrEquipmentNotFound):
+			return err
+		}
+
+		var class *model.EquipmentClass
+		if input.ClassID != "" {
+			if class, err = tx.EquipmentClass().GetByExternalID(ctx, input.ClassID); err != nil {
+				return err
+			}
+		}
+
+		equipment = model.NewEquipment(id, data, class)
+		if status != "" {
+			equipment.SetOperatingStatus(status)
+		}
+		if err := setPropertyValues(ctx, tx.EquipmentClass(), equipment, input.Properties); err != nil {
+			return err
+		}
+
+		return tx.Equipment().Create(ctx, equipment)
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &CreateEquipmentOutput{
-		ID: "placeholder-uuid",
+		ID:        equipment.ID().String(),
+		Equipment: equipment,
 	}, nil
 }
+
+// createFromB2MML создаёт оборудование из существительного B2MML input.Data
+func (uc *CreateEquipmentUseCase) createFromB2MML(ctx context.Context, id model.EquipmentID, status model.OperatingStatus, input CreateEquipmentInput) (*CreateEquipmentOutput, error) {
+	var equipment *model.Equipment
+	err := uc.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		_, err := tx.Equipment().GetByExternalID(ctx, id.String())
+		switch {
+		case err == nil:
+			return model.ErrEquipmentAlreadyExists
+		case !errors.Is(err, model.ErrEquipmentNotFound):
+			return err
+		}
+
+		classes, err := equipmentClasses(ctx, tx.EquipmentClass(), input.Data.EquipmentClassID)
+		if err != nil {
+			return err
+		}
+		var class *model.EquipmentClass
+		if len(classes) > 0 {
+			class = classes[0]
+		}
+		equipment = model.NewEquipment(id, nil, class)
+		if err := equipment.ReplaceB2MML(input.Data, classes); err != nil {
+			return err
+		}
+		if status != "" {
+			equipment.SetOperatingStatus(status)
+		}
+		props := append(b2mmlPropertyInputs(input.Data.EquipmentProperty), input.Properties...)
+		if err := setPropertyValues(ctx, tx.EquipmentClass(), equipment, props); err != nil {
+			return err
+		}
+
+		return tx.Equipment().Create(ctx, equipment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateEquipmentOutput{
+		ID:        equipment.ID().String(),
+		Equipment: equipment,
+	}, nil
+}
+
+// equipmentClasses загружает классы оборудования по идентификаторам B2MML
+func equipmentClasses(ctx context.Context, repo repository.EquipmentClassRepository, ids []*b2mml.IdentifierType) ([]*model.EquipmentClass, error) {
+	classes := make([]*model.EquipmentClass, 0, len(ids))
+	for _, id := range ids {
+		class, err := repo.GetByExternalID(ctx, id.String())
+		if err != nil {
+			return nil, fmt.Errorf("equipment class %s: %w", id.String(), err)
+		}
+		classes = append(classes, class)
+	}
+	return classes, nil
+}
+
+// PropertyInput значение свойства оборудования
+type PropertyInput struct {
+	ID       string
+	Value    string
+	DataType string
+	Unit     string
+}
+
+// Паспортные данные оборудования из API и импорта хранятся как свойства B2MML
+const (
+	PropertyManufacturer     = "MANUFACTURER"
+	PropertyModel            = "MODEL"
+	PropertySerialNumber     = "SERIAL_NUMBER"
+	PropertyInstallationDate = "INSTALLATION_DATE"
+)
+
+// UpdateEquipmentInput входные параметры для UpdateEquipment
+type UpdateEquipmentInput struct {
+	ExternalID string
+	// ExpectedVersion версия, с которой клиент начинал редактирование.
+	// nil означает безусловное обновление
+	ExpectedVersion *int64
+	Status          string
+	Properties      []PropertyInput
+	// Data существительное B2MML Equipment; если задано, заменяет данные,
+	// классы и свойства оборудования (как Replace), Status и Properties
+	// применяются поверх. Дочернее оборудование Data не применяется
+	Data *b2mml.EquipmentType
+}
+
+// UpdateEquipmentOutput выходные данные для UpdateEquipment
+type UpdateEquipmentOutput struct {
+	Equipment *model.Equipment
+}
+
+// UpdateEquipmentUseCase use case для обновления оборудования с оптимистичной блокировкой
+type UpdateEquipmentUseCase struct {
+	uow repository.UnitOfWork
+}
+
+// NewUpdateEquipmentUseCase создаёт новый use case
+func NewUpdateEquipmentUseCase(uow repository.UnitOfWork) *UpdateEquipmentUseCase {
+	return &UpdateEquipmentUseCase{
+		uow: uow,
+	}
+}
+
+// Execute выполняет use case
+func (uc *UpdateEquipmentUseCase) Execute(ctx context.Context, input UpdateEquipmentInput) (*UpdateEquipmentOutput, error) {
+	if input.ExternalID == "" {
+		return nil, fmt.Errorf("external_id is required")
+	}
+
+	var status model.OperatingStatus
+	if input.Status != "" {
+		var err error
+		if status, err = model.ParseOperatingStatus(input.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	var equipment *model.Equipment
+	err := uc.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		var err error
+		equipment, err = tx.Equipment().GetByExternalID(ctx, input.ExternalID)
+		if err != nil {
+			return err
+		}
+
+		expected := equipment.Version()
+		if input.ExpectedVersion != nil {
+			expected = *input.ExpectedVersion
+			if err := equipment.CheckVersion(expected); err != nil {
+				return err
+			}
+		}
+
+		props := input.Properties
+		if input.Data != nil {
+			classes, err := equipmentClasses(ctx, tx.EquipmentClass(), input.Data.EquipmentClassID)
+			if err != nil {
+				return err
+			}
+			if err := equipment.ReplaceB2MML(input.Data, classes); err != nil {
+				return err
+			}
+			props = append(b2mmlPropertyInputs(input.Data.EquipmentProperty), props...)
+		}
+
+		if status != "" && status != equipment.GetOperatingStatus() {
+			equipment.SetOperatingStatus(status)
+		}
+		if err := setPropertyValues(ctx, tx.EquipmentClass(), equipment, props); err != nil {
+			return err
+		}
+
+		return tx.Equipment().Update(ctx, equipment, expected)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateEquipmentOutput{Equipment: equipment}, nil
+}
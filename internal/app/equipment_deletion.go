@@ -0,0 +1,260 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// DeleteEquipmentInput входные параметры для DeleteEquipment
+type DeleteEquipmentInput struct {
+	ExternalID string
+	// Children правило для дочернего оборудования: block (по умолчанию), orphan или cascade
+	Children string
+	// Classes правило для связей с классами: cascade (по умолчанию), orphan или block
+	Classes string
+	// ExpectedVersion версия, с которой клиент начинал редактирование.
+	// nil означает безусловное удаление
+	ExpectedVersion *int64
+}
+
+// DeleteEquipmentOutput выходные данные для DeleteEquipment
+type DeleteEquipmentOutput struct {
+	Events []model.DomainEvent
+}
+
+// DeleteEquipmentUseCase use case для удаления оборудования (soft delete)
+type DeleteEquipmentUseCase struct {
+	uow repository.UnitOfWork
+}
+
+// NewDeleteEquipmentUseCase создаёт новый use case
+func NewDeleteEquipmentUseCase(uow repository.UnitOfWork) *DeleteEquipmentUseCase {
+	return &DeleteEquipmentUseCase{uow: uow}
+}
+
+// Execute выполняет use case
+func (uc *DeleteEquipmentUseCase) Execute(ctx context.Context, input DeleteEquipmentInput) (*DeleteEquipmentOutput, error) {
+	if input.ExternalID == "" {
+		return nil, fmt.Errorf("external_id is required")
+	}
+	children, err := model.ParseDeletePolicy(cmp.Or(input.Children, string(model.DeleteBlock)))
+	if err != nil {
+		return nil, err
+	}
+	classes, err := model.ParseDeletePolicy(cmp.Or(input.Classes, string(model.DeleteCascade)))
+	if err != nil {
+		return nil, err
+	}
+	opts := model.DeleteOptions{Children: children, Classes: classes}
+
+	var events []model.DomainEvent
+	err = uc.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		events, err = deleteEquipment(ctx, tx, input.ExternalID, opts, input.ExpectedVersion)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteEquipmentOutput{Events: events}, nil
+}
+
+// deleteEquipment удаляет оборудование в транзакции tx и возвращает
+// зарегистрированные события. expectedVersion nil - безусловное удаление
+func deleteEquipment(ctx context.Context, tx repository.UnitOfWork, externalID string, opts model.DeleteOptions, expectedVersion *int64) ([]model.DomainEvent, error) {
+	// Каскадное удаление регистрирует события всех потомков, поэтому загружается
+	// всё поддерево; для остальных правил достаточно непосредственных детей
+	depth := int32(1)
+	if opts.Children == model.DeleteCascade {
+		depth = math.MaxInt32
+	}
+
+	equipment, err := tx.Equipment().GetSubtree(ctx, externalID, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	expected := equipment.Version()
+	if expectedVersion != nil {
+		expected = *expectedVersion
+		if err := equipment.CheckVersion(expected); err != nil {
+			return nil, err
+		}
+	}
+
+	ancestors, err := tx.Equipment().Ancestors(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+	var parent *model.EquipmentID
+	if len(ancestors) > 0 {
+		parent = &ancestors[len(ancestors)-1]
+	}
+
+	if err := equipment.Delete(parent, opts); err != nil {
+		return nil, err
+	}
+	// Репозиторий забирает события для outbox, копия нужна для ответа
+	events := subtreeEvents(equipment)
+	if err := tx.Equipment().Delete(ctx, equipment, opts, expected); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// RestoreEquipmentInput входные параметры для RestoreEquipment
+type RestoreEquipmentInput struct {
+	ExternalID string
+}
+
+// RestoreEquipmentOutput выходные данные для RestoreEquipment
+type RestoreEquipmentOutput struct {
+	// Equipment восстановленное оборудование с восстановленными вместе с ним потомками
+	Equipment *model.Equipment
+	Events    []model.DomainEvent
+}
+
+// RestoreEquipmentUseCase use case для восстановления удалённого оборудования
+// вместе с потомками, удалёнными каскадно с ним
+type RestoreEquipmentUseCase struct {
+	uow repository.UnitOfWork
+}
+
+// NewRestoreEquipmentUseCase создаёт новый use case
+func NewRestoreEquipmentUseCase(uow repository.UnitOfWork) *RestoreEquipmentUseCase {
+	return &RestoreEquipmentUseCase{uow: uow}
+}
+
+// Execute выполняет use case
+func (uc *RestoreEquipmentUseCase) Execute(ctx context.Context, input RestoreEquipmentInput) (*RestoreEquipmentOutput, error) {
+	if input.ExternalID == "" {
+		return nil, fmt.Errorf("external_id is required")
+	}
+
+	var (
+		equipment *model.Equipment
+		events    []model.DomainEvent
+	)
+	err := uc.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		var err error
+		equipment, err = tx.Equipment().GetDeleted(ctx, input.ExternalID)
+		if err != nil {
+			return err
+		}
+
+		expected := equipment.Version()
+		equipment.Restore()
+		events = subtreeEvents(equipment)
+		return tx.Equipment().Restore(ctx, equipment, expected)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RestoreEquipmentOutput{Equipment: equipment, Events: events}, nil
+}
+
+// PurgeEquipmentInput входные параметры для PurgeEquipment
+type PurgeEquipmentInput struct {
+	ExternalID string
+}
+
+// PurgeEquipmentUseCase use case для окончательного удаления удалённого оборудования
+type PurgeEquipmentUseCase struct {
+	uow repository.UnitOfWork
+}
+
+// NewPurgeEquipmentUseCase создаёт новый use case
+func NewPurgeEquipmentUseCase(uow repository.UnitOfWork) *PurgeEquipmentUseCase {
+	return &PurgeEquipmentUseCase{uow: uow}
+}
+
+// Execute выполняет use case
+func (uc *PurgeEquipmentUseCase) Execute(ctx context.Context, input PurgeEquipmentInput) error {
+	if input.ExternalID == "" {
+		return fmt.Errorf("external_id is required")
+	}
+	return uc.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		return tx.Equipment().Purge(ctx, input.ExternalID)
+	})
+}
+
+// subtreeEvents возвращает копию событий оборудования и загруженного поддерева
+func subtreeEvents(equipment *model.Equipment) []model.DomainEvent {
+	events := equipment.Events()
+	for _, child := range equipment.Children() {
+		events = append(events, subtreeEvents(child)...)
+	}
+	return events
+}
+
+// EquipmentPurgerConfig параметры окончательного удаления оборудования по сроку
+// хранения; нулевые значения интервала и пачки заменяются значениями по умолчанию
+type EquipmentPurgerConfig struct {
+	// Retention сколько хранить удалённое оборудование до окончательного удаления
+	Retention time.Duration
+	// Interval пауза между проходами
+	Interval time.Duration
+	// BatchSize число единиц оборудования, удаляемых в одной транзакции
+	BatchSize int32
+}
+
+// EquipmentPurger фоновое окончательное удаление оборудования, удалённого
+// раньше срока хранения. Каждая пачка удаляется в своей транзакции вместе
+// с событиями equipment.purged
+type EquipmentPurger struct {
+	uow repository.UnitOfWork
+	cfg EquipmentPurgerConfig
+}
+
+// NewEquipmentPurger создаёт задачу окончательного удаления
+func NewEquipmentPurger(uow repository.UnitOfWork, cfg EquipmentPurgerConfig) *EquipmentPurger {
+	cfg.Interval = cmp.Or(cfg.Interval, time.Hour)
+	cfg.BatchSize = cmp.Or(cfg.BatchSize, 100)
+	return &EquipmentPurger{uow: uow, cfg: cfg}
+}
+
+// Run удаляет просроченное оборудование до отмены ctx
+func (p *EquipmentPurger) Run(ctx context.Context) {
+	for {
+		n, err := p.PurgeOnce(ctx)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			log.Printf("Equipment purge failed: %v", err)
+		case n > 0:
+			log.Printf("Purged %d deleted equipment older than %s", n, p.cfg.Retention)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.cfg.Interval):
+		}
+	}
+}
+
+// PurgeOnce окончательно удаляет всё оборудование, удалённое раньше срока
+// хранения, и возвращает число удалённых единиц
+func (p *EquipmentPurger) PurgeOnce(ctx context.Context) (int, error) {
+	before := time.Now().Add(-p.cfg.Retention)
+	total := 0
+	for {
+		var n int
+		err := p.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+			var err error
+			n, err = tx.Equipment().PurgeDeletedBefore(ctx, before, p.cfg.BatchSize)
+			return err
+		})
+		total += n
+		if err != nil || n < int(p.cfg.BatchSize) {
+			return total, err
+		}
+	}
+}
@@ -0,0 +1,581 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// Поля выгрузки оборудования, которых нет в импорте
+const (
+	exportHierarchyScope     = "HierarchyScope"
+	exportEffectiveStartDate = "EffectiveStartDate"
+	exportEffectiveEndDate   = "EffectiveEndDate"
+
+	// exportAllProperties колонки значений и единиц всех свойств, кроме паспортных
+	exportAllProperties = importPropertyPrefix + "*"
+)
+
+// exportDefaultColumns колонки выгрузки по умолчанию: поля импорта и все свойства
+var exportDefaultColumns = []string{
+	importEquipmentID, importEquipmentType, importDescription, importOperatingStatus, importEquipmentLevel,
+	importParentID, importManufacturer, importModel, importSerialNumber, importInstallationDate,
+	exportAllProperties,
+}
+
+// exportPassport свойства паспортных полей; в Properties.* они не повторяются
+var exportPassport = map[string]string{
+	importManufacturer:     PropertyManufacturer,
+	importModel:            PropertyModel,
+	importSerialNumber:     PropertySerialNumber,
+	importInstallationDate: PropertyInstallationDate,
+}
+
+// passportProperty сообщает, выгружается ли свойство паспортным полем
+func passportProperty(id string) bool {
+	for _, prop := range exportPassport {
+		if prop == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportEquipmentInput входные параметры для ExportEquipment
+type ExportEquipmentInput struct {
+	// Format формат выгрузки: csv, jsonl или b2mml
+	Format string
+	// Columns колонки CSV и поля JSONL: поля импорта оборудования,
+	// HierarchyScope, EffectiveStartDate, EffectiveEndDate и Properties.*
+	// (все свойства); пусто - поля импорта и все свойства. Для b2mml не задаются
+	Columns []string
+	// Delimiter разделитель полей CSV; по умолчанию запятая
+	Delimiter string
+	// RootIDs корни выгружаемых поддеревьев; пусто - вся иерархия
+	RootIDs []string
+	// Query фильтры оборудования; сортировка и страница не используются
+	Query QueryEquipmentInput
+}
+
+// ExportEquipmentUseCase use case для потоковой выгрузки реестра оборудования
+// с эффективными свойствами и классами.
+//
+// Оборудование читается серверным курсором в одной транзакции только для
+// чтения, поэтому выгрузка согласована и не держит реестр в памяти. Порядок -
+// обход иерархии, родитель раньше потомков: CSV и JSONL с колонками по
+// умолчанию загружаются обратно импортом. Документ b2mml вкладывает
+// выгруженное оборудование в ближайшего выгруженного предка (EquipmentChild)
+// и заканчивается классами выгруженного оборудования
+type ExportEquipmentUseCase struct {
+	uow       repository.UnitOfWork
+	registry  *model.UnitRegistry
+	processor *B2MMLProcessor
+	batchSize int32
+}
+
+// NewExportEquipmentUseCase создаёт новый use case. batchSize - число строк,
+// читаемых из курсора за раз (500, если не задано)
+func NewExportEquipmentUseCase(
+	uow repository.UnitOfWork,
+	registry *model.UnitRegistry,
+	processor *B2MMLProcessor,
+	batchSize int32,
+) *ExportEquipmentUseCase {
+	return &ExportEquipmentUseCase{
+		uow:       uow,
+		registry:  registry,
+		processor: processor,
+		batchSize: cmp.Or(batchSize, 500),
+	}
+}
+
+// Execute проверяет параметры и корни выгрузки и возвращает выгрузку;
+// данные читаются при EquipmentExport.Write
+func (uc *ExportEquipmentUseCase) Execute(ctx context.Context, input ExportEquipmentInput) (*EquipmentExport, error) {
+	format, err := model.ParseExportFormat(input.Format)
+	if err != nil {
+		return nil, err
+	}
+	x := &EquipmentExport{uc: uc, format: format, delimiter: ','}
+
+	switch {
+	case format == model.ExportFormatB2MML && len(input.Columns) > 0:
+		return nil, fmt.Errorf("%w: columns are not supported for %s", model.ErrExportInvalidColumn, format)
+	case format == model.ExportFormatB2MML:
+	case len(input.Columns) == 0:
+		x.columns = exportDefaultColumns
+	default:
+		x.columns = input.Columns
+	}
+	seen := make(map[string]bool, len(x.columns))
+	for _, column := range x.columns {
+		if err := checkExportColumn(column); err != nil {
+			return nil, err
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("%w: duplicate column %q", model.ErrExportInvalidColumn, column)
+		}
+		seen[column] = true
+	}
+
+	if input.Delimiter != "" {
+		r, size := utf8.DecodeRuneInString(input.Delimiter)
+		if format != model.ExportFormatCSV || r == utf8.RuneError || size != len(input.Delimiter) {
+			return nil, fmt.Errorf("%w: invalid delimiter %q", model.ErrExportInvalidFormat, input.Delimiter)
+		}
+		x.delimiter = r
+	}
+
+	query, err := input.Query.toQuery(uc.registry)
+	if err != nil {
+		return nil, err
+	}
+	// Класс проверяется до начала выгрузки, чтобы ошибка не оборвала поток
+	if query.ClassID != nil {
+		if _, err := uc.uow.EquipmentClass().GetByExternalID(ctx, query.ClassID.String()); err != nil {
+			return nil, err
+		}
+	}
+	roots, err := uc.exportRoots(ctx, input.RootIDs)
+	if err != nil {
+		return nil, err
+	}
+	x.query = repository.EquipmentExportQuery{Filter: query, RootIDs: roots, BatchSize: uc.batchSize}
+	return x, nil
+}
+
+// exportRoots проверяет корни выгрузки и отбрасывает корни, лежащие в
+// поддереве другого корня
+func (uc *ExportEquipmentUseCase) exportRoots(ctx context.Context, ids []string) ([]string, error) {
+	ancestors := make(map[string][]model.EquipmentID, len(ids))
+	for _, id := range ids {
+		path, err := uc.uow.Equipment().Ancestors(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("equipment %s: %w", id, err)
+		}
+		ancestors[id] = path
+	}
+
+	var roots []string
+	for _, id := range ids {
+		nested := slices.ContainsFunc(ancestors[id], func(ancestor model.EquipmentID) bool {
+			_, ok := ancestors[ancestor.String()]
+			return ok
+		})
+		if !nested && !slices.Contains(roots, id) {
+			roots = append(roots, id)
+		}
+	}
+	return roots, nil
+}
+
+// checkExportColumn проверяет имя колонки выгрузки
+func checkExportColumn(column string) error {
+	switch column {
+	case exportHierarchyScope, exportEffectiveStartDate, exportEffectiveEndDate, exportAllProperties:
+		return nil
+	}
+	if _, err := parseImportTarget(column); err != nil {
+		return fmt.Errorf("%w: unknown column %q", model.ErrExportInvalidColumn, column)
+	}
+	return nil
+}
+
+// EquipmentExport подготовленная выгрузка оборудования
+type EquipmentExport struct {
+	uc        *ExportEquipmentUseCase
+	format    model.ExportFormat
+	columns   []string
+	delimiter rune
+	query     repository.EquipmentExportQuery
+}
+
+// Format возвращает формат выгрузки
+func (x *EquipmentExport) Format() model.ExportFormat {
+	return x.format
+}
+
+// Write записывает выгрузку в w по мере чтения оборудования. При ошибке
+// в w может остаться начало выгрузки
+func (x *EquipmentExport) Write(ctx context.Context, w io.Writer) error {
+	tx, err := x.uc.uow.BeginReadOnly(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var out exportWriter
+	switch x.format {
+	case model.ExportFormatCSV:
+		out, err = x.newCSVWriter(ctx, tx, w)
+	case model.ExportFormatJSONL:
+		out = &jsonlExportWriter{w: bufio.NewWriter(w), columns: x.columns}
+	case model.ExportFormatB2MML:
+		out, err = newB2MMLExportWriter(w, x.uc.processor.applicationArea())
+	}
+	if err != nil {
+		return err
+	}
+
+	lineages := map[model.EquipmentClassID][]*model.EquipmentClass{}
+	resolver := model.NewPropertyResolver()
+	err = tx.Equipment().Export(ctx, x.query, func(record *repository.EquipmentRecord) error {
+		for _, class := range record.Equipment.Classes() {
+			if _, ok := lineages[class.ID()]; ok {
+				continue
+			}
+			lineage, err := tx.EquipmentClass().Lineage(ctx, class.ID())
+			if err != nil {
+				return err
+			}
+			lineages[class.ID()] = lineage
+		}
+		return out.write(record, resolver.Resolve(record.Equipment, lineages))
+	})
+	if err != nil {
+		return err
+	}
+	return out.close(ctx, tx, lineages)
+}
+
+// newCSVWriter создаёт запись CSV и записывает заголовок; Properties.*
+// раскрывается в колонки значений и единиц всех свойств
+func (x *EquipmentExport) newCSVWriter(ctx context.Context, tx repository.UnitOfWork, w io.Writer) (*csvExportWriter, error) {
+	var columns []string
+	for _, column := range x.columns {
+		if column != exportAllProperties {
+			columns = append(columns, column)
+			continue
+		}
+		ids, err := tx.Equipment().PropertyIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			if !passportProperty(id) {
+				columns = append(columns, importPropertyPrefix+id, importPropertyPrefix+id+importUnitSuffix)
+			}
+		}
+	}
+
+	out := &csvExportWriter{w: csv.NewWriter(w), columns: columns, record: make([]string, len(columns))}
+	out.w.Comma = x.delimiter
+	return out, out.w.Write(columns)
+}
+
+// exportWriter запись выгрузки в одном формате
+type exportWriter interface {
+	// write записывает оборудование с его эффективными свойствами
+	write(record *repository.EquipmentRecord, props []*model.EffectiveProperty) error
+	// close завершает выгрузку; lineages - цепочки классов выгруженного оборудования
+	close(ctx context.Context, tx repository.UnitOfWork, lineages map[model.EquipmentClassID][]*model.EquipmentClass) error
+}
+
+// exportValue возвращает значение колонки column оборудования; свойства
+// берутся из эффективного листа props
+func exportValue(record *repository.EquipmentRecord, props []*model.EffectiveProperty, column string) string {
+	e := record.Equipment
+	data := e.GetB2MMLData()
+	if data == nil {
+		data = &b2mml.EquipmentType{}
+	}
+	switch column {
+	case importEquipmentID:
+		return e.ID().String()
+	case importEquipmentType:
+		ids := make([]string, 0, len(e.Classes()))
+		for _, class := range e.Classes() {
+			ids = append(ids, class.ID().String())
+		}
+		return strings.Join(ids, importListSeparator)
+	case importDescription:
+		for _, d := range data.Description {
+			if d != nil && d.Value != "" {
+				return d.Value
+			}
+		}
+		return ""
+	case importOperatingStatus:
+		return string(e.GetOperatingStatus())
+	case importEquipmentLevel:
+		switch level := data.EquipmentLevel; {
+		case level == nil:
+		case level.EquipmentLevel1Type != nil && level.Value != "":
+			return level.Value
+		case level.OtherValueAttr != nil:
+			return *level.OtherValueAttr
+		}
+		return ""
+	case importParentID:
+		return record.ParentID
+	case exportHierarchyScope:
+		if data.HierarchyScope != nil && data.HierarchyScope.EquipmentID != nil {
+			return data.HierarchyScope.EquipmentID.Value
+		}
+		return ""
+	case exportEffectiveStartDate:
+		if data.EffectiveStartDate != nil {
+			return data.EffectiveStartDate.Value
+		}
+		return ""
+	case exportEffectiveEndDate:
+		if data.EffectiveEndDate != nil {
+			return data.EffectiveEndDate.Value
+		}
+		return ""
+	}
+
+	id, unit := exportPassport[column], false
+	if id == "" {
+		target, err := parseImportTarget(column)
+		if err != nil || target.property == "" {
+			return ""
+		}
+		id, unit = target.property, target.unit
+	}
+	for _, prop := range props {
+		if prop.ID.String() != id || prop.Status == model.PropertyValueMissing {
+			continue
+		}
+		if unit {
+			return prop.Value.Unit()
+		}
+		return prop.Value.Value()
+	}
+	return ""
+}
+
+// csvExportWriter запись CSV
+type csvExportWriter struct {
+	w       *csv.Writer
+	columns []string
+	record  []string
+}
+
+func (o *csvExportWriter) write(record *repository.EquipmentRecord, props []*model.EffectiveProperty) error {
+	for i, column := range o.columns {
+		o.record[i] = exportValue(record, props, column)
+	}
+	return o.w.Write(o.record)
+}
+
+func (o *csvExportWriter) close(context.Context, repository.UnitOfWork, map[model.EquipmentClassID][]*model.EquipmentClass) error {
+	o.w.Flush()
+	return o.w.Error()
+}
+
+// jsonlExportWriter запись JSON Lines: строка - плоский объект с колонками
+// в порядке columns. Пустые значения не записываются, EquipmentType - массив
+// классов, Properties.* раскрывается в свойства оборудования со значениями
+type jsonlExportWriter struct {
+	w       *bufio.Writer
+	columns []string
+	line    bytes.Buffer
+}
+
+func (o *jsonlExportWriter) write(record *repository.EquipmentRecord, props []*model.EffectiveProperty) error {
+	o.line.Reset()
+	o.line.WriteByte('{')
+	field := func(key string, value any) {
+		if o.line.Len() > 1 {
+			o.line.WriteByte(',')
+		}
+		k, _ := json.Marshal(key)
+		v, _ := json.Marshal(value)
+		o.line.Write(k)
+		o.line.WriteByte(':')
+		o.line.Write(v)
+	}
+
+	for _, column := range o.columns {
+		switch column {
+		case exportAllProperties:
+			for _, prop := range props {
+				id := prop.ID.String()
+				if passportProperty(id) || prop.Status == model.PropertyValueMissing {
+					continue
+				}
+				field(importPropertyPrefix+id, prop.Value.Value())
+				if prop.Value.Unit() != "" {
+					field(importPropertyPrefix+id+importUnitSuffix, prop.Value.Unit())
+				}
+			}
+		case importEquipmentType:
+			if len(record.Equipment.Classes()) == 0 {
+				continue
+			}
+			ids := make([]string, 0, len(record.Equipment.Classes()))
+			for _, class := range record.Equipment.Classes() {
+				ids = append(ids, class.ID().String())
+			}
+			field(column, ids)
+		default:
+			if value := exportValue(record, props, column); value != "" {
+				field(column, value)
+			}
+		}
+	}
+	o.line.WriteString("}\n")
+	_, err := o.w.Write(o.line.Bytes())
+	return err
+}
+
+func (o *jsonlExportWriter) close(context.Context, repository.UnitOfWork, map[model.EquipmentClassID][]*model.EquipmentClass) error {
+	return o.w.Flush()
+}
+
+// b2mmlExportWriter запись документа SyncEquipmentInformation. Элементы
+// оборудования остаются открытыми, пока записываются его потомки
+type b2mmlExportWriter struct {
+	w *bufio.Writer
+	// open открытые элементы оборудования от верхнего уровня
+	open    []b2mmlOpenEquipment
+	classes []model.EquipmentClassID
+}
+
+// b2mmlOpenEquipment открытый элемент оборудования и его элементы,
+// которые по схеме следуют за EquipmentChild
+type b2mmlOpenEquipment struct {
+	id    string
+	name  string
+	noun  *b2mml.EquipmentType
+	close string
+}
+
+// newB2MMLExportWriter записывает начало документа до списка оборудования
+func newB2MMLExportWriter(w io.Writer, area *b2mml.TransApplicationAreaType) (*b2mmlExportWriter, error) {
+	o := &b2mmlExportWriter{w: bufio.NewWriter(w)}
+	code := b2mml.TransActionCodeType(b2mml.ActionCodeReplace)
+	sync := &b2mml.TransSyncType{ActionCriteria: []*b2mml.TransActionCriteriaType{{
+		ActionExpression: []*b2mml.TransExpressionType{{
+			ActionCodeAttr:       &code,
+			TransExpression1Type: &b2mml.TransExpression1Type{},
+		}},
+	}}}
+
+	name := b2mmlVerbSync + b2mmlNounEquipmentInformation
+	o.w.WriteString(xml.Header)
+	fmt.Fprintf(o.w, `<%s xmlns="%s" releaseID="%s">`, name, b2mml.Namespace, b2mml.ReleaseID)
+	if err := o.element(area, "ApplicationArea"); err != nil {
+		return nil, err
+	}
+	o.w.WriteString("<DataArea>")
+	if err := o.element(sync, "Sync"); err != nil {
+		return nil, err
+	}
+	o.w.WriteString("<" + b2mmlNounEquipmentInformation + ">")
+	return o, nil
+}
+
+// element записывает v элементом name
+func (o *b2mmlExportWriter) element(v any, name string) error {
+	enc := xml.NewEncoder(o.w)
+	if err := enc.EncodeElement(v, xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+		return err
+	}
+	return enc.Flush()
+}
+
+func (o *b2mmlExportWriter) write(record *repository.EquipmentRecord, props []*model.EffectiveProperty) error {
+	// Закрываются элементы, которые не являются предками оборудования
+	for len(o.open) > 0 && !slices.Contains(record.Path, o.open[len(o.open)-1].id) {
+		if err := o.pop(); err != nil {
+			return err
+		}
+	}
+
+	for _, class := range record.Equipment.Classes() {
+		if !slices.Contains(o.classes, class.ID()) {
+			o.classes = append(o.classes, class.ID())
+		}
+	}
+
+	noun := record.Equipment.ToB2MML()
+	// Значения классов дополняют свойства оборудования до эффективного листа
+	for _, prop := range props {
+		if prop.Status != model.PropertyValueInherited {
+			continue
+		}
+		noun.EquipmentProperty = append(noun.EquipmentProperty, &b2mml.EquipmentPropertyType{
+			ID:    &b2mml.IdentifierType{Value: prop.ID.String()},
+			Value: []*b2mml.ValueType{prop.Value.ToB2MML()},
+		})
+	}
+	tail := &b2mml.EquipmentType{EquipmentClassID: noun.EquipmentClassID, TestSpecificationID: noun.TestSpecificationID}
+	noun.EquipmentChild, noun.EquipmentClassID, noun.TestSpecificationID = nil, nil, nil
+
+	name := b2mmlNounEquipment
+	if len(o.open) > 0 {
+		name = "EquipmentChild"
+	}
+	var element bytes.Buffer
+	enc := xml.NewEncoder(&element)
+	if err := enc.EncodeElement(noun, xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+		return err
+	}
+	if err := enc.Flush(); err != nil {
+		return err
+	}
+	end := "</" + name + ">"
+	o.w.Write(bytes.TrimSuffix(element.Bytes(), []byte(end)))
+	o.open = append(o.open, b2mmlOpenEquipment{id: record.Equipment.ID().String(), name: name, noun: tail, close: end})
+	return nil
+}
+
+// pop закрывает последний открытый элемент оборудования
+func (o *b2mmlExportWriter) pop() error {
+	e := o.open[len(o.open)-1]
+	o.open = o.open[:len(o.open)-1]
+	for _, id := range e.noun.EquipmentClassID {
+		if err := o.element(id, "EquipmentClassID"); err != nil {
+			return err
+		}
+	}
+	for _, id := range e.noun.TestSpecificationID {
+		if err := o.element(id, "TestSpecificationID"); err != nil {
+			return err
+		}
+	}
+	_, err := o.w.WriteString(e.close)
+	return err
+}
+
+// close закрывает оборудование и записывает классы выгруженного оборудования.
+// Класс, вложенный в другой записанный класс, отдельно не записывается
+func (o *b2mmlExportWriter) close(ctx context.Context, tx repository.UnitOfWork, lineages map[model.EquipmentClassID][]*model.EquipmentClass) error {
+	for len(o.open) > 0 {
+		if err := o.pop(); err != nil {
+			return err
+		}
+	}
+	for _, id := range o.classes {
+		nested := slices.ContainsFunc(lineages[id], func(ancestor *model.EquipmentClass) bool {
+			return ancestor.ID() != id && slices.Contains(o.classes, ancestor.ID())
+		})
+		if nested {
+			continue
+		}
+		class, err := tx.EquipmentClass().GetByExternalID(ctx, id.String())
+		if err != nil {
+			return fmt.Errorf("equipment class %s: %w", id, err)
+		}
+		if err := o.element(class.ToB2MML(), b2mmlNounEquipmentClass); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(o.w, "</%s></DataArea></%s>\n", b2mmlNounEquipmentInformation, b2mmlVerbSync+b2mmlNounEquipmentInformation)
+	return o.w.Flush()
+}
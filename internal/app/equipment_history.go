@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// ListEquipmentHistoryInput входные параметры для ListEquipmentHistory
+type ListEquipmentHistoryInput struct {
+	ExternalID string
+	// Before номер ревизии, с которой начинается страница (не включительно); 0 - с последней
+	Before int32
+	Limit  int32
+}
+
+// ListEquipmentHistoryOutput выходные данные для ListEquipmentHistory
+type ListEquipmentHistoryOutput struct {
+	Items []*repository.EquipmentRevision
+}
+
+// ListEquipmentHistoryUseCase use case для получения истории изменений оборудования
+type ListEquipmentHistoryUseCase struct {
+	historyRepo repository.EquipmentHistoryRepository
+}
+
+// NewListEquipmentHistoryUseCase создаёт новый use case
+func NewListEquipmentHistoryUseCase(historyRepo repository.EquipmentHistoryRepository) *ListEquipmentHistoryUseCase {
+	return &ListEquipmentHistoryUseCase{historyRepo: historyRepo}
+}
+
+// Execute выполняет use case
+func (uc *ListEquipmentHistoryUseCase) Execute(ctx context.Context, input ListEquipmentHistoryInput) (*ListEquipmentHistoryOutput, error) {
+	items, err := uc.historyRepo.List(ctx, input.ExternalID, input.Before, normalizeLimit(input.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &ListEquipmentHistoryOutput{Items: items}, nil
+}
+
+// DiffEquipmentRevisionsInput входные параметры для DiffEquipmentRevisions
+type DiffEquipmentRevisionsInput struct {
+	ExternalID string
+	// To ревизия, с которой сравнивается From; 0 - последняя
+	To int32
+	// From исходная ревизия; 0 - предыдущая перед To
+	From int32
+}
+
+// DiffEquipmentRevisionsOutput выходные данные для DiffEquipmentRevisions
+type DiffEquipmentRevisionsOutput struct {
+	// From nil, если To - первая ревизия: все её поля считаются добавленными
+	From    *repository.EquipmentRevision
+	To      *repository.EquipmentRevision
+	Changes []model.FieldChange
+}
+
+// DiffEquipmentRevisionsUseCase use case для сравнения двух ревизий оборудования по полям
+type DiffEquipmentRevisionsUseCase struct {
+	historyRepo repository.EquipmentHistoryRepository
+}
+
+// NewDiffEquipmentRevisionsUseCase создаёт новый use case
+func NewDiffEquipmentRevisionsUseCase(historyRepo repository.EquipmentHistoryRepository) *DiffEquipmentRevisionsUseCase {
+	return &DiffEquipmentRevisionsUseCase{historyRepo: historyRepo}
+}
+
+// Execute выполняет use case
+func (uc *DiffEquipmentRevisionsUseCase) Execute(ctx context.Context, input DiffEquipmentRevisionsInput) (*DiffEquipmentRevisionsOutput, error) {
+	to, err := uc.historyRepo.Get(ctx, input.ExternalID, input.To)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &DiffEquipmentRevisionsOutput{To: to}
+	fromRevision := input.From
+	if fromRevision <= 0 {
+		fromRevision = to.Revision - 1
+	}
+	base := &model.EquipmentSnapshot{}
+	if fromRevision > 0 {
+		if output.From, err = uc.historyRepo.Get(ctx, input.ExternalID, fromRevision); err != nil {
+			return nil, err
+		}
+		base = output.From.Snapshot
+	}
+	output.Changes = base.Diff(to.Snapshot)
+	return output, nil
+}
@@ -0,0 +1,409 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// maxImportRowErrors число ошибок строк, сохраняемых в отчёте задания
+const maxImportRowErrors = 1000
+
+// importRowErrors ошибки, из-за которых пропускается только строка источника;
+// остальные ошибки прерывают задание
+var importRowErrors = []error{
+	model.ErrImportInvalidRow,
+	model.ErrImportInvalidMapping,
+	model.ErrB2MMLMalformed,
+	model.ErrEquipmentIDEmpty,
+	model.ErrEquipmentAlreadyExists,
+	model.ErrEquipmentInvalidStatus,
+	model.ErrEquipmentNotFound,
+	model.ErrEquipmentLevelOrder,
+	model.ErrEquipmentClassIDEmpty,
+	model.ErrEquipmentClassNotFound,
+	model.ErrEquipmentPropertyIDEmpty,
+	model.ErrPropertyInvalidDataType,
+	model.ErrPropertyInvalidValue,
+	model.ErrPropertyConstraintViolation,
+	model.ErrUnitUnknown,
+	model.ErrUnitIncompatible,
+}
+
+// EquipmentImporterConfig параметры импорта оборудования; нулевые значения
+// заменяются значениями по умолчанию
+type EquipmentImporterConfig struct {
+	// ChunkSize число строк, фиксируемых в одной транзакции
+	ChunkSize int32
+	// MaxSourceSize максимальный размер исходного файла в байтах
+	MaxSourceSize int64
+	// PollInterval пауза между проверками очереди заданий
+	PollInterval time.Duration
+	// Lease время захвата задания обработчиком; продлевается с каждой пачкой строк
+	Lease time.Duration
+}
+
+// CreateImportInput входные параметры для EquipmentImporter.Create
+type CreateImportInput struct {
+	// Format формат источника: csv или jsonl
+	Format string
+	// Delimiter разделитель полей CSV; по умолчанию запятая
+	Delimiter string
+	// Mapping сопоставление колонок полям оборудования (колонка - поле);
+	// см. EquipmentImporter
+	Mapping map[string]string
+	// DryRun только проверить строки, не сохраняя оборудование
+	DryRun bool
+	// ChunkSize число строк в транзакции; 0 - из конфигурации
+	ChunkSize int32
+	Source    io.Reader
+	// Claim задание захватывается для Execute вызывающим, а не ставится в очередь
+	Claim bool
+}
+
+// EquipmentImporter задания импорта оборудования из CSV и JSON Lines.
+//
+// Колонка источника импортируется в поле с её именем, если Mapping не задаёт
+// другое поле или "-" (колонка пропускается); колонка неизвестного поля -
+// ошибка задания. Поля: EquipmentID (обязательно), EquipmentType (классы через
+// ";", первый - основной), Description, OperatingStatus, EquipmentLevel,
+// ParentID (родитель - существующее оборудование или строка выше в источнике),
+// Manufacturer, Model, SerialNumber, InstallationDate (паспортные свойства),
+// Properties.<ID> - значение свойства и Properties.<ID>.Unit - его единица.
+//
+// Каждая строка проходит проверки создания оборудования: схему B2MML,
+// существование классов и родителя, порядок уровней ISA-95 и определения
+// свойств классов. Строка с ошибкой пропускается и попадает в отчёт задания.
+// Строки фиксируются пачками по ChunkSize в отдельных транзакциях вместе с
+// прогрессом задания, поэтому прерванное задание продолжается с первой
+// незафиксированной строки. Задание DryRun выполняется в одной транзакции,
+// которая откатывается, и после прерывания начинается заново
+type EquipmentImporter struct {
+	uow repository.UnitOfWork
+	cfg EquipmentImporterConfig
+}
+
+// NewEquipmentImporter создаёт импорт оборудования
+func NewEquipmentImporter(uow repository.UnitOfWork, cfg EquipmentImporterConfig) *EquipmentImporter {
+	cfg.ChunkSize = cmp.Or(cfg.ChunkSize, 500)
+	cfg.MaxSourceSize = cmp.Or(cfg.MaxSourceSize, 64<<20)
+	cfg.PollInterval = cmp.Or(cfg.PollInterval, time.Second)
+	cfg.Lease = cmp.Or(cfg.Lease, 5*time.Minute)
+	return &EquipmentImporter{uow: uow, cfg: cfg}
+}
+
+// Create сохраняет задание импорта. Формат и сопоставление колонок
+// проверяются до постановки задания в очередь
+func (im *EquipmentImporter) Create(ctx context.Context, input CreateImportInput) (*repository.ImportJob, error) {
+	format, err := model.ParseImportFormat(input.Format)
+	if err != nil {
+		return nil, err
+	}
+	delimiter := ','
+	if input.Delimiter != "" {
+		r, size := utf8.DecodeRuneInString(input.Delimiter)
+		if r == utf8.RuneError || size != len(input.Delimiter) {
+			return nil, fmt.Errorf("%w: invalid delimiter %q", model.ErrImportInvalidFormat, input.Delimiter)
+		}
+		delimiter = r
+	}
+
+	source, err := io.ReadAll(io.LimitReader(input.Source, im.cfg.MaxSourceSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read import source: %w", err)
+	}
+	if int64(len(source)) > im.cfg.MaxSourceSize {
+		return nil, fmt.Errorf("%w: limit is %d bytes", model.ErrImportSourceTooLarge, im.cfg.MaxSourceSize)
+	}
+
+	mapping := input.Mapping
+	if mapping == nil {
+		mapping = map[string]string{}
+	}
+	now := time.Now()
+	job := &repository.ImportJob{
+		ID:        uuid.New(),
+		Format:    format,
+		Delimiter: delimiter,
+		Mapping:   mapping,
+		DryRun:    input.DryRun,
+		ChunkSize: cmp.Or(input.ChunkSize, im.cfg.ChunkSize),
+		Status:    model.ImportJobPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := newImportSource(job, source); err != nil {
+		return nil, err
+	}
+	if input.Claim {
+		job.Status = model.ImportJobRunning
+	}
+
+	err = im.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		return tx.ImportJobs().Create(ctx, job, source, now.Add(im.cfg.Lease))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Get возвращает задание импорта с отчётом
+func (im *EquipmentImporter) Get(ctx context.Context, id uuid.UUID) (*repository.ImportJob, error) {
+	return im.uow.ImportJobs().Get(ctx, id)
+}
+
+// Resume возвращает прерванное задание в очередь; claim - задание
+// захватывается для Execute вызывающим
+func (im *EquipmentImporter) Resume(ctx context.Context, id uuid.UUID, claim bool) (*repository.ImportJob, error) {
+	var lockedUntil *time.Time
+	if claim {
+		t := time.Now().Add(im.cfg.Lease)
+		lockedUntil = &t
+	}
+	if err := im.uow.ImportJobs().Resume(ctx, id, lockedUntil); err != nil {
+		return nil, err
+	}
+	return im.uow.ImportJobs().Get(ctx, id)
+}
+
+// Run выполняет задания из очереди до отмены ctx
+func (im *EquipmentImporter) Run(ctx context.Context) {
+	for {
+		job, err := im.uow.ImportJobs().Claim(ctx, time.Now().Add(im.cfg.Lease))
+		switch {
+		case err != nil && ctx.Err() == nil:
+			log.Printf("Import job claim failed: %v", err)
+		case job != nil:
+			log.Printf("Import job %s started at row %d", job.ID, job.RowsProcessed)
+			err := im.Execute(ctx, job, nil)
+			switch {
+			case err != nil && ctx.Err() == nil:
+				log.Printf("Import job %s failed: %v", job.ID, err)
+			case err == nil:
+				log.Printf("Import job %s completed: %d rows, %d imported, %d errors",
+					job.ID, job.RowsProcessed, job.RowsImported, job.ErrorCount)
+			}
+			// Следующее задание проверяется без паузы
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(im.cfg.PollInterval):
+		}
+	}
+}
+
+// Execute выполняет захваченное задание, обновляя job по мере фиксации пачек
+// строк; progress (может быть nil) вызывается после каждой пачки. При ошибке
+// задание отмечается прерванным; при отмене ctx остаётся захваченным до
+// истечения аренды и затем продолжается любым обработчиком
+func (im *EquipmentImporter) Execute(ctx context.Context, job *repository.ImportJob, progress func(*repository.ImportJob)) error {
+	saved := job.RowsProcessed
+	err := im.execute(ctx, job, &saved, progress)
+	if err == nil || errors.Is(err, model.ErrImportJobLeaseLost) || ctx.Err() != nil {
+		return err
+	}
+
+	now := time.Now()
+	job.Status = model.ImportJobFailed
+	job.Error = err.Error()
+	job.FinishedAt = &now
+	if saveErr := im.uow.ImportJobs().SaveProgress(ctx, job, saved, nil); saveErr != nil {
+		return errors.Join(err, saveErr)
+	}
+	return err
+}
+
+// execute импортирует строки задания; saved - прогресс, сохранённый в хранилище
+func (im *EquipmentImporter) execute(ctx context.Context, job *repository.ImportJob, saved *int64, progress func(*repository.ImportJob)) error {
+	data, err := im.uow.ImportJobs().Source(ctx, job.ID)
+	if err != nil {
+		return err
+	}
+	source, err := newImportSource(job, data)
+	if err != nil {
+		return err
+	}
+
+	tx := im.uow
+	if job.DryRun {
+		// Проверка ничего не фиксирует, поэтому прерванная проверка начинается заново
+		job.RowsProcessed, job.RowsImported, job.ErrorCount, job.RowErrors = 0, 0, 0, nil
+		if tx, err = im.uow.Begin(ctx); err != nil {
+			return err
+		}
+		defer tx.Rollback(ctx)
+	}
+	for range job.RowsProcessed {
+		if _, err := source.next(); err != nil {
+			return fmt.Errorf("skip imported rows: %w", err)
+		}
+	}
+
+	for {
+		rows, err := readImportChunk(source, int(job.ChunkSize))
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		next := *job
+		lockedUntil := time.Now().Add(im.cfg.Lease)
+		if job.DryRun {
+			err = importChunk(ctx, tx, &next, rows)
+			if err == nil {
+				err = im.uow.ImportJobs().SaveProgress(ctx, &next, *saved, &lockedUntil)
+			}
+		} else {
+			err = im.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+				next = *job
+				if err := importChunk(ctx, tx, &next, rows); err != nil {
+					return err
+				}
+				return tx.ImportJobs().SaveProgress(ctx, &next, *saved, &lockedUntil)
+			})
+		}
+		if err != nil {
+			return err
+		}
+		*job, *saved = next, next.RowsProcessed
+		if progress != nil {
+			progress(job)
+		}
+	}
+
+	now := time.Now()
+	job.Status = model.ImportJobCompleted
+	job.FinishedAt = &now
+	if err := im.uow.ImportJobs().SaveProgress(ctx, job, *saved, nil); err != nil {
+		return err
+	}
+	if progress != nil {
+		progress(job)
+	}
+	return nil
+}
+
+// readImportChunk читает до n строк источника
+func readImportChunk(source importSource, n int) ([]*importRow, error) {
+	rows := make([]*importRow, 0, n)
+	for len(rows) < n {
+		row, err := source.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// importChunk импортирует пачку строк в транзакции tx и учитывает их в job
+func importChunk(ctx context.Context, tx repository.UnitOfWork, job *repository.ImportJob, rows []*importRow) error {
+	job.RowErrors = slices.Clip(job.RowErrors)
+	for _, row := range rows {
+		err := row.err
+		if err == nil {
+			err = createImportedEquipment(ctx, tx, row)
+		}
+		job.RowsProcessed++
+		switch {
+		case err == nil:
+			job.RowsImported++
+			continue
+		case !slices.ContainsFunc(importRowErrors, func(target error) bool { return errors.Is(err, target) }):
+			return fmt.Errorf("row %d: %w", row.row, err)
+		}
+
+		job.ErrorCount++
+		if len(job.RowErrors) < maxImportRowErrors {
+			rowErr := repository.ImportRowError{Row: row.row, Line: row.line, EquipmentID: row.id, Message: err.Error()}
+			var cerr *importColumnError
+			if errors.As(err, &cerr) {
+				rowErr.Column, rowErr.Message = cerr.column, cerr.err.Error()
+			}
+			job.RowErrors = append(job.RowErrors, rowErr)
+		}
+	}
+	return nil
+}
+
+// createImportedEquipment создаёт оборудование строки в транзакции tx. Строка
+// проверяется до первой записи, чтобы отклонённая строка не оставляла в
+// транзакции частичных изменений
+func createImportedEquipment(ctx context.Context, tx repository.UnitOfWork, row *importRow) error {
+	id, err := model.NewEquipmentID(row.id)
+	if err != nil {
+		return row.columnError(importEquipmentID, err)
+	}
+	_, err = tx.Equipment().GetRef(ctx, row.id)
+	switch {
+	case err == nil:
+		return row.columnError(importEquipmentID, model.ErrEquipmentAlreadyExists)
+	case !errors.Is(err, model.ErrEquipmentNotFound):
+		return err
+	}
+
+	classes, err := equipmentClasses(ctx, tx.EquipmentClass(), row.data.EquipmentClassID)
+	if err != nil {
+		return row.columnError(importEquipmentType, err)
+	}
+	var parent *repository.EquipmentRef
+	if row.parentID != "" {
+		if parent, err = tx.Equipment().GetRef(ctx, row.parentID); err != nil {
+			return row.columnError(importParentID, fmt.Errorf("parent %s: %w", row.parentID, err))
+		}
+	}
+
+	var class *model.EquipmentClass
+	if len(classes) > 0 {
+		class = classes[0]
+	}
+	equipment := model.NewEquipment(id, nil, class)
+	if err := equipment.ReplaceB2MML(row.data, classes); err != nil {
+		return err
+	}
+	if row.status != "" {
+		equipment.SetOperatingStatus(row.status)
+	}
+	if err := setPropertyValues(ctx, tx.EquipmentClass(), equipment, row.props); err != nil {
+		return err
+	}
+	if parent != nil {
+		if err := model.CheckEquipmentLevelOrder(parent.Level, equipment.EquipmentLevel()); err != nil {
+			return row.columnError(importParentID, err)
+		}
+	}
+
+	if err := tx.Equipment().Create(ctx, equipment); err != nil {
+		return err
+	}
+	if parent == nil {
+		return nil
+	}
+	// У нового оборудования нет потомков, поэтому предки родителя для
+	// проверки циклов не нужны
+	expected := equipment.Version()
+	if err := equipment.MoveUnder(nil, parent.ID, parent.Level, nil); err != nil {
+		return err
+	}
+	return tx.Equipment().Move(ctx, equipment, &parent.ID, expected)
+}
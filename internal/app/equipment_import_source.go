@@ -0,0 +1,482 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// Поля оборудования, которым сопоставляются колонки источника импорта
+const (
+	importEquipmentID      = "EquipmentID"
+	importEquipmentType    = "EquipmentType"
+	importDescription      = "Description"
+	importOperatingStatus  = "OperatingStatus"
+	importEquipmentLevel   = "EquipmentLevel"
+	importParentID         = "ParentID"
+	importManufacturer     = "Manufacturer"
+	importModel            = "Model"
+	importSerialNumber     = "SerialNumber"
+	importInstallationDate = "InstallationDate"
+
+	// importPropertyPrefix префикс поля значения свойства (Properties.<ID>),
+	// importUnitSuffix - суффикс поля его единицы измерения (Properties.<ID>.Unit)
+	importPropertyPrefix = "Properties."
+	importUnitSuffix     = ".Unit"
+
+	// importIgnore сопоставление колонки, которая не импортируется
+	importIgnore = "-"
+	// importListSeparator разделитель классов в колонке EquipmentType
+	importListSeparator = ";"
+)
+
+// importPassport свойства паспортных полей оборудования
+var importPassport = map[string]string{
+	importManufacturer: PropertyManufacturer,
+	importModel:        PropertyModel,
+	importSerialNumber: PropertySerialNumber,
+}
+
+// importSchemaFields поля импорта элементов B2MML, нарушения схемы в которых
+// относятся к их колонкам
+var importSchemaFields = []struct{ element, field string }{
+	{"EquipmentLevel", importEquipmentLevel},
+	{"EquipmentClassID", importEquipmentType},
+	{"Description", importDescription},
+	{"ID", importEquipmentID},
+}
+
+// importTarget поле оборудования, которому сопоставлена колонка
+type importTarget struct {
+	// field поле оборудования; пусто для свойства
+	field string
+	// property свойство, значение которого (или единицу измерения при unit)
+	// содержит колонка
+	property string
+	unit     bool
+}
+
+func (t importTarget) String() string {
+	switch {
+	case t.field != "":
+		return t.field
+	case t.unit:
+		return importPropertyPrefix + t.property + importUnitSuffix
+	}
+	return importPropertyPrefix + t.property
+}
+
+// parseImportTarget разбирает имя поля оборудования
+func parseImportTarget(value string) (importTarget, error) {
+	switch value {
+	case importEquipmentID, importEquipmentType, importDescription, importOperatingStatus, importEquipmentLevel,
+		importParentID, importManufacturer, importModel, importSerialNumber, importInstallationDate:
+		return importTarget{field: value}, nil
+	}
+	if id, ok := strings.CutPrefix(value, importPropertyPrefix); ok {
+		id, unit := strings.CutSuffix(id, importUnitSuffix)
+		if id != "" {
+			return importTarget{property: id, unit: unit}, nil
+		}
+	}
+	return importTarget{}, fmt.Errorf("%w: unknown field %q", model.ErrImportInvalidMapping, value)
+}
+
+// importPlan сопоставление колонок источника полям оборудования
+type importPlan struct {
+	targets map[string]importTarget
+	ignored map[string]bool
+}
+
+// newImportPlan строит сопоставление колонок из mapping (колонка - поле или
+// importIgnore). Колонка без сопоставления импортируется в поле с её именем
+func newImportPlan(mapping map[string]string) (*importPlan, error) {
+	p := &importPlan{targets: map[string]importTarget{}, ignored: map[string]bool{}}
+	for _, column := range slices.Sorted(maps.Keys(mapping)) {
+		if mapping[column] == importIgnore {
+			p.ignored[column] = true
+			continue
+		}
+		target, err := parseImportTarget(mapping[column])
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", column, err)
+		}
+		p.targets[column] = target
+	}
+	return p, nil
+}
+
+// target возвращает поле колонки; false - колонка не импортируется
+func (p *importPlan) target(column string) (importTarget, bool, error) {
+	if p.ignored[column] {
+		return importTarget{}, false, nil
+	}
+	if target, ok := p.targets[column]; ok {
+		return target, true, nil
+	}
+	target, err := parseImportTarget(column)
+	if err != nil {
+		return importTarget{}, false, fmt.Errorf("column %q: %w", column, err)
+	}
+	return target, true, nil
+}
+
+// importColumnError ошибка значения колонки строки источника
+type importColumnError struct {
+	column string
+	err    error
+}
+
+func (e *importColumnError) Error() string {
+	return fmt.Sprintf("column %q: %v", e.column, e.err)
+}
+
+func (e *importColumnError) Unwrap() error {
+	return e.err
+}
+
+// importRow строка источника, разобранная по сопоставлению колонок
+type importRow struct {
+	// row номер строки данных с 1, line - номер строки файла
+	row, line int64
+	id        string
+	data      *b2mml.EquipmentType
+	status    model.OperatingStatus
+	parentID  string
+	props     []PropertyInput
+	units     map[string]string
+	// columns колонки заданных полей строки
+	columns map[importTarget]string
+	// err ошибка разбора; строка с ошибкой не импортируется
+	err error
+}
+
+func newImportRow(row, line int64) *importRow {
+	return &importRow{
+		row:     row,
+		line:    line,
+		data:    &b2mml.EquipmentType{},
+		units:   map[string]string{},
+		columns: map[importTarget]string{},
+	}
+}
+
+// fail запоминает ошибку разбора строки, если её ещё нет
+func (r *importRow) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// columnError относит ошибку к колонке поля field, если она задана в строке
+func (r *importRow) columnError(field string, err error) error {
+	if column, ok := r.columns[importTarget{field: field}]; ok {
+		return &importColumnError{column: column, err: err}
+	}
+	return err
+}
+
+// set задаёт поле target значением колонки column. После ошибки строки
+// задаётся только EquipmentID для отчёта
+func (r *importRow) set(column string, target importTarget, value string) {
+	if r.err != nil && target.field != importEquipmentID {
+		return
+	}
+	if err := r.setValue(column, target, strings.TrimSpace(value)); err != nil {
+		r.fail(&importColumnError{column: column, err: err})
+	}
+}
+
+func (r *importRow) setValue(column string, target importTarget, value string) error {
+	if other, ok := r.columns[target]; ok {
+		return fmt.Errorf("%w: columns %q and %q map to %s", model.ErrImportInvalidMapping, other, column, target)
+	}
+	r.columns[target] = column
+	if value == "" {
+		return nil
+	}
+
+	switch target.field {
+	case importEquipmentID:
+		r.id = value
+	case importEquipmentType:
+		for id := range strings.SplitSeq(value, importListSeparator) {
+			if id = strings.TrimSpace(id); id != "" {
+				r.data.EquipmentClassID = append(r.data.EquipmentClassID, &b2mml.IdentifierType{Value: id})
+			}
+		}
+	case importDescription:
+		r.data.Description = []*b2mml.DescriptionType{{Value: value}}
+	case importOperatingStatus:
+		status, err := model.ParseOperatingStatus(value)
+		if err != nil {
+			return err
+		}
+		r.status = status
+	case importEquipmentLevel:
+		r.data.EquipmentLevel = &b2mml.EquipmentLevelType{EquipmentLevel1Type: &b2mml.EquipmentLevel1Type{Value: value}}
+	case importParentID:
+		r.parentID = value
+	case importInstallationDate:
+		t, err := parseImportDate(value)
+		if err != nil {
+			return err
+		}
+		r.props = append(r.props, PropertyInput{
+			ID:       PropertyInstallationDate,
+			Value:    t.Format(time.RFC3339),
+			DataType: "datetime",
+		})
+	case importManufacturer, importModel, importSerialNumber:
+		r.props = append(r.props, PropertyInput{ID: importPassport[target.field], Value: value})
+	case "":
+		if target.unit {
+			r.units[target.property] = value
+		} else {
+			r.props = append(r.props, PropertyInput{ID: target.property, Value: value})
+		}
+	}
+	return nil
+}
+
+// parseImportDate разбирает дату установки: RFC 3339 или YYYY-MM-DD
+func parseImportDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q is not a date", model.ErrImportInvalidRow, value)
+	}
+	return t, nil
+}
+
+// finish проверяет заполненную строку: обязательные поля и схему B2MML
+func (r *importRow) finish() {
+	if r.err != nil {
+		return
+	}
+	if r.id == "" {
+		r.err = r.columnError(importEquipmentID, model.ErrEquipmentIDEmpty)
+		return
+	}
+	r.data.ID = &b2mml.IdentifierType{Value: r.id}
+	for i := range r.props {
+		r.props[i].Unit = r.units[r.props[i].ID]
+	}
+
+	doc, err := xml.Marshal(r.data)
+	if err == nil {
+		err = b2mml.Validate(bytes.NewReader(doc), r.data)
+	}
+	var verr *b2mml.ValidationError
+	switch {
+	case errors.As(err, &verr) && len(verr.Violations) > 0:
+		v := verr.Violations[0]
+		err = fmt.Errorf("%w: %s", model.ErrB2MMLMalformed, v.Message)
+		for _, f := range importSchemaFields {
+			if strings.Contains(v.Path, "/"+f.element) {
+				err = r.columnError(f.field, err)
+				break
+			}
+		}
+		r.err = err
+	case err != nil:
+		r.err = fmt.Errorf("%w: %v", model.ErrB2MMLMalformed, err)
+	}
+}
+
+// importSource последовательно читает строки источника задания
+type importSource interface {
+	// next возвращает следующую строку; io.EOF после последней
+	next() (*importRow, error)
+}
+
+// newImportSource открывает источник задания и проверяет сопоставление колонок
+func newImportSource(job *repository.ImportJob, source []byte) (importSource, error) {
+	plan, err := newImportPlan(job.Mapping)
+	if err != nil {
+		return nil, err
+	}
+	// Excel записывает CSV в UTF-8 с BOM
+	source = bytes.TrimPrefix(source, []byte("\ufeff"))
+	switch job.Format {
+	case model.ImportFormatCSV:
+		return newCSVImportSource(plan, job.Delimiter, source)
+	case model.ImportFormatJSONL:
+		return &jsonlImportSource{plan: plan, r: bufio.NewReader(bytes.NewReader(source))}, nil
+	}
+	return nil, fmt.Errorf("%w: %q", model.ErrImportInvalidFormat, job.Format)
+}
+
+// csvImportSource источник CSV: первая строка - имена колонок
+type csvImportSource struct {
+	r       *csv.Reader
+	columns []string
+	targets []*importTarget
+	rows    int64
+}
+
+func newCSVImportSource(plan *importPlan, delimiter rune, source []byte) (*csvImportSource, error) {
+	r := csv.NewReader(bytes.NewReader(source))
+	if delimiter != 0 {
+		r.Comma = delimiter
+	}
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: csv header is missing", model.ErrImportInvalidFormat)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: csv header: %v", model.ErrImportInvalidFormat, err)
+	}
+
+	s := &csvImportSource{r: r, columns: header, targets: make([]*importTarget, len(header))}
+	columns := map[importTarget]string{}
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		s.columns[i] = column
+		target, ok, err := plan.target(column)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if other, ok := columns[target]; ok {
+			return nil, fmt.Errorf("%w: columns %q and %q map to %s", model.ErrImportInvalidMapping, other, column, target)
+		}
+		columns[target] = column
+		s.targets[i] = &target
+	}
+	if _, ok := columns[importTarget{field: importEquipmentID}]; !ok {
+		return nil, fmt.Errorf("%w: no column maps to %s", model.ErrImportInvalidMapping, importEquipmentID)
+	}
+	return s, nil
+}
+
+func (s *csvImportSource) next() (*importRow, error) {
+	record, err := s.r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	s.rows++
+	var perr *csv.ParseError
+	if errors.As(err, &perr) {
+		// Строка с неверным числом полей или кавычками пропускается, чтение продолжается
+		row := newImportRow(s.rows, int64(perr.StartLine))
+		row.fail(fmt.Errorf("%w: %v", model.ErrImportInvalidRow, perr.Err))
+		return row, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	line, _ := s.r.FieldPos(0)
+	row := newImportRow(s.rows, int64(line))
+	for i, value := range record {
+		if target := s.targets[i]; target != nil {
+			row.set(s.columns[i], *target, value)
+		}
+	}
+	row.finish()
+	return row, nil
+}
+
+// jsonlImportSource источник JSON Lines: строка - плоский объект колонок.
+// Значение колонки EquipmentType может быть массивом классов, пустые строки
+// файла пропускаются
+type jsonlImportSource struct {
+	plan       *importPlan
+	r          *bufio.Reader
+	rows, line int64
+}
+
+func (s *jsonlImportSource) next() (*importRow, error) {
+	for {
+		data, err := s.r.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(data)) == 0 {
+			if err != nil {
+				return nil, io.EOF
+			}
+			s.line++
+			continue
+		}
+		s.line++
+		s.rows++
+		row := newImportRow(s.rows, s.line)
+		s.parse(row, data)
+		row.finish()
+		return row, nil
+	}
+}
+
+// parse заполняет строку из объекта JSON
+func (s *jsonlImportSource) parse(row *importRow, data []byte) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var object map[string]any
+	if err := d.Decode(&object); err != nil {
+		row.fail(fmt.Errorf("%w: %v", model.ErrImportInvalidRow, err))
+		return
+	}
+
+	for _, column := range slices.Sorted(maps.Keys(object)) {
+		target, ok, err := s.plan.target(column)
+		if err != nil {
+			row.fail(err)
+			continue
+		}
+		if !ok || object[column] == nil {
+			continue
+		}
+		value, err := jsonImportValue(object[column], target.field == importEquipmentType)
+		if err != nil {
+			row.fail(&importColumnError{column: column, err: err})
+			continue
+		}
+		row.set(column, target, value)
+	}
+}
+
+// jsonImportValue возвращает текст значения колонки JSON; list - колонка
+// допускает массив значений
+func jsonImportValue(value any, list bool) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	case bool:
+		return fmt.Sprint(value), nil
+	case []any:
+		if !list {
+			break
+		}
+		items := make([]string, 0, len(value))
+		for _, item := range value {
+			text, err := jsonImportValue(item, false)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, text)
+		}
+		return strings.Join(items, importListSeparator), nil
+	}
+	return "", fmt.Errorf("%w: value must be a string, number or boolean", model.ErrImportInvalidRow)
+}
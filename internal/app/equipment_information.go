@@ -0,0 +1,423 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// ApplyEquipmentInformationInput входные параметры для ApplyEquipmentInformation
+type ApplyEquipmentInformationInput struct {
+	// Action код действия B2MML: Add, Change, Replace или Delete
+	Action           string
+	EquipmentClasses []*b2mml.EquipmentClassType
+	Equipment        []*b2mml.EquipmentType
+}
+
+// ApplyEquipmentInformationOutput выходные данные для ApplyEquipmentInformation
+type ApplyEquipmentInformationOutput struct {
+	// EquipmentClasses и Equipment сохранённые агрегаты верхнего уровня документа;
+	// при Delete не заполняются
+	EquipmentClasses []*model.EquipmentClass
+	Equipment        []*model.Equipment
+	Events           []model.DomainEvent
+}
+
+// ApplyEquipmentInformationUseCase use case для применения B2MML данных о классах
+// оборудования и оборудовании (EquipmentInformation) по коду действия:
+//   - Add создаёт объекты; существующий объект - ошибка
+//   - Change дополняет существующие объекты заданными полями, свойствами и классами
+//   - Replace заменяет данные, свойства и классы объектов, отсутствующие создаются
+//   - Delete удаляет объекты (оборудование - soft delete с правилами по умолчанию)
+//
+// Дочерние элементы (EquipmentChild, EquipmentClassChild) применяются как
+// отдельные объекты под родителем; Change и Replace создают отсутствующие.
+// Все объекты применяются в одной транзакции
+type ApplyEquipmentInformationUseCase struct {
+	uow repository.UnitOfWork
+}
+
+// NewApplyEquipmentInformationUseCase создаёт новый use case
+func NewApplyEquipmentInformationUseCase(uow repository.UnitOfWork) *ApplyEquipmentInformationUseCase {
+	return &ApplyEquipmentInformationUseCase{uow: uow}
+}
+
+// Execute выполняет use case
+func (uc *ApplyEquipmentInformationUseCase) Execute(ctx context.Context, input ApplyEquipmentInformationInput) (*ApplyEquipmentInformationOutput, error) {
+	switch input.Action {
+	case b2mml.ActionCodeAdd, b2mml.ActionCodeChange, b2mml.ActionCodeReplace, b2mml.ActionCodeDelete:
+	default:
+		return nil, fmt.Errorf("%w: %q", model.ErrB2MMLUnsupportedAction, input.Action)
+	}
+
+	var output *ApplyEquipmentInformationOutput
+	err := uc.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		a := &informationApplier{tx: tx, action: input.Action}
+		output = &ApplyEquipmentInformationOutput{}
+
+		if input.Action == b2mml.ActionCodeDelete {
+			// Оборудование удаляется раньше классов, в которые оно входит
+			for _, data := range input.Equipment {
+				events, err := deleteEquipment(ctx, tx, data.ID.String(), model.DeleteOptions{
+					Children: model.DeleteBlock,
+					Classes:  model.DeleteCascade,
+				}, nil)
+				if err != nil {
+					return fmt.Errorf("equipment %s: %w", data.ID.String(), err)
+				}
+				output.Events = append(output.Events, events...)
+			}
+			for _, data := range input.EquipmentClasses {
+				class, err := tx.EquipmentClass().GetByExternalID(ctx, data.ID.String())
+				if err != nil {
+					return fmt.Errorf("equipment class %s: %w", data.ID.String(), err)
+				}
+				if err := tx.EquipmentClass().Delete(ctx, class); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		// Классы применяются раньше оборудования, которое на них ссылается
+		for _, data := range input.EquipmentClasses {
+			class, err := a.applyClass(ctx, data, nil)
+			if err != nil {
+				return err
+			}
+			output.EquipmentClasses = append(output.EquipmentClasses, class)
+		}
+		for _, data := range input.Equipment {
+			equipment, err := a.applyEquipment(ctx, data, nil)
+			if err != nil {
+				return err
+			}
+			output.Equipment = append(output.Equipment, equipment)
+		}
+		output.Events = a.events
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// informationApplier применяет существительные B2MML в транзакции tx
+type informationApplier struct {
+	tx     repository.UnitOfWork
+	action string
+	events []model.DomainEvent
+}
+
+// applyEquipment применяет оборудование и его дочернее оборудование.
+// parent - родитель из документа (nil для оборудования верхнего уровня)
+func (a *informationApplier) applyEquipment(ctx context.Context, data *b2mml.EquipmentType, parent *model.Equipment) (*model.Equipment, error) {
+	id, err := model.NewEquipmentID(data.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	classes, err := equipmentClasses(ctx, a.tx.EquipmentClass(), data.EquipmentClassID)
+	if err != nil {
+		return nil, fmt.Errorf("equipment %s: %w", id, err)
+	}
+
+	equipment, err := a.tx.Equipment().GetSubtree(ctx, id.String(), 0)
+	switch {
+	case errors.Is(err, model.ErrEquipmentNotFound) && (a.action != b2mml.ActionCodeChange || parent != nil):
+		equipment, err = a.addEquipment(ctx, id, data, classes, parent)
+	case err != nil:
+		return nil, fmt.Errorf("equipment %s: %w", id, err)
+	case a.action == b2mml.ActionCodeAdd:
+		return nil, fmt.Errorf("%w: %s", model.ErrEquipmentAlreadyExists, id)
+	default:
+		err = a.changeEquipment(ctx, equipment, data, classes, parent)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("equipment %s: %w", id, err)
+	}
+
+	for _, child := range data.EquipmentChild {
+		if _, err := a.applyEquipment(ctx, child, equipment); err != nil {
+			return nil, err
+		}
+	}
+	return equipment, nil
+}
+
+// addEquipment создаёт оборудование и переносит его под parent
+func (a *informationApplier) addEquipment(ctx context.Context, id model.EquipmentID, data *b2mml.EquipmentType, classes []*model.EquipmentClass, parent *model.Equipment) (*model.Equipment, error) {
+	var class *model.EquipmentClass
+	if len(classes) > 0 {
+		class = classes[0]
+	}
+	equipment := model.NewEquipment(id, nil, class)
+	if err := equipment.ReplaceB2MML(data, classes); err != nil {
+		return nil, err
+	}
+	if err := setPropertyValues(ctx, a.tx.EquipmentClass(), equipment, b2mmlPropertyInputs(data.EquipmentProperty)); err != nil {
+		return nil, err
+	}
+
+	a.events = append(a.events, equipment.Events()...)
+	if err := a.tx.Equipment().Create(ctx, equipment); err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		return equipment, nil
+	}
+	return equipment, a.moveEquipment(ctx, equipment, nil, parent)
+}
+
+// changeEquipment применяет к оборудованию Change или Replace и переносит его
+// под parent, если родитель в документе отличается от текущего
+func (a *informationApplier) changeEquipment(ctx context.Context, equipment *model.Equipment, data *b2mml.EquipmentType, classes []*model.EquipmentClass, parent *model.Equipment) error {
+	expected := equipment.Version()
+	var err error
+	if a.action == b2mml.ActionCodeReplace {
+		err = equipment.ReplaceB2MML(data, classes)
+	} else {
+		err = equipment.ChangeB2MML(data, classes)
+	}
+	if err != nil {
+		return err
+	}
+	if err := setPropertyValues(ctx, a.tx.EquipmentClass(), equipment, b2mmlPropertyInputs(data.EquipmentProperty)); err != nil {
+		return err
+	}
+
+	a.events = append(a.events, equipment.Events()...)
+	if err := a.tx.Equipment().Update(ctx, equipment, expected); err != nil {
+		return err
+	}
+	if parent == nil {
+		return nil
+	}
+
+	ancestors, err := a.tx.Equipment().Ancestors(ctx, equipment.ID().String())
+	if err != nil {
+		return err
+	}
+	var from *model.EquipmentID
+	if len(ancestors) > 0 {
+		from = &ancestors[len(ancestors)-1]
+		if *from == parent.ID() {
+			return nil
+		}
+	}
+	return a.moveEquipment(ctx, equipment, from, parent)
+}
+
+// moveEquipment переносит оборудование под parent с проверками иерархии
+func (a *informationApplier) moveEquipment(ctx context.Context, equipment *model.Equipment, from *model.EquipmentID, parent *model.Equipment) error {
+	path, err := a.tx.Equipment().Ancestors(ctx, parent.ID().String())
+	if err != nil {
+		return err
+	}
+	expected := equipment.Version()
+	if err := equipment.MoveTo(from, parent, path); err != nil {
+		return err
+	}
+
+	parentID := parent.ID()
+	a.events = append(a.events, equipment.Events()...)
+	return a.tx.Equipment().Move(ctx, equipment, &parentID, expected)
+}
+
+// applyClass применяет класс оборудования и его дочерние классы.
+// parent - родительский класс из документа (nil для класса верхнего уровня)
+func (a *informationApplier) applyClass(ctx context.Context, data *b2mml.EquipmentClassType, parent *model.EquipmentClass) (*model.EquipmentClass, error) {
+	id, err := model.NewEquipmentClassID(data.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := a.tx.EquipmentClass().GetByExternalID(ctx, id.String())
+	switch {
+	case errors.Is(err, model.ErrEquipmentClassNotFound) && (a.action != b2mml.ActionCodeChange || parent != nil):
+		class = model.NewEquipmentClass(id, nil)
+		if err = class.ReplaceB2MML(data); err != nil {
+			break
+		}
+		a.events = append(a.events, class.Events()...)
+		if parent == nil {
+			err = a.tx.EquipmentClass().Create(ctx, class)
+			break
+		}
+		// Дочерний класс создаётся при сохранении родителя
+		if err = parent.AddChild(class); err == nil {
+			err = a.tx.EquipmentClass().Update(ctx, parent)
+		}
+	case err != nil:
+	case a.action == b2mml.ActionCodeAdd:
+		err = model.ErrEquipmentClassAlreadyExists
+	case a.action == b2mml.ActionCodeReplace:
+		if err = class.ReplaceB2MML(data); err == nil {
+			err = a.tx.EquipmentClass().Update(ctx, class)
+		}
+	default:
+		if err = class.ChangeB2MML(data); err == nil {
+			err = a.tx.EquipmentClass().Update(ctx, class)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("equipment class %s: %w", id, err)
+	}
+
+	for _, child := range data.EquipmentClassChild {
+		if _, err := a.applyClass(ctx, child, class); err != nil {
+			return nil, err
+		}
+	}
+	return class, nil
+}
+
+// b2mmlPropertyInputs возвращает значения свойств B2MML для проверки по
+// определениям классов. Свойства без Value не меняют значение
+func b2mmlPropertyInputs(props []*b2mml.EquipmentPropertyType) []PropertyInput {
+	inputs := make([]PropertyInput, 0, len(props))
+	for _, prop := range props {
+		if prop == nil || len(prop.Value) == 0 || prop.Value[0] == nil {
+			continue
+		}
+		value := prop.Value[0]
+		input := PropertyInput{ID: prop.ID.String()}
+		if value.ValueString != nil {
+			input.Value = value.ValueString.Value
+		}
+		if value.DataType != nil {
+			switch {
+			case value.DataType.DataType1Type != nil && value.DataType.Value != "":
+				input.DataType = value.DataType.Value
+			case value.DataType.OtherValueAttr != nil:
+				input.DataType = *value.DataType.OtherValueAttr
+			}
+		}
+		if value.UnitOfMeasure != nil {
+			input.Unit = value.UnitOfMeasure.Value
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
+
+// GetEquipmentInformationInput входные параметры для GetEquipmentInformation.
+// Оборудование выбирается по EquipmentIDs или фильтру Query, классы - по
+// ClassIDs или постранично (Query.Limit, Query.Cursor)
+type GetEquipmentInformationInput struct {
+	Equipment    bool
+	EquipmentIDs []string
+	Query        QueryEquipmentInput
+
+	Classes  bool
+	ClassIDs []string
+	// EquipmentClasses добавить классы выбранного оборудования
+	EquipmentClasses bool
+}
+
+// GetEquipmentInformationOutput выходные данные для GetEquipmentInformation
+type GetEquipmentInformationOutput struct {
+	Equipment        []*model.Equipment
+	EquipmentClasses []*model.EquipmentClass
+	// NextCursor курсор следующей страницы; пустой - страница последняя
+	NextCursor string
+}
+
+// GetEquipmentInformationUseCase use case для выборки оборудования и классов
+// оборудования для B2MML Get. Объекты, запрошенные по ID и не найденные, пропускаются
+type GetEquipmentInformationUseCase struct {
+	equipmentRepo repository.EquipmentRepository
+	classRepo     repository.EquipmentClassRepository
+	registry      *model.UnitRegistry
+}
+
+// NewGetEquipmentInformationUseCase создаёт новый use case
+func NewGetEquipmentInformationUseCase(
+	equipmentRepo repository.EquipmentRepository,
+	classRepo repository.EquipmentClassRepository,
+	registry *model.UnitRegistry,
+) *GetEquipmentInformationUseCase {
+	return &GetEquipmentInformationUseCase{
+		equipmentRepo: equipmentRepo,
+		classRepo:     classRepo,
+		registry:      registry,
+	}
+}
+
+// Execute выполняет use case
+func (uc *GetEquipmentInformationUseCase) Execute(ctx context.Context, input GetEquipmentInformationInput) (*GetEquipmentInformationOutput, error) {
+	equipmentPaged := input.Equipment && len(input.EquipmentIDs) == 0
+	classesPaged := input.Classes && len(input.ClassIDs) == 0
+	if equipmentPaged && classesPaged {
+		return nil, fmt.Errorf("%w: equipment and classes cannot be paged together", ErrInvalidEquipmentQuery)
+	}
+
+	output := &GetEquipmentInformationOutput{}
+	switch {
+	case equipmentPaged:
+		query, err := input.Query.toQuery(uc.registry)
+		if err != nil {
+			return nil, err
+		}
+		page, err := uc.equipmentRepo.Query(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		output.Equipment, output.NextCursor = page.Items, page.NextCursor
+	case input.Equipment:
+		for _, id := range input.EquipmentIDs {
+			equipment, err := uc.equipmentRepo.GetByExternalID(ctx, id)
+			if errors.Is(err, model.ErrEquipmentNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			output.Equipment = append(output.Equipment, equipment)
+		}
+	}
+
+	switch {
+	case classesPaged:
+		page, err := uc.classRepo.List(ctx, repository.PageRequest{
+			Limit:  normalizeLimit(input.Query.Limit),
+			Cursor: input.Query.Cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+		output.EquipmentClasses, output.NextCursor = page.Items, page.NextCursor
+	case input.Classes:
+		for _, id := range input.ClassIDs {
+			class, err := uc.classRepo.GetByExternalID(ctx, id)
+			if errors.Is(err, model.ErrEquipmentClassNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			output.EquipmentClasses = append(output.EquipmentClasses, class)
+		}
+	}
+
+	if input.EquipmentClasses {
+		seen := make(map[model.EquipmentClassID]bool)
+		for _, class := range output.EquipmentClasses {
+			seen[class.ID()] = true
+		}
+		for _, equipment := range output.Equipment {
+			for _, class := range equipment.Classes() {
+				if !seen[class.ID()] {
+					seen[class.ID()] = true
+					output.EquipmentClasses = append(output.EquipmentClasses, class)
+				}
+			}
+		}
+	}
+	return output, nil
+}
@@ -0,0 +1,160 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// GetEffectivePropertiesInput входные параметры для GetEffectiveProperties
+type GetEffectivePropertiesInput struct {
+	ExternalID string
+	// Units единицы, в которые переводятся числовые значения (не более одной на вид величины)
+	Units []string
+	// SiteUnits переводит значения в предпочтительные единицы площадки оборудования;
+	// единицы из Units имеют приоритет
+	SiteUnits bool
+}
+
+// GetEffectivePropertiesOutput выходные данные для GetEffectiveProperties
+type GetEffectivePropertiesOutput struct {
+	Equipment  *model.Equipment
+	Properties []*model.EffectiveProperty
+}
+
+// GetEffectivePropertiesUseCase use case для получения эффективного листа свойств
+// оборудования с учётом наследования свойств по иерархии классов
+type GetEffectivePropertiesUseCase struct {
+	equipmentRepo repository.EquipmentRepository
+	classRepo     repository.EquipmentClassRepository
+	siteUnitRepo  repository.SiteUnitRepository
+	registry      *model.UnitRegistry
+	resolver      *model.PropertyResolver
+}
+
+// NewGetEffectivePropertiesUseCase создаёт новый use case
+func NewGetEffectivePropertiesUseCase(
+	equipmentRepo repository.EquipmentRepository,
+	classRepo repository.EquipmentClassRepository,
+	siteUnitRepo repository.SiteUnitRepository,
+	registry *model.UnitRegistry,
+) *GetEffectivePropertiesUseCase {
+	return &GetEffectivePropertiesUseCase{
+		equipmentRepo: equipmentRepo,
+		classRepo:     classRepo,
+		siteUnitRepo:  siteUnitRepo,
+		registry:      registry,
+		resolver:      model.NewPropertyResolver(),
+	}
+}
+
+// Execute выполняет use case
+func (uc *GetEffectivePropertiesUseCase) Execute(ctx context.Context, input GetEffectivePropertiesInput) (*GetEffectivePropertiesOutput, error) {
+	if input.ExternalID == "" {
+		return nil, fmt.Errorf("external_id is required")
+	}
+
+	units, err := uc.registry.Preferences(input.Units)
+	if err != nil {
+		return nil, err
+	}
+
+	equipment, err := uc.equipmentRepo.GetSubtree(ctx, input.ExternalID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	lineages, err := classLineages(ctx, uc.classRepo, equipment)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.SiteUnits {
+		_, siteUnits, err := uc.siteUnitRepo.ForEquipment(ctx, input.ExternalID)
+		if err != nil {
+			return nil, err
+		}
+		units = siteUnits.Merge(units)
+	}
+
+	properties := uc.resolver.Resolve(equipment, lineages)
+	normalizeProperties(uc.registry, properties, units)
+	return &GetEffectivePropertiesOutput{
+		Equipment:  equipment,
+		Properties: properties,
+	}, nil
+}
+
+// classLineages загружает цепочки наследования всех классов оборудования
+func classLineages(
+	ctx context.Context,
+	classRepo repository.EquipmentClassRepository,
+	equipment *model.Equipment,
+) (map[model.EquipmentClassID][]*model.EquipmentClass, error) {
+	lineages := make(map[model.EquipmentClassID][]*model.EquipmentClass, len(equipment.Classes()))
+	for _, class := range equipment.Classes() {
+		lineage, err := classRepo.Lineage(ctx, class.ID())
+		if err != nil {
+			return nil, err
+		}
+		lineages[class.ID()] = lineage
+	}
+	return lineages, nil
+}
+
+// setPropertyValues задаёт значения свойств оборудования и проверяет их по определениям
+// классов; уже сохранённые значения других свойств не перепроверяются. Если тип данных или единица измерения не указаны, они берутся из определения.
+// Описание существующего значения сохраняется
+func setPropertyValues(
+	ctx context.Context,
+	classRepo repository.EquipmentClassRepository,
+	equipment *model.Equipment,
+	props []PropertyInput,
+) error {
+	if len(props) == 0 {
+		return nil
+	}
+
+	lineages, err := classLineages(ctx, classRepo, equipment)
+	if err != nil {
+		return err
+	}
+	resolver := model.NewPropertyResolver()
+	definitions := make(map[string]*model.EquipmentClassProperty)
+	for _, prop := range resolver.Resolve(equipment, lineages) {
+		if prop.Definition != nil {
+			definitions[prop.ID.String()] = prop.Definition
+		}
+	}
+
+	ids := make([]model.EquipmentPropertyID, 0, len(props))
+	for _, prop := range props {
+		id, err := model.NewEquipmentPropertyID(prop.ID)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+		dataType, unit := prop.DataType, prop.Unit
+		if def, ok := definitions[prop.ID]; ok && (dataType == "" || unit == "") {
+			c, err := def.Constraint()
+			if err != nil {
+				return err
+			}
+			dataType = cmp.Or(dataType, string(c.DataType))
+			unit = cmp.Or(unit, c.Unit)
+		}
+		value, err := model.ParsePropertyValue(prop.Value, dataType, unit)
+		if err != nil {
+			return fmt.Errorf("property %s: %w", prop.ID, err)
+		}
+		if existing, ok := equipment.Property(id); ok {
+			value.SetDescription(existing.Value().Description())
+		}
+		equipment.SetPropertyValue(id, value)
+	}
+
+	return resolver.Validate(equipment, lineages, ids...)
+}
@@ -0,0 +1,207 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// ErrInvalidEquipmentQuery возвращается для некорректных параметров поиска оборудования
+var ErrInvalidEquipmentQuery = errors.New("invalid equipment query")
+
+// equipmentSortFields поддерживаемые поля сортировки
+var equipmentSortFields = map[string]repository.EquipmentSortField{
+	"created_at":           repository.SortByCreatedAt,
+	"updated_at":           repository.SortByUpdatedAt,
+	"external_id":          repository.SortByExternalID,
+	"effective_start_date": repository.SortByEffectiveStartDate,
+	"equipment_level":      repository.SortByEquipmentLevel,
+	"operating_status":     repository.SortByOperatingStatus,
+}
+
+// PropertyPredicateInput условие на значение свойства
+type PropertyPredicateInput struct {
+	ID       string
+	Operator string
+	// Type тип сравнения; если не задан, определяется по значению
+	Type  string
+	Value string
+	Unit  string
+}
+
+// QueryEquipmentInput входные параметры для QueryEquipment
+type QueryEquipmentInput struct {
+	Statuses          []string
+	Levels            []string
+	HierarchyScopeIDs []string
+	ClassID           string
+	IncludeSubclasses bool
+	EffectiveAt       *time.Time
+	EffectiveFrom     *time.Time
+	EffectiveTo       *time.Time
+	Properties        []PropertyPredicateInput
+	// Sort поле сортировки (по умолчанию created_at по убыванию)
+	Sort  string
+	Desc  bool
+	Limit int32
+	// Cursor курсор страницы из предыдущего ответа
+	Cursor string
+}
+
+// QueryEquipmentUseCase use case для поиска оборудования по набору фильтров
+type QueryEquipmentUseCase struct {
+	equipmentRepo repository.EquipmentRepository
+	registry      *model.UnitRegistry
+}
+
+// NewQueryEquipmentUseCase создаёт новый use case
+func NewQueryEquipmentUseCase(equipmentRepo repository.EquipmentRepository, registry *model.UnitRegistry) *QueryEquipmentUseCase {
+	return &QueryEquipmentUseCase{
+		equipmentRepo: equipmentRepo,
+		registry:      registry,
+	}
+}
+
+// Execute выполняет use case
+func (uc *QueryEquipmentUseCase) Execute(ctx context.Context, input QueryEquipmentInput) (*ListEquipmentOutput, error) {
+	query, err := input.toQuery(uc.registry)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := uc.equipmentRepo.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListEquipmentOutput{
+		Items:      result.Items,
+		Count:      len(result.Items),
+		NextCursor: result.NextCursor,
+		PrevCursor: result.PrevCursor,
+	}, nil
+}
+
+// toQuery проверяет входные параметры и преобразует их в запрос репозитория
+func (input QueryEquipmentInput) toQuery(registry *model.UnitRegistry) (repository.EquipmentQuery, error) {
+	query := repository.EquipmentQuery{
+		Levels:            input.Levels,
+		HierarchyScopeIDs: input.HierarchyScopeIDs,
+		IncludeSubclasses: input.IncludeSubclasses,
+		EffectiveAt:       input.EffectiveAt,
+		EffectiveFrom:     input.EffectiveFrom,
+		EffectiveTo:       input.EffectiveTo,
+		Sort:              repository.DefaultEquipmentSort,
+		Page: repository.PageRequest{
+			Limit:  normalizeLimit(input.Limit),
+			Cursor: input.Cursor,
+		},
+	}
+
+	for _, s := range input.Statuses {
+		status, err := model.ParseOperatingStatus(s)
+		if err != nil {
+			return query, fmt.Errorf("%w: %w", ErrInvalidEquipmentQuery, err)
+		}
+		query.Statuses = append(query.Statuses, status)
+	}
+
+	if input.ClassID != "" {
+		classID, err := model.NewEquipmentClassID(input.ClassID)
+		if err != nil {
+			return query, err
+		}
+		query.ClassID = &classID
+	} else if input.IncludeSubclasses {
+		return query, fmt.Errorf("%w: include_subclasses requires class", ErrInvalidEquipmentQuery)
+	}
+
+	if input.EffectiveFrom != nil && input.EffectiveTo != nil && !input.EffectiveFrom.Before(*input.EffectiveTo) {
+		return query, fmt.Errorf("%w: effective_from must be before effective_to", ErrInvalidEquipmentQuery)
+	}
+
+	for _, p := range input.Properties {
+		pred, err := p.toPredicate(registry)
+		if err != nil {
+			return query, err
+		}
+		query.Properties = append(query.Properties, pred)
+	}
+
+	if input.Sort != "" {
+		field, ok := equipmentSortFields[input.Sort]
+		if !ok {
+			return query, fmt.Errorf("%w: unsupported sort field %q", ErrInvalidEquipmentQuery, input.Sort)
+		}
+		query.Sort = repository.EquipmentSort{Field: field, Desc: input.Desc}
+	}
+	return query, nil
+}
+
+// toPredicate проверяет условие на свойство и определяет тип сравнения.
+// Числовые условия с известной единицей измерения охватывают значения во всех
+// совместимых единицах
+func (p PropertyPredicateInput) toPredicate(registry *model.UnitRegistry) (repository.PropertyPredicate, error) {
+	pred := repository.PropertyPredicate{
+		PropertyID: p.ID,
+		Operator:   repository.PropertyOperator(p.Operator),
+		Type:       repository.PropertyValueType(p.Type),
+		Value:      p.Value,
+		Unit:       p.Unit,
+	}
+	if p.ID == "" {
+		return pred, fmt.Errorf("%w: property id is required", ErrInvalidEquipmentQuery)
+	}
+
+	switch pred.Operator {
+	case repository.PropertyExists:
+		return pred, nil
+	case repository.PropertyLike:
+		if pred.Type == "" {
+			pred.Type = repository.PropertyTypeString
+		}
+		if pred.Type != repository.PropertyTypeString {
+			return pred, fmt.Errorf("%w: operator like requires string type", ErrInvalidEquipmentQuery)
+		}
+		return pred, nil
+	case repository.PropertyEq, repository.PropertyNe,
+		repository.PropertyLt, repository.PropertyLte,
+		repository.PropertyGt, repository.PropertyGte:
+	default:
+		return pred, fmt.Errorf("%w: unsupported operator %q", ErrInvalidEquipmentQuery, p.Operator)
+	}
+
+	if pred.Type == "" {
+		pred.Type = repository.PropertyTypeString
+		if _, err := strconv.ParseFloat(p.Value, 64); err == nil {
+			pred.Type = repository.PropertyTypeNumber
+		}
+	}
+
+	var err error
+	switch pred.Type {
+	case repository.PropertyTypeString:
+	case repository.PropertyTypeNumber:
+		_, err = strconv.ParseFloat(p.Value, 64)
+	case repository.PropertyTypeDateTime:
+		if _, err = time.Parse(time.RFC3339, p.Value); err != nil {
+			_, err = time.Parse(time.DateOnly, p.Value)
+		}
+	case repository.PropertyTypeBoolean:
+		_, err = strconv.ParseBool(p.Value)
+	default:
+		return pred, fmt.Errorf("%w: unsupported value type %q", ErrInvalidEquipmentQuery, p.Type)
+	}
+	if err != nil {
+		return pred, fmt.Errorf("%w: property %s value %q is not a %s", ErrInvalidEquipmentQuery, p.ID, p.Value, pred.Type)
+	}
+	if pred.Type == repository.PropertyTypeNumber && pred.Unit != "" {
+		pred.UnitConversions = unitConversions(registry, pred.Unit)
+	}
+	return pred, nil
+}
@@ -0,0 +1,174 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+const (
+	// defaultTreeDepth глубина дерева оборудования по умолчанию
+	defaultTreeDepth = 3
+	// maxTreeDepth максимальная глубина загружаемого дерева оборудования
+	maxTreeDepth = 20
+)
+
+// GetEquipmentTreeInput входные параметры для GetEquipmentTree
+type GetEquipmentTreeInput struct {
+	ExternalID string
+	// Depth число загружаемых уровней дочернего оборудования
+	Depth int32
+	// AsOf момент, на который нужна иерархия; nil - текущая
+	AsOf *time.Time
+}
+
+// GetEquipmentTreeOutput выходные данные для GetEquipmentTree
+type GetEquipmentTreeOutput struct {
+	Equipment *model.Equipment
+}
+
+// GetEquipmentTreeUseCase use case для получения поддерева оборудования
+type GetEquipmentTreeUseCase struct {
+	equipmentRepo repository.EquipmentRepository
+	historyRepo   repository.EquipmentHistoryRepository
+}
+
+// NewGetEquipmentTreeUseCase создаёт новый use case
+func NewGetEquipmentTreeUseCase(equipmentRepo repository.EquipmentRepository, historyRepo repository.EquipmentHistoryRepository) *GetEquipmentTreeUseCase {
+	return &GetEquipmentTreeUseCase{
+		equipmentRepo: equipmentRepo,
+		historyRepo:   historyRepo,
+	}
+}
+
+// Execute выполняет use case
+func (uc *GetEquipmentTreeUseCase) Execute(ctx context.Context, input GetEquipmentTreeInput) (*GetEquipmentTreeOutput, error) {
+	if input.ExternalID == "" {
+		return nil, fmt.Errorf("external_id is required")
+	}
+
+	depth := input.Depth
+	switch {
+	case depth <= 0:
+		depth = defaultTreeDepth
+	case depth > maxTreeDepth:
+		depth = maxTreeDepth
+	}
+
+	var (
+		equipment *model.Equipment
+		err       error
+	)
+	if input.AsOf != nil {
+		equipment, err = uc.historyRepo.GetSubtreeAsOf(ctx, input.ExternalID, depth, *input.AsOf)
+	} else {
+		equipment, err = uc.equipmentRepo.GetSubtree(ctx, input.ExternalID, depth)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetEquipmentTreeOutput{Equipment: equipment}, nil
+}
+
+// MoveEquipmentInput входные параметры для MoveEquipment
+type MoveEquipmentInput struct {
+	ExternalID string
+	// ParentID новый родитель; пустая строка переносит оборудование в корень иерархии
+	ParentID string
+	// ExpectedVersion версия, с которой клиент начинал редактирование.
+	// nil означает безусловный перенос
+	ExpectedVersion *int64
+}
+
+// MoveEquipmentOutput выходные данные для MoveEquipment
+type MoveEquipmentOutput struct {
+	Equipment *model.Equipment
+	Events    []model.DomainEvent
+}
+
+// MoveEquipmentUseCase use case для переноса поддерева оборудования под другого родителя
+type MoveEquipmentUseCase struct {
+	uow repository.UnitOfWork
+}
+
+// NewMoveEquipmentUseCase создаёт новый use case
+func NewMoveEquipmentUseCase(uow repository.UnitOfWork) *MoveEquipmentUseCase {
+	return &MoveEquipmentUseCase{
+		uow: uow,
+	}
+}
+
+// Execute выполняет use case
+func (uc *MoveEquipmentUseCase) Execute(ctx context.Context, input MoveEquipmentInput) (*MoveEquipmentOutput, error) {
+	if input.ExternalID == "" {
+		return nil, fmt.Errorf("external_id is required")
+	}
+
+	var (
+		equipment *model.Equipment
+		events    []model.DomainEvent
+	)
+	err := uc.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		var err error
+		// Поддерево не нужно: связи внутри него при переносе не меняются
+		equipment, err = tx.Equipment().GetSubtree(ctx, input.ExternalID, 0)
+		if err != nil {
+			return err
+		}
+
+		expected := equipment.Version()
+		if input.ExpectedVersion != nil {
+			expected = *input.ExpectedVersion
+			if err := equipment.CheckVersion(expected); err != nil {
+				return err
+			}
+		}
+
+		ancestors, err := tx.Equipment().Ancestors(ctx, input.ExternalID)
+		if err != nil {
+			return err
+		}
+		var from *model.EquipmentID
+		if len(ancestors) > 0 {
+			from = &ancestors[len(ancestors)-1]
+		}
+
+		var (
+			parent *model.Equipment
+			path   []model.EquipmentID
+		)
+		if input.ParentID != "" {
+			if parent, err = tx.Equipment().GetSubtree(ctx, input.ParentID, 0); err != nil {
+				return err
+			}
+			if path, err = tx.Equipment().Ancestors(ctx, input.ParentID); err != nil {
+				return err
+			}
+		}
+
+		if err := equipment.MoveTo(from, parent, path); err != nil {
+			return err
+		}
+
+		var parentID *model.EquipmentID
+		if parent != nil {
+			id := parent.ID()
+			parentID = &id
+		}
+		// Репозиторий забирает события для outbox, копия нужна для ответа
+		events = equipment.Events()
+		return tx.Equipment().Move(ctx, equipment, parentID, expected)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MoveEquipmentOutput{
+		Equipment: equipment,
+		Events:    events,
+	}, nil
+}
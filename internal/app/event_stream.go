@@ -0,0 +1,410 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+var (
+	// ErrEventStreamUnavailable поток событий не запущен или остановлен
+	ErrEventStreamUnavailable = errors.New("event stream is not running")
+	// ErrEventStreamLagging подписчик не успевает читать события и отключён;
+	// переподключение с Last-Event-ID продолжит поток без потерь
+	ErrEventStreamLagging = errors.New("event stream subscriber is lagging behind")
+)
+
+// StreamEvent событие потока для подписчика
+type StreamEvent struct {
+	// Sequence номер события в outbox; передаётся клиенту как id события SSE
+	// и принимается обратно в Last-Event-ID
+	Sequence int64
+	Envelope EventEnvelope
+}
+
+// EventStreamFilter фильтр подписки на поток событий; пустые поля не ограничивают поток.
+// Если задан EquipmentIDs или Scope, события других агрегатов (классов) не передаются
+type EventStreamFilter struct {
+	// EquipmentIDs B2MML ID оборудования, события которого нужны
+	EquipmentIDs []string
+	// Scope B2MML ID узла иерархии: передаются события самого узла и его потомков.
+	// Принадлежность определяется по текущей иерархии, для перемещения учитывается
+	// и прежний родитель
+	Scope string
+	// EventTypes типы событий или префиксы вида "equipment.*"
+	EventTypes []string
+}
+
+// EventBroadcasterConfig параметры потока событий; нулевые значения
+// заменяются значениями по умолчанию
+type EventBroadcasterConfig struct {
+	// PollInterval пауза между чтениями outbox, когда новых событий нет
+	PollInterval time.Duration
+	// BatchSize максимальное число событий, читаемых за раз
+	BatchSize int32
+	// BufferSize размер очереди подписчика; при переполнении подписчик отключается
+	BufferSize int
+}
+
+// EventBroadcaster раздаёт доменные события из outbox подписчикам потока SSE.
+// Один экземпляр читает outbox и рассылает события всем подключённым клиентам;
+// клиент, переподключившийся с Last-Event-ID, сначала дочитывает пропущенные
+// события из outbox, затем переходит на общий поток. Возобновление возможно,
+// пока события хранятся в outbox (см. OutboxDispatcherConfig.Retention)
+type EventBroadcaster struct {
+	outboxRepo    repository.OutboxRepository
+	equipmentRepo repository.EquipmentRepository
+	cfg           EventBroadcasterConfig
+
+	mu          sync.Mutex
+	running     bool
+	head        repository.StreamPosition
+	subscribers map[*EventSubscription]struct{}
+}
+
+// NewEventBroadcaster создаёт поток событий
+func NewEventBroadcaster(outboxRepo repository.OutboxRepository, equipmentRepo repository.EquipmentRepository, cfg EventBroadcasterConfig) *EventBroadcaster {
+	cfg.PollInterval = cmp.Or(cfg.PollInterval, 500*time.Millisecond)
+	cfg.BatchSize = cmp.Or(cfg.BatchSize, 100)
+	cfg.BufferSize = cmp.Or(cfg.BufferSize, 256)
+	return &EventBroadcaster{
+		outboxRepo:    outboxRepo,
+		equipmentRepo: equipmentRepo,
+		cfg:           cfg,
+		subscribers:   make(map[*EventSubscription]struct{}),
+	}
+}
+
+// Run читает новые события outbox и рассылает их подписчикам до отмены ctx.
+// После остановки все подписки закрываются с ErrEventStreamUnavailable
+func (b *EventBroadcaster) Run(ctx context.Context) {
+	head, err := b.outboxRepo.StreamHead(ctx)
+	for err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Event stream start failed: %v", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(b.cfg.PollInterval):
+		}
+		head, err = b.outboxRepo.StreamHead(ctx)
+	}
+
+	b.mu.Lock()
+	b.head = head
+	b.running = true
+	b.mu.Unlock()
+	defer b.stop()
+
+	for {
+		n, err := b.pollOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Event stream poll failed: %v", err)
+		}
+		if err == nil && n == int(b.cfg.BatchSize) {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(b.cfg.PollInterval):
+		}
+	}
+}
+
+// pollOnce читает одну пачку событий после текущей позиции и рассылает её.
+// Иерархия событий запрашивается до блокировки, под блокировкой события
+// только раздаются подписчикам
+func (b *EventBroadcaster) pollOnce(ctx context.Context) (int, error) {
+	b.mu.Lock()
+	head, scoped := b.head, b.scopedLocked()
+	b.mu.Unlock()
+
+	events, err := b.outboxRepo.Stream(ctx, head, b.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	items := make([]*streamItem, 0, len(events))
+	for _, event := range events {
+		item := newStreamItem(event)
+		// Иерархия нужна только подписчикам с фильтром по узлу
+		if scoped {
+			if err := b.resolveHierarchy(ctx, item); err != nil {
+				return 0, err
+			}
+		}
+		items = append(items, item)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i, item := range items {
+		for sub := range b.subscribers {
+			if !item.event.Position().After(sub.position) {
+				// Подписчик уже получил событие при дочитывании
+				continue
+			}
+			if sub.filter.Scope != "" && !item.resolved() {
+				// Подписчик с фильтром по узлу подключился после чтения пачки:
+				// остаток пачки будет перечитан с иерархией при следующем опросе
+				return i, nil
+			}
+			sub.position = item.event.Position()
+			if !sub.filter.matches(item) {
+				continue
+			}
+			select {
+			case sub.events <- item.streamEvent():
+			default:
+				b.removeLocked(sub, ErrEventStreamLagging)
+			}
+		}
+		b.head = item.event.Position()
+	}
+	return len(items), nil
+}
+
+// scopedLocked сообщает, есть ли подписчики с фильтром по узлу иерархии
+func (b *EventBroadcaster) scopedLocked() bool {
+	for sub := range b.subscribers {
+		if sub.filter.Scope != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// stop закрывает все подписки при остановке потока
+func (b *EventBroadcaster) stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.running = false
+	for sub := range b.subscribers {
+		b.removeLocked(sub, ErrEventStreamUnavailable)
+	}
+}
+
+func (b *EventBroadcaster) removeLocked(sub *EventSubscription, err error) {
+	delete(b.subscribers, sub)
+	sub.err = err
+	close(sub.events)
+}
+
+// Subscribe подписывается на поток событий до отмены ctx. lastEventID - номер
+// последнего полученного клиентом события (заголовок Last-Event-ID); nil -
+// только новые события
+func (b *EventBroadcaster) Subscribe(ctx context.Context, filter EventStreamFilter, lastEventID *int64) (*EventSubscription, error) {
+	for _, eventType := range filter.EventTypes {
+		if err := model.ValidateEventTypeFilter(eventType); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range filter.EquipmentIDs {
+		if _, err := model.NewEquipmentID(id); err != nil {
+			return nil, err
+		}
+	}
+	if filter.Scope != "" {
+		if _, err := b.equipmentRepo.GetByExternalID(ctx, filter.Scope); err != nil {
+			return nil, err
+		}
+	}
+
+	b.mu.Lock()
+	running, position := b.running, b.head
+	b.mu.Unlock()
+	if !running {
+		return nil, ErrEventStreamUnavailable
+	}
+	if lastEventID != nil {
+		var err error
+		if position, err = b.outboxRepo.PositionOf(ctx, *lastEventID); err != nil {
+			return nil, err
+		}
+	}
+
+	sub := &EventSubscription{
+		filter:   filter,
+		position: position,
+		events:   make(chan *StreamEvent, b.cfg.BufferSize),
+	}
+	go b.serve(ctx, sub)
+	return sub, nil
+}
+
+// serve дочитывает пропущенные подписчиком события из outbox, подключает его
+// к общему потоку и отключает после отмены ctx
+func (b *EventBroadcaster) serve(ctx context.Context, sub *EventSubscription) {
+	if err := b.catchUp(ctx, sub); err != nil {
+		// Подписчик ещё не подключён к общему потоку: канал закрывается здесь
+		if ctx.Err() == nil {
+			log.Printf("Event stream replay failed: %v", err)
+		}
+		sub.err = err
+		close(sub.events)
+		return
+	}
+
+	<-ctx.Done()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscribers[sub]; ok {
+		b.removeLocked(sub, ctx.Err())
+	}
+}
+
+// catchUp передаёт подписчику события после его позиции, пока не догонит
+// общий поток, и регистрирует его. Регистрация выполняется под блокировкой,
+// когда позиция подписчика не отстаёт от общего потока, поэтому события не
+// теряются и не повторяются
+func (b *EventBroadcaster) catchUp(ctx context.Context, sub *EventSubscription) error {
+	for {
+		b.mu.Lock()
+		if !b.running {
+			b.mu.Unlock()
+			return ErrEventStreamUnavailable
+		}
+		if !b.head.After(sub.position) {
+			b.subscribers[sub] = struct{}{}
+			b.mu.Unlock()
+			return nil
+		}
+		b.mu.Unlock()
+
+		events, err := b.outboxRepo.Stream(ctx, sub.position, b.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			item := newStreamItem(event)
+			if sub.filter.Scope != "" {
+				if err := b.resolveHierarchy(ctx, item); err != nil {
+					return err
+				}
+			}
+			if sub.filter.matches(item) {
+				select {
+				case sub.events <- item.streamEvent():
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			sub.position = event.Position()
+		}
+		if len(events) == 0 {
+			// Общий поток ещё не дошёл до позиции подписчика
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(b.cfg.PollInterval):
+			}
+		}
+	}
+}
+
+// resolveHierarchy заполняет узлы иерархии, к которым относится событие
+// оборудования, одним запросом к репозиторию
+func (b *EventBroadcaster) resolveHierarchy(ctx context.Context, item *streamItem) error {
+	if item.resolved() {
+		return nil
+	}
+
+	nodes := []string{item.event.AggregateID}
+	// Перемещённое оборудование относится и к прежнему родителю, удалённое -
+	// к родителю на момент удаления: текущих предков у него нет
+	if item.event.EventType == model.EventTypeEquipmentMoved || item.event.EventType == model.EventTypeEquipmentDeleted {
+		var payload struct {
+			OldParentID *string `json:"old_parent_id"`
+			ParentID    *string `json:"parent_id"`
+		}
+		if err := json.Unmarshal(item.event.Payload, &payload); err == nil && cmp.Or(payload.OldParentID, payload.ParentID) != nil {
+			nodes = append(nodes, *cmp.Or(payload.OldParentID, payload.ParentID))
+		}
+	}
+
+	hierarchy, err := b.equipmentRepo.Hierarchy(ctx, nodes)
+	if err != nil {
+		return err
+	}
+	for _, node := range hierarchy {
+		nodes = append(nodes, node.String())
+	}
+	item.hierarchy = nodes
+	return nil
+}
+
+// EventSubscription подписка на поток событий
+type EventSubscription struct {
+	filter EventStreamFilter
+	// position позиция последнего просмотренного подписчиком события
+	position repository.StreamPosition
+	events   chan *StreamEvent
+	err      error
+}
+
+// Events возвращает канал событий; канал закрывается при завершении подписки
+func (s *EventSubscription) Events() <-chan *StreamEvent {
+	return s.events
+}
+
+// Err возвращает причину завершения подписки после закрытия канала событий
+func (s *EventSubscription) Err() error {
+	return s.err
+}
+
+// streamItem событие outbox при рассылке подписчикам
+type streamItem struct {
+	event *repository.OutboxEvent
+	// hierarchy оборудование события и его предки; заполняется только для
+	// подписчиков с фильтром по узлу иерархии
+	hierarchy []string
+}
+
+func newStreamItem(event *repository.OutboxEvent) *streamItem {
+	return &streamItem{event: event}
+}
+
+// resolved сообщает, что иерархия события известна или не нужна
+func (i *streamItem) resolved() bool {
+	return i.hierarchy != nil || i.event.AggregateType != model.AggregateTypeEquipment
+}
+
+func (i *streamItem) streamEvent() *StreamEvent {
+	return &StreamEvent{Sequence: i.event.Sequence, Envelope: NewEventEnvelope(i.event)}
+}
+
+// matches сообщает, проходит ли событие через фильтр
+func (f *EventStreamFilter) matches(item *streamItem) bool {
+	if len(f.EventTypes) > 0 && !slices.ContainsFunc(f.EventTypes, func(filter string) bool {
+		return model.MatchEventType(filter, item.event.EventType)
+	}) {
+		return false
+	}
+	if len(f.EquipmentIDs) == 0 && f.Scope == "" {
+		return true
+	}
+	if item.event.AggregateType != model.AggregateTypeEquipment {
+		return false
+	}
+	if len(f.EquipmentIDs) > 0 && !slices.Contains(f.EquipmentIDs, item.event.AggregateID) {
+		return false
+	}
+	if f.Scope != "" && !slices.Contains(item.hierarchy, f.Scope) {
+		return false
+	}
+	return true
+}
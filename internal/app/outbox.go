@@ -0,0 +1,184 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// EventSink получатель доменных событий из outbox (лог, webhook, брокер...).
+// Доставка выполняется не менее одного раза, поэтому получатель должен
+// отбрасывать повторы по EventID
+type EventSink interface {
+	// Name возвращает имя получателя для журналов и сообщений об ошибках
+	Name() string
+	// Deliver доставляет событие; ошибка означает повторную попытку позже
+	Deliver(ctx context.Context, event *repository.OutboxEvent) error
+}
+
+// EventEnvelope внешнее представление доменного события для webhook и потока SSE.
+// Data содержит поля события (outbox payload)
+type EventEnvelope struct {
+	// ID идентификатор события; одинаков во всех повторах доставки
+	ID            uuid.UUID       `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type,omitempty"`
+	AggregateID   string          `json:"aggregate_id,omitempty"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// NewEventEnvelope создаёт внешнее представление события из outbox
+func NewEventEnvelope(event *repository.OutboxEvent) EventEnvelope {
+	return EventEnvelope{
+		ID:            event.EventID,
+		Type:          event.EventType,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		OccurredAt:    event.OccurredAt.UTC(),
+		Data:          event.Payload,
+	}
+}
+
+// OutboxDispatcherConfig параметры диспетчера outbox; нулевые значения
+// заменяются значениями по умолчанию
+type OutboxDispatcherConfig struct {
+	// BatchSize максимальное число событий, захватываемых за один проход
+	BatchSize int32
+	// PollInterval пауза между проходами, когда готовых событий нет
+	PollInterval time.Duration
+	// Lease время, на которое захватываются события; по его истечении
+	// недоставленные события снова доступны другим экземплярам
+	Lease time.Duration
+	// MinBackoff задержка перед первой повторной попыткой, далее удваивается
+	MinBackoff time.Duration
+	// MaxBackoff верхняя граница задержки между попытками
+	MaxBackoff time.Duration
+	// Retention сколько хранить доставленные события; отрицательное значение - не удалять
+	Retention time.Duration
+}
+
+// outboxPurgeInterval период удаления доставленных событий
+const outboxPurgeInterval = time.Hour
+
+// OutboxDispatcher фоновая доставка событий из outbox получателям.
+// За проход для каждого агрегата берётся только самое раннее недоставленное
+// событие, поэтому события агрегата доставляются по порядку, а разные
+// агрегаты - параллельно. Неудачная доставка повторяется с экспоненциальной
+// задержкой, следующие события агрегата ждут её успеха
+type OutboxDispatcher struct {
+	repo  repository.OutboxRepository
+	sinks []EventSink
+	cfg   OutboxDispatcherConfig
+}
+
+// NewOutboxDispatcher создаёт диспетчер outbox
+func NewOutboxDispatcher(repo repository.OutboxRepository, cfg OutboxDispatcherConfig, sinks ...EventSink) *OutboxDispatcher {
+	cfg.BatchSize = cmp.Or(cfg.BatchSize, 100)
+	cfg.PollInterval = cmp.Or(cfg.PollInterval, time.Second)
+	cfg.Lease = cmp.Or(cfg.Lease, time.Minute)
+	cfg.MinBackoff = cmp.Or(cfg.MinBackoff, time.Second)
+	cfg.MaxBackoff = max(cmp.Or(cfg.MaxBackoff, time.Hour), cfg.MinBackoff)
+	cfg.Retention = cmp.Or(cfg.Retention, 7*24*time.Hour)
+	return &OutboxDispatcher{repo: repo, sinks: sinks, cfg: cfg}
+}
+
+// Run доставляет события до отмены ctx
+func (d *OutboxDispatcher) Run(ctx context.Context) {
+	var lastPurge time.Time
+	for {
+		n, err := d.DispatchOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Outbox dispatch failed: %v", err)
+		}
+
+		if d.cfg.Retention > 0 && time.Since(lastPurge) >= outboxPurgeInterval {
+			if _, err := d.repo.PurgeDispatched(ctx, time.Now().Add(-d.cfg.Retention)); err != nil && ctx.Err() == nil {
+				log.Printf("Outbox purge failed: %v", err)
+			}
+			lastPurge = time.Now()
+		}
+
+		// Полная пачка - вероятно, есть ещё готовые события
+		if err == nil && n == int(d.cfg.BatchSize) {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.cfg.PollInterval):
+		}
+	}
+}
+
+// DispatchOnce захватывает одну пачку событий, доставляет их и возвращает
+// число обработанных событий
+func (d *OutboxDispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	events, err := d.repo.Claim(ctx, d.cfg.BatchSize, time.Now().Add(d.cfg.Lease))
+	if err != nil {
+		return 0, err
+	}
+
+	// В пачке не больше одного события на агрегат, поэтому их можно
+	// доставлять параллельно без нарушения порядка
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, event := range events {
+		wg.Go(func() {
+			if err := d.dispatch(ctx, event); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return len(events), errors.Join(errs...)
+}
+
+// dispatch доставляет событие всем получателям и сохраняет результат.
+// При ошибке любого получателя событие повторяется целиком
+func (d *OutboxDispatcher) dispatch(ctx context.Context, event *repository.OutboxEvent) error {
+	var errs []error
+	for _, sink := range d.sinks {
+		if err := sink.Deliver(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		log.Printf("Outbox event %s (%s %s/%s) delivery failed, attempt %d: %v",
+			event.EventID, event.EventType, event.AggregateType, event.AggregateID, event.Attempts+1, err)
+		return d.repo.MarkFailed(ctx, event.EventID, time.Now().Add(retryBackoff(d.cfg.MinBackoff, d.cfg.MaxBackoff, event.Attempts)), err)
+	}
+	return d.repo.MarkDispatched(ctx, event.EventID)
+}
+
+// retryBackoff возвращает задержку перед следующей попыткой: minDelay,
+// удваиваемый с каждой сделанной попыткой до maxDelay, со случайным разбросом до 10%
+func retryBackoff(minDelay, maxDelay time.Duration, attempts int32) time.Duration {
+	delay := minDelay
+	for range attempts {
+		if delay >= maxDelay/2 {
+			delay = maxDelay
+			break
+		}
+		delay *= 2
+	}
+	return delay + rand.N(delay/10+1)
+}
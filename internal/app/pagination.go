@@ -0,0 +1,27 @@
+package app
+
+const (
+	// defaultPageLimit размер страницы по умолчанию
+	defaultPageLimit = 10
+	// maxPageLimit максимальный размер страницы
+	maxPageLimit = 100
+)
+
+// normalizeLimit приводит размер страницы к допустимому значению
+func normalizeLimit(limit int32) int32 {
+	if limit <= 0 {
+		return defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return limit
+}
+
+// normalizePage приводит параметры offset-пагинации к допустимым значениям
+func normalizePage(limit, offset int32) (int32, int32) {
+	if offset < 0 {
+		offset = 0
+	}
+	return normalizeLimit(limit), offset
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// ListPersonsInput входные параметры для ListPersons
+type ListPersonsInput struct {
+	Limit  int32
+	Offset int32
+}
+
+// ListPersonsOutput выходные данные для ListPersons
+type ListPersonsOutput struct {
+	Items []*model.Person
+	Count int
+}
+
+// ListPersonsUseCase use case для получения списка сотрудников
+type ListPersonsUseCase struct {
+	personRepo repository.PersonRepository
+}
+
+// NewListPersonsUseCase создаёт новый use case
+func NewListPersonsUseCase(personRepo repository.PersonRepository) *ListPersonsUseCase {
+	return &ListPersonsUseCase{
+		personRepo: personRepo,
+	}
+}
+
+// Execute выполняет use case
+func (uc *ListPersonsUseCase) Execute(ctx context.Context, input ListPersonsInput) (*ListPersonsOutput, error) {
+	input.Limit, input.Offset = normalizePage(input.Limit, input.Offset)
+
+	items, err := uc.personRepo.List(ctx, input.Limit, input.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListPersonsOutput{
+		Items: items,
+		Count: len(items),
+	}, nil
+}
+
+// CreatePersonInput входные параметры для CreatePerson
+type CreatePersonInput struct {
+	Person *b2mml.PersonType
+}
+
+// CreatePersonOutput выходные данные для CreatePerson
+type CreatePersonOutput struct {
+	Person *model.Person
+}
+
+// CreatePersonUseCase use case для создания сотрудника
+type CreatePersonUseCase struct {
+	uow repository.UnitOfWork
+}
+
+// NewCreatePersonUseCase создаёт новый use case
+func NewCreatePersonUseCase(uow repository.UnitOfWork) *CreatePersonUseCase {
+	return &CreatePersonUseCase{
+		uow: uow,
+	}
+}
+
+// Execute выполняет use case
+func (uc *CreatePersonUseCase) Execute(ctx context.Context, input CreatePersonInput) (*CreatePersonOutput, error) {
+	person, err := model.NewPersonFromB2MML(input.Person)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		_, err := tx.Person().GetByExternalID(ctx, person.ID().String())
+		switch {
+		case err == nil:
+			return model.ErrPersonAlreadyExists
+		case !errors.Is(err, model.ErrPersonNotFound):
+			return err
+		}
+		return tx.Person().Create(ctx, person)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreatePersonOutput{Person: person}, nil
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+const (
+	// maxSearchQueryLength максимальная длина поискового запроса в символах
+	maxSearchQueryLength = 200
+	// maxSearchTerms максимальное число слов в поисковом запросе
+	maxSearchTerms = 10
+)
+
+// ErrInvalidSearchQuery возвращается для пустого или слишком длинного поискового запроса
+var ErrInvalidSearchQuery = errors.New("invalid search query")
+
+// SearchInput входные параметры для Search
+type SearchInput struct {
+	Query    string
+	Language string
+	// Kinds типы объектов для поиска; пустой список - все типы
+	Kinds []string
+	Limit int32
+}
+
+// SearchOutput выходные данные для Search
+type SearchOutput struct {
+	Items []*repository.SearchHit
+}
+
+// SearchUseCase use case для полнотекстового поиска по оборудованию,
+// классам оборудования и физическим активам
+type SearchUseCase struct {
+	searchRepo repository.SearchRepository
+}
+
+// NewSearchUseCase создаёт новый use case
+func NewSearchUseCase(searchRepo repository.SearchRepository) *SearchUseCase {
+	return &SearchUseCase{
+		searchRepo: searchRepo,
+	}
+}
+
+// Execute выполняет use case
+func (uc *SearchUseCase) Execute(ctx context.Context, input SearchInput) (*SearchOutput, error) {
+	query, err := input.toQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := uc.searchRepo.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchOutput{Items: items}, nil
+}
+
+// toQuery проверяет входные параметры и преобразует их в запрос к репозиторию
+func (input SearchInput) toQuery() (repository.SearchQuery, error) {
+	text := strings.TrimSpace(input.Query)
+	if text == "" {
+		return repository.SearchQuery{}, fmt.Errorf("%w: query is empty", ErrInvalidSearchQuery)
+	}
+	if utf8.RuneCountInString(text) > maxSearchQueryLength {
+		return repository.SearchQuery{}, fmt.Errorf("%w: query is longer than %d characters", ErrInvalidSearchQuery, maxSearchQueryLength)
+	}
+	terms := strings.Fields(text)
+	if len(terms) > maxSearchTerms {
+		return repository.SearchQuery{}, fmt.Errorf("%w: query has more than %d words", ErrInvalidSearchQuery, maxSearchTerms)
+	}
+
+	query := repository.SearchQuery{
+		Terms:    terms,
+		Language: input.Language,
+		Kinds:    repository.SearchKinds,
+		Limit:    normalizeLimit(input.Limit),
+	}
+	if len(input.Kinds) > 0 {
+		query.Kinds = nil
+		for _, kind := range input.Kinds {
+			if !isSearchKind(kind) {
+				return repository.SearchQuery{}, fmt.Errorf("%w: unknown kind %q", ErrInvalidSearchQuery, kind)
+			}
+			query.Kinds = append(query.Kinds, repository.SearchKind(kind))
+		}
+	}
+	return query, nil
+}
+
+func isSearchKind(kind string) bool {
+	for _, k := range repository.SearchKinds {
+		if string(k) == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"context"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// ListUnitsInput входные параметры для ListUnits
+type ListUnitsInput struct {
+	// Kind вид величины (power, pressure...); пустой - все единицы
+	Kind string
+}
+
+// ListUnitsOutput выходные данные для ListUnits
+type ListUnitsOutput struct {
+	Units []*model.Unit
+}
+
+// ListUnitsUseCase use case для получения справочника единиц измерения
+type ListUnitsUseCase struct {
+	registry *model.UnitRegistry
+}
+
+// NewListUnitsUseCase создаёт новый use case
+func NewListUnitsUseCase(registry *model.UnitRegistry) *ListUnitsUseCase {
+	return &ListUnitsUseCase{registry: registry}
+}
+
+// Execute выполняет use case
+func (uc *ListUnitsUseCase) Execute(ctx context.Context, input ListUnitsInput) (*ListUnitsOutput, error) {
+	return &ListUnitsOutput{Units: uc.registry.Units(model.QuantityKind(input.Kind))}, nil
+}
+
+// ConvertUnitInput входные параметры для ConvertUnit
+type ConvertUnitInput struct {
+	Value float64
+	From  string
+	To    string
+}
+
+// ConvertUnitOutput выходные данные для ConvertUnit
+type ConvertUnitOutput struct {
+	Value float64
+	From  *model.Unit
+	To    *model.Unit
+}
+
+// ConvertUnitUseCase use case для перевода значения между единицами измерения
+type ConvertUnitUseCase struct {
+	registry *model.UnitRegistry
+}
+
+// NewConvertUnitUseCase создаёт новый use case
+func NewConvertUnitUseCase(registry *model.UnitRegistry) *ConvertUnitUseCase {
+	return &ConvertUnitUseCase{registry: registry}
+}
+
+// Execute выполняет use case
+func (uc *ConvertUnitUseCase) Execute(ctx context.Context, input ConvertUnitInput) (*ConvertUnitOutput, error) {
+	from, err := uc.registry.Lookup(input.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := uc.registry.Lookup(input.To)
+	if err != nil {
+		return nil, err
+	}
+	factor, offset, err := model.Conversion(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &ConvertUnitOutput{
+		Value: input.Value*factor + offset,
+		From:  from,
+		To:    to,
+	}, nil
+}
+
+// SiteUnitsOutput предпочтительные единицы площадки
+type SiteUnitsOutput struct {
+	SiteID string
+	Units  model.UnitPreferences
+}
+
+// GetSiteUnitsInput входные параметры для GetSiteUnits
+type GetSiteUnitsInput struct {
+	SiteID string
+}
+
+// GetSiteUnitsUseCase use case для получения предпочтительных единиц площадки
+type GetSiteUnitsUseCase struct {
+	equipmentRepo repository.EquipmentRepository
+	siteUnitRepo  repository.SiteUnitRepository
+}
+
+// NewGetSiteUnitsUseCase создаёт новый use case
+func NewGetSiteUnitsUseCase(
+	equipmentRepo repository.EquipmentRepository,
+	siteUnitRepo repository.SiteUnitRepository,
+) *GetSiteUnitsUseCase {
+	return &GetSiteUnitsUseCase{
+		equipmentRepo: equipmentRepo,
+		siteUnitRepo:  siteUnitRepo,
+	}
+}
+
+// Execute выполняет use case
+func (uc *GetSiteUnitsUseCase) Execute(ctx context.Context, input GetSiteUnitsInput) (*SiteUnitsOutput, error) {
+	site, err := uc.equipmentRepo.GetByExternalID(ctx, input.SiteID)
+	if err != nil {
+		return nil, err
+	}
+	if !site.IsSite() {
+		return nil, model.ErrEquipmentNotSite
+	}
+
+	units, err := uc.siteUnitRepo.Get(ctx, site.ID())
+	if err != nil {
+		return nil, err
+	}
+	return &SiteUnitsOutput{SiteID: site.ID().String(), Units: units}, nil
+}
+
+// SetSiteUnitsInput входные параметры для SetSiteUnits
+type SetSiteUnitsInput struct {
+	SiteID string
+	// Units предпочтительные единицы по видам величин; заменяют прежние целиком
+	Units map[string]string
+}
+
+// SetSiteUnitsUseCase use case для замены предпочтительных единиц площадки
+type SetSiteUnitsUseCase struct {
+	uow      repository.UnitOfWork
+	registry *model.UnitRegistry
+}
+
+// NewSetSiteUnitsUseCase создаёт новый use case
+func NewSetSiteUnitsUseCase(uow repository.UnitOfWork, registry *model.UnitRegistry) *SetSiteUnitsUseCase {
+	return &SetSiteUnitsUseCase{
+		uow:      uow,
+		registry: registry,
+	}
+}
+
+// Execute выполняет use case
+func (uc *SetSiteUnitsUseCase) Execute(ctx context.Context, input SetSiteUnitsInput) (*SiteUnitsOutput, error) {
+	prefs := make(model.UnitPreferences, len(input.Units))
+	for kind, code := range input.Units {
+		prefs[model.QuantityKind(kind)] = code
+	}
+	if err := uc.registry.CheckPreferences(prefs); err != nil {
+		return nil, err
+	}
+
+	var siteID model.EquipmentID
+	err := uc.uow.Do(ctx, func(tx repository.UnitOfWork) error {
+		site, err := tx.Equipment().GetByExternalID(ctx, input.SiteID)
+		if err != nil {
+			return err
+		}
+		if !site.IsSite() {
+			return model.ErrEquipmentNotSite
+		}
+		siteID = site.ID()
+		return tx.SiteUnits().Replace(ctx, siteID, prefs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SiteUnitsOutput{SiteID: siteID.String(), Units: prefs}, nil
+}
+
+// unitConversions возвращает перевод значений во всех известных единицах той же
+// размерности в unit, включая их коды UN/ECE и синонимы. Для неизвестной единицы
+// или без справочника - nil
+func unitConversions(registry *model.UnitRegistry, unit string) []repository.UnitConversion {
+	if registry == nil {
+		return nil
+	}
+	target, err := registry.Lookup(unit)
+	if err != nil {
+		return nil
+	}
+
+	conversions := []repository.UnitConversion{{Unit: unit, Factor: 1}}
+	seen := map[string]bool{unit: true}
+	for _, u := range registry.Compatible(target) {
+		factor, offset, err := model.Conversion(u, target)
+		if err != nil {
+			continue
+		}
+		for _, spelling := range registry.Spellings(u) {
+			if seen[spelling] {
+				continue
+			}
+			seen[spelling] = true
+			conversions = append(conversions, repository.UnitConversion{
+				Unit:   spelling,
+				Factor: factor,
+				Offset: offset,
+			})
+		}
+	}
+	return conversions
+}
+
+// normalizeProperties переводит значения эффективных свойств в предпочтительные единицы
+func normalizeProperties(registry *model.UnitRegistry, props []*model.EffectiveProperty, prefs model.UnitPreferences) {
+	for _, prop := range props {
+		if value, ok := registry.Normalize(prop.Value, prefs); ok {
+			prop.Value = value
+		}
+	}
+}
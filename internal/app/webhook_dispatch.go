@@ -0,0 +1,191 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// WebhookSender отправляет доставку подписчику
+type WebhookSender interface {
+	// Send отправляет тело доставки на адрес подписки и возвращает HTTP статус
+	// ответа (0, если ответа нет). Ошибка означает, что доставка не удалась
+	Send(ctx context.Context, subscription *model.WebhookSubscription, delivery *repository.WebhookDelivery) (int, error)
+}
+
+// WebhookSink получатель outbox, ставящий события в очередь доставки
+// каждой активной подписке с подходящим фильтром
+type WebhookSink struct {
+	webhookRepo repository.WebhookRepository
+}
+
+// NewWebhookSink создаёт получателя outbox для webhook
+func NewWebhookSink(webhookRepo repository.WebhookRepository) *WebhookSink {
+	return &WebhookSink{webhookRepo: webhookRepo}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Deliver(ctx context.Context, event *repository.OutboxEvent) error {
+	subscriptions, err := s.webhookRepo.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	var payload json.RawMessage
+	deliveries := make([]*repository.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event.EventType) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(NewEventEnvelope(event)); err != nil {
+				return fmt.Errorf("failed to encode webhook payload: %w", err)
+			}
+		}
+		deliveries = append(deliveries, &repository.WebhookDelivery{
+			SubscriptionID: subscription.ID(),
+			EventID:        event.EventID,
+			EventType:      event.EventType,
+			Payload:        payload,
+		})
+	}
+	return s.webhookRepo.Enqueue(ctx, deliveries...)
+}
+
+// WebhookDispatcherConfig параметры отправки webhook; нулевые значения
+// заменяются значениями по умолчанию
+type WebhookDispatcherConfig struct {
+	BatchSize    int32
+	PollInterval time.Duration
+	Lease        time.Duration
+	MinBackoff   time.Duration
+	MaxBackoff   time.Duration
+	// MaxAttempts число попыток, после которого доставка уходит в dead letter
+	MaxAttempts int32
+	// Retention сколько хранить успешные доставки; отрицательное значение - не удалять
+	Retention time.Duration
+}
+
+// WebhookDispatcher фоновая отправка доставок webhook. Каждая доставка
+// повторяется независимо с экспоненциальной задержкой, поэтому недоступный
+// подписчик не задерживает остальных. Порядок доставок подписчику не
+// гарантируется: для упорядочивания используется occurred_at события
+type WebhookDispatcher struct {
+	webhookRepo repository.WebhookRepository
+	sender      WebhookSender
+	cfg         WebhookDispatcherConfig
+}
+
+// NewWebhookDispatcher создаёт диспетчер webhook
+func NewWebhookDispatcher(webhookRepo repository.WebhookRepository, sender WebhookSender, cfg WebhookDispatcherConfig) *WebhookDispatcher {
+	cfg.BatchSize = cmp.Or(cfg.BatchSize, 50)
+	cfg.PollInterval = cmp.Or(cfg.PollInterval, time.Second)
+	cfg.Lease = cmp.Or(cfg.Lease, time.Minute)
+	cfg.MinBackoff = cmp.Or(cfg.MinBackoff, 10*time.Second)
+	cfg.MaxBackoff = max(cmp.Or(cfg.MaxBackoff, 6*time.Hour), cfg.MinBackoff)
+	cfg.MaxAttempts = cmp.Or(cfg.MaxAttempts, 10)
+	cfg.Retention = cmp.Or(cfg.Retention, 7*24*time.Hour)
+	return &WebhookDispatcher{webhookRepo: webhookRepo, sender: sender, cfg: cfg}
+}
+
+// Run отправляет доставки до отмены ctx
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	var lastPurge time.Time
+	for {
+		n, err := d.DispatchOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Webhook dispatch failed: %v", err)
+		}
+
+		if d.cfg.Retention > 0 && time.Since(lastPurge) >= outboxPurgeInterval {
+			if _, err := d.webhookRepo.PurgeDelivered(ctx, time.Now().Add(-d.cfg.Retention)); err != nil && ctx.Err() == nil {
+				log.Printf("Webhook deliveries purge failed: %v", err)
+			}
+			lastPurge = time.Now()
+		}
+
+		if err == nil && n == int(d.cfg.BatchSize) {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.cfg.PollInterval):
+		}
+	}
+}
+
+// DispatchOnce захватывает одну пачку доставок, отправляет их и возвращает
+// число обработанных доставок
+func (d *WebhookDispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	deliveries, err := d.webhookRepo.ClaimDeliveries(ctx, d.cfg.BatchSize, time.Now().Add(d.cfg.Lease))
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions := make(map[uuid.UUID]*model.WebhookSubscription)
+	for _, delivery := range deliveries {
+		if _, ok := subscriptions[delivery.SubscriptionID]; ok {
+			continue
+		}
+		subscription, err := d.webhookRepo.Get(ctx, delivery.SubscriptionID)
+		if err != nil && !errors.Is(err, model.ErrWebhookNotFound) {
+			return 0, err
+		}
+		subscriptions[delivery.SubscriptionID] = subscription
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, delivery := range deliveries {
+		subscription := subscriptions[delivery.SubscriptionID]
+		if subscription == nil {
+			// Подписку удалили после захвата, доставки удалены вместе с ней
+			continue
+		}
+		wg.Go(func() {
+			if err := d.dispatch(ctx, subscription, delivery); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return len(deliveries), errors.Join(errs...)
+}
+
+func (d *WebhookDispatcher) dispatch(ctx context.Context, subscription *model.WebhookSubscription, delivery *repository.WebhookDelivery) error {
+	status, err := d.sender.Send(ctx, subscription, delivery)
+	if err == nil {
+		return d.webhookRepo.MarkDelivered(ctx, delivery.ID, status)
+	}
+
+	next := model.WebhookDeliveryPending
+	if delivery.Attempts+1 >= d.cfg.MaxAttempts {
+		next = model.WebhookDeliveryDead
+	}
+	log.Printf("Webhook delivery %s of event %s to %s failed, attempt %d, %s: %v",
+		delivery.ID, delivery.EventID, subscription.URL(), delivery.Attempts+1, next, err)
+	return d.webhookRepo.MarkFailed(ctx, delivery.ID, next,
+		time.Now().Add(retryBackoff(d.cfg.MinBackoff, d.cfg.MaxBackoff, delivery.Attempts)), status, err)
+}
@@ -0,0 +1,307 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// CreateWebhookInput входные параметры для CreateWebhook
+type CreateWebhookInput struct {
+	URL string
+	// Secret ключ подписи; пустой - сгенерировать
+	Secret      string
+	EventTypes  []string
+	Description string
+	// Active nil - подписка активна
+	Active *bool
+}
+
+// WebhookOutput подписка на события
+type WebhookOutput struct {
+	Subscription *model.WebhookSubscription
+}
+
+// CreateWebhookUseCase use case для создания подписки на события
+type CreateWebhookUseCase struct {
+	webhookRepo repository.WebhookRepository
+}
+
+// NewCreateWebhookUseCase создаёт новый use case
+func NewCreateWebhookUseCase(webhookRepo repository.WebhookRepository) *CreateWebhookUseCase {
+	return &CreateWebhookUseCase{webhookRepo: webhookRepo}
+}
+
+// Execute выполняет use case
+func (uc *CreateWebhookUseCase) Execute(ctx context.Context, input CreateWebhookInput) (*WebhookOutput, error) {
+	active := input.Active == nil || *input.Active
+	subscription, err := model.NewWebhookSubscription(input.URL, input.Secret, input.EventTypes, input.Description, active)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.webhookRepo.Create(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return &WebhookOutput{Subscription: subscription}, nil
+}
+
+// ListWebhooksOutput выходные данные для ListWebhooks
+type ListWebhooksOutput struct {
+	Items []*model.WebhookSubscription
+}
+
+// ListWebhooksUseCase use case для получения списка подписок
+type ListWebhooksUseCase struct {
+	webhookRepo repository.WebhookRepository
+}
+
+// NewListWebhooksUseCase создаёт новый use case
+func NewListWebhooksUseCase(webhookRepo repository.WebhookRepository) *ListWebhooksUseCase {
+	return &ListWebhooksUseCase{webhookRepo: webhookRepo}
+}
+
+// Execute выполняет use case
+func (uc *ListWebhooksUseCase) Execute(ctx context.Context) (*ListWebhooksOutput, error) {
+	items, err := uc.webhookRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ListWebhooksOutput{Items: items}, nil
+}
+
+// GetWebhookInput входные параметры для GetWebhook
+type GetWebhookInput struct {
+	ID uuid.UUID
+}
+
+// GetWebhookUseCase use case для получения подписки
+type GetWebhookUseCase struct {
+	webhookRepo repository.WebhookRepository
+}
+
+// NewGetWebhookUseCase создаёт новый use case
+func NewGetWebhookUseCase(webhookRepo repository.WebhookRepository) *GetWebhookUseCase {
+	return &GetWebhookUseCase{webhookRepo: webhookRepo}
+}
+
+// Execute выполняет use case
+func (uc *GetWebhookUseCase) Execute(ctx context.Context, input GetWebhookInput) (*WebhookOutput, error) {
+	subscription, err := uc.webhookRepo.Get(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookOutput{Subscription: subscription}, nil
+}
+
+// UpdateWebhookInput входные параметры для UpdateWebhook
+type UpdateWebhookInput struct {
+	ID          uuid.UUID
+	URL         string
+	EventTypes  []string
+	Description string
+	Active      bool
+}
+
+// UpdateWebhookUseCase use case для изменения подписки
+type UpdateWebhookUseCase struct {
+	webhookRepo repository.WebhookRepository
+}
+
+// NewUpdateWebhookUseCase создаёт новый use case
+func NewUpdateWebhookUseCase(webhookRepo repository.WebhookRepository) *UpdateWebhookUseCase {
+	return &UpdateWebhookUseCase{webhookRepo: webhookRepo}
+}
+
+// Execute выполняет use case
+func (uc *UpdateWebhookUseCase) Execute(ctx context.Context, input UpdateWebhookInput) (*WebhookOutput, error) {
+	subscription, err := uc.webhookRepo.Get(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := subscription.Update(input.URL, input.EventTypes, input.Description, input.Active); err != nil {
+		return nil, err
+	}
+	if err := uc.webhookRepo.Update(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return &WebhookOutput{Subscription: subscription}, nil
+}
+
+// DeleteWebhookInput входные параметры для DeleteWebhook
+type DeleteWebhookInput struct {
+	ID uuid.UUID
+}
+
+// DeleteWebhookUseCase use case для удаления подписки вместе с её доставками
+type DeleteWebhookUseCase struct {
+	webhookRepo repository.WebhookRepository
+}
+
+// NewDeleteWebhookUseCase создаёт новый use case
+func NewDeleteWebhookUseCase(webhookRepo repository.WebhookRepository) *DeleteWebhookUseCase {
+	return &DeleteWebhookUseCase{webhookRepo: webhookRepo}
+}
+
+// Execute выполняет use case
+func (uc *DeleteWebhookUseCase) Execute(ctx context.Context, input DeleteWebhookInput) error {
+	return uc.webhookRepo.Delete(ctx, input.ID)
+}
+
+// ListWebhookDeliveriesInput входные параметры для ListWebhookDeliveries
+type ListWebhookDeliveriesInput struct {
+	SubscriptionID uuid.UUID
+	// Status pending, delivered или dead (dead letter); пустой - все
+	Status string
+	Limit  int32
+}
+
+// ListWebhookDeliveriesOutput выходные данные для ListWebhookDeliveries
+type ListWebhookDeliveriesOutput struct {
+	Items []*repository.WebhookDelivery
+}
+
+// ListWebhookDeliveriesUseCase use case для просмотра доставок подписки, в том числе dead letter
+type ListWebhookDeliveriesUseCase struct {
+	webhookRepo repository.WebhookRepository
+}
+
+// NewListWebhookDeliveriesUseCase создаёт новый use case
+func NewListWebhookDeliveriesUseCase(webhookRepo repository.WebhookRepository) *ListWebhookDeliveriesUseCase {
+	return &ListWebhookDeliveriesUseCase{webhookRepo: webhookRepo}
+}
+
+// Execute выполняет use case
+func (uc *ListWebhookDeliveriesUseCase) Execute(ctx context.Context, input ListWebhookDeliveriesInput) (*ListWebhookDeliveriesOutput, error) {
+	var status model.WebhookDeliveryStatus
+	if input.Status != "" {
+		var err error
+		if status, err = model.ParseWebhookDeliveryStatus(input.Status); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := uc.webhookRepo.Get(ctx, input.SubscriptionID); err != nil {
+		return nil, err
+	}
+
+	items, err := uc.webhookRepo.ListDeliveries(ctx, input.SubscriptionID, status, normalizeLimit(input.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &ListWebhookDeliveriesOutput{Items: items}, nil
+}
+
+// ReplayWebhookDeliveryInput входные параметры для ReplayWebhookDelivery
+type ReplayWebhookDeliveryInput struct {
+	SubscriptionID uuid.UUID
+	DeliveryID     uuid.UUID
+}
+
+// ReplayWebhookDeliveryOutput выходные данные для ReplayWebhookDelivery
+type ReplayWebhookDeliveryOutput struct {
+	Delivery *repository.WebhookDelivery
+}
+
+// ReplayWebhookDeliveryUseCase use case для повторной отправки доставки из dead letter
+type ReplayWebhookDeliveryUseCase struct {
+	webhookRepo repository.WebhookRepository
+}
+
+// NewReplayWebhookDeliveryUseCase создаёт новый use case
+func NewReplayWebhookDeliveryUseCase(webhookRepo repository.WebhookRepository) *ReplayWebhookDeliveryUseCase {
+	return &ReplayWebhookDeliveryUseCase{webhookRepo: webhookRepo}
+}
+
+// Execute выполняет use case
+func (uc *ReplayWebhookDeliveryUseCase) Execute(ctx context.Context, input ReplayWebhookDeliveryInput) (*ReplayWebhookDeliveryOutput, error) {
+	delivery, err := uc.webhookRepo.GetDelivery(ctx, input.DeliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.SubscriptionID != input.SubscriptionID {
+		return nil, model.ErrWebhookDeliveryNotFound
+	}
+	if err := uc.webhookRepo.Replay(ctx, input.DeliveryID); err != nil {
+		return nil, err
+	}
+
+	if delivery, err = uc.webhookRepo.GetDelivery(ctx, input.DeliveryID); err != nil {
+		return nil, err
+	}
+	return &ReplayWebhookDeliveryOutput{Delivery: delivery}, nil
+}
+
+// WebhookPingEventType тип проверочного события, отправляемого PingWebhook
+const WebhookPingEventType = "webhook.ping"
+
+// PingWebhookInput входные параметры для PingWebhook
+type PingWebhookInput struct {
+	ID uuid.UUID
+}
+
+// PingWebhookOutput результат проверочной отправки
+type PingWebhookOutput struct {
+	Delivered bool
+	// StatusCode HTTP статус ответа подписчика; 0, если ответа нет
+	StatusCode int
+	Error      string
+	Duration   time.Duration
+}
+
+// PingWebhookUseCase use case для синхронной отправки проверочного события
+// подписчику. Отправка не сохраняется и не повторяется
+type PingWebhookUseCase struct {
+	webhookRepo repository.WebhookRepository
+	sender      WebhookSender
+}
+
+// NewPingWebhookUseCase создаёт новый use case
+func NewPingWebhookUseCase(webhookRepo repository.WebhookRepository, sender WebhookSender) *PingWebhookUseCase {
+	return &PingWebhookUseCase{webhookRepo: webhookRepo, sender: sender}
+}
+
+// Execute выполняет use case
+func (uc *PingWebhookUseCase) Execute(ctx context.Context, input PingWebhookInput) (*PingWebhookOutput, error) {
+	subscription, err := uc.webhookRepo.Get(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(struct {
+		SubscriptionID uuid.UUID `json:"subscription_id"`
+	}{subscription.ID()})
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(EventEnvelope{
+		ID:         uuid.New(),
+		Type:       WebhookPingEventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	delivery := &repository.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID(),
+		EventType:      WebhookPingEventType,
+		Payload:        payload,
+	}
+
+	start := time.Now()
+	status, err := uc.sender.Send(ctx, subscription, delivery)
+	output := &PingWebhookOutput{
+		Delivered:  err == nil,
+		StatusCode: status,
+		Duration:   time.Since(start),
+	}
+	if err != nil {
+		output.Error = err.Error()
+	}
+	return output, nil
+}
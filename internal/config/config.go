@@ -12,6 +12,13 @@ type Config struct {
 	Server   ServerConfig
 	Database DatabaseConfig
 	Log      LogConfig
+	Outbox   OutboxConfig
+	Webhook  WebhookConfig
+	Events   EventStreamConfig
+	Deletion DeletionConfig
+	B2MML    B2MMLConfig
+	Import   ImportConfig
+	Export   ExportConfig
 }
 
 // ServerConfig конфигурация сервера
@@ -25,11 +32,14 @@ type ServerConfig struct {
 
 // DatabaseConfig конфигурация БД
 type DatabaseConfig struct {
-	URL            string
-	MaxOpenConns   int
-	MaxIdleConns   int
-	ConnMaxLifeMS  int
+	URL           string
+	MaxOpenConns  int
+	MaxIdleConns  int
+	ConnMaxLifeMS int
+	// MigrationsPath каталог с миграциями; пустое значение - миграции, встроенные в бинарник
 	MigrationsPath string
+	// AutoMigrate применять миграции при старте сервера
+	AutoMigrate bool
 }
 
 // LogConfig конфигурация логирования
@@ -37,6 +47,82 @@ type LogConfig struct {
 	Level string // debug, info, warn, error
 }
 
+// OutboxConfig конфигурация доставки доменных событий из outbox
+type OutboxConfig struct {
+	// Enabled запускать фоновый диспетчер outbox вместе с сервером
+	Enabled      bool
+	BatchSize    int
+	PollInterval time.Duration
+	// Lease время захвата пачки событий одним экземпляром сервера
+	Lease      time.Duration
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Retention срок хранения доставленных событий; отрицательный - хранить всегда
+	Retention time.Duration
+}
+
+// WebhookConfig конфигурация отправки webhook
+type WebhookConfig struct {
+	// Enabled ставить события в очередь webhook и отправлять их
+	Enabled      bool
+	Timeout      time.Duration
+	BatchSize    int
+	PollInterval time.Duration
+	MinBackoff   time.Duration
+	MaxBackoff   time.Duration
+	// MaxAttempts число попыток до переноса доставки в dead letter
+	MaxAttempts int
+	Retention   time.Duration
+}
+
+// EventStreamConfig конфигурация потока событий SSE
+type EventStreamConfig struct {
+	// Enabled обслуживать GET /api/v1/events/stream
+	Enabled      bool
+	PollInterval time.Duration
+	BatchSize    int
+	// BufferSize очередь событий клиента; отстающий клиент отключается
+	BufferSize int
+	Heartbeat  time.Duration
+}
+
+// DeletionConfig конфигурация окончательного удаления удалённого оборудования
+type DeletionConfig struct {
+	// Retention срок хранения удалённого оборудования; 0 - хранить до ручного purge
+	Retention      time.Duration
+	PurgeInterval  time.Duration
+	PurgeBatchSize int
+}
+
+// B2MMLConfig конфигурация обмена сообщениями B2MML
+type B2MMLConfig struct {
+	// LogicalID идентификатор сервера в Sender ответных документов
+	LogicalID string
+	// ProfilePath файл MasterDataProfile с каналами сообщений; пусто - без каналов
+	ProfilePath string
+	// PollInterval период опроса папок обмена (каналы file://)
+	PollInterval time.Duration
+}
+
+// ImportConfig конфигурация заданий импорта оборудования
+type ImportConfig struct {
+	// Enabled выполнять задания из очереди вместе с сервером
+	Enabled bool
+	// ChunkSize число строк, фиксируемых в одной транзакции
+	ChunkSize int
+	// MaxSize максимальный размер исходного файла в байтах
+	MaxSize      int
+	PollInterval time.Duration
+	// Lease время захвата задания; продлевается с каждой пачкой строк
+	Lease time.Duration
+}
+
+// ExportConfig конфигурация выгрузки оборудования
+type ExportConfig struct {
+	// BatchSize число строк, читаемых из курсора выгрузки за раз
+	BatchSize int
+}
+
 // Load загружает конфигурацию из переменных окружения
 func Load() Config {
 	return Config{
@@ -52,11 +138,58 @@ func Load() Config {
 			MaxOpenConns:   getEnvInt("DATABASE_MAX_OPEN_CONNS", 25),
 			MaxIdleConns:   getEnvInt("DATABASE_MAX_IDLE_CONNS", 5),
 			ConnMaxLifeMS:  getEnvInt("DATABASE_CONN_MAX_LIFE_MS", 5*60*1000),
-			MigrationsPath: getEnv("DATABASE_MIGRATIONS_PATH", "file://internal/infrastructure/persistence/sqlc/migrations"),
+			MigrationsPath: getEnv("DATABASE_MIGRATIONS_PATH", ""),
+			AutoMigrate:    getEnvBool("DATABASE_AUTO_MIGRATE", false),
 		},
 		Log: LogConfig{
 			Level: getEnv("LOG_LEVEL", "info"),
 		},
+		Outbox: OutboxConfig{
+			Enabled:      getEnvBool("OUTBOX_ENABLED", true),
+			BatchSize:    getEnvInt("OUTBOX_BATCH_SIZE", 100),
+			PollInterval: getEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			Lease:        getEnvDuration("OUTBOX_LEASE", time.Minute),
+			MinBackoff:   getEnvDuration("OUTBOX_MIN_BACKOFF", time.Second),
+			MaxBackoff:   getEnvDuration("OUTBOX_MAX_BACKOFF", time.Hour),
+			Retention:    getEnvDuration("OUTBOX_RETENTION", 7*24*time.Hour),
+		},
+		Webhook: WebhookConfig{
+			Enabled:      getEnvBool("WEBHOOK_ENABLED", true),
+			Timeout:      getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			BatchSize:    getEnvInt("WEBHOOK_BATCH_SIZE", 50),
+			PollInterval: getEnvDuration("WEBHOOK_POLL_INTERVAL", time.Second),
+			MinBackoff:   getEnvDuration("WEBHOOK_MIN_BACKOFF", 10*time.Second),
+			MaxBackoff:   getEnvDuration("WEBHOOK_MAX_BACKOFF", 6*time.Hour),
+			MaxAttempts:  getEnvInt("WEBHOOK_MAX_ATTEMPTS", 10),
+			Retention:    getEnvDuration("WEBHOOK_RETENTION", 7*24*time.Hour),
+		},
+		Events: EventStreamConfig{
+			Enabled:      getEnvBool("EVENT_STREAM_ENABLED", true),
+			PollInterval: getEnvDuration("EVENT_STREAM_POLL_INTERVAL", 500*time.Millisecond),
+			BatchSize:    getEnvInt("EVENT_STREAM_BATCH_SIZE", 100),
+			BufferSize:   getEnvInt("EVENT_STREAM_BUFFER_SIZE", 256),
+			Heartbeat:    getEnvDuration("EVENT_STREAM_HEARTBEAT", 15*time.Second),
+		},
+		Deletion: DeletionConfig{
+			Retention:      getEnvDuration("EQUIPMENT_RETENTION", 0),
+			PurgeInterval:  getEnvDuration("EQUIPMENT_PURGE_INTERVAL", time.Hour),
+			PurgeBatchSize: getEnvInt("EQUIPMENT_PURGE_BATCH_SIZE", 100),
+		},
+		B2MML: B2MMLConfig{
+			LogicalID:    getEnv("B2MML_LOGICAL_ID", "go-cmms"),
+			ProfilePath:  getEnv("B2MML_PROFILE", ""),
+			PollInterval: getEnvDuration("B2MML_POLL_INTERVAL", time.Second),
+		},
+		Import: ImportConfig{
+			Enabled:      getEnvBool("IMPORT_ENABLED", true),
+			ChunkSize:    getEnvInt("IMPORT_CHUNK_SIZE", 500),
+			MaxSize:      getEnvInt("IMPORT_MAX_SIZE", 64<<20),
+			PollInterval: getEnvDuration("IMPORT_POLL_INTERVAL", time.Second),
+			Lease:        getEnvDuration("IMPORT_LEASE", 5*time.Minute),
+		},
+		Export: ExportConfig{
+			BatchSize: getEnvInt("EXPORT_BATCH_SIZE", 500),
+		},
 	}
 }
 
@@ -92,3 +225,13 @@ func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+// getEnvBool читает переменную окружения как булево значение
+func getEnvBool(key string, defaultValue bool) bool {
+	if value, exists := os.LookupEnv(key); exists {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
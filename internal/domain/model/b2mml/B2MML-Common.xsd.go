@@ -89,6 +89,7 @@ type CauseType struct {
 // - InstanceType: the property value of the class is undefined; and
 // - DefaultType: the property value is defined for the class as the default instance value, but individual instances of the class may redefine specific values.
 type ClassPropertyTypeType struct {
+	Value string `xml:",chardata"`
 }
 
 // ConfidenceFactorType ...
@@ -97,6 +98,7 @@ type ConfidenceFactorType struct {
 
 // DataType1Type ...
 type DataType1Type struct {
+	Value string `xml:",chardata"`
 }
 
 // DataTypeType ...
@@ -152,6 +154,8 @@ type DispositionType struct {
 
 // DescriptionType ...
 type DescriptionType struct {
+	LanguageIDAttr *string `xml:"languageID,attr"`
+	Value          string  `xml:",chardata"`
 }
 
 // DurationType ...
@@ -183,6 +187,7 @@ type EquipmentAssetMappingType struct {
 
 // EquipmentLevel1Type ...
 type EquipmentLevel1Type struct {
+	Value string `xml:",chardata"`
 }
 
 // EquipmentLevelType is Defines the role based equipment hierarchy level as defined in ISA 95.
@@ -819,6 +824,8 @@ type ParameterType struct {
 
 // PersonNameType ...
 type PersonNameType struct {
+	LanguageIDAttr *string `xml:"languageID,attr"`
+	Value          string  `xml:",chardata"`
 }
 
 // PersonnelUseType ...
@@ -835,6 +842,37 @@ type PriorityType struct {
 
 // QuantityStringType ...
 type QuantityStringType struct {
+	CurrencyIDAttr                *string `xml:"currencyID,attr"`
+	CurrencyCodeListVersionIDAttr *string `xml:"currencyCodeListVersionID,attr"`
+	EncodingCodeAttr              *string `xml:"encodingCode,attr"`
+	FormatAttr                    *string `xml:"format,attr"`
+	CharacterSetCodeAttr          *string `xml:"characterSetCode,attr"`
+	ListIDAttr                    *string `xml:"listID,attr"`
+	ListAgencyIDAttr              *string `xml:"listAgencyID,attr"`
+	ListAgencyNameAttr            *string `xml:"listAgencyName,attr"`
+	ListNameAttr                  *string `xml:"listName,attr"`
+	ListVersionIDAttr             *string `xml:"listVersionID,attr"`
+	LanguageIDAttr                *string `xml:"languageID,attr"`
+	LanguageLocaleIDAttr          *string `xml:"languageLocaleID,attr"`
+	ListURIAttr                   *string `xml:"listURI,attr"`
+	ListSchemaURIAttr             *string `xml:"listSchemaURI,attr"`
+	MimeCodeAttr                  *string `xml:"mimeCode,attr"`
+	NameAttr                      *string `xml:"name,attr"`
+	SchemaIDAttr                  *string `xml:"schemaID,attr"`
+	SchemaNameAttr                *string `xml:"schemaName,attr"`
+	SchemaAgencyIDAttr            *string `xml:"schemaAgencyID,attr"`
+	SchemaAgencyNameAttr          *string `xml:"schemaAgencyName,attr"`
+	SchemaVersionIDAttr           *string `xml:"schemaVersionID,attr"`
+	SchemaDataURIAttr             *string `xml:"schemaDataURI,attr"`
+	SchemaURIAttr                 *string `xml:"schemaURI,attr"`
+	UnitCodeAttr                  *string `xml:"unitCode,attr"`
+	UnitCodeListIDAttr            *string `xml:"unitCodeListID,attr"`
+	UnitCodeListAgencyIDAttr      *string `xml:"unitCodeListAgencyID,attr"`
+	UnitCodeListAgencyNameAttr    *string `xml:"unitCodeListAgencyName,attr"`
+	UnitCodeListVersionIDAttr     *string `xml:"unitCodeListVersionID,attr"`
+	FilenameAttr                  *string `xml:"filename,attr"`
+	UriAttr                       *string `xml:"uri,attr"`
+	Value                         string  `xml:",chardata"`
 }
 
 // QuantityValueType ...
@@ -999,10 +1037,51 @@ type StatusType struct {
 
 // UnitOfMeasureType ...
 type UnitOfMeasureType struct {
+	ListIDAttr         *string `xml:"listID,attr"`
+	ListAgencyIDAttr   *string `xml:"listAgencyID,attr"`
+	ListAgencyNameAttr *string `xml:"listAgencyName,attr"`
+	ListNameAttr       *string `xml:"listName,attr"`
+	ListVersionIDAttr  *string `xml:"listVersionID,attr"`
+	NameAttr           *string `xml:"name,attr"`
+	LanguageIDAttr     *string `xml:"languageID,attr"`
+	ListURIAttr        *string `xml:"listURI,attr"`
+	ListSchemeURIAttr  *string `xml:"listSchemeURI,attr"`
+	Value              string  `xml:",chardata"`
 }
 
 // ValueStringType ...
 type ValueStringType struct {
+	CurrencyIDAttr                *string `xml:"currencyID,attr"`
+	CurrencyCodeListVersionIDAttr *string `xml:"currencyCodeListVersionID,attr"`
+	EncodingCodeAttr              *string `xml:"encodingCode,attr"`
+	FormatAttr                    *string `xml:"format,attr"`
+	CharacterSetCodeAttr          *string `xml:"characterSetCode,attr"`
+	ListIDAttr                    *string `xml:"listID,attr"`
+	ListAgencyIDAttr              *string `xml:"listAgencyID,attr"`
+	ListAgencyNameAttr            *string `xml:"listAgencyName,attr"`
+	ListNameAttr                  *string `xml:"listName,attr"`
+	ListVersionIDAttr             *string `xml:"listVersionID,attr"`
+	LanguageIDAttr                *string `xml:"languageID,attr"`
+	LanguageLocaleIDAttr          *string `xml:"languageLocaleID,attr"`
+	ListURIAttr                   *string `xml:"listURI,attr"`
+	ListSchemaURIAttr             *string `xml:"listSchemaURI,attr"`
+	MimeCodeAttr                  *string `xml:"mimeCode,attr"`
+	NameAttr                      *string `xml:"name,attr"`
+	SchemaIDAttr                  *string `xml:"schemaID,attr"`
+	SchemaNameAttr                *string `xml:"schemaName,attr"`
+	SchemaAgencyIDAttr            *string `xml:"schemaAgencyID,attr"`
+	SchemaAgencyNameAttr          *string `xml:"schemaAgencyName,attr"`
+	SchemaVersionIDAttr           *string `xml:"schemaVersionID,attr"`
+	SchemaDataURIAttr             *string `xml:"schemaDataURI,attr"`
+	SchemaURIAttr                 *string `xml:"schemaURI,attr"`
+	UnitCodeAttr                  *string `xml:"unitCode,attr"`
+	UnitCodeListIDAttr            *string `xml:"unitCodeListID,attr"`
+	UnitCodeListAgencyIDAttr      *string `xml:"unitCodeListAgencyID,attr"`
+	UnitCodeListAgencyNameAttr    *string `xml:"unitCodeListAgencyName,attr"`
+	UnitCodeListVersionIDAttr     *string `xml:"unitCodeListVersionID,attr"`
+	FilenameAttr                  *string `xml:"filename,attr"`
+	UriAttr                       *string `xml:"uri,attr"`
+	Value                         string  `xml:",chardata"`
 }
 
 // ValueType ...
@@ -1031,10 +1110,7 @@ type TransAcknowledgeType struct {
 }
 
 // TransActionCodeType ...
-type TransActionCodeType struct {
-	NormalizedString               string
-	TransActionCodeEnumerationType string
-}
+type TransActionCodeType string
 
 // TransActionCodeEnumerationType ...
 type TransActionCodeEnumerationType string
@@ -1079,6 +1155,7 @@ type TransChangeStatusType struct {
 
 // TransConfirmationCodeType ...
 type TransConfirmationCodeType struct {
+	Value string `xml:",chardata"`
 }
 
 // TransConfirmType ...
@@ -1101,7 +1178,9 @@ type TransExpressionType struct {
 
 // TransGetType ...
 type TransGetType struct {
-	Expression []string `xml:"Expression"`
+	MaxItemsAttr             *int32   `xml:"maxItems,attr"`
+	RecordSetReferenceIDAttr *string  `xml:"recordSetReferenceId,attr"`
+	Expression               []string `xml:"Expression"`
 }
 
 // TransProcessType ...
@@ -1144,8 +1223,11 @@ type TransSenderType struct {
 
 // TransShowType ...
 type TransShowType struct {
-	OriginalApplicationArea *TransApplicationAreaType    `xml:"OriginalApplicationArea"`
-	ResponseCriteria        []*TransResponseCriteriaType `xml:"ResponseCriteria"`
+	RecordSetCountAttr             *int32                       `xml:"recordSetCount,attr"`
+	RecordSetCompleteIndicatorAttr *bool                        `xml:"recordSetCompleteIndicator,attr"`
+	RecordSetReferenceIDAttr       *string                      `xml:"recordSetReferenceId,attr"`
+	OriginalApplicationArea        *TransApplicationAreaType    `xml:"OriginalApplicationArea"`
+	ResponseCriteria               []*TransResponseCriteriaType `xml:"ResponseCriteria"`
 }
 
 // TransSignatureType ...
@@ -19,7 +19,7 @@ type ConfirmBODType struct {
 
 // BODType ...
 type BODType struct {
-	FreeFormTextGroup       *FreeFormTextGroup
+	*FreeFormTextGroup
 	OriginalApplicationArea *TransApplicationAreaType `xml:"OriginalApplicationArea"`
 	UserArea                *TransUserAreaType        `xml:"UserArea"`
 }
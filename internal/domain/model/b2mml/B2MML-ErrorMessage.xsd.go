@@ -47,6 +47,7 @@ type ErrorTypeType struct {
 
 // ErrorType1Type ...
 type ErrorType1Type struct {
+	Value string `xml:",chardata"`
 }
 
 // DataArea ...
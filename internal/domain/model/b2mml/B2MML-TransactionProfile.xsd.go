@@ -42,6 +42,7 @@ type SupportedActionType struct {
 
 // TransactionNoun1Type ...
 type TransactionNoun1Type struct {
+	Value string `xml:",chardata"`
 }
 
 // TransactionNounType ...
@@ -52,6 +53,7 @@ type TransactionNounType struct {
 
 // TransactionVerb1Type ...
 type TransactionVerb1Type struct {
+	Value string `xml:",chardata"`
 }
 
 // TransactionVerbType ...
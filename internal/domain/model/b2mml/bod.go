@@ -0,0 +1,135 @@
+package b2mml
+
+import "encoding/xml"
+
+// Namespace пространство имён документов B2MML
+const Namespace = "http://www.mesa.org/xml/B2MML"
+
+// ReleaseID версия схем B2MML, которой соответствуют документы сервера
+const ReleaseID = "7.0"
+
+// Коды действий ActionExpression и ответов ResponseExpression (OAGIS)
+const (
+	ActionCodeAdd      = "Add"
+	ActionCodeChange   = "Change"
+	ActionCodeDelete   = "Delete"
+	ActionCodeReplace  = "Replace"
+	ActionCodeAccepted = "Accepted"
+	ActionCodeModified = "Modified"
+	ActionCodeRejected = "Rejected"
+)
+
+// Коды запроса ответа: acknowledgeCode глагола Process и ConfirmationCode отправителя
+const (
+	ResponseCodeAlways  = "Always"
+	ResponseCodeOnError = "OnError"
+	ResponseCodeNever   = "Never"
+)
+
+// BOD документ B2MML (Business Object Document): корневой элемент
+// <Глагол><Существительное> с ApplicationArea и DataArea
+type BOD[D any] struct {
+	XMLName         xml.Name
+	ReleaseIDAttr   string                    `xml:"releaseID,attr"`
+	VersionIDAttr   *string                   `xml:"versionID,attr"`
+	ApplicationArea *TransApplicationAreaType `xml:"ApplicationArea"`
+	DataArea        *D                        `xml:"DataArea"`
+}
+
+// NewBOD создаёт документ с корневым элементом name в пространстве имён B2MML
+func NewBOD[D any](name string, area *TransApplicationAreaType, data *D) *BOD[D] {
+	return &BOD[D]{
+		XMLName:         xml.Name{Space: Namespace, Local: name},
+		ReleaseIDAttr:   ReleaseID,
+		ApplicationArea: area,
+		DataArea:        data,
+	}
+}
+
+// Verb глагол BOD; в DataArea задан один из них
+type Verb struct {
+	Get         *TransGetType         `xml:"Get"`
+	Show        *TransShowType        `xml:"Show"`
+	Process     *TransProcessType     `xml:"Process"`
+	Acknowledge *TransAcknowledgeType `xml:"Acknowledge"`
+	Sync        *TransSyncType        `xml:"Sync"`
+}
+
+// ActionCodes возвращает коды действий ActionExpression глаголов Process и Sync
+func (v Verb) ActionCodes() []string {
+	var criteria []*TransActionCriteriaType
+	switch {
+	case v.Process != nil:
+		criteria = v.Process.ActionCriteria
+	case v.Sync != nil:
+		criteria = v.Sync.ActionCriteria
+	}
+
+	var codes []string
+	for _, c := range criteria {
+		if c == nil {
+			continue
+		}
+		for _, expr := range c.ActionExpression {
+			if expr != nil && expr.ActionCodeAttr != nil {
+				codes = append(codes, string(*expr.ActionCodeAttr))
+			}
+		}
+	}
+	return codes
+}
+
+// EquipmentDataArea DataArea сообщений с существительными EquipmentInformation,
+// EquipmentClass и Equipment. Используется список существительного из имени документа
+type EquipmentDataArea struct {
+	Verb
+	EquipmentInformation []*EquipmentInformationType `xml:"EquipmentInformation"`
+	EquipmentClass       []*EquipmentClassType       `xml:"EquipmentClass"`
+	Equipment            []*EquipmentType            `xml:"Equipment"`
+}
+
+// ConfirmBODDataArea DataArea документа ConfirmBOD
+type ConfirmBODDataArea struct {
+	Confirm *TransConfirmType `xml:"Confirm"`
+	BOD     []*BODType        `xml:"BOD"`
+}
+
+// ErrorMessageDataArea DataArea документов с существительным ErrorMessage
+type ErrorMessageDataArea struct {
+	Verb
+	ErrorMessage []*ErrorMessageType `xml:"ErrorMessage"`
+}
+
+// TransactionProfileDataArea DataArea документов с существительным TransactionProfile
+type TransactionProfileDataArea struct {
+	Verb
+	TransactionProfile []*TransactionProfileType `xml:"TransactionProfile"`
+}
+
+// NewResponseCriteria создаёт ResponseCriteria с кодом ответа actionCode.
+// Описания reasons передаются в ChangeStatus
+func NewResponseCriteria(actionCode string, reasons ...string) *TransResponseCriteriaType {
+	code := TransActionCodeType(actionCode)
+	criteria := &TransResponseCriteriaType{
+		ResponseExpression: &TransExpressionType{
+			ActionCodeAttr:       &code,
+			TransExpression1Type: &TransExpression1Type{},
+		},
+	}
+	if len(reasons) > 0 {
+		status := &TransChangeStatusType{Code: &CodeType{Value: actionCode}}
+		for _, reason := range reasons {
+			status.Description = append(status.Description, &DescriptionType{Value: reason})
+		}
+		criteria.ChangeStatus = status
+	}
+	return criteria
+}
+
+// String возвращает значение идентификатора; для nil - пустую строку
+func (id *IdentifierType) String() string {
+	if id == nil {
+		return ""
+	}
+	return id.Value
+}
@@ -1,3 +1,18 @@
 package b2mml
 
 // //go:generate go tool xgen -i ../../../../third_party/b2mml/Schema -o . -l Go -p b2mml
+//go:generate go run ./internal/xsdrules -i ../../../../third_party/b2mml/Schema -o schema_rules.go
+
+// xgen не генерирует поля для simpleContent-расширений. После регенерации
+// в B2MML-Common.xsd.go нужно вручную вернуть значения (chardata и атрибуты) типам
+// DescriptionType, PersonNameType, ValueStringType, QuantityStringType,
+// UnitOfMeasureType, DataType1Type, EquipmentLevel1Type, ClassPropertyTypeType
+// и TransConfirmationCodeType (в B2MML-ErrorMessage.xsd.go - ErrorType1Type, в
+// B2MML-TransactionProfile.xsd.go - TransactionVerb1Type и TransactionNoun1Type),
+// а объединение TransActionCodeType объявить строкой.
+// TransGetType и TransShowType дополняются атрибутами постраничной выборки
+// (maxItems, recordSetReferenceId, recordSetCount, recordSetCompleteIndicator).
+// В B2MML-ConfirmBOD.xsd.go группа FreeFormTextGroup встраивается в BODType.
+// DataArea сообщений xgen сводит в один тип, поэтому BOD описаны в bod.go.
+// minOccurs, xs:choice, обязательные атрибуты и перечисления xgen не переносит:
+// их таблицу schemaRules строит xsdrules (нужен подмодуль third_party/b2mml).
@@ -0,0 +1,560 @@
+// Команда xsdrules строит таблицу schemaRules пакета b2mml из схем XSD:
+// minOccurs и ограниченный maxOccurs элементов, альтернативы xs:choice,
+// обязательные атрибуты, перечисления и встроенные типы XSD значений. Структуру
+// и порядок элементов валидатор берёт из типов xgen, поэтому здесь они не нужны.
+//
+//	go run ./internal/xsdrules -i ../../../../third_party/b2mml/Schema -o schema_rules.go
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// xsdNamespace пространство имён XML Schema
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// unbounded maxOccurs="unbounded"
+const unbounded = -1
+
+func main() {
+	input := flag.String("i", "", "directory with XSD files")
+	output := flag.String("o", "schema_rules.go", "output Go file")
+	pkg := flag.String("p", "b2mml", "package name")
+	namespace := flag.String("ns", "http://www.mesa.org/xml/B2MML", "target namespace of the rules")
+	flag.Parse()
+	if *input == "" {
+		log.Fatal("usage: xsdrules -i dir [-o file] [-p package] [-ns namespace]")
+	}
+
+	s, err := load(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := s.generate(*pkg, *namespace)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// node элемент XSD с атрибутами и дочерними элементами в порядке документа
+type node struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Nodes   []*node    `xml:",any"`
+	// ns префиксы пространств имён файла схемы
+	ns map[string]string
+	// target targetNamespace файла схемы
+	target string
+}
+
+func (n *node) attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Space == "" && a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func (n *node) is(local string) bool {
+	return n.XMLName.Space == xsdNamespace && n.XMLName.Local == local
+}
+
+// qname разрешает QName атрибута name в пространство имён и локальное имя
+func (n *node) qname(name string) qname {
+	value := n.attr(name)
+	prefix, local, ok := strings.Cut(value, ":")
+	if !ok {
+		return qname{space: n.ns[""], local: value}
+	}
+	return qname{space: n.ns[prefix], local: local}
+}
+
+// setFile передаёт узлам поддерева сведения о файле схемы
+func (n *node) setFile(ns map[string]string, target string) {
+	n.ns, n.target = ns, target
+	for _, child := range n.Nodes {
+		child.setFile(ns, target)
+	}
+}
+
+type qname struct {
+	space, local string
+}
+
+// schemas глобальные определения всех файлов схемы
+type schemas struct {
+	complexTypes    map[qname]*node
+	simpleTypes     map[qname]*node
+	groups          map[qname]*node
+	attributeGroups map[qname]*node
+	elements        map[qname]*node
+}
+
+// load читает все файлы XSD каталога dir
+func load(dir string) (*schemas, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.xsd"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no XSD files in %s", dir)
+	}
+
+	s := &schemas{
+		complexTypes:    map[qname]*node{},
+		simpleTypes:     map[qname]*node{},
+		groups:          map[qname]*node{},
+		attributeGroups: map[qname]*node{},
+		elements:        map[qname]*node{},
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var root node
+		if err := xml.Unmarshal(data, &root); err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		ns := map[string]string{}
+		for _, a := range root.Attrs {
+			switch {
+			case a.Name.Space == "xmlns":
+				ns[a.Name.Local] = a.Value
+			case a.Name.Space == "" && a.Name.Local == "xmlns":
+				ns[""] = a.Value
+			}
+		}
+		target := root.attr("targetNamespace")
+		root.setFile(ns, target)
+
+		for _, def := range root.Nodes {
+			name := qname{space: target, local: def.attr("name")}
+			switch {
+			case def.is("complexType"):
+				s.complexTypes[name] = def
+			case def.is("simpleType"):
+				s.simpleTypes[name] = def
+			case def.is("group"):
+				s.groups[name] = def
+			case def.is("attributeGroup"):
+				s.attributeGroups[name] = def
+			case def.is("element"):
+				s.elements[name] = def
+			}
+		}
+	}
+	return s, nil
+}
+
+// rule ограничения типа, которые не выражены в типах xgen
+type rule struct {
+	elements map[string]*occurs
+	attrs    map[string]*value
+	text     *value
+}
+
+// occurs вхождения элемента в содержимое типа
+type occurs struct {
+	min, max int
+	choice   int
+}
+
+// value ограничения простого значения
+type value struct {
+	required bool
+	base     string
+	enum     []string
+}
+
+func (v *value) empty() bool {
+	return v == nil || (!v.required && v.base == "" && v.enum == nil)
+}
+
+// builder строит правила одного типа
+type builder struct {
+	s       *schemas
+	rule    *rule
+	choices int
+}
+
+// ruleOf строит правила сложного типа def
+func (s *schemas) ruleOf(def *node) *rule {
+	b := &builder{s: s, rule: &rule{elements: map[string]*occurs{}, attrs: map[string]*value{}}}
+	b.complexType(def)
+	return b.rule
+}
+
+func (b *builder) complexType(def *node) {
+	for _, child := range def.Nodes {
+		switch {
+		case child.is("sequence"), child.is("choice"), child.is("all"), child.is("group"):
+			b.particle(child, 1, 1, 0)
+		case child.is("complexContent"):
+			b.complexContent(child)
+		case child.is("simpleContent"):
+			b.simpleContent(child)
+		case child.is("attribute"), child.is("attributeGroup"):
+			b.attribute(child)
+		}
+	}
+}
+
+func (b *builder) complexContent(content *node) {
+	for _, derivation := range content.Nodes {
+		if !derivation.is("extension") && !derivation.is("restriction") {
+			continue
+		}
+		// Расширение наследует содержимое базового типа, ограничение задаёт его заново
+		if derivation.is("extension") {
+			if base, ok := b.s.complexTypes[derivation.qname("base")]; ok {
+				b.complexType(base)
+			}
+		}
+		b.complexType(derivation)
+	}
+}
+
+func (b *builder) simpleContent(content *node) {
+	for _, derivation := range content.Nodes {
+		if !derivation.is("extension") && !derivation.is("restriction") {
+			continue
+		}
+		base := derivation.qname("base")
+		if def, ok := b.s.complexTypes[base]; ok {
+			b.complexType(def)
+		} else {
+			b.rule.text = b.s.simpleValue(base, nil)
+		}
+		if derivation.is("restriction") {
+			if enum := enumerations(derivation); enum != nil {
+				if b.rule.text == nil {
+					b.rule.text = &value{}
+				}
+				b.rule.text.enum = enum
+			}
+		}
+		for _, child := range derivation.Nodes {
+			if child.is("attribute") || child.is("attributeGroup") {
+				b.attribute(child)
+			}
+		}
+	}
+}
+
+// particle добавляет элементы частицы p; min и max - множители вхождений
+// объемлющих частиц, choice - номер объемлющего xs:choice
+func (b *builder) particle(p *node, min, max, choice int) {
+	pmin, pmax := occursOf(p)
+	min, max = min*pmin, multiply(max, pmax)
+
+	switch {
+	case p.is("element"):
+		name := p.attr("name")
+		if name == "" {
+			ref := p.qname("ref")
+			// Элементы других пространств имён валидатор пропускает
+			if ref.space != p.target {
+				return
+			}
+			name = ref.local
+		}
+		if o, ok := b.rule.elements[name]; ok {
+			o.min += min
+			o.max = add(o.max, max)
+			return
+		}
+		b.rule.elements[name] = &occurs{min: min, max: max, choice: choice}
+	case p.is("sequence"), p.is("all"):
+		for _, child := range p.Nodes {
+			b.particle(child, min, max, choice)
+		}
+	case p.is("choice"):
+		alternatives := slices.DeleteFunc(slices.Clone(p.Nodes), func(n *node) bool {
+			return n.is("annotation")
+		})
+		if len(alternatives) > 1 {
+			// Ни одна альтернатива не обязательна сама по себе
+			b.choices++
+			choice, min = b.choices, 0
+		}
+		for _, child := range alternatives {
+			b.particle(child, min, max, choice)
+		}
+	case p.is("group"):
+		ref := p.qname("ref")
+		if ref.space != p.target {
+			return
+		}
+		if def, ok := b.s.groups[ref]; ok {
+			for _, child := range def.Nodes {
+				b.particle(child, min, max, choice)
+			}
+		}
+	}
+}
+
+// attribute добавляет атрибут или группу атрибутов
+func (b *builder) attribute(a *node) {
+	if a.is("attributeGroup") {
+		if def, ok := b.s.attributeGroups[a.qname("ref")]; ok {
+			for _, child := range def.Nodes {
+				if child.is("attribute") || child.is("attributeGroup") {
+					b.attribute(child)
+				}
+			}
+		}
+		return
+	}
+
+	name := a.attr("name")
+	if name == "" {
+		name = a.qname("ref").local
+	}
+	if a.attr("use") == "prohibited" {
+		delete(b.rule.attrs, name)
+		return
+	}
+	var v *value
+	if a.attr("type") != "" {
+		v = b.s.simpleValue(a.qname("type"), nil)
+	} else if inline := child(a, "simpleType"); inline != nil {
+		v = b.s.simpleType(inline, nil)
+	}
+	if v == nil {
+		v = &value{}
+	}
+	v.required = a.attr("use") == "required"
+	b.rule.attrs[name] = v
+}
+
+// simpleValue возвращает ограничения простого типа name; для встроенного
+// типа XSD - только его имя
+func (s *schemas) simpleValue(name qname, seen map[qname]bool) *value {
+	if name.space == xsdNamespace {
+		// xs:string и xs:anySimpleType допускают любой текст
+		if name.local == "string" || name.local == "anySimpleType" {
+			return &value{}
+		}
+		return &value{base: name.local}
+	}
+	def, ok := s.simpleTypes[name]
+	if !ok || seen[name] {
+		return nil
+	}
+	if seen == nil {
+		seen = map[qname]bool{}
+	}
+	seen[name] = true
+	return s.simpleType(def, seen)
+}
+
+// simpleType возвращает ограничения определения простого типа def
+func (s *schemas) simpleType(def *node, seen map[qname]bool) *value {
+	for _, derivation := range def.Nodes {
+		switch {
+		case derivation.is("restriction"):
+			var v *value
+			if derivation.attr("base") != "" {
+				v = s.simpleValue(derivation.qname("base"), seen)
+			} else if inline := child(derivation, "simpleType"); inline != nil {
+				v = s.simpleType(inline, seen)
+			}
+			if v == nil {
+				v = &value{}
+			}
+			if enum := enumerations(derivation); enum != nil {
+				v.enum = enum
+			}
+			return v
+		case derivation.is("union"):
+			// Объединение ограничено, только если все его члены - перечисления
+			var members []*value
+			for _, member := range strings.Fields(derivation.attr("memberTypes")) {
+				prefix, local, ok := strings.Cut(member, ":")
+				name := qname{space: derivation.ns[""], local: member}
+				if ok {
+					name = qname{space: derivation.ns[prefix], local: local}
+				}
+				members = append(members, s.simpleValue(name, seen))
+			}
+			for _, inline := range derivation.Nodes {
+				if inline.is("simpleType") {
+					members = append(members, s.simpleType(inline, seen))
+				}
+			}
+			union := &value{}
+			for _, m := range members {
+				if m == nil || m.enum == nil {
+					return &value{}
+				}
+				union.enum = append(union.enum, m.enum...)
+			}
+			return union
+		case derivation.is("list"):
+			return &value{}
+		}
+	}
+	return nil
+}
+
+// enumerations значения xs:enumeration ограничения; nil - перечисления нет
+func enumerations(restriction *node) []string {
+	var enum []string
+	for _, facet := range restriction.Nodes {
+		if facet.is("enumeration") && !slices.Contains(enum, facet.attr("value")) {
+			enum = append(enum, facet.attr("value"))
+		}
+	}
+	return enum
+}
+
+func child(n *node, local string) *node {
+	for _, c := range n.Nodes {
+		if c.is(local) {
+			return c
+		}
+	}
+	return nil
+}
+
+// occursOf возвращает minOccurs и maxOccurs частицы
+func occursOf(p *node) (int, int) {
+	min, max := 1, 1
+	if v := p.attr("minOccurs"); v != "" {
+		min, _ = strconv.Atoi(v)
+	}
+	switch v := p.attr("maxOccurs"); v {
+	case "":
+	case "unbounded":
+		max = unbounded
+	default:
+		max, _ = strconv.Atoi(v)
+	}
+	return min, max
+}
+
+func multiply(a, b int) int {
+	if a == unbounded || b == unbounded {
+		return unbounded
+	}
+	return a * b
+}
+
+func add(a, b int) int {
+	if a == unbounded || b == unbounded {
+		return unbounded
+	}
+	return a + b
+}
+
+// generate возвращает исходный код таблицы правил сложных типов namespace
+func (s *schemas) generate(pkg, namespace string) ([]byte, error) {
+	var names []string
+	for name := range s.complexTypes {
+		if name.space == namespace {
+			names = append(names, name.local)
+		}
+	}
+	slices.Sort(names)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by xsdrules. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	b.WriteString("// schemaRules ограничения сложных типов схемы, которые не выражены в типах xgen\n")
+	b.WriteString("var schemaRules = map[string]schemaRule{\n")
+	for _, name := range names {
+		r := s.ruleOf(s.complexTypes[qname{space: namespace, local: name}])
+		body := r.source()
+		if body == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "%q: {\n%s},\n", name, body)
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+// source возвращает поля schemaRule; пустая строка - ограничений нет
+func (r *rule) source() string {
+	var b strings.Builder
+
+	var elements []string
+	for _, name := range sortedKeys(r.elements) {
+		o := r.elements[name]
+		var fields []string
+		if o.min > 0 {
+			fields = append(fields, fmt.Sprintf("min: %d", o.min))
+		}
+		// maxOccurs=1 и unbounded выражены в типе xgen (поле или срез)
+		if o.max > 1 {
+			fields = append(fields, fmt.Sprintf("max: %d", o.max))
+		}
+		if o.choice > 0 {
+			fields = append(fields, fmt.Sprintf("choice: %d", o.choice))
+		}
+		if len(fields) > 0 {
+			elements = append(elements, fmt.Sprintf("%q: {%s},\n", name, strings.Join(fields, ", ")))
+		}
+	}
+	if len(elements) > 0 {
+		b.WriteString("elements: map[string]elementRule{\n" + strings.Join(elements, "") + "},\n")
+	}
+
+	var attrs []string
+	for _, name := range sortedKeys(r.attrs) {
+		if v := r.attrs[name]; !v.empty() {
+			attrs = append(attrs, fmt.Sprintf("%q: {%s},\n", name, v.source()))
+		}
+	}
+	if len(attrs) > 0 {
+		b.WriteString("attrs: map[string]valueRule{\n" + strings.Join(attrs, "") + "},\n")
+	}
+
+	if !r.text.empty() {
+		b.WriteString("text: valueRule{" + r.text.source() + "},\n")
+	}
+	return b.String()
+}
+
+// source возвращает поля valueRule
+func (v *value) source() string {
+	var fields []string
+	if v.required {
+		fields = append(fields, "required: true")
+	}
+	if v.base != "" {
+		fields = append(fields, fmt.Sprintf("base: %q", v.base))
+	}
+	if v.enum != nil {
+		quoted := make([]string, len(v.enum))
+		for i, e := range v.enum {
+			quoted[i] = strconv.Quote(e)
+		}
+		fields = append(fields, "enum: []string{"+strings.Join(quoted, ", ")+"}")
+	}
+	return strings.Join(fields, ", ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,271 @@
+package b2mml
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Ключи канонического JSON для атрибутов и текста элемента
+const (
+	JSONAttrPrefix = "@"
+	JSONTextKey    = "#text"
+)
+
+// MarshalJSON возвращает канонический JSON типа xgen v, из которого
+// UnmarshalJSON восстанавливает те же данные, что xml.Unmarshal из XML:
+//   - элемент - объект с ключами по именам дочерних элементов в порядке схемы;
+//     элементы с maxOccurs>1 - всегда массивы
+//   - атрибут - ключ с префиксом @ (@languageID, @unitCode, @schemeID)
+//   - текст элемента - ключ #text; элемент только с текстом - значение без объекта
+//   - числовые и логические значения - числа и true/false JSON, остальные - строки
+func MarshalJSON(v any) ([]byte, error) {
+	doc, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	root, err := parseElement(xml.NewDecoder(bytes.NewReader(doc)))
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	writeJSONElement(&b, root, indirect(reflect.TypeOf(v)))
+	return b.Bytes(), nil
+}
+
+// writeJSONElement записывает элемент e типа t
+func writeJSONElement(b *bytes.Buffer, e *element, t reflect.Type) {
+	s := schemaOf(t)
+	var attrs []xml.Attr
+	for _, a := range e.attrs {
+		if a.Name.Space == "" && a.Name.Local != "xmlns" {
+			attrs = append(attrs, a)
+		}
+	}
+	text := e.text.String()
+	if s.text != nil && len(attrs) == 0 && len(e.children) == 0 {
+		writeJSONValue(b, text, s.text)
+		return
+	}
+
+	b.WriteByte('{')
+	first := true
+	key := func(name string) {
+		if !first {
+			b.WriteByte(',')
+		}
+		first = false
+		writeJSONString(b, name)
+		b.WriteByte(':')
+	}
+	for _, a := range attrs {
+		key(JSONAttrPrefix + a.Name.Local)
+		if field, ok := s.attrs[a.Name.Local]; ok {
+			writeJSONValue(b, a.Value, field.typ)
+		} else {
+			writeJSONString(b, a.Value)
+		}
+	}
+	if s.text != nil && text != "" {
+		key(JSONTextKey)
+		writeJSONValue(b, text, s.text)
+	}
+
+	// Повторяющиеся элементы собираются в массив на месте первого вхождения
+	var names []string
+	groups := map[string][]*element{}
+	for _, child := range e.children {
+		name := child.name.Local
+		if _, ok := groups[name]; !ok {
+			names = append(names, name)
+		}
+		groups[name] = append(groups[name], child)
+	}
+	for _, name := range names {
+		// Поля вне схемы (Extended... у xgen) не переносятся
+		field, ok := s.elements[name]
+		if !ok {
+			continue
+		}
+		key(name)
+		if !field.many {
+			writeJSONElement(b, groups[name][0], field.typ)
+			continue
+		}
+		b.WriteByte('[')
+		for i, child := range groups[name] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeJSONElement(b, child, field.typ)
+		}
+		b.WriteByte(']')
+	}
+	b.WriteByte('}')
+}
+
+// writeJSONValue записывает значение простого типа t
+func writeJSONValue(b *bytes.Buffer, value string, t reflect.Type) {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		// NaN и INF не являются числами JSON и остаются строками
+		if _, err := strconv.ParseFloat(value, 64); err == nil && json.Valid([]byte(value)) {
+			b.WriteString(value)
+			return
+		}
+	case reflect.Bool:
+		if v, err := strconv.ParseBool(strings.TrimSpace(value)); err == nil {
+			b.WriteString(strconv.FormatBool(v))
+			return
+		}
+	}
+	writeJSONString(b, value)
+}
+
+func writeJSONString(b *bytes.Buffer, s string) {
+	data, _ := json.Marshal(s)
+	b.Write(data)
+}
+
+// UnmarshalJSON заполняет v (указатель на тип xgen) из канонического JSON
+// (см. MarshalJSON). Одиночный элемент с maxOccurs>1 принимается и без массива,
+// null равнозначен отсутствию элемента.
+//
+// Данные проверяются по схеме так же, как XML в Validate; пути нарушений
+// указываются от корня объекта (/ID, /EquipmentProperty[2]/Value).
+// Нарушения возвращаются в *ValidationError, ошибки синтаксиса JSON - как есть
+func UnmarshalJSON(data []byte, v any) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var value any
+	if err := d.Decode(&value); err != nil {
+		return err
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return fmt.Errorf("unexpected data after JSON value")
+	}
+
+	t := indirect(reflect.TypeOf(v))
+	val := &validator{}
+	root := val.jsonElement("", t.Name(), value, t)
+	val.element("", root, t)
+	if len(val.violations) > 0 {
+		return &ValidationError{Violations: val.violations}
+	}
+
+	var doc bytes.Buffer
+	enc := xml.NewEncoder(&doc)
+	if err := root.encode(enc); err != nil {
+		return err
+	}
+	if err := enc.Flush(); err != nil {
+		return err
+	}
+	return xml.Unmarshal(doc.Bytes(), v)
+}
+
+// jsonElement строит элемент name типа t из значения JSON. Несоответствия
+// структуре схемы выявляет element; здесь отмечаются только значения, которые
+// нельзя представить в XML. t nil - элемент вне схемы
+func (v *validator) jsonElement(path, name string, value any, t reflect.Type) *element {
+	e := &element{name: xml.Name{Local: name}}
+	if t == nil {
+		return e
+	}
+	s := schemaOf(t)
+
+	switch value := value.(type) {
+	case map[string]any:
+		// Объект JSON не упорядочен: элементы строятся в порядке схемы, чтобы
+		// XML соответствовал xs:sequence; ключи вне схемы - в конце
+		rank := func(key string) int {
+			if field, ok := s.elements[key]; ok {
+				return field.pos
+			}
+			return len(s.elements)
+		}
+		keys := slices.SortedFunc(maps.Keys(value), func(a, b string) int {
+			return cmp.Or(cmp.Compare(rank(a), rank(b)), strings.Compare(a, b))
+		})
+		for _, key := range keys {
+			item := value[key]
+			if key == JSONTextKey {
+				e.text.WriteString(v.jsonScalar(path, item))
+				continue
+			}
+			if attr, ok := strings.CutPrefix(key, JSONAttrPrefix); ok {
+				if item != nil {
+					e.attrs = append(e.attrs, xml.Attr{Name: xml.Name{Local: attr}, Value: v.jsonScalar(path+"/@"+attr, item)})
+				}
+				continue
+			}
+
+			items, ok := item.([]any)
+			if !ok {
+				items = []any{item}
+			}
+			items = slices.DeleteFunc(items, func(item any) bool { return item == nil })
+			var childType reflect.Type
+			if field, ok := s.elements[key]; ok {
+				childType = field.typ
+			}
+			for i, item := range items {
+				childPath := path + "/" + key
+				if len(items) > 1 {
+					childPath += "[" + strconv.Itoa(i+1) + "]"
+				}
+				e.children = append(e.children, v.jsonElement(childPath, key, item, childType))
+			}
+		}
+	case []any:
+		v.add(path, ViolationFormat, "array is not allowed here")
+	default:
+		e.text.WriteString(v.jsonScalar(path, value))
+	}
+	return e
+}
+
+// jsonScalar возвращает текст простого значения JSON
+func (v *validator) jsonScalar(path string, value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	}
+	v.add(path, ViolationFormat, "value must be a string, number or boolean")
+	return ""
+}
+
+// encode записывает элемент в XML
+func (e *element) encode(enc *xml.Encoder) error {
+	start := xml.StartElement{Name: e.name, Attr: e.attrs}
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+	if e.text.Len() > 0 {
+		if err := enc.EncodeToken(xml.CharData(e.text.String())); err != nil {
+			return err
+		}
+	}
+	for _, child := range e.children {
+		if err := child.encode(enc); err != nil {
+			return err
+		}
+	}
+	return enc.EncodeToken(start.End())
+}
@@ -0,0 +1,75 @@
+package b2mml
+
+// Таблица перезаписывается командой xsdrules (go generate, см. gen.go) из схем
+// каталога third_party/b2mml; до первой генерации в ней перенесены ограничения,
+// которые раньше задавались вручную.
+
+// schemaRules ограничения сложных типов схемы, которые не выражены в типах xgen
+var schemaRules = map[string]schemaRule{
+	"ChannelTopicType": {
+		elements: map[string]elementRule{
+			"ChannelURI": {min: 1},
+		},
+	},
+	"ClassPropertyTypeType": {
+		text: valueRule{enum: []string{"ClassType", "InstanceType", "Other"}},
+	},
+	"DateTimeType": {
+		text: valueRule{base: "dateTime"},
+	},
+	"EquipmentClassPropertyType": {
+		elements: map[string]elementRule{
+			"ID": {min: 1},
+		},
+	},
+	"EquipmentClassType": {
+		elements: map[string]elementRule{
+			"ID": {min: 1},
+		},
+	},
+	"EquipmentLevelType": {
+		text: valueRule{enum: []string{"Enterprise", "Site", "Area", "WorkCenter", "ProcessCell", "ProductionLine", "ProductionUnit", "StorageZone", "WorkUnit", "Unit", "WorkCell", "StorageUnit", "EquipmentModule", "ControlModule", "Other"}},
+	},
+	"EquipmentPropertyType": {
+		elements: map[string]elementRule{
+			"ID": {min: 1},
+		},
+	},
+	"EquipmentType": {
+		elements: map[string]elementRule{
+			"ID": {min: 1},
+		},
+	},
+	"IdentifierType": {
+		text: valueRule{base: "normalizedString"},
+	},
+	"MasterDataTransactionProfileType": {
+		elements: map[string]elementRule{
+			"ApplicationID":      {min: 1},
+			"TransactionProfile": {min: 1},
+		},
+	},
+	"PersonPropertyType": {
+		elements: map[string]elementRule{
+			"ID": {min: 1},
+		},
+	},
+	"PersonType": {
+		elements: map[string]elementRule{
+			"ID": {min: 1},
+		},
+	},
+	"TransConfirmationCodeType": {
+		text: valueRule{enum: []string{"Always", "OnError", "Never"}},
+	},
+	"TransExpressionType": {
+		attrs: map[string]valueRule{
+			"actionCode": {enum: []string{"Add", "Change", "Delete", "Replace", "Accepted", "Modified", "Rejected"}},
+		},
+	},
+	"TransProcessType": {
+		attrs: map[string]valueRule{
+			"acknowledgeCode": {enum: []string{"Always", "OnError", "Never"}},
+		},
+	},
+}
@@ -0,0 +1,453 @@
+package b2mml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Коды нарушений схемы
+const (
+	ViolationRequired    = "required"
+	ViolationEnumeration = "enumeration"
+	ViolationCardinality = "cardinality"
+	ViolationFormat      = "format"
+	ViolationUnexpected  = "unexpected"
+	ViolationOrder       = "order"
+)
+
+// maxViolations предел нарушений в одном отчёте
+const maxViolations = 100
+
+// Violation нарушение схемы во входящем документе
+type Violation struct {
+	// Path XPath элемента или атрибута, например /SyncEquipment/DataArea/Equipment[2]/ID
+	Path    string
+	Code    string
+	Message string
+}
+
+// ValidationError документ не соответствует схеме B2MML
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Violations) == 0 {
+		return "b2mml schema violation"
+	}
+	v := e.Violations[0]
+	msg := fmt.Sprintf("%s: %s", v.Path, v.Message)
+	if len(e.Violations) > 1 {
+		msg += fmt.Sprintf(" (and %d more)", len(e.Violations)-1)
+	}
+	return msg
+}
+
+// valueCheck проверяет текстовое значение; возвращает код и описание нарушения
+type valueCheck func(value string) (code, message string)
+
+// schemaRule ограничения сложного типа схемы, которые не выражены в типах xgen.
+// Таблица schemaRules строится из XSD генератором xsdrules (см. gen.go)
+type schemaRule struct {
+	// elements вхождения элементов, отличные от заданных типом xgen
+	elements map[string]elementRule
+	// attrs ограничения атрибутов
+	attrs map[string]valueRule
+	// text ограничения содержимого элемента
+	text valueRule
+}
+
+// elementRule вхождения элемента в содержимое типа
+type elementRule struct {
+	// min minOccurs
+	min int
+	// max ограниченный maxOccurs больше 1; 0 - как в типе xgen (1 или unbounded)
+	max int
+	// choice номер xs:choice: его альтернативы могут идти в любом порядке; 0 - вне xs:choice
+	choice int
+}
+
+// valueRule ограничения простого значения
+type valueRule struct {
+	// required атрибут обязателен (use="required")
+	required bool
+	// base встроенный тип XSD, от которого выведено значение
+	base string
+	// enum допустимые значения xs:enumeration; nil - любые
+	enum []string
+}
+
+// builtinChecks проверки лексического представления встроенных типов XSD,
+// которые не выражены типом поля xgen
+var builtinChecks = map[string]valueCheck{
+	"normalizedString": checkNormalizedString,
+	"dateTime":         checkDateTime,
+}
+
+// check проверяет значение по встроенному типу и перечислению
+func (r valueRule) check(value string) (string, string) {
+	if check := builtinChecks[r.base]; check != nil {
+		if code, message := check(value); code != "" {
+			return code, message
+		}
+	}
+	if r.enum != nil {
+		return checkEnumeration(value, r.enum)
+	}
+	return "", ""
+}
+
+// ruleProvider задаёт ограничения типов, описанных вручную (BOD)
+type ruleProvider interface {
+	schemaRule() schemaRule
+}
+
+func (BOD[D]) schemaRule() schemaRule {
+	return schemaRule{
+		elements: map[string]elementRule{"ApplicationArea": {min: 1}, "DataArea": {min: 1}},
+		attrs:    map[string]valueRule{"releaseID": {required: true, base: "normalizedString"}},
+	}
+}
+
+// Validate проверяет XML документ из r по схеме типа doc (указатель на тип
+// документа, например *BOD[EquipmentDataArea]); doc не заполняется.
+//
+// Структура и порядок элементов xs:sequence берутся из тегов и порядка полей
+// типов xgen: неизвестные элементы и атрибуты, нарушение порядка, повтор
+// элементов с maxOccurs=1 и текст в элементах без содержимого являются
+// нарушениями. minOccurs, ограниченный maxOccurs, xs:choice, обязательные
+// атрибуты, перечисления и встроенные типы XSD берутся из schemaRules.
+// Элементы и атрибуты других пространств имён пропускаются.
+//
+// Нарушения возвращаются в *ValidationError, ошибки синтаксиса XML - как есть
+func Validate(r io.Reader, doc any) error {
+	root, err := parseElement(xml.NewDecoder(r))
+	if err != nil {
+		return err
+	}
+	v := &validator{space: root.name.Space}
+	v.element("/"+root.name.Local, root, indirect(reflect.TypeOf(doc)))
+	if len(v.violations) > 0 {
+		return &ValidationError{Violations: v.violations}
+	}
+	return nil
+}
+
+// element узел разобранного документа
+type element struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	text     strings.Builder
+	children []*element
+}
+
+// parseElement читает корневой элемент документа
+func parseElement(d *xml.Decoder) (*element, error) {
+	var stack []*element
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("unexpected end of document")
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			e := &element{name: t.Name, attrs: t.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, e)
+			}
+			stack = append(stack, e)
+		case xml.EndElement:
+			e := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return e, nil
+			}
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+}
+
+// schemaField элемент или атрибут типа
+type schemaField struct {
+	typ  reflect.Type
+	many bool
+	// pos положение элемента в xs:sequence (порядок полей xgen)
+	pos int
+}
+
+// schemaType структура типа xgen
+type schemaType struct {
+	elements map[string]schemaField
+	attrs    map[string]schemaField
+	// text тип содержимого элемента; nil - содержимое не допускается
+	text reflect.Type
+	// open тип без полей (xgen не сгенерировал содержимое): не проверяется
+	open bool
+}
+
+// schemaTypes кэш структур типов
+var schemaTypes sync.Map
+
+// schemaOf возвращает структуру типа t
+func schemaOf(t reflect.Type) *schemaType {
+	if s, ok := schemaTypes.Load(t); ok {
+		return s.(*schemaType)
+	}
+	s := &schemaType{elements: map[string]schemaField{}, attrs: map[string]schemaField{}}
+	if t.Kind() == reflect.Struct {
+		s.open = t.NumField() == 0
+		collectFields(s, t)
+	} else {
+		s.text = t
+	}
+	schemaTypes.Store(t, s)
+	return s
+}
+
+// collectFields добавляет в s поля структуры t, включая встроенные типы
+func collectFields(s *schemaType, t reflect.Type) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup("xml")
+		if f.Anonymous && !hasTag {
+			ft := indirect(f.Type)
+			switch {
+			case ft.Kind() != reflect.Struct:
+			case ft.NumField() == 0:
+				// Содержимое simpleContent, которое xgen не сгенерировал
+				s.text = reflect.TypeFor[string]()
+			default:
+				collectFields(s, ft)
+			}
+			continue
+		}
+		// Поля без тега (Extended... у xgen) и XMLName не относятся к схеме
+		if !hasTag || tag == "-" || f.Name == "XMLName" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		field := schemaField{typ: indirect(f.Type)}
+		if f.Type.Kind() == reflect.Slice {
+			field.many = true
+			field.typ = indirect(f.Type.Elem())
+		}
+		switch {
+		case opts == "chardata":
+			s.text = field.typ
+		case strings.HasPrefix(opts, "attr"):
+			s.attrs[name] = field
+		case name != "":
+			field.pos = len(s.elements)
+			s.elements[name] = field
+		}
+	}
+}
+
+// indirect снимает указатели с типа
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// ruleOf возвращает ограничения типа t
+func ruleOf(t reflect.Type) schemaRule {
+	if p, ok := reflect.Zero(t).Interface().(ruleProvider); ok {
+		return p.schemaRule()
+	}
+	return schemaRules[t.Name()]
+}
+
+// validator собирает нарушения документа
+type validator struct {
+	// space пространство имён корневого элемента
+	space      string
+	violations []Violation
+}
+
+func (v *validator) add(path, code, message string) {
+	if len(v.violations) < maxViolations {
+		v.violations = append(v.violations, Violation{Path: path, Code: code, Message: message})
+	}
+}
+
+// element проверяет элемент e по типу t
+func (v *validator) element(path string, e *element, t reflect.Type) {
+	s := schemaOf(t)
+	if s.open {
+		return
+	}
+	rule := ruleOf(t)
+
+	for _, a := range e.attrs {
+		if a.Name.Space != "" || a.Name.Local == "xmlns" {
+			continue
+		}
+		attrPath := path + "/@" + a.Name.Local
+		field, ok := s.attrs[a.Name.Local]
+		if !ok {
+			v.add(attrPath, ViolationUnexpected, fmt.Sprintf("attribute %s is not allowed", a.Name.Local))
+			continue
+		}
+		v.value(attrPath, a.Value, field.typ, rule.attrs[a.Name.Local])
+	}
+
+	text := e.text.String()
+	switch {
+	case s.text != nil:
+		v.value(path, text, s.text, rule.text)
+		if _, ok := s.attrs["OtherValue"]; ok && strings.TrimSpace(text) == "Other" && !hasAttr(e, "OtherValue") {
+			v.add(path+"/@OtherValue", ViolationRequired, "attribute OtherValue is required for Other")
+		}
+	case strings.TrimSpace(text) != "":
+		v.add(path, ViolationUnexpected, "text content is not allowed")
+	}
+
+	counts := map[string]int{}
+	for _, child := range e.children {
+		if child.name.Space == v.space {
+			counts[child.name.Local]++
+		}
+	}
+	seen := map[string]int{}
+	// last элемент с наибольшей позицией в xs:sequence среди просмотренных
+	last, lastPos := "", -1
+	for _, child := range e.children {
+		name := child.name.Local
+		if child.name.Space != v.space {
+			continue
+		}
+		seen[name]++
+		childPath := path + "/" + name
+		if counts[name] > 1 {
+			childPath += "[" + strconv.Itoa(seen[name]) + "]"
+		}
+		field, ok := s.elements[name]
+		occurs := rule.elements[name]
+		switch {
+		case !ok:
+			v.add(childPath, ViolationUnexpected, fmt.Sprintf("element %s is not allowed here", name))
+			continue
+		case !field.many && seen[name] == 2:
+			v.add(childPath, ViolationCardinality, fmt.Sprintf("element %s must occur at most once", name))
+		case occurs.max > 0 && seen[name] == occurs.max+1:
+			v.add(childPath, ViolationCardinality, fmt.Sprintf("element %s must occur at most %d times", name, occurs.max))
+		}
+		if pos := s.position(rule, name); pos < lastPos {
+			v.add(childPath, ViolationOrder, fmt.Sprintf("element %s must precede %s", name, last))
+		} else {
+			last, lastPos = name, pos
+		}
+		v.element(childPath, child, field.typ)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(rule.elements)) {
+		occurs := rule.elements[name]
+		switch n := counts[name]; {
+		case n == 0 && occurs.min > 0:
+			v.add(path+"/"+name, ViolationRequired, fmt.Sprintf("element %s is required", name))
+		case n < occurs.min:
+			v.add(path+"/"+name, ViolationCardinality, fmt.Sprintf("element %s must occur at least %d times", name, occurs.min))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(rule.attrs)) {
+		if rule.attrs[name].required && !hasAttr(e, name) {
+			v.add(path+"/@"+name, ViolationRequired, fmt.Sprintf("attribute %s is required", name))
+		}
+	}
+}
+
+// position возвращает положение элемента name в xs:sequence типа; альтернативы
+// одного xs:choice занимают общее положение первой из них
+func (s *schemaType) position(rule schemaRule, name string) int {
+	pos := s.elements[name].pos
+	choice := rule.elements[name].choice
+	if choice == 0 {
+		return pos
+	}
+	for other, occurs := range rule.elements {
+		if field, ok := s.elements[other]; ok && occurs.choice == choice {
+			pos = min(pos, field.pos)
+		}
+	}
+	return pos
+}
+
+// value проверяет текстовое значение по типу поля и ограничениям rule
+func (v *validator) value(path, value string, t reflect.Type, rule valueRule) {
+	trimmed := strings.TrimSpace(value)
+	var err error
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		_, err = strconv.ParseInt(trimmed, 10, t.Bits())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		_, err = strconv.ParseUint(trimmed, 10, t.Bits())
+	case reflect.Float32, reflect.Float64:
+		_, err = strconv.ParseFloat(trimmed, t.Bits())
+	case reflect.Bool:
+		_, err = strconv.ParseBool(trimmed)
+	}
+	if err != nil {
+		v.add(path, ViolationFormat, fmt.Sprintf("%q is not a valid %s", value, t.Kind()))
+		return
+	}
+	if code, message := rule.check(value); code != "" {
+		v.add(path, code, message)
+	}
+}
+
+func hasAttr(e *element, name string) bool {
+	return slices.ContainsFunc(e.attrs, func(a xml.Attr) bool {
+		return a.Name.Space == "" && a.Name.Local == name
+	})
+}
+
+// checkEnumeration проверяет перечисление значений. Значение сравнивается без
+// пробелов, как уровни оборудования ("Process Cell" = "ProcessCell")
+func checkEnumeration(value string, enum []string) (string, string) {
+	compact := strings.ReplaceAll(strings.TrimSpace(value), " ", "")
+	if slices.ContainsFunc(enum, func(item string) bool { return strings.ReplaceAll(item, " ", "") == compact }) {
+		return "", ""
+	}
+	return ViolationEnumeration, fmt.Sprintf("%q is not one of %s", value, strings.Join(enum, ", "))
+}
+
+// checkNormalizedString проверяет xs:normalizedString: без табуляций и
+// переводов строк; крайние пробелы допустимы
+func checkNormalizedString(value string) (string, string) {
+	if strings.ContainsAny(value, "\t\r\n") {
+		return ViolationFormat, "value must not contain tabs or line breaks"
+	}
+	return "", ""
+}
+
+// dateTimePattern лексическое представление xs:dateTime
+var dateTimePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})?$`)
+
+// checkDateTime проверяет дату и время xs:dateTime
+func checkDateTime(value string) (string, string) {
+	value = strings.TrimSpace(value)
+	if dateTimePattern.MatchString(value) {
+		if _, err := time.Parse("2006-01-02T15:04:05", value[:19]); err == nil {
+			return "", ""
+		}
+	}
+	return ViolationFormat, fmt.Sprintf("%q is not a valid xs:dateTime", value)
+}
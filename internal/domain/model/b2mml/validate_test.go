@@ -0,0 +1,201 @@
+package b2mml_test
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+)
+
+// syncEquipment возвращает документ SyncEquipment с атрибутами корня attrs,
+// кодом действия action и существительным equipment
+func syncEquipment(attrs, action, equipment string) string {
+	return `<SyncEquipment xmlns="http://www.mesa.org/xml/B2MML"` + attrs + `>
+  <ApplicationArea>
+    <Sender><LogicalID>erp</LogicalID><ConfirmationCode>OnError</ConfirmationCode></Sender>
+    <CreationDateTime>2026-10-18T09:30:00Z</CreationDateTime>
+  </ApplicationArea>
+  <DataArea>
+    <Sync><ActionCriteria><ActionExpression actionCode="` + action + `"/></ActionCriteria></Sync>
+    <Equipment>` + equipment + `</Equipment>
+  </DataArea>
+</SyncEquipment>`
+}
+
+const validEquipment = `
+      <ID>PUMP-1</ID>
+      <Description>Насос</Description>
+      <PublishedDate>2026-10-18T09:30:00+03:00</PublishedDate>
+      <EquipmentLevel>Unit</EquipmentLevel>
+      <EquipmentProperty><ID>Power</ID><Value><ValueString>15</ValueString></Value></EquipmentProperty>
+      <EquipmentClassID>PUMP</EquipmentClassID>`
+
+func TestValidate(t *testing.T) {
+	const release = ` releaseID="7.0"`
+	const equipment = "/SyncEquipment/DataArea/Equipment"
+
+	tests := []struct {
+		name string
+		doc  string
+		// want пути и коды нарушений
+		want []b2mml.Violation
+	}{
+		{
+			name: "valid",
+			doc:  syncEquipment(release, "Add", validEquipment),
+		},
+		{
+			name: "identifier with leading and trailing spaces",
+			doc:  syncEquipment(release, "Add", `<ID> PUMP-1 </ID>`),
+		},
+		{
+			name: "equipment level with spaces",
+			doc:  syncEquipment(release, "Add", `<ID>PUMP-1</ID><EquipmentLevel>Process Cell</EquipmentLevel>`),
+		},
+		{
+			name: "other level with OtherValue",
+			doc:  syncEquipment(release, "Add", `<ID>PUMP-1</ID><EquipmentLevel OtherValue="Skid">Other</EquipmentLevel>`),
+		},
+		{
+			name: "unexpected element",
+			doc:  syncEquipment(release, "Add", `<ID>PUMP-1</ID><Colour>red</Colour>`),
+			want: []b2mml.Violation{{Path: equipment + "/Colour", Code: b2mml.ViolationUnexpected}},
+		},
+		{
+			name: "unexpected attribute",
+			doc:  syncEquipment(release, "Add", `<ID colour="red">PUMP-1</ID>`),
+			want: []b2mml.Violation{{Path: equipment + "/ID/@colour", Code: b2mml.ViolationUnexpected}},
+		},
+		{
+			name: "unexpected text",
+			doc:  syncEquipment(release, "Add", `<ID>PUMP-1</ID><EquipmentProperty>15<ID>Power</ID></EquipmentProperty>`),
+			want: []b2mml.Violation{{Path: equipment + "/EquipmentProperty", Code: b2mml.ViolationUnexpected}},
+		},
+		{
+			name: "element repeated over maxOccurs",
+			doc:  syncEquipment(release, "Add", `<ID>PUMP-1</ID><ID>PUMP-2</ID>`),
+			want: []b2mml.Violation{{Path: equipment + "/ID[2]", Code: b2mml.ViolationCardinality}},
+		},
+		{
+			name: "required element missing",
+			doc:  syncEquipment(release, "Add", `<Description>Насос</Description>`),
+			want: []b2mml.Violation{{Path: equipment + "/ID", Code: b2mml.ViolationRequired}},
+		},
+		{
+			name: "required nested element missing",
+			doc:  syncEquipment(release, "Add", `<ID>PUMP-1</ID><EquipmentProperty><Description>Мощность</Description></EquipmentProperty>`),
+			want: []b2mml.Violation{{Path: equipment + "/EquipmentProperty/ID", Code: b2mml.ViolationRequired}},
+		},
+		{
+			name: "required attribute missing",
+			doc:  syncEquipment("", "Add", `<ID>PUMP-1</ID>`),
+			want: []b2mml.Violation{{Path: "/SyncEquipment/@releaseID", Code: b2mml.ViolationRequired}},
+		},
+		{
+			name: "OtherValue missing for Other",
+			doc:  syncEquipment(release, "Add", `<ID>PUMP-1</ID><EquipmentLevel>Other</EquipmentLevel>`),
+			want: []b2mml.Violation{{Path: equipment + "/EquipmentLevel/@OtherValue", Code: b2mml.ViolationRequired}},
+		},
+		{
+			name: "text outside enumeration",
+			doc:  syncEquipment(release, "Add", `<ID>PUMP-1</ID><EquipmentLevel>Galaxy</EquipmentLevel>`),
+			want: []b2mml.Violation{{Path: equipment + "/EquipmentLevel", Code: b2mml.ViolationEnumeration}},
+		},
+		{
+			name: "attribute outside enumeration",
+			doc:  syncEquipment(release, "Upsert", `<ID>PUMP-1</ID>`),
+			want: []b2mml.Violation{{
+				Path: "/SyncEquipment/DataArea/Sync/ActionCriteria/ActionExpression/@actionCode",
+				Code: b2mml.ViolationEnumeration,
+			}},
+		},
+		{
+			name: "identifier with line break",
+			doc:  syncEquipment(release, "Add", "<ID>PUMP\n1</ID>"),
+			want: []b2mml.Violation{{Path: equipment + "/ID", Code: b2mml.ViolationFormat}},
+		},
+		{
+			name: "invalid date time",
+			doc:  syncEquipment(release, "Add", `<ID>PUMP-1</ID><PublishedDate>18.10.2026</PublishedDate>`),
+			want: []b2mml.Violation{{Path: equipment + "/PublishedDate", Code: b2mml.ViolationFormat}},
+		},
+		{
+			name: "sequence order",
+			doc:  syncEquipment(release, "Add", `<Description>Насос</Description><ID>PUMP-1</ID>`),
+			want: []b2mml.Violation{{Path: equipment + "/ID", Code: b2mml.ViolationOrder}},
+		},
+		{
+			name: "sequence order of repeated elements",
+			doc: syncEquipment(release, "Add", `<ID>PUMP-1</ID>
+				<EquipmentProperty><ID>Power</ID></EquipmentProperty>
+				<EquipmentClassID>PUMP</EquipmentClassID>
+				<EquipmentProperty><ID>Flow</ID></EquipmentProperty>`),
+			want: []b2mml.Violation{{Path: equipment + "/EquipmentProperty[2]", Code: b2mml.ViolationOrder}},
+		},
+		{
+			name: "violations in several elements",
+			doc:  syncEquipment("", "Add", `<EquipmentLevel>Galaxy</EquipmentLevel><Description>Насос</Description>`),
+			want: []b2mml.Violation{
+				{Path: equipment + "/EquipmentLevel", Code: b2mml.ViolationEnumeration},
+				{Path: equipment + "/Description", Code: b2mml.ViolationOrder},
+				{Path: equipment + "/ID", Code: b2mml.ViolationRequired},
+				{Path: "/SyncEquipment/@releaseID", Code: b2mml.ViolationRequired},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := b2mml.Validate(strings.NewReader(tt.doc), &b2mml.BOD[b2mml.EquipmentDataArea]{})
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+
+			var verr *b2mml.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Validate() = %v, want *ValidationError", err)
+			}
+			got := make([]b2mml.Violation, len(verr.Violations))
+			for i, v := range verr.Violations {
+				if v.Message == "" {
+					t.Errorf("violation %s has no message", v.Path)
+				}
+				got[i] = b2mml.Violation{Path: v.Path, Code: v.Code}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateSyntaxError(t *testing.T) {
+	err := b2mml.Validate(strings.NewReader(`<SyncEquipment><DataArea>`), &b2mml.BOD[b2mml.EquipmentDataArea]{})
+	var verr *b2mml.ValidationError
+	if err == nil || errors.As(err, &verr) {
+		t.Fatalf("Validate() = %v, want XML syntax error", err)
+	}
+}
+
+func TestUnmarshalJSONOrder(t *testing.T) {
+	// Ключи объекта JSON не упорядочены и не должны нарушать xs:sequence
+	data := `{"EquipmentClassID": ["PUMP"], "Description": ["Насос"], "ID": "PUMP-1", "EquipmentLevel": "Unit"}`
+	var equipment b2mml.EquipmentType
+	if err := b2mml.UnmarshalJSON([]byte(data), &equipment); err != nil {
+		t.Fatalf("UnmarshalJSON() = %v", err)
+	}
+	if got := equipment.ID.String(); got != "PUMP-1" {
+		t.Errorf("ID = %q, want PUMP-1", got)
+	}
+
+	err := b2mml.UnmarshalJSON([]byte(`{"Description": ["Насос"]}`), &equipment)
+	var verr *b2mml.ValidationError
+	if !errors.As(err, &verr) || verr.Violations[0].Path != "/ID" || verr.Violations[0].Code != b2mml.ViolationRequired {
+		t.Errorf("UnmarshalJSON() = %v, want required /ID", err)
+	}
+}
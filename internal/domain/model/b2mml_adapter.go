@@ -2,6 +2,8 @@ package model
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 
 	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
 )
@@ -30,9 +32,12 @@ func NewEquipmentFromB2MML(
 					continue
 				}
 
-				value := PropertyValue{
-					value:       prop.ID.Value,
-					dataType:    "string",
+				var value PropertyValue
+				if len(prop.Value) > 0 {
+					value, err = PropertyValueFromB2MML(prop.Value[0])
+					if err != nil {
+						return nil, fmt.Errorf("equipment property %s: %w", propID, err)
+					}
 				}
 
 				equipmentProp := NewEquipmentProperty(propID, prop, value)
@@ -107,20 +112,326 @@ func NewEquipmentClassFromB2MML(
 	return ec, nil
 }
 
-// ToB2MML преобразует Equipment обратно в B2MML структуру
+// ToB2MML преобразует Equipment обратно в B2MML структуру: данные агрегата
+// дополняются идентификатором, классами, свойствами с текущими значениями и
+// загруженным дочерним оборудованием. Данные агрегата не изменяются
 func (e *Equipment) ToB2MML() *b2mml.EquipmentType {
-	if e == nil || e.data == nil {
+	if e == nil {
 		return nil
 	}
-	// Возвращаем оригинальные данные B2MML
-	// которые синхронизируются через репозиторий
-	return e.data
+	noun := &b2mml.EquipmentType{}
+	if e.data != nil {
+		*noun = *e.data
+	}
+	noun.ID = &b2mml.IdentifierType{Value: e.id.String()}
+
+	noun.EquipmentClassID = nil
+	for _, class := range e.classes {
+		noun.EquipmentClassID = append(noun.EquipmentClassID, &b2mml.IdentifierType{Value: class.ID().String()})
+	}
+
+	noun.EquipmentProperty = nil
+	for _, prop := range e.properties {
+		propNoun := &b2mml.EquipmentPropertyType{}
+		if prop.data != nil {
+			*propNoun = *prop.data
+		}
+		propNoun.ID = &b2mml.IdentifierType{Value: prop.id.String()}
+		propNoun.Value = nil
+		if value := prop.value.ToB2MML(); value != nil {
+			propNoun.Value = []*b2mml.ValueType{value}
+		}
+		noun.EquipmentProperty = append(noun.EquipmentProperty, propNoun)
+	}
+
+	noun.EquipmentChild = nil
+	for _, child := range e.children {
+		noun.EquipmentChild = append(noun.EquipmentChild, child.ToB2MML())
+	}
+	return noun
 }
 
-// ToB2MML преобразует EquipmentClass обратно в B2MML структуру
+// ToB2MML преобразует EquipmentClass обратно в B2MML структуру со свойствами
+// и дочерними классами
 func (ec *EquipmentClass) ToB2MML() *b2mml.EquipmentClassType {
-	if ec == nil || ec.data == nil {
+	if ec == nil {
 		return nil
 	}
-	return ec.data
+	noun := &b2mml.EquipmentClassType{}
+	if ec.data != nil {
+		*noun = *ec.data
+	}
+	noun.ID = &b2mml.IdentifierType{Value: ec.id.String()}
+	noun.EquipmentClassProperty = classPropertiesToB2MML(ec.properties)
+
+	noun.EquipmentClassChild = nil
+	for _, child := range ec.children {
+		noun.EquipmentClassChild = append(noun.EquipmentClassChild, child.ToB2MML())
+	}
+	return noun
+}
+
+// classPropertiesToB2MML преобразует свойства класса в B2MML вместе с вложенными
+func classPropertiesToB2MML(properties []*EquipmentClassProperty) []*b2mml.EquipmentClassPropertyType {
+	var nouns []*b2mml.EquipmentClassPropertyType
+	for _, prop := range properties {
+		noun := &b2mml.EquipmentClassPropertyType{}
+		if prop.data != nil {
+			*noun = *prop.data
+		}
+		noun.ID = &b2mml.IdentifierType{Value: prop.id.String()}
+		noun.EquipmentClassPropertyChild = classPropertiesToB2MML(prop.properties)
+		nouns = append(nouns, noun)
+	}
+	return nouns
+}
+
+// ReplaceB2MML заменяет данные B2MML оборудования (actionCode Replace): классы
+// заменяются на classes, свойства - на свойства из data. Сохранённые свойства
+// сохраняют значения, новые создаются без значения: значения задаются через
+// SetPropertyValue. Дочернее оборудование data не применяется: это отдельные агрегаты
+func (e *Equipment) ReplaceB2MML(data *b2mml.EquipmentType, classes []*EquipmentClass) error {
+	if data == nil {
+		return errors.New("b2mml data cannot be nil")
+	}
+	properties, err := e.mergeB2MMLProperties(nil, data.EquipmentProperty)
+	if err != nil {
+		return err
+	}
+
+	e.class, e.classes = nil, make([]*EquipmentClass, 0, len(classes))
+	for _, class := range classes {
+		if err := e.AddClass(class); err != nil {
+			return err
+		}
+	}
+	e.data = equipmentNoun(data, e.classes)
+	e.properties = properties
+	e.version++
+	return nil
+}
+
+// ChangeB2MML дополняет данные B2MML оборудования (actionCode Change): заданные
+// в data поля заменяют текущие, classes добавляются к классам оборудования,
+// свойства из data добавляются или заменяют одноимённые, остальные сохраняются.
+// Дочернее оборудование data не применяется
+func (e *Equipment) ChangeB2MML(data *b2mml.EquipmentType, classes []*EquipmentClass) error {
+	if data == nil {
+		return errors.New("b2mml data cannot be nil")
+	}
+	properties, err := e.mergeB2MMLProperties(e.properties, data.EquipmentProperty)
+	if err != nil {
+		return err
+	}
+
+	for _, class := range classes {
+		if err := e.AddClass(class); err != nil {
+			return err
+		}
+	}
+	e.data = equipmentNoun(mergeEquipmentData(e.data, data), e.classes)
+	e.properties = properties
+	e.version++
+	return nil
+}
+
+// mergeB2MMLProperties возвращает свойства keep, дополненные свойствами из data.
+// Для свойств, которые уже есть у оборудования, заменяются только данные B2MML
+func (e *Equipment) mergeB2MMLProperties(keep []*EquipmentProperty, data []*b2mml.EquipmentPropertyType) ([]*EquipmentProperty, error) {
+	result := append([]*EquipmentProperty(nil), keep...)
+	for _, propData := range data {
+		if propData == nil {
+			continue
+		}
+		id, err := NewEquipmentPropertyID(propData.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		if i := slices.IndexFunc(result, func(p *EquipmentProperty) bool { return p.id == id }); i >= 0 {
+			result[i].data = propData
+			continue
+		}
+		if prop, ok := e.Property(id); ok {
+			prop.data = propData
+			result = append(result, prop)
+			continue
+		}
+		result = append(result, NewEquipmentProperty(id, propData, PropertyValue{}))
+	}
+	return result, nil
+}
+
+// ReplaceB2MML заменяет данные B2MML и свойства класса (actionCode Replace).
+// Дочерние классы data не применяются: это отдельные агрегаты
+func (ec *EquipmentClass) ReplaceB2MML(data *b2mml.EquipmentClassType) error {
+	if data == nil {
+		return errors.New("b2mml data cannot be nil")
+	}
+	properties, err := classPropertiesFromB2MML(data.EquipmentClassProperty)
+	if err != nil {
+		return err
+	}
+	ec.data = equipmentClassNoun(data)
+	ec.properties = properties
+	return nil
+}
+
+// ChangeB2MML дополняет данные B2MML класса (actionCode Change): заданные в data
+// поля заменяют текущие, свойства из data добавляются или заменяют одноимённые.
+// Дочерние классы data не применяются
+func (ec *EquipmentClass) ChangeB2MML(data *b2mml.EquipmentClassType) error {
+	if data == nil {
+		return errors.New("b2mml data cannot be nil")
+	}
+	changed, err := classPropertiesFromB2MML(data.EquipmentClassProperty)
+	if err != nil {
+		return err
+	}
+
+	properties := append([]*EquipmentClassProperty(nil), ec.properties...)
+	for _, prop := range changed {
+		if i := slices.IndexFunc(properties, func(p *EquipmentClassProperty) bool { return p.id == prop.id }); i >= 0 {
+			properties[i] = prop
+			continue
+		}
+		properties = append(properties, prop)
+	}
+	ec.data = equipmentClassNoun(mergeEquipmentClassData(ec.data, data))
+	ec.properties = properties
+	return nil
+}
+
+// classPropertiesFromB2MML создаёт дерево свойств класса из B2MML данных
+func classPropertiesFromB2MML(data []*b2mml.EquipmentClassPropertyType) ([]*EquipmentClassProperty, error) {
+	properties := make([]*EquipmentClassProperty, 0, len(data))
+	for _, propData := range data {
+		if propData == nil {
+			continue
+		}
+		id, err := NewEquipmentClassPropertyID(propData.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		children, err := classPropertiesFromB2MML(propData.EquipmentClassPropertyChild)
+		if err != nil {
+			return nil, err
+		}
+		prop := NewEquipmentClassProperty(id, propData)
+		prop.properties = append(prop.properties, children...)
+		properties = append(properties, prop)
+	}
+	return properties, nil
+}
+
+// equipmentNoun возвращает копию данных B2MML оборудования для хранения в агрегате:
+// свойства и дочернее оборудование агрегат хранит отдельно, классы берутся из classes
+func equipmentNoun(data *b2mml.EquipmentType, classes []*EquipmentClass) *b2mml.EquipmentType {
+	noun := *data
+	noun.EquipmentProperty = nil
+	noun.EquipmentChild = nil
+	noun.EquipmentClassID = nil
+	for _, class := range classes {
+		noun.EquipmentClassID = append(noun.EquipmentClassID, &b2mml.IdentifierType{Value: class.ID().String()})
+	}
+	return &noun
+}
+
+// equipmentClassNoun возвращает копию данных B2MML класса без свойств и дочерних классов
+func equipmentClassNoun(data *b2mml.EquipmentClassType) *b2mml.EquipmentClassType {
+	noun := *data
+	noun.EquipmentClassProperty = nil
+	noun.EquipmentClassChild = nil
+	return &noun
+}
+
+// mergeEquipmentData возвращает данные оборудования current, в которых заданные
+// в changed поля заменены
+func mergeEquipmentData(current, changed *b2mml.EquipmentType) *b2mml.EquipmentType {
+	merged := &b2mml.EquipmentType{}
+	if current != nil {
+		*merged = *current
+	}
+	if changed.ID != nil {
+		merged.ID = changed.ID
+	}
+	if changed.Version != nil {
+		merged.Version = changed.Version
+	}
+	if len(changed.Description) > 0 {
+		merged.Description = changed.Description
+	}
+	if changed.PublishedDate != nil {
+		merged.PublishedDate = changed.PublishedDate
+	}
+	if changed.EffectiveStartDate != nil {
+		merged.EffectiveStartDate = changed.EffectiveStartDate
+	}
+	if changed.EffectiveEndDate != nil {
+		merged.EffectiveEndDate = changed.EffectiveEndDate
+	}
+	if changed.HierarchyScope != nil {
+		merged.HierarchyScope = changed.HierarchyScope
+	}
+	if changed.EquipmentLevel != nil {
+		merged.EquipmentLevel = changed.EquipmentLevel
+	}
+	if changed.SpatialDefinition != nil {
+		merged.SpatialDefinition = changed.SpatialDefinition
+	}
+	if len(changed.EquipmentAssetMapping) > 0 {
+		merged.EquipmentAssetMapping = changed.EquipmentAssetMapping
+	}
+	if changed.PhysicalAssetID != nil {
+		merged.PhysicalAssetID = changed.PhysicalAssetID
+	}
+	if changed.OperationalLocation != nil {
+		merged.OperationalLocation = changed.OperationalLocation
+	}
+	if len(changed.TestSpecificationID) > 0 {
+		merged.TestSpecificationID = changed.TestSpecificationID
+	}
+	return merged
+}
+
+// mergeEquipmentClassData возвращает данные класса current, в которых заданные
+// в changed поля заменены
+func mergeEquipmentClassData(current, changed *b2mml.EquipmentClassType) *b2mml.EquipmentClassType {
+	merged := &b2mml.EquipmentClassType{}
+	if current != nil {
+		*merged = *current
+	}
+	if changed.ID != nil {
+		merged.ID = changed.ID
+	}
+	if changed.Version != nil {
+		merged.Version = changed.Version
+	}
+	if len(changed.Description) > 0 {
+		merged.Description = changed.Description
+	}
+	if changed.PublishedDate != nil {
+		merged.PublishedDate = changed.PublishedDate
+	}
+	if changed.EffectiveStartDate != nil {
+		merged.EffectiveStartDate = changed.EffectiveStartDate
+	}
+	if changed.EffectiveEndDate != nil {
+		merged.EffectiveEndDate = changed.EffectiveEndDate
+	}
+	if changed.HierarchyScope != nil {
+		merged.HierarchyScope = changed.HierarchyScope
+	}
+	if changed.EquipmentLevel != nil {
+		merged.EquipmentLevel = changed.EquipmentLevel
+	}
+	if len(changed.EquipmentClassBaseID) > 0 {
+		merged.EquipmentClassBaseID = changed.EquipmentClassBaseID
+	}
+	if len(changed.EquipmentSourceID) > 0 {
+		merged.EquipmentSourceID = changed.EquipmentSourceID
+	}
+	if len(changed.TestSpecificationID) > 0 {
+		merged.TestSpecificationID = changed.TestSpecificationID
+	}
+	return merged
 }
@@ -0,0 +1,103 @@
+package model
+
+import "fmt"
+
+// DeletePolicy правило обработки зависимых объектов при удалении оборудования
+type DeletePolicy string
+
+const (
+	// DeleteBlock запрещает удаление, пока зависимые объекты есть
+	DeleteBlock DeletePolicy = "block"
+	// DeleteOrphan отвязывает зависимые объекты: дочернее оборудование
+	// переносится в корень иерархии, связи с классами удаляются
+	DeleteOrphan DeletePolicy = "orphan"
+	// DeleteCascade удаляет зависимые объекты вместе с оборудованием (soft delete);
+	// при восстановлении оборудования они восстанавливаются вместе с ним
+	DeleteCascade DeletePolicy = "cascade"
+)
+
+// ParseDeletePolicy разбирает правило удаления
+func ParseDeletePolicy(s string) (DeletePolicy, error) {
+	switch policy := DeletePolicy(s); policy {
+	case DeleteBlock, DeleteOrphan, DeleteCascade:
+		return policy, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidDeletePolicy, s)
+}
+
+// DeleteOptions правила удаления оборудования
+type DeleteOptions struct {
+	// Children правило для дочернего оборудования
+	Children DeletePolicy
+	// Classes правило для связей оборудования с классами
+	Classes DeletePolicy
+}
+
+// Delete помечает оборудование удалённым по правилам opts; parent - текущий
+// родитель (nil - корень). Дочернее оборудование должно быть загружено: при
+// Children = DeleteCascade всё поддерево, иначе один уровень.
+// Регистрирует EquipmentDeletedEvent для оборудования и каскадно удаляемых
+// потомков и EquipmentMovedEvent для отвязанного дочернего оборудования
+func (e *Equipment) Delete(parent *EquipmentID, opts DeleteOptions) error {
+	if opts.Children == DeleteBlock && len(e.children) > 0 {
+		return ErrEquipmentHasChildren
+	}
+
+	type removal struct {
+		equipment *Equipment
+		parent    *EquipmentID
+	}
+	removed := []removal{{e, parent}}
+	if opts.Children == DeleteCascade {
+		for i := 0; i < len(removed); i++ {
+			node := removed[i].equipment
+			for _, child := range node.children {
+				removed = append(removed, removal{child, &node.id})
+			}
+		}
+	}
+	if opts.Classes == DeleteBlock {
+		for _, r := range removed {
+			if len(r.equipment.classes) > 0 {
+				return fmt.Errorf("%w: %s", ErrEquipmentHasClasses, r.equipment.id)
+			}
+		}
+	}
+
+	if opts.Children == DeleteOrphan {
+		for _, child := range e.children {
+			child.version++
+			child.recordEvent(NewEquipmentMovedEvent(child.id, &e.id, nil))
+		}
+	}
+	for _, r := range removed {
+		node := r.equipment
+		if opts.Classes == DeleteOrphan {
+			node.class, node.classes = nil, nil
+		}
+		var cascadeRoot *EquipmentID
+		if node != e {
+			cascadeRoot = &e.id
+		}
+		node.version++
+		node.recordEvent(NewEquipmentDeletedEvent(node.id, r.parent, cascadeRoot))
+	}
+	return nil
+}
+
+// Restore восстанавливает удалённое оборудование вместе с загруженными
+// потомками, удалёнными каскадно с ним. Регистрирует EquipmentRestoredEvent
+func (e *Equipment) Restore() {
+	e.version++
+	e.recordEvent(NewEquipmentRestoredEvent(e.id, nil))
+
+	var restore func(node *Equipment)
+	restore = func(node *Equipment) {
+		for _, child := range node.children {
+			child.version++
+			child.recordEvent(NewEquipmentRestoredEvent(child.id, &e.id))
+			restore(child)
+		}
+	}
+	restore(e)
+}
@@ -12,10 +12,13 @@ type Equipment struct {
 	id              EquipmentID
 	data            *b2mml.EquipmentType
 	class           *EquipmentClass
+	classes         []*EquipmentClass // все классы, class - основной
 	properties      []*EquipmentProperty
 	children        []*Equipment
 	operatingStatus OperatingStatus
 	version         int64
+
+	aggregateEvents // события, ещё не переданные наружу
 }
 
 // EquipmentID является Value Object идентификатора оборудования
@@ -39,11 +42,20 @@ func (id EquipmentID) String() string {
 type OperatingStatus string
 
 const (
-	OperatingStatusActive    OperatingStatus = "active"
-	OperatingStatusInactive  OperatingStatus = "inactive"
+	OperatingStatusActive      OperatingStatus = "active"
+	OperatingStatusInactive    OperatingStatus = "inactive"
 	OperatingStatusMaintenance OperatingStatus = "maintenance"
 )
 
+// ParseOperatingStatus преобразует строку в статус эксплуатации
+func ParseOperatingStatus(value string) (OperatingStatus, error) {
+	switch status := OperatingStatus(value); status {
+	case OperatingStatusActive, OperatingStatusInactive, OperatingStatusMaintenance:
+		return status, nil
+	}
+	return "", ErrEquipmentInvalidStatus
+}
+
 // EquipmentClass представляет класс (категорию) оборудования.
 // Оборудование может принадлежать нескольким классам.
 type EquipmentClass struct {
@@ -51,6 +63,8 @@ type EquipmentClass struct {
 	data       *b2mml.EquipmentClassType
 	properties []*EquipmentClassProperty
 	children   []*EquipmentClass // иерархия классов
+
+	aggregateEvents
 }
 
 // EquipmentClassID является Value Object идентификатора класса оборудования
@@ -140,20 +154,67 @@ func NewEquipment(
 	b2mmlData *b2mml.EquipmentType,
 	class *EquipmentClass,
 ) *Equipment {
-	return &Equipment{
+	classes := make([]*EquipmentClass, 0)
+	if class != nil {
+		classes = append(classes, class)
+	}
+	e := &Equipment{
 		id:         id,
 		data:       b2mmlData,
 		class:      class,
+		classes:    classes,
 		properties: make([]*EquipmentProperty, 0),
 		children:   make([]*Equipment, 0),
 		version:    1,
 	}
+	var classID EquipmentClassID
+	if class != nil {
+		classID = class.ID()
+	}
+	e.recordEvent(NewEquipmentCreatedEvent(id, classID))
+	return e
+}
+
+// RestoreEquipment восстанавливает агрегат Equipment из хранилища.
+// В отличие от NewEquipment не изменяет версию и принимает все классы:
+// первый из них считается основным.
+func RestoreEquipment(
+	id EquipmentID,
+	b2mmlData *b2mml.EquipmentType,
+	classes []*EquipmentClass,
+	properties []*EquipmentProperty,
+	children []*Equipment,
+	status OperatingStatus,
+	version int64,
+) *Equipment {
+	e := NewEquipment(id, b2mmlData, nil)
+	if len(classes) > 0 {
+		e.class = classes[0]
+		e.classes = append(e.classes, classes...)
+	}
+	e.properties = append(e.properties, properties...)
+	e.children = append(e.children, children...)
+	e.operatingStatus = status
+	e.version = version
+	e.PullEvents()
+	return e
 }
 
 // NewEquipmentClass создаёт новый класс оборудования
 func NewEquipmentClass(
 	id EquipmentClassID,
 	b2mmlData *b2mml.EquipmentClassType,
+) *EquipmentClass {
+	ec := RestoreEquipmentClass(id, b2mmlData)
+	ec.recordEvent(NewEquipmentClassCreatedEvent(id))
+	return ec
+}
+
+// RestoreEquipmentClass восстанавливает класс оборудования из хранилища
+// без регистрации события создания
+func RestoreEquipmentClass(
+	id EquipmentClassID,
+	b2mmlData *b2mml.EquipmentClassType,
 ) *EquipmentClass {
 	return &EquipmentClass{
 		id:         id,
@@ -175,6 +236,30 @@ func (e *Equipment) Class() *EquipmentClass {
 	return e.class
 }
 
+// Classes возвращает все классы оборудования, начиная с основного
+func (e *Equipment) Classes() []*EquipmentClass {
+	return e.classes
+}
+
+// AddClass добавляет оборудование в класс.
+// Первый добавленный класс становится основным.
+func (e *Equipment) AddClass(class *EquipmentClass) error {
+	if class == nil {
+		return ErrEquipmentClassNotFound
+	}
+	for _, c := range e.classes {
+		if c.ID() == class.ID() {
+			return nil
+		}
+	}
+	if e.class == nil {
+		e.class = class
+	}
+	e.classes = append(e.classes, class)
+	e.version++
+	return nil
+}
+
 // GetB2MMLData возвращает исходные данные B2MML для интеграции и сохранения
 func (e *Equipment) GetB2MMLData() *b2mml.EquipmentType {
 	return e.data
@@ -187,6 +272,9 @@ func (e *Equipment) Properties() []*EquipmentProperty {
 
 // SetOperatingStatus устанавливает статус эксплуатации
 func (e *Equipment) SetOperatingStatus(status OperatingStatus) {
+	if status != e.operatingStatus {
+		e.recordEvent(NewEquipmentStatusChangedEvent(e.id, e.operatingStatus, status))
+	}
 	e.operatingStatus = status
 	e.version++
 }
@@ -201,6 +289,14 @@ func (e *Equipment) Version() int64 {
 	return e.version
 }
 
+// CheckVersion проверяет, что агрегат имеет ожидаемую версию
+func (e *Equipment) CheckVersion(expected int64) error {
+	if e.version != expected {
+		return ErrEquipmentVersionConflict
+	}
+	return nil
+}
+
 // Методы EquipmentClass
 
 // ID возвращает идентификатор класса
@@ -238,6 +334,32 @@ func (e *Equipment) AddProperty(prop *EquipmentProperty) error {
 	return nil
 }
 
+// SetPropertyValue устанавливает значение свойства оборудования.
+// Если свойства с таким ID нет, оно добавляется.
+func (e *Equipment) SetPropertyValue(id EquipmentPropertyID, value PropertyValue) {
+	for _, prop := range e.properties {
+		if prop.id == id {
+			if prop.value != value {
+				prop.value = value
+				e.version++
+			}
+			return
+		}
+	}
+	data := &b2mml.EquipmentPropertyType{ID: &b2mml.IdentifierType{Value: id.String()}}
+	_ = e.AddProperty(NewEquipmentProperty(id, data, value))
+}
+
+// Property возвращает свойство оборудования по ID
+func (e *Equipment) Property(id EquipmentPropertyID) (*EquipmentProperty, bool) {
+	for _, prop := range e.properties {
+		if prop.id == id {
+			return prop, true
+		}
+	}
+	return nil, false
+}
+
 // IsActive проверяет, активно ли оборудование
 func (e *Equipment) IsActive() bool {
 	return e.operatingStatus == OperatingStatusActive
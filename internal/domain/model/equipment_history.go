@@ -0,0 +1,147 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+)
+
+// ChangeOperation вид изменения оборудования в истории
+type ChangeOperation string
+
+const (
+	ChangeCreated  ChangeOperation = "created"
+	ChangeUpdated  ChangeOperation = "updated"
+	ChangeMoved    ChangeOperation = "moved"
+	ChangeDeleted  ChangeOperation = "deleted"
+	ChangeRestored ChangeOperation = "restored"
+)
+
+// EquipmentSnapshot состояние оборудования в ревизии истории: запись
+// оборудования, его свойства и классы
+type EquipmentSnapshot struct {
+	ExternalID string
+	// ParentID внешний ID родителя; пустой - корень иерархии
+	ParentID              string
+	Position              int32
+	Version               string
+	Description           string
+	PublishedDate         *time.Time
+	EffectiveStartDate    *time.Time
+	EffectiveEndDate      *time.Time
+	HierarchyScopeID      string
+	EquipmentLevel        string
+	OperatingStatus       string
+	PhysicalAssetID       string
+	OperationalLocationID string
+	Deleted               bool
+	// Classes внешние ID классов в порядке их позиций
+	Classes    []string
+	Properties []PropertySnapshot
+	B2MML      *b2mml.EquipmentType
+}
+
+// PropertySnapshot состояние свойства оборудования в ревизии истории
+type PropertySnapshot struct {
+	ID          string
+	Value       string
+	DataType    string
+	Unit        string
+	Description string
+	B2MML       *b2mml.EquipmentPropertyType
+}
+
+// FieldChange изменение поля между двумя ревизиями. Old nil - поле появилось,
+// New nil - поле удалено
+type FieldChange struct {
+	// Field имя поля; поля свойств имеют вид properties.<id>.<поле>
+	Field string
+	Old   *string
+	New   *string
+}
+
+// Diff возвращает изменения полей от ревизии s к ревизии to.
+// Исходные B2MML данные не сравниваются: значимые поля вынесены в снимок
+func (s *EquipmentSnapshot) Diff(to *EquipmentSnapshot) []FieldChange {
+	from, target := s.fields(), to.fields()
+
+	var changes []FieldChange
+	for _, field := range snapshotFieldOrder(s, to) {
+		old, hadOld := from[field]
+		value, hasNew := target[field]
+		switch {
+		case hadOld && hasNew && old == value:
+			continue
+		case !hadOld:
+			changes = append(changes, FieldChange{Field: field, New: &value})
+		case !hasNew:
+			changes = append(changes, FieldChange{Field: field, Old: &old})
+		default:
+			changes = append(changes, FieldChange{Field: field, Old: &old, New: &value})
+		}
+	}
+	return changes
+}
+
+// fields возвращает значения полей снимка; пустые значения не включаются
+func (s *EquipmentSnapshot) fields() map[string]string {
+	fields := make(map[string]string)
+	set := func(field, value string) {
+		if value != "" {
+			fields[field] = value
+		}
+	}
+	setTime := func(field string, value *time.Time) {
+		if value != nil {
+			fields[field] = value.UTC().Format(time.RFC3339Nano)
+		}
+	}
+
+	set("parent_id", s.ParentID)
+	set("version", s.Version)
+	set("description", s.Description)
+	setTime("published_date", s.PublishedDate)
+	setTime("effective_start_date", s.EffectiveStartDate)
+	setTime("effective_end_date", s.EffectiveEndDate)
+	set("hierarchy_scope_id", s.HierarchyScopeID)
+	set("equipment_level", s.EquipmentLevel)
+	set("operating_status", s.OperatingStatus)
+	set("physical_asset_id", s.PhysicalAssetID)
+	set("operational_location_id", s.OperationalLocationID)
+	if s.Deleted {
+		fields["deleted"] = "true"
+	}
+	set("classes", strings.Join(s.Classes, ","))
+	for _, p := range s.Properties {
+		prefix := "properties." + p.ID + "."
+		set(prefix+"value", p.Value)
+		set(prefix+"data_type", p.DataType)
+		set(prefix+"unit", p.Unit)
+		set(prefix+"description", p.Description)
+	}
+	return fields
+}
+
+// snapshotFieldOrder возвращает поля обоих снимков в стабильном порядке:
+// сначала поля оборудования, затем свойства в порядке их появления
+func snapshotFieldOrder(a, b *EquipmentSnapshot) []string {
+	order := []string{
+		"parent_id", "version", "description",
+		"published_date", "effective_start_date", "effective_end_date",
+		"hierarchy_scope_id", "equipment_level", "operating_status",
+		"physical_asset_id", "operational_location_id", "deleted", "classes",
+	}
+	seen := make(map[string]bool)
+	for _, s := range []*EquipmentSnapshot{a, b} {
+		for _, p := range s.Properties {
+			if seen[p.ID] {
+				continue
+			}
+			seen[p.ID] = true
+			prefix := "properties." + p.ID + "."
+			order = append(order, prefix+"value", prefix+"data_type", prefix+"unit", prefix+"description")
+		}
+	}
+	return order
+}
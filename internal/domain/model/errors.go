@@ -4,17 +4,81 @@ import "errors"
 
 var (
 	// Equipment errors
-	ErrEquipmentIDEmpty           = errors.New("equipment id cannot be empty")
-	ErrEquipmentClassIDEmpty      = errors.New("equipment class id cannot be empty")
-	ErrEquipmentPropertyIDEmpty   = errors.New("equipment property id cannot be empty")
+	ErrEquipmentIDEmpty              = errors.New("equipment id cannot be empty")
+	ErrEquipmentClassIDEmpty         = errors.New("equipment class id cannot be empty")
+	ErrEquipmentPropertyIDEmpty      = errors.New("equipment property id cannot be empty")
 	ErrEquipmentClassPropertyIDEmpty = errors.New("equipment class property id cannot be empty")
 
 	// Equipment validation errors
-	ErrEquipmentNotFound       = errors.New("equipment not found")
-	ErrEquipmentAlreadyExists  = errors.New("equipment already exists")
-	ErrEquipmentInvalidStatus  = errors.New("invalid equipment status")
+	ErrEquipmentNotFound        = errors.New("equipment not found")
+	ErrEquipmentAlreadyExists   = errors.New("equipment already exists")
+	ErrEquipmentInvalidStatus   = errors.New("invalid equipment status")
+	ErrEquipmentVersionConflict = errors.New("equipment version conflict")
+
+	// Equipment hierarchy errors
+	ErrEquipmentHierarchyCycle = errors.New("equipment cannot be moved under itself or its descendant")
+	ErrEquipmentLevelOrder     = errors.New("equipment level must be below the parent level")
+	ErrEquipmentNotSite        = errors.New("equipment is not a site")
+
+	// Equipment deletion errors
+	ErrInvalidDeletePolicy    = errors.New("invalid delete policy")
+	ErrEquipmentHasChildren   = errors.New("equipment has child equipment")
+	ErrEquipmentHasClasses    = errors.New("equipment is mapped to equipment classes")
+	ErrEquipmentNotDeleted    = errors.New("equipment is not deleted")
+	ErrEquipmentParentDeleted = errors.New("parent equipment is deleted")
+
+	// Equipment history errors
+	ErrEquipmentRevisionNotFound = errors.New("equipment revision not found")
+
+	// Property value errors
+	ErrPropertyInvalidDataType     = errors.New("invalid property data type")
+	ErrPropertyInvalidValue        = errors.New("property value does not match its data type")
+	ErrPropertyConstraintViolation = errors.New("property value violates class property definition")
+
+	// Unit of measure errors
+	ErrUnitUnknown      = errors.New("unknown unit of measure")
+	ErrUnitIncompatible = errors.New("units of measure have different dimensions")
 
 	// EquipmentClass errors
 	ErrEquipmentClassNotFound      = errors.New("equipment class not found")
 	ErrEquipmentClassAlreadyExists = errors.New("equipment class already exists")
+	ErrEquipmentClassInUse         = errors.New("equipment class has member equipment or subclasses")
+
+	// Person errors
+	ErrPersonIDEmpty       = errors.New("person id cannot be empty")
+	ErrPersonNotFound      = errors.New("person not found")
+	ErrPersonAlreadyExists = errors.New("person already exists")
+
+	// B2MML message errors
+	ErrB2MMLMalformed             = errors.New("malformed b2mml document")
+	ErrB2MMLUnsupportedMessage    = errors.New("unsupported b2mml message")
+	ErrB2MMLUnsupportedAction     = errors.New("unsupported b2mml action code")
+	ErrB2MMLUnsupportedExpression = errors.New("unsupported b2mml expression")
+
+	// B2MML channel errors
+	ErrB2MMLChannelUnsupported        = errors.New("unsupported b2mml channel uri")
+	ErrB2MMLChannelInboundUnsupported = errors.New("b2mml channel does not accept inbound documents")
+
+	// Import errors
+	ErrImportInvalidFormat   = errors.New("invalid import format")
+	ErrImportInvalidMapping  = errors.New("invalid import column mapping")
+	ErrImportSourceTooLarge  = errors.New("import source is too large")
+	ErrImportInvalidRow      = errors.New("invalid import row")
+	ErrImportJobNotFound     = errors.New("import job not found")
+	ErrImportJobNotResumable = errors.New("only failed import jobs can be resumed")
+	ErrImportJobLeaseLost    = errors.New("import job is continued by another worker")
+
+	// Export errors
+	ErrExportInvalidFormat = errors.New("invalid export format")
+	ErrExportInvalidColumn = errors.New("invalid export column")
+
+	// Domain event errors
+	ErrUnknownEventType = errors.New("unknown event type")
+
+	// Webhook errors
+	ErrWebhookNotFound              = errors.New("webhook subscription not found")
+	ErrWebhookInvalidURL            = errors.New("webhook url must be an absolute http or https url")
+	ErrWebhookDeliveryNotFound      = errors.New("webhook delivery not found")
+	ErrWebhookDeliveryNotDead       = errors.New("only dead webhook deliveries can be replayed")
+	ErrWebhookInvalidDeliveryStatus = errors.New("invalid webhook delivery status")
 )
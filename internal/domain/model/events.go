@@ -1,13 +1,95 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Типы агрегатов, к которым относятся события
+const (
+	AggregateTypeEquipment      = "equipment"
+	AggregateTypeEquipmentClass = "equipment_class"
+)
+
+// Типы доменных событий
+const (
+	EventTypeEquipmentCreated       = "equipment.created"
+	EventTypeEquipmentStatusChanged = "equipment.status_changed"
+	EventTypeEquipmentMoved         = "equipment.moved"
+	EventTypeEquipmentDeleted       = "equipment.deleted"
+	EventTypeEquipmentRestored      = "equipment.restored"
+	EventTypeEquipmentPurged        = "equipment.purged"
+	EventTypeEquipmentClassCreated  = "equipment_class.created"
+)
+
+// EventTypes возвращает все типы доменных событий
+func EventTypes() []string {
+	return []string{
+		EventTypeEquipmentCreated,
+		EventTypeEquipmentStatusChanged,
+		EventTypeEquipmentMoved,
+		EventTypeEquipmentDeleted,
+		EventTypeEquipmentRestored,
+		EventTypeEquipmentPurged,
+		EventTypeEquipmentClassCreated,
+	}
+}
+
+// EventTypeWildcard фильтр, подходящий под все типы событий
+const EventTypeWildcard = "*"
+
+// MatchEventType сообщает, подходит ли тип события под фильтр: точный тип
+// (equipment.moved), префикс с * (equipment.*) или *
+func MatchEventType(filter, eventType string) bool {
+	if filter == EventTypeWildcard || filter == eventType {
+		return true
+	}
+	prefix, ok := strings.CutSuffix(filter, ".*")
+	return ok && strings.HasPrefix(eventType, prefix+".")
+}
+
+// ValidateEventTypeFilter проверяет, что фильтр подходит хотя бы под один тип событий
+func ValidateEventTypeFilter(filter string) error {
+	for _, eventType := range EventTypes() {
+		if MatchEventType(filter, eventType) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownEventType, filter)
+}
 
 // DomainEvent является базовым интерфейсом для всех доменных событий
 type DomainEvent interface {
+	// EventType возвращает тип события, например equipment.created
+	EventType() string
+	// AggregateType возвращает тип агрегата: события одного агрегата доставляются по порядку
+	AggregateType() string
 	AggregateID() string
 	OccurredAt() time.Time
 }
 
+// aggregateEvents накапливает события агрегата до сохранения в хранилище
+type aggregateEvents struct {
+	events []DomainEvent
+}
+
+// Events возвращает зарегистрированные, но ещё не переданные наружу события
+func (a *aggregateEvents) Events() []DomainEvent {
+	return append([]DomainEvent(nil), a.events...)
+}
+
+// PullEvents возвращает зарегистрированные доменные события и очищает их список
+func (a *aggregateEvents) PullEvents() []DomainEvent {
+	events := a.events
+	a.events = nil
+	return events
+}
+
+func (a *aggregateEvents) recordEvent(event DomainEvent) {
+	a.events = append(a.events, event)
+}
+
 // EquipmentCreatedEvent возникает при создании нового оборудования
 type EquipmentCreatedEvent struct {
 	aggregateID string
@@ -33,6 +115,19 @@ func (e *EquipmentCreatedEvent) OccurredAt() time.Time {
 	return e.occurredAt
 }
 
+func (e *EquipmentCreatedEvent) EventType() string {
+	return EventTypeEquipmentCreated
+}
+
+func (e *EquipmentCreatedEvent) AggregateType() string {
+	return AggregateTypeEquipment
+}
+
+// ClassID возвращает основной класс оборудования (пустой, если класса нет)
+func (e *EquipmentCreatedEvent) ClassID() EquipmentClassID {
+	return e.classID
+}
+
 // EquipmentStatusChangedEvent возникает при изменении статуса оборудования
 type EquipmentStatusChangedEvent struct {
 	aggregateID string
@@ -60,6 +155,191 @@ func (e *EquipmentStatusChangedEvent) OccurredAt() time.Time {
 	return e.occurredAt
 }
 
+func (e *EquipmentStatusChangedEvent) EventType() string {
+	return EventTypeEquipmentStatusChanged
+}
+
+func (e *EquipmentStatusChangedEvent) AggregateType() string {
+	return AggregateTypeEquipment
+}
+
+// OldStatus возвращает прежний статус
+func (e *EquipmentStatusChangedEvent) OldStatus() OperatingStatus {
+	return e.oldStatus
+}
+
+// NewStatus возвращает новый статус
+func (e *EquipmentStatusChangedEvent) NewStatus() OperatingStatus {
+	return e.newStatus
+}
+
+// EquipmentMovedEvent возникает при переносе оборудования (с поддеревом) под другого родителя
+type EquipmentMovedEvent struct {
+	aggregateID string
+	occurredAt  time.Time
+	equipmentID EquipmentID
+	oldParentID *EquipmentID
+	newParentID *EquipmentID
+}
+
+// NewEquipmentMovedEvent создаёт событие переноса; nil родитель означает корень иерархии
+func NewEquipmentMovedEvent(equipmentID EquipmentID, oldParentID, newParentID *EquipmentID) *EquipmentMovedEvent {
+	return &EquipmentMovedEvent{
+		aggregateID: equipmentID.String(),
+		occurredAt:  time.Now(),
+		equipmentID: equipmentID,
+		oldParentID: oldParentID,
+		newParentID: newParentID,
+	}
+}
+
+func (e *EquipmentMovedEvent) AggregateID() string {
+	return e.aggregateID
+}
+
+func (e *EquipmentMovedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+func (e *EquipmentMovedEvent) EventType() string {
+	return EventTypeEquipmentMoved
+}
+
+func (e *EquipmentMovedEvent) AggregateType() string {
+	return AggregateTypeEquipment
+}
+
+// OldParentID возвращает прежнего родителя (nil - корень)
+func (e *EquipmentMovedEvent) OldParentID() *EquipmentID {
+	return e.oldParentID
+}
+
+// NewParentID возвращает нового родителя (nil - корень)
+func (e *EquipmentMovedEvent) NewParentID() *EquipmentID {
+	return e.newParentID
+}
+
+// EquipmentDeletedEvent возникает при удалении оборудования (soft delete)
+type EquipmentDeletedEvent struct {
+	aggregateID string
+	occurredAt  time.Time
+	equipmentID EquipmentID
+	parentID    *EquipmentID
+	cascadeRoot *EquipmentID
+}
+
+// NewEquipmentDeletedEvent создаёт событие удаления. parentID - родитель на момент
+// удаления (nil - корень), cascadeRoot - удаляемое оборудование, вместе с которым
+// удалён потомок (nil, если оборудование удалено само)
+func NewEquipmentDeletedEvent(equipmentID EquipmentID, parentID, cascadeRoot *EquipmentID) *EquipmentDeletedEvent {
+	return &EquipmentDeletedEvent{
+		aggregateID: equipmentID.String(),
+		occurredAt:  time.Now(),
+		equipmentID: equipmentID,
+		parentID:    parentID,
+		cascadeRoot: cascadeRoot,
+	}
+}
+
+func (e *EquipmentDeletedEvent) AggregateID() string {
+	return e.aggregateID
+}
+
+func (e *EquipmentDeletedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+func (e *EquipmentDeletedEvent) EventType() string {
+	return EventTypeEquipmentDeleted
+}
+
+func (e *EquipmentDeletedEvent) AggregateType() string {
+	return AggregateTypeEquipment
+}
+
+// ParentID возвращает родителя на момент удаления (nil - корень)
+func (e *EquipmentDeletedEvent) ParentID() *EquipmentID {
+	return e.parentID
+}
+
+// CascadeRoot возвращает оборудование, вместе с которым удалён потомок
+// (nil, если оборудование удалено само)
+func (e *EquipmentDeletedEvent) CascadeRoot() *EquipmentID {
+	return e.cascadeRoot
+}
+
+// EquipmentRestoredEvent возникает при восстановлении удалённого оборудования
+type EquipmentRestoredEvent struct {
+	aggregateID string
+	occurredAt  time.Time
+	equipmentID EquipmentID
+	cascadeRoot *EquipmentID
+}
+
+// NewEquipmentRestoredEvent создаёт событие восстановления; cascadeRoot - оборудование,
+// вместе с которым восстановлен потомок (nil, если оборудование восстановлено само)
+func NewEquipmentRestoredEvent(equipmentID EquipmentID, cascadeRoot *EquipmentID) *EquipmentRestoredEvent {
+	return &EquipmentRestoredEvent{
+		aggregateID: equipmentID.String(),
+		occurredAt:  time.Now(),
+		equipmentID: equipmentID,
+		cascadeRoot: cascadeRoot,
+	}
+}
+
+func (e *EquipmentRestoredEvent) AggregateID() string {
+	return e.aggregateID
+}
+
+func (e *EquipmentRestoredEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+func (e *EquipmentRestoredEvent) EventType() string {
+	return EventTypeEquipmentRestored
+}
+
+func (e *EquipmentRestoredEvent) AggregateType() string {
+	return AggregateTypeEquipment
+}
+
+// CascadeRoot возвращает оборудование, вместе с которым восстановлен потомок
+// (nil, если оборудование восстановлено само)
+func (e *EquipmentRestoredEvent) CascadeRoot() *EquipmentID {
+	return e.cascadeRoot
+}
+
+// EquipmentPurgedEvent возникает при окончательном удалении оборудования из хранилища
+type EquipmentPurgedEvent struct {
+	aggregateID string
+	occurredAt  time.Time
+	equipmentID EquipmentID
+}
+
+func NewEquipmentPurgedEvent(equipmentID EquipmentID) *EquipmentPurgedEvent {
+	return &EquipmentPurgedEvent{
+		aggregateID: equipmentID.String(),
+		occurredAt:  time.Now(),
+		equipmentID: equipmentID,
+	}
+}
+
+func (e *EquipmentPurgedEvent) AggregateID() string {
+	return e.aggregateID
+}
+
+func (e *EquipmentPurgedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+func (e *EquipmentPurgedEvent) EventType() string {
+	return EventTypeEquipmentPurged
+}
+
+func (e *EquipmentPurgedEvent) AggregateType() string {
+	return AggregateTypeEquipment
+}
+
 // EquipmentClassCreatedEvent возникает при создании нового класса оборудования
 type EquipmentClassCreatedEvent struct {
 	aggregateID string
@@ -82,3 +362,11 @@ func (e *EquipmentClassCreatedEvent) AggregateID() string {
 func (e *EquipmentClassCreatedEvent) OccurredAt() time.Time {
 	return e.occurredAt
 }
+
+func (e *EquipmentClassCreatedEvent) EventType() string {
+	return EventTypeEquipmentClassCreated
+}
+
+func (e *EquipmentClassCreatedEvent) AggregateType() string {
+	return AggregateTypeEquipmentClass
+}
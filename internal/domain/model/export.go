@@ -0,0 +1,24 @@
+package model
+
+import "fmt"
+
+// ExportFormat формат выгрузки оборудования
+type ExportFormat string
+
+const (
+	// ExportFormatCSV CSV с заголовком - именами колонок
+	ExportFormatCSV ExportFormat = "csv"
+	// ExportFormatJSONL JSON Lines: строка - плоский объект колонок
+	ExportFormatJSONL ExportFormat = "jsonl"
+	// ExportFormatB2MML один документ SyncEquipmentInformation
+	ExportFormatB2MML ExportFormat = "b2mml"
+)
+
+// ParseExportFormat разбирает формат выгрузки
+func ParseExportFormat(value string) (ExportFormat, error) {
+	switch format := ExportFormat(value); format {
+	case ExportFormatCSV, ExportFormatJSONL, ExportFormatB2MML:
+		return format, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrExportInvalidFormat, value)
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+)
+
+// equipmentLevelRanks положение уровней ISA-95 в ролевой иерархии оборудования:
+// чем меньше ранг, тем выше уровень. Уровни рабочих центров и рабочих единиц
+// разных типов (ProcessCell, ProductionLine, Unit, ...) находятся на одной ступени
+var equipmentLevelRanks = map[string]int{
+	"Enterprise":      1,
+	"Site":            2,
+	"Area":            3,
+	"WorkCenter":      4,
+	"ProcessCell":     4,
+	"ProductionLine":  4,
+	"ProductionUnit":  4,
+	"StorageZone":     4,
+	"WorkUnit":        5,
+	"Unit":            5,
+	"WorkCell":        5,
+	"StorageUnit":     5,
+	"EquipmentModule": 6,
+	"ControlModule":   7,
+}
+
+// nestableLevels уровни, которые по ISA-88 могут вкладываться сами в себя
+var nestableLevels = map[string]bool{
+	"EquipmentModule": true,
+	"ControlModule":   true,
+}
+
+// equipmentLevelName возвращает стандартное имя уровня ISA-95 ("Control Module" -> "ControlModule").
+// Для уровней Other возвращает пустую строку
+func equipmentLevelName(level *b2mml.EquipmentLevelType) string {
+	if level == nil || level.EquipmentLevel1Type == nil {
+		return ""
+	}
+	return strings.ReplaceAll(strings.TrimSpace(level.Value), " ", "")
+}
+
+// CheckEquipmentLevelOrder проверяет, что оборудование уровня child может находиться
+// под оборудованием уровня parent. Нестандартные и незаданные уровни не проверяются
+func CheckEquipmentLevelOrder(parent, child *b2mml.EquipmentLevelType) error {
+	parentName, childName := equipmentLevelName(parent), equipmentLevelName(child)
+	parentRank, parentOK := equipmentLevelRanks[parentName]
+	childRank, childOK := equipmentLevelRanks[childName]
+	if !parentOK || !childOK {
+		return nil
+	}
+	if parentRank < childRank || (parentName == childName && nestableLevels[childName]) {
+		return nil
+	}
+	return ErrEquipmentLevelOrder
+}
+
+// MoveTo переносит оборудование вместе с поддеревом под newParent (nil - в корень иерархии).
+// from - текущий родитель (nil для корня), path - предки newParent от корня;
+// по ним обнаруживаются циклы. Регистрирует EquipmentMovedEvent
+func (e *Equipment) MoveTo(from *EquipmentID, newParent *Equipment, path []EquipmentID) error {
+	if newParent == nil {
+		e.version++
+		e.recordEvent(NewEquipmentMovedEvent(e.id, from, nil))
+		return nil
+	}
+	return e.MoveUnder(from, newParent.ID(), newParent.EquipmentLevel(), path)
+}
+
+// MoveUnder переносит оборудование под родителя parentID уровня parentLevel,
+// когда агрегат родителя не загружен. from и path - как в MoveTo
+func (e *Equipment) MoveUnder(from *EquipmentID, parentID EquipmentID, parentLevel *b2mml.EquipmentLevelType, path []EquipmentID) error {
+	if parentID == e.id || slices.Contains(path, e.id) {
+		return ErrEquipmentHierarchyCycle
+	}
+	if err := CheckEquipmentLevelOrder(parentLevel, e.EquipmentLevel()); err != nil {
+		return err
+	}
+
+	e.version++
+	e.recordEvent(NewEquipmentMovedEvent(e.id, from, &parentID))
+	return nil
+}
+
+// IsSite сообщает, что оборудование имеет уровень ISA-95 Site
+func (e *Equipment) IsSite() bool {
+	return equipmentLevelName(e.EquipmentLevel()) == "Site"
+}
@@ -0,0 +1,36 @@
+package model
+
+import "fmt"
+
+// ImportFormat формат источника импорта оборудования
+type ImportFormat string
+
+const (
+	// ImportFormatCSV CSV с заголовком - именами колонок
+	ImportFormatCSV ImportFormat = "csv"
+	// ImportFormatJSONL JSON Lines: строка источника - плоский объект колонок
+	ImportFormatJSONL ImportFormat = "jsonl"
+)
+
+// ParseImportFormat разбирает формат источника импорта
+func ParseImportFormat(value string) (ImportFormat, error) {
+	switch format := ImportFormat(value); format {
+	case ImportFormatCSV, ImportFormatJSONL:
+		return format, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrImportInvalidFormat, value)
+}
+
+// ImportJobStatus состояние задания импорта
+type ImportJobStatus string
+
+const (
+	// ImportJobPending задание ожидает обработчика
+	ImportJobPending ImportJobStatus = "pending"
+	// ImportJobRunning задание выполняется
+	ImportJobRunning ImportJobStatus = "running"
+	// ImportJobCompleted все строки источника обработаны
+	ImportJobCompleted ImportJobStatus = "completed"
+	// ImportJobFailed задание прервано ошибкой и может быть продолжено
+	ImportJobFailed ImportJobStatus = "failed"
+)
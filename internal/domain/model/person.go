@@ -0,0 +1,95 @@
+package model
+
+import (
+	"errors"
+
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+)
+
+// Person представляет сотрудника (B2MML Person), выполняющего работы
+// по обслуживанию оборудования
+type Person struct {
+	id      PersonID
+	data    *b2mml.PersonType
+	version int64
+}
+
+// PersonID является Value Object идентификатора сотрудника
+type PersonID struct {
+	value string
+}
+
+// NewPersonID создаёт новый идентификатор сотрудника
+func NewPersonID(value string) (PersonID, error) {
+	if value == "" {
+		return PersonID{}, ErrPersonIDEmpty
+	}
+	return PersonID{value: value}, nil
+}
+
+func (id PersonID) String() string {
+	return id.value
+}
+
+// NewPerson создаёт нового сотрудника
+func NewPerson(id PersonID, b2mmlData *b2mml.PersonType) *Person {
+	return &Person{
+		id:      id,
+		data:    b2mmlData,
+		version: 1,
+	}
+}
+
+// NewPersonFromB2MML создаёт Person из B2MML данных
+func NewPersonFromB2MML(b2mmlData *b2mml.PersonType) (*Person, error) {
+	if b2mmlData == nil {
+		return nil, errors.New("b2mml data cannot be nil")
+	}
+	var value string
+	if b2mmlData.ID != nil {
+		value = b2mmlData.ID.Value
+	}
+	id, err := NewPersonID(value)
+	if err != nil {
+		return nil, err
+	}
+	return NewPerson(id, b2mmlData), nil
+}
+
+// RestorePerson восстанавливает Person из хранилища без изменения версии
+func RestorePerson(id PersonID, b2mmlData *b2mml.PersonType, version int64) *Person {
+	p := NewPerson(id, b2mmlData)
+	p.version = version
+	return p
+}
+
+// ID возвращает идентификатор сотрудника
+func (p *Person) ID() PersonID {
+	return p.id
+}
+
+// GetB2MMLData возвращает исходные данные B2MML
+func (p *Person) GetB2MMLData() *b2mml.PersonType {
+	return p.data
+}
+
+// Name возвращает имя сотрудника
+func (p *Person) Name() string {
+	if p.data == nil || p.data.PersonName == nil {
+		return ""
+	}
+	return p.data.PersonName.Value
+}
+
+// Version возвращает версию агрегата
+func (p *Person) Version() int64 {
+	return p.version
+}
+
+// ToB2MML преобразует Person обратно в B2MML структуру
+func (p *Person) ToB2MML() *b2mml.PersonType {
+	if p == nil {
+		return nil
+	}
+	return p.data
+}
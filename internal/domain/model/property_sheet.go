@@ -0,0 +1,172 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+)
+
+// PropertyValueStatus состояние значения свойства в эффективном листе свойств оборудования
+type PropertyValueStatus string
+
+const (
+	// PropertyValueMissing свойство определено классом, но значение не задано
+	// ни оборудованием, ни определением класса
+	PropertyValueMissing PropertyValueStatus = "missing"
+	// PropertyValueInherited значение взято из определения свойства в классе
+	PropertyValueInherited PropertyValueStatus = "inherited"
+	// PropertyValueOverridden значение задано оборудованием поверх определения класса
+	PropertyValueOverridden PropertyValueStatus = "overridden"
+	// PropertyValueLocal свойство задано оборудованием и не определено ни одним его классом
+	PropertyValueLocal PropertyValueStatus = "local"
+)
+
+// EffectiveProperty свойство оборудования с учётом определений его классов
+type EffectiveProperty struct {
+	ID EquipmentPropertyID
+	// Definition действующее определение свойства (nil для PropertyValueLocal)
+	Definition *EquipmentClassProperty
+	// DefinedBy класс, в котором объявлено действующее определение
+	DefinedBy *EquipmentClass
+	// Value значение свойства; пустое для PropertyValueMissing
+	Value  PropertyValue
+	Status PropertyValueStatus
+}
+
+// PropertyResolver доменный сервис, вычисляющий эффективный лист свойств оборудования
+// по иерархии его классов
+type PropertyResolver struct{}
+
+// NewPropertyResolver создаёт сервис вычисления эффективных свойств
+func NewPropertyResolver() *PropertyResolver {
+	return &PropertyResolver{}
+}
+
+// Resolve возвращает эффективный лист свойств оборудования.
+// lineages содержит для каждого класса оборудования цепочку классов от корневого
+// до него самого. Внутри цепочки определение дочернего класса переопределяет
+// родительское, между разными классами действует определение класса, идущего
+// раньше в Equipment.Classes() (основной класс первым). Свойства, которые
+// задало оборудование, но не определяет ни один класс, идут в конце листа
+func (r *PropertyResolver) Resolve(e *Equipment, lineages map[EquipmentClassID][]*EquipmentClass) []*EffectiveProperty {
+	var (
+		sheet   []*EffectiveProperty
+		defined = make(map[string]bool)
+	)
+	for _, class := range e.Classes() {
+		for _, def := range mergeLineage(lineages[class.ID()]) {
+			id := def.prop.ID().String()
+			if defined[id] {
+				continue
+			}
+			defined[id] = true
+			sheet = append(sheet, r.resolveProperty(e, def))
+		}
+	}
+
+	for _, prop := range e.Properties() {
+		if defined[prop.ID().String()] {
+			continue
+		}
+		sheet = append(sheet, &EffectiveProperty{
+			ID:     prop.ID(),
+			Value:  prop.Value(),
+			Status: PropertyValueLocal,
+		})
+	}
+	return sheet
+}
+
+// Validate проверяет значения свойств оборудования на соответствие определениям
+// его классов: тип данных, единицу измерения, диапазон и допустимые значения.
+// Свойства, не определённые классами, проверяются только на соответствие своему типу.
+// Если переданы ids, проверяются только эти свойства
+func (r *PropertyResolver) Validate(e *Equipment, lineages map[EquipmentClassID][]*EquipmentClass, ids ...EquipmentPropertyID) error {
+	for _, prop := range r.Resolve(e, lineages) {
+		if prop.Status != PropertyValueOverridden && prop.Status != PropertyValueLocal {
+			continue
+		}
+		if len(ids) > 0 && !slices.Contains(ids, prop.ID) {
+			continue
+		}
+		if prop.Definition == nil {
+			if _, err := prop.Value.Typed(); err != nil {
+				return fmt.Errorf("property %s: %w", prop.ID, err)
+			}
+			continue
+		}
+		c, err := prop.Definition.Constraint()
+		if err != nil {
+			return err
+		}
+		if err := c.Check(prop.Value); err != nil {
+			return fmt.Errorf("property %s: %w", prop.ID, err)
+		}
+	}
+	return nil
+}
+
+// resolveProperty определяет значение свойства по определению класса и значению оборудования
+func (r *PropertyResolver) resolveProperty(e *Equipment, def classPropertyDefinition) *EffectiveProperty {
+	id := EquipmentPropertyID{value: def.prop.ID().String()}
+	result := &EffectiveProperty{
+		ID:         id,
+		Definition: def.prop,
+		DefinedBy:  def.class,
+		Status:     PropertyValueMissing,
+	}
+	if prop, ok := e.Property(id); ok {
+		result.Value = prop.Value()
+		result.Status = PropertyValueOverridden
+	} else if value, ok := classPropertyValue(def.prop); ok {
+		result.Value = value
+		result.Status = PropertyValueInherited
+	}
+	return result
+}
+
+// classPropertyDefinition определение свойства вместе с классом, в котором оно объявлено
+type classPropertyDefinition struct {
+	prop  *EquipmentClassProperty
+	class *EquipmentClass
+}
+
+// mergeLineage объединяет определения свойств цепочки классов от корня к листу:
+// определение с тем же ID в дочернем классе заменяет родительское, сохраняя его позицию
+func mergeLineage(lineage []*EquipmentClass) []classPropertyDefinition {
+	var (
+		defs  []classPropertyDefinition
+		index = make(map[string]int)
+	)
+	for _, class := range lineage {
+		for _, prop := range flattenClassProperties(class.Properties()) {
+			def := classPropertyDefinition{prop: prop, class: class}
+			if i, ok := index[prop.ID().String()]; ok {
+				defs[i] = def
+				continue
+			}
+			index[prop.ID().String()] = len(defs)
+			defs = append(defs, def)
+		}
+	}
+	return defs
+}
+
+// flattenClassProperties разворачивает иерархию свойств класса в плоский список:
+// свойства оборудования хранятся без вложенности
+func flattenClassProperties(props []*EquipmentClassProperty) []*EquipmentClassProperty {
+	var flat []*EquipmentClassProperty
+	for _, prop := range props {
+		flat = append(flat, prop)
+		flat = append(flat, flattenClassProperties(prop.Children())...)
+	}
+	return flat
+}
+
+// classPropertyValue возвращает значение по умолчанию из определения свойства класса
+func classPropertyValue(prop *EquipmentClassProperty) (PropertyValue, bool) {
+	c, err := prop.Constraint()
+	if err != nil || c.Default == nil {
+		return PropertyValue{}, false
+	}
+	return *c.Default, true
+}
@@ -0,0 +1,404 @@
+package model
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+)
+
+// PropertyDataType тип значения свойства
+type PropertyDataType string
+
+const (
+	PropertyDataTypeString      PropertyDataType = "string"
+	PropertyDataTypeInteger     PropertyDataType = "integer"
+	PropertyDataTypeFloat       PropertyDataType = "float"
+	PropertyDataTypeBoolean     PropertyDataType = "boolean"
+	PropertyDataTypeDateTime    PropertyDataType = "datetime"
+	PropertyDataTypeDuration    PropertyDataType = "duration"
+	PropertyDataTypeEnumeration PropertyDataType = "enumeration"
+	// PropertyDataTypeQuantity число с обязательной единицей измерения
+	PropertyDataTypeQuantity PropertyDataType = "quantity"
+)
+
+// propertyDataTypeAliases имена типов данных B2MML (xsd) и их синонимы
+var propertyDataTypeAliases = map[string]PropertyDataType{
+	"":              PropertyDataTypeString,
+	"string":        PropertyDataTypeString,
+	"text":          PropertyDataTypeString,
+	"code":          PropertyDataTypeString,
+	"identifier":    PropertyDataTypeString,
+	"binary":        PropertyDataTypeString,
+	"integer":       PropertyDataTypeInteger,
+	"int":           PropertyDataTypeInteger,
+	"long":          PropertyDataTypeInteger,
+	"short":         PropertyDataTypeInteger,
+	"byte":          PropertyDataTypeInteger,
+	"unsignedbyte":  PropertyDataTypeInteger,
+	"unsignedint":   PropertyDataTypeInteger,
+	"unsignedlong":  PropertyDataTypeInteger,
+	"unsignedshort": PropertyDataTypeInteger,
+	"float":         PropertyDataTypeFloat,
+	"double":        PropertyDataTypeFloat,
+	"decimal":       PropertyDataTypeFloat,
+	"numeric":       PropertyDataTypeFloat,
+	"number":        PropertyDataTypeFloat,
+	"boolean":       PropertyDataTypeBoolean,
+	"bool":          PropertyDataTypeBoolean,
+	"datetime":      PropertyDataTypeDateTime,
+	"date":          PropertyDataTypeDateTime,
+	"time":          PropertyDataTypeDateTime,
+	"duration":      PropertyDataTypeDuration,
+	"enumeration":   PropertyDataTypeEnumeration,
+	"enum":          PropertyDataTypeEnumeration,
+	"quantity":      PropertyDataTypeQuantity,
+	"measure":       PropertyDataTypeQuantity,
+	"amount":        PropertyDataTypeQuantity,
+}
+
+// ParsePropertyDataType приводит имя типа данных (в том числе xsd-имена B2MML,
+// например xs:dateTime или unsignedInt) к PropertyDataType. Пустое имя означает string
+func ParsePropertyDataType(name string) (PropertyDataType, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.TrimPrefix(strings.TrimPrefix(key, "xs:"), "xsd:")
+	if t, ok := propertyDataTypeAliases[key]; ok {
+		return t, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrPropertyInvalidDataType, name)
+}
+
+// dateTimeLayouts форматы xsd:dateTime, xsd:date и xsd:time
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+	"15:04:05Z07:00",
+	"15:04:05",
+}
+
+// isoDurationPattern формат xsd:duration (ISO 8601): PnYnMnWnDTnHnMnS
+var isoDurationPattern = regexp.MustCompile(
+	`^(-)?P(?:(\d+(?:\.\d+)?)Y)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)W)?(?:(\d+(?:\.\d+)?)D)?` +
+		`(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// isoDurationUnits длительность единиц компонентов ISO 8601. Год и месяц
+// не имеют точной длительности и считаются равными 365 и 30 дням
+var isoDurationUnits = []time.Duration{
+	365 * 24 * time.Hour,
+	30 * 24 * time.Hour,
+	7 * 24 * time.Hour,
+	24 * time.Hour,
+	time.Hour,
+	time.Minute,
+	time.Second,
+}
+
+// parseDuration разбирает xsd:duration (PT1H30M) или длительность Go (1h30m)
+func parseDuration(s string) (time.Duration, error) {
+	m := isoDurationPattern.FindStringSubmatch(s)
+	if m == nil || s == "P" || s == "-P" || strings.HasSuffix(s, "T") {
+		return time.ParseDuration(s)
+	}
+	var total float64
+	for i, unit := range isoDurationUnits {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.ParseFloat(m[i+2], 64)
+		if err != nil {
+			return 0, err
+		}
+		total += n * float64(unit)
+	}
+	if total > math.MaxInt64 {
+		return 0, fmt.Errorf("duration %q is too long", s)
+	}
+	if m[1] == "-" {
+		total = -total
+	}
+	return time.Duration(total), nil
+}
+
+// Quantity числовое значение с единицей измерения
+type Quantity struct {
+	Value float64
+	Unit  string
+}
+
+// ParsePropertyValue создаёт значение свойства и проверяет, что оно соответствует типу данных
+func ParsePropertyValue(value, dataType, unit string) (PropertyValue, error) {
+	t, err := ParsePropertyDataType(dataType)
+	if err != nil {
+		return PropertyValue{}, err
+	}
+	pv := PropertyValue{value: value, dataType: string(t), unit: unit}
+	if _, err := pv.Typed(); err != nil {
+		return PropertyValue{}, err
+	}
+	return pv, nil
+}
+
+// PropertyValueFromB2MML создаёт значение свойства из B2MML ValueType
+// (ValueString, DataType, UnitOfMeasure) с проверкой типа данных
+func PropertyValueFromB2MML(v *b2mml.ValueType) (PropertyValue, error) {
+	if v == nil {
+		return PropertyValue{}, nil
+	}
+	var value, dataType, unit string
+	if v.ValueString != nil {
+		value = v.ValueString.Value
+	}
+	if v.DataType != nil {
+		switch {
+		case v.DataType.DataType1Type != nil && v.DataType.Value != "":
+			dataType = v.DataType.Value
+		case v.DataType.OtherValueAttr != nil:
+			dataType = *v.DataType.OtherValueAttr
+		}
+	}
+	if v.UnitOfMeasure != nil {
+		unit = v.UnitOfMeasure.Value
+	}
+	return ParsePropertyValue(value, dataType, unit)
+}
+
+// ToB2MML преобразует значение свойства в B2MML ValueType; для пустого значения - nil
+func (pv PropertyValue) ToB2MML() *b2mml.ValueType {
+	if pv.value == "" && pv.dataType == "" && pv.unit == "" {
+		return nil
+	}
+	value := &b2mml.ValueType{ValueString: &b2mml.ValueStringType{Value: pv.value}}
+	if pv.dataType != "" {
+		value.DataType = &b2mml.DataTypeType{DataType1Type: &b2mml.DataType1Type{Value: pv.dataType}}
+	}
+	if pv.unit != "" {
+		value.UnitOfMeasure = &b2mml.UnitOfMeasureType{Value: pv.unit}
+	}
+	return value
+}
+
+// Type возвращает нормализованный тип данных значения
+func (pv PropertyValue) Type() (PropertyDataType, error) {
+	return ParsePropertyDataType(pv.dataType)
+}
+
+// Typed возвращает значение, приведённое к типу данных: int64, float64, bool,
+// time.Time, time.Duration, Quantity или string (для string и enumeration)
+func (pv PropertyValue) Typed() (any, error) {
+	t, err := pv.Type()
+	if err != nil {
+		return nil, err
+	}
+	s := strings.TrimSpace(pv.value)
+	invalid := func() error {
+		return fmt.Errorf("%w: %q is not a valid %s", ErrPropertyInvalidValue, pv.value, t)
+	}
+
+	switch t {
+	case PropertyDataTypeInteger:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, invalid()
+		}
+		return n, nil
+	case PropertyDataTypeFloat:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, invalid()
+		}
+		return f, nil
+	case PropertyDataTypeBoolean:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, invalid()
+		}
+		return b, nil
+	case PropertyDataTypeDateTime:
+		for _, layout := range dateTimeLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+		return nil, invalid()
+	case PropertyDataTypeDuration:
+		d, err := parseDuration(s)
+		if err != nil {
+			return nil, invalid()
+		}
+		return d, nil
+	case PropertyDataTypeQuantity:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, invalid()
+		}
+		if strings.TrimSpace(pv.unit) == "" {
+			return nil, fmt.Errorf("%w: quantity %q has no unit of measure", ErrPropertyInvalidValue, pv.value)
+		}
+		return Quantity{Value: f, Unit: pv.unit}, nil
+	default:
+		return pv.value, nil
+	}
+}
+
+// Ключи B2MML Value в определении свойства класса
+const (
+	propertyKeyMin     = "min"
+	propertyKeyMax     = "max"
+	propertyKeyDefault = "default"
+	propertyKeyAllowed = "allowed"
+)
+
+// PropertyConstraint ограничения на значение свойства, заданные определением в классе.
+// Определение описывается списком B2MML Value: значения с Key min/max задают диапазон,
+// с Key default или первое значение без Key — значение по умолчанию, для enumeration
+// значения без Key (и с Key allowed) перечисляют допустимые варианты
+type PropertyConstraint struct {
+	DataType PropertyDataType
+	Unit     string
+	Min      *PropertyValue
+	Max      *PropertyValue
+	Allowed  []string
+	Default  *PropertyValue
+	// ClassOnly свойство типа ClassType: значение задаётся только классом
+	ClassOnly bool
+}
+
+// Constraint возвращает ограничения на значение свойства из его определения
+func (ecp *EquipmentClassProperty) Constraint() (PropertyConstraint, error) {
+	c := PropertyConstraint{DataType: PropertyDataTypeString}
+	if ecp.data == nil {
+		return c, nil
+	}
+	if ecp.data.PropertyType != nil && ecp.data.PropertyType.Value == "ClassType" {
+		c.ClassOnly = true
+	}
+
+	// Тип данных и единица измерения берутся из первого значения, где они указаны
+	var dataType string
+	for _, v := range ecp.data.Value {
+		if v == nil {
+			continue
+		}
+		if dataType == "" && v.DataType != nil {
+			if v.DataType.DataType1Type != nil && v.DataType.Value != "" {
+				dataType = v.DataType.Value
+			} else if v.DataType.OtherValueAttr != nil {
+				dataType = *v.DataType.OtherValueAttr
+			}
+		}
+		if c.Unit == "" && v.UnitOfMeasure != nil {
+			c.Unit = v.UnitOfMeasure.Value
+		}
+	}
+	t, err := ParsePropertyDataType(dataType)
+	if err != nil {
+		return PropertyConstraint{}, fmt.Errorf("class property %s: %w", ecp.id, err)
+	}
+	c.DataType = t
+
+	for _, v := range ecp.data.Value {
+		if v == nil || v.ValueString == nil {
+			continue
+		}
+		var key string
+		if v.Key != nil {
+			key = strings.ToLower(strings.TrimSpace(v.Key.Value))
+		}
+		raw := v.ValueString.Value
+		if key == propertyKeyAllowed || (key == "" && t == PropertyDataTypeEnumeration) {
+			c.Allowed = append(c.Allowed, raw)
+			continue
+		}
+
+		unit := c.Unit
+		if v.UnitOfMeasure != nil && v.UnitOfMeasure.Value != "" {
+			unit = v.UnitOfMeasure.Value
+		}
+		pv, err := ParsePropertyValue(raw, string(t), unit)
+		if err != nil {
+			return PropertyConstraint{}, fmt.Errorf("class property %s: %w", ecp.id, err)
+		}
+		switch key {
+		case propertyKeyMin:
+			c.Min = &pv
+		case propertyKeyMax:
+			c.Max = &pv
+		case propertyKeyDefault, "":
+			if c.Default == nil || key == propertyKeyDefault {
+				c.Default = &pv
+			}
+		}
+	}
+	return c, nil
+}
+
+// Check проверяет значение оборудования на соответствие ограничениям определения
+func (c PropertyConstraint) Check(v PropertyValue) error {
+	if c.ClassOnly {
+		return fmt.Errorf("%w: value is defined by the class only", ErrPropertyConstraintViolation)
+	}
+	t, err := v.Type()
+	if err != nil {
+		return err
+	}
+	if t != c.DataType && !(t == PropertyDataTypeInteger && (c.DataType == PropertyDataTypeFloat || c.DataType == PropertyDataTypeQuantity)) {
+		return fmt.Errorf("%w: data type %s, expected %s", ErrPropertyConstraintViolation, t, c.DataType)
+	}
+	if c.Unit != "" && v.unit != "" && v.unit != c.Unit {
+		return fmt.Errorf("%w: unit of measure %q, expected %q", ErrPropertyConstraintViolation, v.unit, c.Unit)
+	}
+
+	// Значение приводится к типу определения, чтобы целое сравнивалось с float-границами
+	value, err := (PropertyValue{value: v.value, dataType: string(c.DataType), unit: cmp.Or(v.unit, c.Unit)}).Typed()
+	if err != nil {
+		return err
+	}
+	if len(c.Allowed) > 0 && !slices.Contains(c.Allowed, v.value) {
+		return fmt.Errorf("%w: %q is not one of %s", ErrPropertyConstraintViolation, v.value, strings.Join(c.Allowed, ", "))
+	}
+	if c.Min != nil {
+		if lo, _ := c.Min.Typed(); compareTyped(value, lo) < 0 {
+			return fmt.Errorf("%w: %s is less than %s", ErrPropertyConstraintViolation, v.value, c.Min.value)
+		}
+	}
+	if c.Max != nil {
+		if hi, _ := c.Max.Typed(); compareTyped(value, hi) > 0 {
+			return fmt.Errorf("%w: %s is greater than %s", ErrPropertyConstraintViolation, v.value, c.Max.value)
+		}
+	}
+	return nil
+}
+
+// compareTyped сравнивает значения, возвращённые Typed; несравнимые значения равны
+func compareTyped(a, b any) int {
+	switch a := a.(type) {
+	case int64:
+		if b, ok := b.(int64); ok {
+			return cmp.Compare(a, b)
+		}
+	case float64:
+		if b, ok := b.(float64); ok {
+			return cmp.Compare(a, b)
+		}
+	case Quantity:
+		if b, ok := b.(Quantity); ok {
+			return cmp.Compare(a.Value, b.Value)
+		}
+	case time.Duration:
+		if b, ok := b.(time.Duration); ok {
+			return cmp.Compare(a, b)
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			return a.Compare(b)
+		}
+	}
+	return 0
+}
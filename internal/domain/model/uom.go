@@ -0,0 +1,543 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Базовые размерности SI в порядке компонентов Dimension
+const (
+	dimLength = iota
+	dimMass
+	dimTime
+	dimCurrent
+	dimTemperature
+	dimAmount
+	dimLuminosity
+	dimCount
+)
+
+// dimensionSymbols обозначения базовых размерностей
+var dimensionSymbols = [dimCount]string{"L", "M", "T", "I", "Θ", "N", "J"}
+
+// Dimension размерность величины как показатели степеней базовых размерностей SI
+type Dimension [dimCount]int8
+
+// String возвращает размерность в виде L2.M.T-3; для безразмерных величин - "1"
+func (d Dimension) String() string {
+	var parts []string
+	for i, exp := range d {
+		switch exp {
+		case 0:
+		case 1:
+			parts = append(parts, dimensionSymbols[i])
+		default:
+			parts = append(parts, dimensionSymbols[i]+strconv.Itoa(int(exp)))
+		}
+	}
+	if len(parts) == 0 {
+		return "1"
+	}
+	return strings.Join(parts, ".")
+}
+
+func (d Dimension) mul(o Dimension, sign int8) Dimension {
+	for i := range d {
+		d[i] += o[i] * sign
+	}
+	return d
+}
+
+func (d Dimension) pow(n int8) Dimension {
+	for i := range d {
+		d[i] *= n
+	}
+	return d
+}
+
+// QuantityKind вид величины (power, pressure...), по которому задаются предпочтительные единицы
+type QuantityKind string
+
+// quantityKinds названия видов величин для распространённых размерностей.
+// Размерности без названия обозначаются своей записью (Dimension.String)
+var quantityKinds = map[Dimension]QuantityKind{
+	{}:                      "dimensionless",
+	{1, 0, 0, 0, 0, 0, 0}:   "length",
+	{0, 1, 0, 0, 0, 0, 0}:   "mass",
+	{0, 0, 1, 0, 0, 0, 0}:   "time",
+	{0, 0, 0, 1, 0, 0, 0}:   "current",
+	{0, 0, 0, 0, 1, 0, 0}:   "temperature",
+	{0, 0, 0, 0, 0, 1, 0}:   "amount",
+	{0, 0, 0, 0, 0, 0, 1}:   "luminous_intensity",
+	{2, 0, 0, 0, 0, 0, 0}:   "area",
+	{3, 0, 0, 0, 0, 0, 0}:   "volume",
+	{1, 0, -1, 0, 0, 0, 0}:  "velocity",
+	{1, 0, -2, 0, 0, 0, 0}:  "acceleration",
+	{0, 0, -1, 0, 0, 0, 0}:  "frequency",
+	{1, 1, -2, 0, 0, 0, 0}:  "force",
+	{-1, 1, -2, 0, 0, 0, 0}: "pressure",
+	{2, 1, -2, 0, 0, 0, 0}:  "energy",
+	{2, 1, -3, 0, 0, 0, 0}:  "power",
+	{0, 0, 1, 1, 0, 0, 0}:   "charge",
+	{2, 1, -3, -1, 0, 0, 0}: "voltage",
+	{2, 1, -3, -2, 0, 0, 0}: "resistance",
+	{3, 0, -1, 0, 0, 0, 0}:  "volumetric_flow",
+	{0, 1, -1, 0, 0, 0, 0}:  "mass_flow",
+	{-3, 1, 0, 0, 0, 0, 0}:  "density",
+}
+
+// Kind возвращает вид величины для размерности
+func (d Dimension) Kind() QuantityKind {
+	if kind, ok := quantityKinds[d]; ok {
+		return kind
+	}
+	return QuantityKind(d.String())
+}
+
+// Unit единица измерения. Значение в единице переводится в когерентную единицу SI
+// как value*factor + offset (offset ненулевой только для шкал температуры)
+type Unit struct {
+	// Code обозначение UCUM
+	Code string
+	// UNECE код UN/ECE Recommendation 20, если он есть
+	UNECE     string
+	Name      string
+	Dimension Dimension
+	factor    float64
+	offset    float64
+}
+
+// Kind возвращает вид величины единицы
+func (u *Unit) Kind() QuantityKind {
+	return u.Dimension.Kind()
+}
+
+// unitAtom единица UCUM, из которых составляются выражения вроде kW.h или m3/h
+type unitAtom struct {
+	name      string
+	dimension Dimension
+	factor    float64
+	offset    float64
+	// metric допускает десятичные приставки
+	metric bool
+	// special шкала со смещением, не может входить в составное выражение
+	special bool
+}
+
+var (
+	dimL = Dimension{dimLength: 1}
+	dimM = Dimension{dimMass: 1}
+	dimT = Dimension{dimTime: 1}
+	dimI = Dimension{dimCurrent: 1}
+	dimK = Dimension{dimTemperature: 1}
+
+	dimForce    = Dimension{dimLength: 1, dimMass: 1, dimTime: -2}
+	dimPressure = Dimension{dimLength: -1, dimMass: 1, dimTime: -2}
+	dimEnergy   = Dimension{dimLength: 2, dimMass: 1, dimTime: -2}
+	dimPower    = Dimension{dimLength: 2, dimMass: 1, dimTime: -3}
+)
+
+// unitAtoms поддерживаемые единицы UCUM (когерентная единица массы - kg)
+var unitAtoms = map[string]unitAtom{
+	"1":   {name: "one", factor: 1},
+	"%":   {name: "percent", factor: 0.01},
+	"m":   {name: "metre", dimension: dimL, factor: 1, metric: true},
+	"g":   {name: "gram", dimension: dimM, factor: 1e-3, metric: true},
+	"t":   {name: "tonne", dimension: dimM, factor: 1e3, metric: true},
+	"s":   {name: "second", dimension: dimT, factor: 1, metric: true},
+	"min": {name: "minute", dimension: dimT, factor: 60},
+	"h":   {name: "hour", dimension: dimT, factor: 3600},
+	"d":   {name: "day", dimension: dimT, factor: 86400},
+	"A":   {name: "ampere", dimension: dimI, factor: 1, metric: true},
+	"K":   {name: "kelvin", dimension: dimK, factor: 1, metric: true},
+	"mol": {name: "mole", dimension: Dimension{dimAmount: 1}, factor: 1, metric: true},
+	"cd":  {name: "candela", dimension: Dimension{dimLuminosity: 1}, factor: 1, metric: true},
+	"L":   {name: "litre", dimension: dimL.pow(3), factor: 1e-3, metric: true},
+	"l":   {name: "litre", dimension: dimL.pow(3), factor: 1e-3, metric: true},
+	"Hz":  {name: "hertz", dimension: dimT.pow(-1), factor: 1, metric: true},
+	"N":   {name: "newton", dimension: dimForce, factor: 1, metric: true},
+	"Pa":  {name: "pascal", dimension: dimPressure, factor: 1, metric: true},
+	"bar": {name: "bar", dimension: dimPressure, factor: 1e5, metric: true},
+	"J":   {name: "joule", dimension: dimEnergy, factor: 1, metric: true},
+	"W":   {name: "watt", dimension: dimPower, factor: 1, metric: true},
+	"C":   {name: "coulomb", dimension: dimT.mul(dimI, 1), factor: 1, metric: true},
+	"V":   {name: "volt", dimension: dimPower.mul(dimI, -1), factor: 1, metric: true},
+	"Ohm": {name: "ohm", dimension: dimPower.mul(dimI.pow(2), -1), factor: 1, metric: true},
+
+	"Cel":    {name: "degree Celsius", dimension: dimK, factor: 1, offset: 273.15, special: true},
+	"[degF]": {name: "degree Fahrenheit", dimension: dimK, factor: 5.0 / 9, offset: 459.67 * 5 / 9, special: true},
+
+	"[HP]":     {name: "horsepower", dimension: dimPower, factor: 745.69987158227022},
+	"[psi]":    {name: "pound per square inch", dimension: dimPressure, factor: 6894.757293168361},
+	"atm":      {name: "standard atmosphere", dimension: dimPressure, factor: 101325},
+	"[lb_av]":  {name: "pound", dimension: dimM, factor: 0.45359237},
+	"[in_i]":   {name: "inch", dimension: dimL, factor: 0.0254},
+	"[ft_i]":   {name: "foot", dimension: dimL, factor: 0.3048},
+	"[mi_i]":   {name: "mile", dimension: dimL, factor: 1609.344},
+	"[gal_us]": {name: "US gallon", dimension: dimL.pow(3), factor: 0.003785411784},
+}
+
+// unitPrefix десятичная приставка UCUM
+type unitPrefix struct {
+	symbol string
+	name   string
+	factor float64
+}
+
+// unitPrefixes десятичные приставки; da проверяется раньше d
+var unitPrefixes = []unitPrefix{
+	{"da", "deca", 1e1}, {"G", "giga", 1e9}, {"M", "mega", 1e6}, {"k", "kilo", 1e3}, {"h", "hecto", 1e2},
+	{"d", "deci", 1e-1}, {"c", "centi", 1e-2}, {"m", "milli", 1e-3}, {"u", "micro", 1e-6}, {"n", "nano", 1e-9},
+}
+
+// unitCodes коды UN/ECE Recommendation 20 и их выражения UCUM
+var unitCodes = map[string]string{
+	"C62": "1", "P1": "%",
+	"MTR": "m", "MMT": "mm", "CMT": "cm", "KMT": "km", "INH": "[in_i]", "FOT": "[ft_i]", "SMI": "[mi_i]",
+	"KGM": "kg", "GRM": "g", "TNE": "t", "LBR": "[lb_av]",
+	"SEC": "s", "C26": "ms", "MIN": "min", "HUR": "h", "DAY": "d",
+	"AMP": "A", "VLT": "V", "KVT": "kV", "OHM": "Ohm",
+	"KEL": "K", "CEL": "Cel", "FAH": "[degF]",
+	"LTR": "L", "MLT": "mL", "MTQ": "m3", "GLL": "[gal_us]",
+	"MQH": "m3/h", "MQS": "m3/s", "L2": "L/min",
+	"MTS": "m/s", "KMH": "km/h",
+	"HTZ": "Hz", "KHZ": "kHz", "RPM": "/min",
+	"NEW": "N", "B47": "kN", "NU": "N.m",
+	"PAL": "Pa", "KPA": "kPa", "MPA": "MPa", "BAR": "bar", "PS": "[psi]", "ATM": "atm",
+	"JOU": "J", "KJO": "kJ", "WHR": "W.h", "KWH": "kW.h",
+	"WTT": "W", "KWT": "kW", "MAW": "MW", "BHP": "[HP]",
+}
+
+// unitAliases распространённые неформальные обозначения
+var unitAliases = map[string]string{
+	"hp": "[HP]", "HP": "[HP]", "psi": "[psi]",
+	"°C": "Cel", "degC": "Cel", "°F": "[degF]", "degF": "[degF]",
+	"rpm": "/min", "kWh": "kW.h", "Wh": "W.h", "Nm": "N.m",
+	"lb": "[lb_av]", "in": "[in_i]", "ft": "[ft_i]", "mi": "[mi_i]", "gal": "[gal_us]",
+	"m³": "m3", "m²": "m2", "m³/h": "m3/h", "sec": "s", "hr": "h", "ohm": "Ohm", "Ω": "Ohm",
+}
+
+// UnitRegistry справочник единиц измерения: коды UCUM (включая составные выражения
+// вроде kW.h или m3/h), коды UN/ECE Recommendation 20 и распространённые синонимы
+type UnitRegistry struct {
+	units []*Unit
+}
+
+// NewUnitRegistry создаёт справочник единиц
+func NewUnitRegistry() *UnitRegistry {
+	r := &UnitRegistry{}
+	for code, ucum := range unitCodes {
+		u, err := parseUCUM(ucum)
+		if err != nil {
+			panic(fmt.Sprintf("uom: UN/ECE %s: %v", code, err))
+		}
+		u.UNECE = code
+		r.units = append(r.units, u)
+	}
+	sort.Slice(r.units, func(i, j int) bool {
+		if ki, kj := r.units[i].Kind(), r.units[j].Kind(); ki != kj {
+			return ki < kj
+		}
+		return r.units[i].Code < r.units[j].Code
+	})
+	return r
+}
+
+// Units возвращает единицы с кодами UN/ECE, упорядоченные по виду величины.
+// Пустой kind означает все виды
+func (r *UnitRegistry) Units(kind QuantityKind) []*Unit {
+	var units []*Unit
+	for _, u := range r.units {
+		if kind == "" || u.Kind() == kind {
+			units = append(units, u)
+		}
+	}
+	return units
+}
+
+// Lookup находит единицу по коду UCUM, коду UN/ECE или синониму
+func (r *UnitRegistry) Lookup(code string) (*Unit, error) {
+	code = strings.TrimSpace(code)
+	if ucum, ok := unitCodes[code]; ok {
+		u, err := parseUCUM(ucum)
+		if err == nil {
+			u.UNECE = code
+		}
+		return u, err
+	}
+	if ucum, ok := unitAliases[code]; ok {
+		code = ucum
+	}
+	u, err := parseUCUM(code)
+	if err != nil {
+		return nil, err
+	}
+	for _, known := range r.units {
+		if known.Code == u.Code {
+			u.UNECE = known.UNECE
+			break
+		}
+	}
+	return u, nil
+}
+
+// Spellings возвращает все известные обозначения единицы: код UCUM, код UN/ECE и синонимы
+func (r *UnitRegistry) Spellings(u *Unit) []string {
+	spellings := []string{u.Code}
+	for code, ucum := range unitCodes {
+		if ucum == u.Code {
+			spellings = append(spellings, code)
+		}
+	}
+	for alias, ucum := range unitAliases {
+		if ucum == u.Code {
+			spellings = append(spellings, alias)
+		}
+	}
+	sort.Strings(spellings[1:])
+	return spellings
+}
+
+// Convert переводит значение из одной единицы в другую
+func (r *UnitRegistry) Convert(value float64, from, to string) (float64, error) {
+	src, err := r.Lookup(from)
+	if err != nil {
+		return 0, err
+	}
+	dst, err := r.Lookup(to)
+	if err != nil {
+		return 0, err
+	}
+	factor, offset, err := Conversion(src, dst)
+	if err != nil {
+		return 0, err
+	}
+	return value*factor + offset, nil
+}
+
+// Conversion возвращает коэффициенты перевода: value_to = value_from*factor + offset
+func Conversion(from, to *Unit) (factor, offset float64, err error) {
+	if from.Dimension != to.Dimension {
+		return 0, 0, fmt.Errorf("%w: %s (%s) and %s (%s)",
+			ErrUnitIncompatible, from.Code, from.Kind(), to.Code, to.Kind())
+	}
+	factor = from.factor / to.factor
+	offset = (from.offset - to.offset) / to.factor
+	return factor, offset, nil
+}
+
+// ucumTermPattern член выражения UCUM: атом с приставкой и целым показателем степени
+var ucumTermPattern = regexp.MustCompile(`^(.*?[^0-9+-]|1)([+-]?[0-9]+)?$`)
+
+// parseUCUM разбирает выражение UCUM без скобок: члены, соединённые "." (умножение)
+// и "/" (деление на следующий член), например kW.h, m3/h, /min
+func parseUCUM(code string) (*Unit, error) {
+	if code == "" {
+		return nil, fmt.Errorf("%w: empty code", ErrUnitUnknown)
+	}
+	u := &Unit{Code: code, factor: 1}
+	expr, sign := code, int8(1)
+	if strings.HasPrefix(expr, "/") {
+		expr, sign = expr[1:], -1
+	}
+	terms, lastName := 0, ""
+	for {
+		i := strings.IndexAny(expr, "./")
+		term := expr
+		if i >= 0 {
+			term = expr[:i]
+		}
+
+		m := ucumTermPattern.FindStringSubmatch(term)
+		if m == nil {
+			return nil, fmt.Errorf("%w: %q", ErrUnitUnknown, code)
+		}
+		atom, factor, name, ok := lookupUnitAtom(m[1])
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnitUnknown, code)
+		}
+		exp := int8(1)
+		if m[2] != "" {
+			n, err := strconv.ParseInt(m[2], 10, 8)
+			if err != nil || n == 0 {
+				return nil, fmt.Errorf("%w: %q", ErrUnitUnknown, code)
+			}
+			exp = int8(n)
+		}
+		if atom.special {
+			if terms > 0 || i >= 0 || sign < 0 || exp != 1 || factor != 1 {
+				return nil, fmt.Errorf("%w: %s cannot be combined with other units", ErrUnitUnknown, m[1])
+			}
+			u.Name = name
+			u.Dimension = atom.dimension
+			u.factor = atom.factor
+			u.offset = atom.offset
+			return u, nil
+		}
+
+		exp *= sign
+		lastName = name
+		u.Dimension = u.Dimension.mul(atom.dimension.pow(exp), 1)
+		u.factor *= math.Pow(atom.factor*factor, float64(exp))
+		terms++
+
+		if i < 0 {
+			break
+		}
+		sign = 1
+		if expr[i] == '/' {
+			sign = -1
+		}
+		expr = expr[i+1:]
+	}
+	u.Name = code
+	if terms == 1 && !strings.ContainsAny(code, "/0123456789") {
+		u.Name = lastName
+	}
+	return u, nil
+}
+
+// lookupUnitAtom находит атом по символу, при необходимости отделяя десятичную приставку.
+// Возвращает атом, множитель приставки и название с приставкой
+func lookupUnitAtom(symbol string) (unitAtom, float64, string, bool) {
+	if atom, ok := unitAtoms[symbol]; ok {
+		return atom, 1, atom.name, true
+	}
+	for _, prefix := range unitPrefixes {
+		rest, ok := strings.CutPrefix(symbol, prefix.symbol)
+		if !ok {
+			continue
+		}
+		if atom, ok := unitAtoms[rest]; ok && atom.metric {
+			return atom, prefix.factor, prefix.name + atom.name, true
+		}
+	}
+	return unitAtom{}, 0, "", false
+}
+
+// Compatible возвращает единицы справочника той же размерности, что и u
+func (r *UnitRegistry) Compatible(u *Unit) []*Unit {
+	var units []*Unit
+	for _, known := range r.units {
+		if known.Dimension == u.Dimension {
+			units = append(units, known)
+		}
+	}
+	return units
+}
+
+// UnitPreferences предпочтительные единицы по видам величин, например power: kW
+type UnitPreferences map[QuantityKind]string
+
+// Preferences строит предпочтения из списка единиц; вид величины определяется по единице
+func (r *UnitRegistry) Preferences(codes []string) (UnitPreferences, error) {
+	prefs := make(UnitPreferences, len(codes))
+	for _, code := range codes {
+		u, err := r.Lookup(code)
+		if err != nil {
+			return nil, err
+		}
+		if existing, ok := prefs[u.Kind()]; ok {
+			return nil, fmt.Errorf("%w: %s and %s are both %s units", ErrUnitIncompatible, existing, code, u.Kind())
+		}
+		prefs[u.Kind()] = code
+	}
+	return prefs, nil
+}
+
+// CheckPreferences проверяет, что каждая единица известна и относится к своему виду величины
+func (r *UnitRegistry) CheckPreferences(prefs UnitPreferences) error {
+	for kind, code := range prefs {
+		u, err := r.Lookup(code)
+		if err != nil {
+			return err
+		}
+		if u.Kind() != kind {
+			return fmt.Errorf("%w: %s is a %s unit, not %s", ErrUnitIncompatible, code, u.Kind(), kind)
+		}
+	}
+	return nil
+}
+
+// Merge возвращает предпочтения, дополненные other; единицы other имеют приоритет
+func (p UnitPreferences) Merge(other UnitPreferences) UnitPreferences {
+	merged := make(UnitPreferences, len(p)+len(other))
+	for kind, code := range p {
+		merged[kind] = code
+	}
+	for kind, code := range other {
+		merged[kind] = code
+	}
+	return merged
+}
+
+// Normalize переводит числовое значение свойства в предпочтительную единицу его вида
+// величины. Значения без единицы, с неизвестной единицей или без предпочтения
+// возвращаются без изменений
+func (r *UnitRegistry) Normalize(pv PropertyValue, prefs UnitPreferences) (PropertyValue, bool) {
+	if pv.unit == "" || len(prefs) == 0 {
+		return pv, false
+	}
+	u, err := r.Lookup(pv.unit)
+	if err != nil {
+		return pv, false
+	}
+	target, ok := prefs[u.Kind()]
+	if !ok || target == pv.unit {
+		return pv, false
+	}
+	converted, err := pv.ConvertTo(r, target)
+	if err != nil {
+		return pv, false
+	}
+	return converted, true
+}
+
+// ConvertTo переводит числовое значение свойства (integer, float, quantity) в другую единицу.
+// Целые значения после перевода становятся float
+func (pv PropertyValue) ConvertTo(r *UnitRegistry, unit string) (PropertyValue, error) {
+	typed, err := pv.Typed()
+	if err != nil {
+		return PropertyValue{}, err
+	}
+	var value float64
+	switch v := typed.(type) {
+	case int64:
+		value = float64(v)
+	case float64:
+		value = v
+	case Quantity:
+		value = v.Value
+	default:
+		return PropertyValue{}, fmt.Errorf("%w: %s value has no magnitude", ErrUnitIncompatible, pv.dataType)
+	}
+	converted, err := r.Convert(value, pv.unit, unit)
+	if err != nil {
+		return PropertyValue{}, err
+	}
+
+	result := pv
+	result.value = strconv.FormatFloat(roundSignificant(converted), 'g', -1, 64)
+	result.unit = unit
+	if _, ok := typed.(int64); ok {
+		result.dataType = string(PropertyDataTypeFloat)
+	}
+	return result, nil
+}
+
+// roundSignificant округляет результат перевода до 12 значащих цифр,
+// отбрасывая погрешность вычислений с плавающей точкой
+func roundSignificant(v float64) float64 {
+	if v == 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(v, 'g', 12, 64), 64)
+	if err != nil {
+		return v
+	}
+	return rounded
+}
@@ -0,0 +1,176 @@
+package model
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookSubscription подписка внешней системы на доменные события.
+// События доставляются POST-запросом на url с JSON телом, подписанным HMAC-SHA256 по secret
+type WebhookSubscription struct {
+	id          uuid.UUID
+	url         string
+	secret      string
+	eventTypes  []string // пустой список - все события
+	description string
+	active      bool
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewWebhookSubscription создаёт подписку. Пустой secret заменяется случайным
+func NewWebhookSubscription(target, secret string, eventTypes []string, description string, active bool) (*WebhookSubscription, error) {
+	if secret == "" {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		secret = hex.EncodeToString(b)
+	}
+	now := time.Now()
+	w := &WebhookSubscription{
+		id:        uuid.New(),
+		secret:    secret,
+		createdAt: now,
+	}
+	if err := w.Update(target, eventTypes, description, active); err != nil {
+		return nil, err
+	}
+	w.updatedAt = now
+	return w, nil
+}
+
+// RestoreWebhookSubscription восстанавливает подписку из хранилища
+func RestoreWebhookSubscription(
+	id uuid.UUID,
+	target, secret string,
+	eventTypes []string,
+	description string,
+	active bool,
+	createdAt, updatedAt time.Time,
+) *WebhookSubscription {
+	return &WebhookSubscription{
+		id:          id,
+		url:         target,
+		secret:      secret,
+		eventTypes:  eventTypes,
+		description: description,
+		active:      active,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// ID возвращает идентификатор подписки
+func (w *WebhookSubscription) ID() uuid.UUID {
+	return w.id
+}
+
+// URL возвращает адрес доставки
+func (w *WebhookSubscription) URL() string {
+	return w.url
+}
+
+// Secret возвращает ключ подписи HMAC
+func (w *WebhookSubscription) Secret() string {
+	return w.secret
+}
+
+// EventTypes возвращает фильтр типов событий (пустой - все события)
+func (w *WebhookSubscription) EventTypes() []string {
+	return w.eventTypes
+}
+
+// Description возвращает описание подписки
+func (w *WebhookSubscription) Description() string {
+	return w.description
+}
+
+// Active сообщает, доставляются ли события по подписке
+func (w *WebhookSubscription) Active() bool {
+	return w.active
+}
+
+// CreatedAt возвращает время создания
+func (w *WebhookSubscription) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+// UpdatedAt возвращает время последнего изменения
+func (w *WebhookSubscription) UpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+// Update изменяет адрес, фильтр событий, описание и активность подписки
+func (w *WebhookSubscription) Update(target string, eventTypes []string, description string, active bool) error {
+	if err := validateWebhookURL(target); err != nil {
+		return err
+	}
+	filters := make([]string, 0, len(eventTypes))
+	for _, filter := range eventTypes {
+		filter = strings.TrimSpace(filter)
+		if err := ValidateEventTypeFilter(filter); err != nil {
+			return err
+		}
+		if !slices.Contains(filters, filter) {
+			filters = append(filters, filter)
+		}
+	}
+
+	w.url = target
+	w.eventTypes = filters
+	w.description = description
+	w.active = active
+	w.updatedAt = time.Now()
+	return nil
+}
+
+// Matches сообщает, подходит ли тип события под фильтр подписки.
+// Фильтр - точный тип (equipment.moved), префикс с * (equipment.*) или *
+func (w *WebhookSubscription) Matches(eventType string) bool {
+	if len(w.eventTypes) == 0 {
+		return true
+	}
+	for _, filter := range w.eventTypes {
+		if MatchEventType(filter, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateWebhookURL(target string) error {
+	u, err := url.Parse(target)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("%w: %q", ErrWebhookInvalidURL, target)
+	}
+	return nil
+}
+
+// WebhookDeliveryStatus состояние доставки события подписчику
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending доставка ожидает отправки или повторной попытки
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryDelivered подписчик ответил 2xx
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryDead попытки исчерпаны, доставка в dead letter
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+// ParseWebhookDeliveryStatus разбирает состояние доставки
+func ParseWebhookDeliveryStatus(value string) (WebhookDeliveryStatus, error) {
+	switch status := WebhookDeliveryStatus(value); status {
+	case WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryDead:
+		return status, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrWebhookInvalidDeliveryStatus, value)
+}
@@ -2,12 +2,25 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
 )
 
-// EquipmentRepository интерфейс репозитория для Equipment
+// EquipmentRef сведения об оборудовании для проверок существования и
+// иерархии без загрузки агрегата
+type EquipmentRef struct {
+	ID model.EquipmentID
+	// Level уровень оборудования в иерархии ISA-95; nil, если не задан
+	Level *b2mml.EquipmentLevelType
+	// Version сохранённая версия оборудования
+	Version int64
+}
+
+// EquipmentRepository интерфейс репозитория для Equipment.
+// Create, Update и Move записывают накопленные доменные события агрегата в outbox
 type EquipmentRepository interface {
 	// Create сохраняет новое оборудование
 	Create(ctx context.Context, equipment *model.Equipment) error
@@ -18,17 +31,74 @@ type EquipmentRepository interface {
 	// GetByExternalID получает оборудование по внешнему ID
 	GetByExternalID(ctx context.Context, externalID string) (*model.Equipment, error)
 
-	// List получает список оборудования с пагинацией
-	List(ctx context.Context, limit int32, offset int32) ([]*model.Equipment, error)
+	// GetRef получает ID, уровень и версию оборудования одним запросом без
+	// загрузки агрегата. Если оборудования нет, возвращает model.ErrEquipmentNotFound
+	GetRef(ctx context.Context, externalID string) (*EquipmentRef, error)
+
+	// List получает страницу списка оборудования
+	List(ctx context.Context, page PageRequest) (*Page[*model.Equipment], error)
+
+	// ListByStatus получает страницу списка оборудования по статусу
+	ListByStatus(ctx context.Context, status model.OperatingStatus, page PageRequest) (*Page[*model.Equipment], error)
+
+	// ListByClass получает страницу списка оборудования, входящего в класс
+	ListByClass(ctx context.Context, classID model.EquipmentClassID, page PageRequest) (*Page[*model.Equipment], error)
+
+	// GetSubtree получает оборудование с поддеревом дочернего оборудования до глубины depth.
+	// При depth = 0 дочернее оборудование не загружается
+	GetSubtree(ctx context.Context, externalID string, depth int32) (*model.Equipment, error)
+
+	// Ancestors возвращает предков оборудования от корня иерархии до непосредственного родителя
+	Ancestors(ctx context.Context, externalID string) ([]model.EquipmentID, error)
+
+	// Hierarchy возвращает одним запросом оборудование externalIDs и всех его
+	// предков без учёта порядка; удалённое и отсутствующее оборудование пропускается
+	Hierarchy(ctx context.Context, externalIDs []string) ([]model.EquipmentID, error)
+
+	// Move переносит оборудование вместе с поддеревом под parentID (nil - в корень),
+	// если сохранённая версия совпадает с expectedVersion
+	Move(ctx context.Context, equipment *model.Equipment, parentID *model.EquipmentID, expectedVersion int64) error
+
+	// Query получает страницу оборудования, удовлетворяющего всем фильтрам запроса
+	Query(ctx context.Context, query EquipmentQuery) (*Page[*model.Equipment], error)
+
+	// Export передаёт fn оборудование выгрузки в порядке обхода иерархии
+	// (родитель раньше потомков), читая его серверным курсором пачками.
+	// Работает только в транзакции (UnitOfWork.Begin)
+	Export(ctx context.Context, query EquipmentExportQuery, fn func(*EquipmentRecord) error) error
 
-	// ListByStatus получает список оборудования по статусу
-	ListByStatus(ctx context.Context, status model.OperatingStatus, limit int32, offset int32) ([]*model.Equipment, error)
+	// PropertyIDs возвращает по возрастанию ID свойств, заданных оборудованием
+	// или определённых классами
+	PropertyIDs(ctx context.Context) ([]string, error)
 
-	// Update обновляет оборудование
-	Update(ctx context.Context, equipment *model.Equipment) error
+	// Update обновляет оборудование, если сохранённая версия совпадает с expectedVersion.
+	// При несовпадении возвращает model.ErrEquipmentVersionConflict
+	Update(ctx context.Context, equipment *model.Equipment, expectedVersion int64) error
 
-	// Delete удаляет оборудование (soft delete)
-	Delete(ctx context.Context, id uuid.UUID) error
+	// Delete удаляет оборудование (soft delete) по правилам opts, если сохранённая
+	// версия совпадает с expectedVersion. Дочернее оборудование обрабатывается
+	// в базе; события агрегата и загруженного поддерева записываются в outbox
+	Delete(ctx context.Context, equipment *model.Equipment, opts model.DeleteOptions, expectedVersion int64) error
+
+	// ListDeleted получает страницу удалённого оборудования, начиная с удалённого последним
+	ListDeleted(ctx context.Context, page PageRequest) (*Page[*model.Equipment], error)
+
+	// GetDeleted получает удалённое оборудование вместе с потомками, удалёнными
+	// каскадно с ним. Для неудалённого оборудования возвращает model.ErrEquipmentNotDeleted
+	GetDeleted(ctx context.Context, externalID string) (*model.Equipment, error)
+
+	// Restore восстанавливает оборудование, полученное через GetDeleted, вместе с
+	// удалёнными каскадно с ним потомками, если сохранённая версия совпадает с
+	// expectedVersion. Родитель должен быть активен (model.ErrEquipmentParentDeleted)
+	Restore(ctx context.Context, equipment *model.Equipment, expectedVersion int64) error
+
+	// Purge окончательно удаляет удалённое оборудование вместе с удалёнными
+	// каскадно с ним потомками. История изменений сохраняется
+	Purge(ctx context.Context, externalID string) error
+
+	// PurgeDeletedBefore окончательно удаляет до limit единиц оборудования,
+	// удалённого раньше before, и возвращает их число
+	PurgeDeletedBefore(ctx context.Context, before time.Time, limit int32) (int, error)
 }
 
 // EquipmentClassRepository интерфейс репозитория для EquipmentClass
@@ -42,14 +112,19 @@ type EquipmentClassRepository interface {
 	// GetByExternalID получает класс по внешнему ID
 	GetByExternalID(ctx context.Context, externalID string) (*model.EquipmentClass, error)
 
-	// List получает список классов с пагинацией
-	List(ctx context.Context, limit int32, offset int32) ([]*model.EquipmentClass, error)
+	// Lineage получает класс и его предков от корневого класса до самого класса.
+	// Дочерние классы при этом не загружаются
+	Lineage(ctx context.Context, id model.EquipmentClassID) ([]*model.EquipmentClass, error)
+
+	// List получает страницу списка классов
+	List(ctx context.Context, page PageRequest) (*Page[*model.EquipmentClass], error)
 
 	// Update обновляет класс
 	Update(ctx context.Context, class *model.EquipmentClass) error
 
-	// Delete удаляет класс (soft delete)
-	Delete(ctx context.Context, id uuid.UUID) error
+	// Delete удаляет класс (soft delete). Класс с дочерними классами или
+	// входящим в него оборудованием не удаляется (model.ErrEquipmentClassInUse)
+	Delete(ctx context.Context, class *model.EquipmentClass) error
 }
 
 // UnitOfWork паттерн для управления транзакциями
@@ -60,12 +135,29 @@ type UnitOfWork interface {
 	// EquipmentClass возвращает репозиторий EquipmentClass
 	EquipmentClass() EquipmentClassRepository
 
+	// Person возвращает репозиторий Person
+	Person() PersonRepository
+
+	// SiteUnits возвращает репозиторий предпочтительных единиц площадок
+	SiteUnits() SiteUnitRepository
+
+	// ImportJobs возвращает репозиторий заданий импорта
+	ImportJobs() ImportJobRepository
+
 	// Begin начинает транзакцию
 	Begin(ctx context.Context) (UnitOfWork, error)
 
+	// BeginReadOnly начинает транзакцию только для чтения, все запросы которой
+	// видят один снимок данных
+	BeginReadOnly(ctx context.Context) (UnitOfWork, error)
+
 	// Commit коммитит транзакцию
 	Commit(ctx context.Context) error
 
 	// Rollback откатывает транзакцию
 	Rollback(ctx context.Context) error
+
+	// Do выполняет fn в транзакции: фиксирует её при успехе, откатывает при ошибке
+	// и повторяет при конфликтах сериализации
+	Do(ctx context.Context, fn func(UnitOfWork) error) error
 }
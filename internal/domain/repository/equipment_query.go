@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// EquipmentSortField поле сортировки оборудования
+type EquipmentSortField string
+
+const (
+	SortByCreatedAt          EquipmentSortField = "created_at"
+	SortByUpdatedAt          EquipmentSortField = "updated_at"
+	SortByExternalID         EquipmentSortField = "external_id"
+	SortByEffectiveStartDate EquipmentSortField = "effective_start_date"
+	SortByEquipmentLevel     EquipmentSortField = "equipment_level"
+	SortByOperatingStatus    EquipmentSortField = "operating_status"
+)
+
+// EquipmentSort порядок сортировки. Нулевое значение - по created_at по возрастанию,
+// поэтому по умолчанию используется DefaultEquipmentSort
+type EquipmentSort struct {
+	Field EquipmentSortField
+	Desc  bool
+}
+
+// DefaultEquipmentSort сортировка по умолчанию: сначала новые записи
+var DefaultEquipmentSort = EquipmentSort{Field: SortByCreatedAt, Desc: true}
+
+// String возвращает сортировку в виде "field" или "-field" (по убыванию)
+func (s EquipmentSort) String() string {
+	if s.Desc {
+		return "-" + string(s.Field)
+	}
+	return string(s.Field)
+}
+
+// PropertyOperator оператор сравнения значения свойства
+type PropertyOperator string
+
+const (
+	PropertyEq     PropertyOperator = "eq"
+	PropertyNe     PropertyOperator = "ne"
+	PropertyLt     PropertyOperator = "lt"
+	PropertyLte    PropertyOperator = "lte"
+	PropertyGt     PropertyOperator = "gt"
+	PropertyGte    PropertyOperator = "gte"
+	PropertyLike   PropertyOperator = "like"
+	PropertyExists PropertyOperator = "exists"
+)
+
+// PropertyValueType тип, к которому приводится значение свойства при сравнении
+type PropertyValueType string
+
+const (
+	PropertyTypeString   PropertyValueType = "string"
+	PropertyTypeNumber   PropertyValueType = "number"
+	PropertyTypeDateTime PropertyValueType = "datetime"
+	PropertyTypeBoolean  PropertyValueType = "boolean"
+)
+
+// PropertyPredicate условие на значение свойства оборудования,
+// например POWER_RATING gt 50 (number, kW)
+type PropertyPredicate struct {
+	PropertyID string
+	Operator   PropertyOperator
+	Value      string
+	Type       PropertyValueType
+	// Unit единица измерения; если задана, сравниваются только значения в этой единице
+	// или в единицах из UnitConversions
+	Unit string
+	// UnitConversions перевод значений в совместимых единицах в Unit (для number),
+	// например 73.7 [HP] сравнивается с 55 kW
+	UnitConversions []UnitConversion
+}
+
+// UnitConversion перевод значения свойства в единицу предиката: value*Factor + Offset
+type UnitConversion struct {
+	Unit   string
+	Factor float64
+	Offset float64
+}
+
+// EquipmentQuery параметры поиска оборудования. Пустые фильтры не применяются,
+// значения внутри одного фильтра объединяются через ИЛИ, фильтры - через И
+type EquipmentQuery struct {
+	Statuses          []model.OperatingStatus
+	Levels            []string
+	HierarchyScopeIDs []string
+
+	// ClassID класс оборудования; IncludeSubclasses включает и его подклассы
+	ClassID           *model.EquipmentClassID
+	IncludeSubclasses bool
+
+	// EffectiveAt оборудование, действующее в указанный момент
+	EffectiveAt *time.Time
+	// EffectiveFrom и EffectiveTo оборудование, период действия которого
+	// пересекается с окном [EffectiveFrom, EffectiveTo)
+	EffectiveFrom *time.Time
+	EffectiveTo   *time.Time
+
+	Properties []PropertyPredicate
+
+	Sort EquipmentSort
+	Page PageRequest
+}
+
+// EquipmentExportQuery параметры выгрузки оборудования
+type EquipmentExportQuery struct {
+	// Filter фильтры оборудования; Sort и Page не используются
+	Filter EquipmentQuery
+	// RootIDs внешние ID корней выгружаемых поддеревьев (корни не должны
+	// быть потомками друг друга); пусто - вся иерархия
+	RootIDs []string
+	// BatchSize число строк, читаемых из курсора за раз
+	BatchSize int32
+}
+
+// EquipmentRecord оборудование выгрузки с положением в иерархии
+type EquipmentRecord struct {
+	// Equipment агрегат без дочернего оборудования
+	Equipment *model.Equipment
+	// ParentID внешний ID родителя; пусто для корня иерархии
+	ParentID string
+	// Path внешние ID предков от корня выгрузки до непосредственного родителя
+	Path []string
+}
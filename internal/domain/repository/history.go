@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// ChangeInfo автор и причина изменения, которые записываются в историю
+type ChangeInfo struct {
+	Actor  string
+	Reason string
+}
+
+type changeInfoKey struct{}
+
+// WithChangeInfo возвращает контекст с автором и причиной изменения для
+// репозиториев, ведущих историю
+func WithChangeInfo(ctx context.Context, info ChangeInfo) context.Context {
+	return context.WithValue(ctx, changeInfoKey{}, info)
+}
+
+// ChangeInfoFromContext возвращает автора и причину изменения из контекста
+func ChangeInfoFromContext(ctx context.Context) ChangeInfo {
+	info, _ := ctx.Value(changeInfoKey{}).(ChangeInfo)
+	return info
+}
+
+// EquipmentRevision ревизия оборудования в истории изменений
+type EquipmentRevision struct {
+	// Revision порядковый номер ревизии оборудования, начиная с 1
+	Revision      int32
+	RecordVersion int64
+	Operation     model.ChangeOperation
+	ChangedAt     time.Time
+	ChangedBy     string
+	Reason        string
+	Snapshot      *model.EquipmentSnapshot
+}
+
+// EquipmentHistoryRepository история изменений оборудования. Ревизии
+// записывает EquipmentRepository в той же транзакции, что и изменение
+type EquipmentHistoryRepository interface {
+	// List возвращает до limit ревизий оборудования от новых к старым;
+	// before > 0 - только ревизии с номером меньше before. Снимки не загружаются.
+	// История доступна и для удалённого оборудования
+	List(ctx context.Context, externalID string, before int32, limit int32) ([]*EquipmentRevision, error)
+
+	// Get возвращает ревизию со снимком; revision 0 - последняя ревизия
+	Get(ctx context.Context, externalID string, revision int32) (*EquipmentRevision, error)
+
+	// GetAsOf возвращает оборудование со всеми потомками в состоянии на момент asOf
+	GetAsOf(ctx context.Context, externalID string, asOf time.Time) (*model.Equipment, error)
+
+	// GetSubtreeAsOf возвращает поддерево оборудования глубиной depth на момент asOf
+	GetSubtreeAsOf(ctx context.Context, externalID string, depth int32, asOf time.Time) (*model.Equipment, error)
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// ImportJob задание импорта оборудования и его отчёт
+type ImportJob struct {
+	ID     uuid.UUID
+	Format model.ImportFormat
+	// Delimiter разделитель полей CSV
+	Delimiter rune
+	// Mapping сопоставление колонок источника полям оборудования
+	// (см. app.EquipmentImporter)
+	Mapping   map[string]string
+	DryRun    bool
+	ChunkSize int32
+	Status    model.ImportJobStatus
+	// RowsProcessed число строк источника, результат которых зафиксирован
+	RowsProcessed int64
+	// RowsImported число строк, создавших оборудование (при DryRun - прошедших проверку)
+	RowsImported int64
+	ErrorCount   int64
+	// RowErrors первые ошибки строк
+	RowErrors  []ImportRowError
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// ImportRowError ошибка строки источника импорта; строка не импортируется
+type ImportRowError struct {
+	// Row номер строки данных с 1, Line - номер строки файла
+	Row         int64
+	Line        int64
+	EquipmentID string
+	// Column колонка источника, если ошибку можно к ней отнести
+	Column  string
+	Message string
+}
+
+// ImportJobRepository хранилище заданий импорта оборудования
+type ImportJobRepository interface {
+	// Create сохраняет новое задание с исходным файлом source. Задание в
+	// состоянии running захватывается до lockedUntil
+	Create(ctx context.Context, job *ImportJob, source []byte, lockedUntil time.Time) error
+
+	// Get возвращает задание; model.ErrImportJobNotFound, если его нет
+	Get(ctx context.Context, id uuid.UUID) (*ImportJob, error)
+
+	// Source возвращает исходный файл задания
+	Source(ctx context.Context, id uuid.UUID) ([]byte, error)
+
+	// Claim захватывает до lockedUntil ожидающее задание или задание, аренда
+	// которого истекла; nil, если таких нет
+	Claim(ctx context.Context, lockedUntil time.Time) (*ImportJob, error)
+
+	// SaveProgress сохраняет состояние и прогресс выполняемого задания,
+	// сохранённого с прогрессом fromRows, и продлевает аренду до lockedUntil
+	// (nil - освобождает задание). model.ErrImportJobLeaseLost, если задание
+	// продолжил другой обработчик
+	SaveProgress(ctx context.Context, job *ImportJob, fromRows int64, lockedUntil *time.Time) error
+
+	// Resume возвращает прерванное задание в очередь или, если задан
+	// lockedUntil, захватывает его до этого момента;
+	// model.ErrImportJobNotResumable, если оно не прервано
+	Resume(ctx context.Context, id uuid.UUID, lockedUntil *time.Time) error
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEvent доменное событие, сохранённое в outbox для доставки
+type OutboxEvent struct {
+	// Sequence сквозной номер события в outbox (id события в потоке SSE)
+	Sequence int64
+	// TxID транзакция, записавшая событие
+	TxID int64
+	// EventID уникальный идентификатор события: получатели используют его
+	// для отбрасывания повторов, доставка выполняется не менее одного раза
+	EventID       uuid.UUID
+	AggregateType string
+	AggregateID   string
+	EventType     string
+	Payload       json.RawMessage
+	OccurredAt    time.Time
+	// Attempts число уже сделанных попыток доставки
+	Attempts  int32
+	LastError string
+}
+
+// OutboxRepository хранилище недоставленных доменных событий.
+// События записываются репозиториями агрегатов в той же транзакции, что и
+// изменение агрегата
+type OutboxRepository interface {
+	// Claim захватывает до limit готовых к доставке событий до момента lockedUntil.
+	// Для каждого агрегата возвращается не больше одного события - самое раннее
+	// недоставленное, поэтому порядок событий агрегата сохраняется
+	Claim(ctx context.Context, limit int32, lockedUntil time.Time) ([]*OutboxEvent, error)
+
+	// MarkDispatched отмечает событие доставленным
+	MarkDispatched(ctx context.Context, eventID uuid.UUID) error
+
+	// MarkFailed снимает захват и откладывает следующую попытку до nextAttemptAt
+	MarkFailed(ctx context.Context, eventID uuid.UUID, nextAttemptAt time.Time, cause error) error
+
+	// PurgeDispatched удаляет события, доставленные раньше before
+	PurgeDispatched(ctx context.Context, before time.Time) (int64, error)
+
+	// Stream возвращает до limit событий после позиции after в порядке фиксации
+	// транзакций, независимо от их доставки получателям
+	Stream(ctx context.Context, after StreamPosition, limit int32) ([]*OutboxEvent, error)
+
+	// StreamHead возвращает текущий конец потока: все события, которые ещё
+	// не прочитаны через Stream, окажутся после него
+	StreamHead(ctx context.Context) (StreamPosition, error)
+
+	// PositionOf возвращает позицию события с номером sequence. Если событие уже
+	// удалено, позиция приблизительная: поток продолжится с ближайших событий
+	PositionOf(ctx context.Context, sequence int64) (StreamPosition, error)
+}
+
+// StreamPosition позиция в потоке событий outbox. События упорядочены по
+// транзакции, записавшей их, затем по номеру: номера выдаются до фиксации,
+// поэтому порядок номеров не совпадает с порядком появления событий
+type StreamPosition struct {
+	TxID     int64
+	Sequence int64
+}
+
+// Position возвращает позицию события в потоке
+func (e *OutboxEvent) Position() StreamPosition {
+	return StreamPosition{TxID: e.TxID, Sequence: e.Sequence}
+}
+
+// After сообщает, что позиция p находится дальше позиции other
+func (p StreamPosition) After(other StreamPosition) bool {
+	if p.TxID != other.TxID {
+		return p.TxID > other.TxID
+	}
+	return p.Sequence > other.Sequence
+}
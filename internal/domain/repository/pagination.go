@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidCursor возвращается для повреждённого курсора пагинации
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// PageRequest параметры запроса страницы (keyset-пагинация)
+type PageRequest struct {
+	// Limit размер страницы
+	Limit int32
+	// Cursor непрозрачный курсор из NextCursor/PrevCursor предыдущего ответа.
+	// Пустой курсор - первая страница
+	Cursor string
+}
+
+// Page страница результатов. Пустой курсор означает, что страницы в этом
+// направлении нет
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+	PrevCursor string
+}
+
+// Cursor позиция в списке, упорядоченном по (created_at, id) по убыванию.
+// Для списков с произвольной сортировкой позицию задают Sort и Key
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+	// Backward курсор указывает на записи перед позицией (предыдущая страница)
+	Backward bool
+	// Sort сортировка, для которой выдан курсор
+	Sort string
+	// Key значение ключа сортировки граничной записи
+	Key string
+}
+
+// cursorToken сериализованное представление Cursor
+type cursorToken struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"i"`
+	Backward  bool      `json:"b,omitempty"`
+	Sort      string    `json:"s,omitempty"`
+	Key       string    `json:"k,omitempty"`
+}
+
+// Encode кодирует курсор в непрозрачную строку
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(cursorToken{
+		CreatedAt: c.CreatedAt,
+		ID:        c.ID,
+		Backward:  c.Backward,
+		Sort:      c.Sort,
+		Key:       c.Key,
+	})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor разбирает курсор. Для пустой строки возвращает nil
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var t cursorToken
+	if err := json.Unmarshal(raw, &t); err != nil || t.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+	if t.Sort == "" && t.CreatedAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &Cursor{CreatedAt: t.CreatedAt, ID: t.ID, Backward: t.Backward, Sort: t.Sort, Key: t.Key}, nil
+}
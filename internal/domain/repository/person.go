@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// PersonRepository интерфейс репозитория для Person
+type PersonRepository interface {
+	// Create сохраняет нового сотрудника
+	Create(ctx context.Context, person *model.Person) error
+
+	// GetByExternalID получает сотрудника по внешнему ID
+	GetByExternalID(ctx context.Context, externalID string) (*model.Person, error)
+
+	// List получает список сотрудников с пагинацией
+	List(ctx context.Context, limit int32, offset int32) ([]*model.Person, error)
+}
@@ -0,0 +1,55 @@
+package repository
+
+import "context"
+
+// SearchKind тип объекта в результатах поиска
+type SearchKind string
+
+const (
+	SearchKindEquipment      SearchKind = "equipment"
+	SearchKindEquipmentClass SearchKind = "equipment_class"
+	SearchKindPhysicalAsset  SearchKind = "physical_asset"
+)
+
+// SearchKinds все типы объектов, по которым выполняется поиск
+var SearchKinds = []SearchKind{
+	SearchKindEquipment,
+	SearchKindEquipmentClass,
+	SearchKindPhysicalAsset,
+}
+
+// SearchQuery параметры полнотекстового поиска
+type SearchQuery struct {
+	// Terms слова запроса. Объект должен содержать все слова, слово
+	// совпадает и как начало более длинного (поиск по фрагменту серийного номера)
+	Terms []string
+	// Language код языка запроса (B2MML languageID) для стемминга; пустой - без стемминга
+	Language string
+	Kinds    []SearchKind
+	Limit    int32
+}
+
+// HighlightSegment часть фрагмента текста; Match отмечает совпадение с запросом
+type HighlightSegment struct {
+	Text  string
+	Match bool
+}
+
+// SearchHit найденный объект
+type SearchHit struct {
+	Kind SearchKind
+	// ID внешний идентификатор объекта
+	ID string
+	// EquipmentID оборудование, к которому привязан физический актив
+	EquipmentID string
+	Title       string
+	// Highlight фрагменты текста объекта с выделенными совпадениями
+	Highlight []HighlightSegment
+	Rank      float32
+}
+
+// SearchRepository интерфейс полнотекстового поиска по справочникам
+type SearchRepository interface {
+	// Search возвращает найденные объекты по убыванию релевантности
+	Search(ctx context.Context, query SearchQuery) ([]*SearchHit, error)
+}
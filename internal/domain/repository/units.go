@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// SiteUnitRepository хранилище предпочтительных единиц измерения площадок
+// (оборудования уровня ISA-95 Site)
+type SiteUnitRepository interface {
+	// Get возвращает предпочтительные единицы площадки
+	Get(ctx context.Context, siteID model.EquipmentID) (model.UnitPreferences, error)
+
+	// Replace заменяет предпочтительные единицы площадки
+	Replace(ctx context.Context, siteID model.EquipmentID, prefs model.UnitPreferences) error
+
+	// ForEquipment находит ближайшую площадку среди оборудования и его предков
+	// и возвращает её единицы. Если площадки нет, siteID равен nil
+	ForEquipment(ctx context.Context, externalID string) (siteID *model.EquipmentID, prefs model.UnitPreferences, err error)
+}
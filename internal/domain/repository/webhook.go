@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// WebhookDelivery доставка одного события одному подписчику
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	// Payload тело запроса: конверт события (см. app.EventEnvelope)
+	Payload        json.RawMessage
+	Status         model.WebhookDeliveryStatus
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastError      string
+	ResponseStatus int
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// WebhookRepository хранилище подписок на события и очереди их доставок
+type WebhookRepository interface {
+	// Create сохраняет новую подписку
+	Create(ctx context.Context, subscription *model.WebhookSubscription) error
+
+	// Get возвращает подписку; model.ErrWebhookNotFound, если её нет
+	Get(ctx context.Context, id uuid.UUID) (*model.WebhookSubscription, error)
+
+	// List возвращает все подписки в порядке создания
+	List(ctx context.Context) ([]*model.WebhookSubscription, error)
+
+	// ListActive возвращает активные подписки
+	ListActive(ctx context.Context) ([]*model.WebhookSubscription, error)
+
+	// Update сохраняет изменения подписки
+	Update(ctx context.Context, subscription *model.WebhookSubscription) error
+
+	// Delete удаляет подписку вместе с её доставками
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Enqueue ставит доставки в очередь. Доставка того же события той же
+	// подписке повторно не ставится
+	Enqueue(ctx context.Context, deliveries ...*WebhookDelivery) error
+
+	// ClaimDeliveries захватывает до limit готовых к отправке доставок
+	// активных подписок до момента lockedUntil
+	ClaimDeliveries(ctx context.Context, limit int32, lockedUntil time.Time) ([]*WebhookDelivery, error)
+
+	// MarkDelivered отмечает доставку успешной
+	MarkDelivered(ctx context.Context, id uuid.UUID, responseStatus int) error
+
+	// MarkFailed сохраняет неудачную попытку: status pending - повтор в nextAttemptAt,
+	// dead - доставка переносится в dead letter
+	MarkFailed(ctx context.Context, id uuid.UUID, status model.WebhookDeliveryStatus, nextAttemptAt time.Time, responseStatus int, cause error) error
+
+	// GetDelivery возвращает доставку; model.ErrWebhookDeliveryNotFound, если её нет
+	GetDelivery(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error)
+
+	// ListDeliveries возвращает последние доставки подписки, пустой status - в любом состоянии
+	ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, status model.WebhookDeliveryStatus, limit int32) ([]*WebhookDelivery, error)
+
+	// Replay возвращает доставку из dead letter в очередь;
+	// model.ErrWebhookDeliveryNotDead, если она не в dead letter
+	Replay(ctx context.Context, id uuid.UUID) error
+
+	// PurgeDelivered удаляет доставки, успешно выполненные раньше before
+	PurgeDelivered(ctx context.Context, before time.Time) (int64, error)
+}
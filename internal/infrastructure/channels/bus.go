@@ -0,0 +1,70 @@
+package channels
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// ReplyTopicSuffix суффикс темы, в которую шина публикует ответы подписчиков
+const ReplyTopicSuffix = "/reply"
+
+// Bus шина сообщений в процессе: документ темы синхронно передаётся всем её
+// подписчикам, их ответы публикуются в тему <тема>/reply
+type Bus struct {
+	mu     sync.RWMutex
+	nextID int
+	subs   map[string]map[int]func(ctx context.Context, document []byte) []byte
+}
+
+// NewBus создаёт шину сообщений
+func NewBus() *Bus {
+	return &Bus{subs: map[string]map[int]func(ctx context.Context, document []byte) []byte{}}
+}
+
+// Publish передаёт документ подписчикам темы. Ответы на документы тем
+// ответов не публикуются
+func (b *Bus) Publish(ctx context.Context, topic string, document []byte) {
+	b.mu.RLock()
+	receivers := make([]func(ctx context.Context, document []byte) []byte, 0, len(b.subs[topic]))
+	for _, receive := range b.subs[topic] {
+		receivers = append(receivers, receive)
+	}
+	b.mu.RUnlock()
+
+	for _, receive := range receivers {
+		reply := receive(ctx, document)
+		if reply != nil && !strings.HasSuffix(topic, ReplyTopicSuffix) {
+			b.Publish(ctx, topic+ReplyTopicSuffix, reply)
+		}
+	}
+}
+
+// Subscribe подписывает receive на тему; возвращает функцию отписки
+func (b *Bus) Subscribe(topic string, receive func(ctx context.Context, document []byte) []byte) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	id := b.nextID
+	b.nextID++
+	if b.subs[topic] == nil {
+		b.subs[topic] = map[int]func(ctx context.Context, document []byte) []byte{}
+	}
+	b.subs[topic][id] = receive
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs[topic], id)
+	}
+}
+
+func (b *Bus) Send(ctx context.Context, topic string, document []byte) error {
+	b.Publish(ctx, topic, document)
+	return nil
+}
+
+func (b *Bus) Listen(ctx context.Context, topic string, receive func(ctx context.Context, document []byte) []byte) error {
+	unsubscribe := b.Subscribe(topic, receive)
+	defer unsubscribe()
+	<-ctx.Done()
+	return ctx.Err()
+}
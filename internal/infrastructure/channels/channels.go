@@ -0,0 +1,93 @@
+// Package channels содержит транспорты каналов сообщений B2MML из MasterDataProfile
+package channels
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// Transport транспорт канала сообщений B2MML
+type Transport interface {
+	// Send отправляет документ в тему канала
+	Send(ctx context.Context, topic string, document []byte) error
+	// Listen принимает документы темы до отмены ctx и передаёт их receive
+	Listen(ctx context.Context, topic string, receive func(ctx context.Context, document []byte) []byte) error
+}
+
+// Opener открывает транспорты каналов по ChannelURI:
+//   - http://, https:// - POST документа на адрес (только исходящие)
+//   - file:///путь - папка обмена, тема - подпапка
+//   - bus://имя - шина сообщений в процессе
+type Opener struct {
+	client       *http.Client
+	pollInterval time.Duration
+
+	mu    sync.Mutex
+	buses map[string]*Bus
+}
+
+// NewOpener создаёт Opener. client nil - http.Client с таймаутом 10 секунд;
+// pollInterval - период опроса папок (1 секунда, если не задан), для канала его
+// можно переопределить параметром interval в URI (file:///in?interval=5s)
+func NewOpener(client *http.Client, pollInterval time.Duration) *Opener {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	return &Opener{client: client, pollInterval: pollInterval, buses: map[string]*Bus{}}
+}
+
+// Open открывает транспорт канала uri
+func (o *Opener) Open(uri string) (Transport, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrB2MMLChannelUnsupported, err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			return nil, fmt.Errorf("%w: %s has no host", model.ErrB2MMLChannelUnsupported, uri)
+		}
+		return NewHTTPTransport(o.client, uri), nil
+	case "file":
+		dir := u.Path
+		if dir == "" {
+			dir = u.Opaque
+		}
+		if dir == "" {
+			return nil, fmt.Errorf("%w: %s has no path", model.ErrB2MMLChannelUnsupported, uri)
+		}
+		interval := o.pollInterval
+		if v := u.Query().Get("interval"); v != "" {
+			if interval, err = time.ParseDuration(v); err != nil || interval <= 0 {
+				return nil, fmt.Errorf("%w: invalid interval %q", model.ErrB2MMLChannelUnsupported, v)
+			}
+		}
+		return NewFileTransport(filepath.FromSlash(dir), interval), nil
+	case "bus":
+		return o.Bus(u.Host + u.Opaque), nil
+	}
+	return nil, fmt.Errorf("%w: %s", model.ErrB2MMLChannelUnsupported, uri)
+}
+
+// Bus возвращает шину в процессе с именем name; один и тот же экземпляр для
+// всех каналов bus://name, чтобы компоненты сервера могли обмениваться через неё
+func (o *Opener) Bus(name string) *Bus {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	bus, ok := o.buses[name]
+	if !ok {
+		bus = NewBus()
+		o.buses[name] = bus
+	}
+	return bus
+}
@@ -0,0 +1,124 @@
+package channels
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// processedDir подпапка темы для обработанных входящих документов и ответов на них
+const processedDir = "processed"
+
+// FileTransport обменивается документами через папку: тема - подпапка,
+// документ - файл *.xml. Исходящие документы записываются атомарно (через
+// переименование временного файла). Входящие файлы читаются по имени по
+// порядку и переносятся в подпапку processed, ответ записывается рядом
+// с ними как <имя>.reply.xml
+type FileTransport struct {
+	dir      string
+	interval time.Duration
+}
+
+// NewFileTransport создаёт файловый транспорт папки dir с периодом опроса interval
+func NewFileTransport(dir string, interval time.Duration) *FileTransport {
+	return &FileTransport{dir: dir, interval: interval}
+}
+
+// topicDir возвращает папку темы; тема не может выходить за пределы папки канала
+func (t *FileTransport) topicDir(topic string) (string, error) {
+	if topic == "" {
+		return t.dir, nil
+	}
+	topic = filepath.FromSlash(topic)
+	if !filepath.IsLocal(topic) {
+		return "", fmt.Errorf("invalid topic %q", topic)
+	}
+	return filepath.Join(t.dir, topic), nil
+}
+
+func (t *FileTransport) Send(ctx context.Context, topic string, document []byte) error {
+	dir, err := t.topicDir(topic)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	name := time.Now().UTC().Format("20060102T150405.000000000") + "-" + uuid.NewString() + ".xml"
+	tmp := filepath.Join(dir, "."+name+".tmp")
+	if err := os.WriteFile(tmp, document, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, name)); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+func (t *FileTransport) Listen(ctx context.Context, topic string, receive func(ctx context.Context, document []byte) []byte) error {
+	dir, err := t.topicDir(topic)
+	if err != nil {
+		return err
+	}
+	processed := filepath.Join(dir, processedDir)
+	if err := os.MkdirAll(processed, 0o755); err != nil {
+		return err
+	}
+	for {
+		if err := t.receiveAll(ctx, dir, processed, receive); err != nil && ctx.Err() == nil {
+			log.Printf("B2MML folder %s: %v", dir, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(t.interval):
+		}
+	}
+}
+
+// receiveAll обрабатывает входящие файлы папки dir
+func (t *FileTransport) receiveAll(ctx context.Context, dir, processed string, receive func(ctx context.Context, document []byte) []byte) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.Type().IsRegular() && strings.HasSuffix(name, ".xml") && !strings.HasPrefix(name, ".") {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if ctx.Err() != nil {
+			return nil
+		}
+		path := filepath.Join(dir, name)
+		document, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		reply := receive(ctx, document)
+		// Файл переносится до записи ответа: при сбое документ не обрабатывается повторно
+		if err := os.Rename(path, filepath.Join(processed, name)); err != nil {
+			return err
+		}
+		if reply != nil {
+			replyPath := filepath.Join(processed, strings.TrimSuffix(name, ".xml")+".reply.xml")
+			if err := os.WriteFile(replyPath, reply, 0o644); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
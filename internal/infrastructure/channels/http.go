@@ -0,0 +1,53 @@
+package channels
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+)
+
+// HeaderTopic тема канала в запросе HTTP транспорта
+const HeaderTopic = "X-B2MML-Topic"
+
+// HTTPTransport отправляет документы POST-запросами на адрес канала.
+// Входящие документы по HTTP принимает POST /api/v1/b2mml
+type HTTPTransport struct {
+	client *http.Client
+	url    string
+}
+
+// NewHTTPTransport создаёт HTTP транспорт канала url
+func NewHTTPTransport(client *http.Client, url string) *HTTPTransport {
+	return &HTTPTransport{client: client, url: url}
+}
+
+func (t *HTTPTransport) Send(ctx context.Context, topic string, document []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(document))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	if topic != "" {
+		req.Header.Set(HeaderTopic, topic)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+func (t *HTTPTransport) Listen(ctx context.Context, topic string, receive func(ctx context.Context, document []byte) []byte) error {
+	return model.ErrB2MMLChannelInboundUnsupported
+}
@@ -0,0 +1,26 @@
+// Package events содержит получателей доменных событий из outbox
+package events
+
+import (
+	"context"
+	"log"
+
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// LogSink записывает доменные события в журнал приложения
+type LogSink struct{}
+
+// NewLogSink создаёт получателя, пишущего события в журнал
+func NewLogSink() *LogSink {
+	return &LogSink{}
+}
+
+func (s *LogSink) Name() string {
+	return "log"
+}
+
+func (s *LogSink) Deliver(ctx context.Context, event *repository.OutboxEvent) error {
+	log.Printf("Event %s %s %s/%s: %s", event.EventID, event.EventType, event.AggregateType, event.AggregateID, event.Payload)
+	return nil
+}
@@ -0,0 +1,119 @@
+package events
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// Заголовки запроса webhook
+const (
+	// HeaderWebhookSignature подпись тела: sha256=<hex HMAC-SHA256(secret, timestamp + "." + body)>
+	HeaderWebhookSignature = "X-CMMS-Signature"
+	// HeaderWebhookTimestamp время отправки в секундах Unix, входит в подпись
+	HeaderWebhookTimestamp = "X-CMMS-Timestamp"
+	// HeaderWebhookEvent тип события
+	HeaderWebhookEvent = "X-CMMS-Event"
+	// HeaderWebhookDelivery идентификатор доставки; одинаков во всех повторах
+	HeaderWebhookDelivery = "X-CMMS-Delivery"
+)
+
+// webhookSignaturePrefix префикс значения заголовка подписи
+const webhookSignaturePrefix = "sha256="
+
+// ErrWebhookSignatureMismatch подпись webhook не совпадает или устарела
+var ErrWebhookSignatureMismatch = errors.New("webhook signature mismatch")
+
+// WebhookSender отправляет доставки webhook POST-запросами с JSON телом
+type WebhookSender struct {
+	client *http.Client
+}
+
+// NewWebhookSender создаёт отправителя webhook. client nil - http.Client
+// с таймаутом timeout (10 секунд, если timeout не задан). Перенаправления
+// не выполняются: подписчик не должен переслать подписанное тело на другой,
+// например внутренний, адрес
+func NewWebhookSender(client *http.Client, timeout time.Duration) *WebhookSender {
+	var c http.Client
+	if client != nil {
+		c = *client
+	} else {
+		c.Timeout = cmp.Or(max(timeout, 0), 10*time.Second)
+	}
+	c.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return &WebhookSender{client: &c}
+}
+
+// Send отправляет доставку; успехом считается любой ответ 2xx, ответ 3xx -
+// неудачная попытка
+func (s *WebhookSender) Send(ctx context.Context, subscription *model.WebhookSubscription, delivery *repository.WebhookDelivery) (int, error) {
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL(), bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-cmms-webhook/1")
+	req.Header.Set(HeaderWebhookEvent, delivery.EventType)
+	req.Header.Set(HeaderWebhookDelivery, delivery.ID.String())
+	req.Header.Set(HeaderWebhookTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderWebhookSignature, SignWebhook(subscription.Secret(), timestamp, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Фрагмент ответа сохраняется в last_error для разбора ошибок
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return resp.StatusCode, nil
+}
+
+// SignWebhook вычисляет значение заголовка X-CMMS-Signature
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhook проверяет подпись полученного webhook. Для получателей
+// на Go и тестовых серверов; tolerance ограничивает возраст запроса
+// (0 - не проверять)
+func VerifyWebhook(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	timestamp, err := strconv.ParseInt(header.Get(HeaderWebhookTimestamp), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid timestamp", ErrWebhookSignatureMismatch)
+	}
+	if tolerance > 0 {
+		if age := time.Since(time.Unix(timestamp, 0)); age > tolerance || age < -tolerance {
+			return fmt.Errorf("%w: timestamp outside tolerance", ErrWebhookSignatureMismatch)
+		}
+	}
+	expected := SignWebhook(secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(header.Get(HeaderWebhookSignature))) {
+		return ErrWebhookSignatureMismatch
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/app"
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	"github.com/grnsv/go-cmms/internal/infrastructure/events"
+)
+
+const testSecret = "s3cr3t"
+
+// received запрос, полученный тестовым подписчиком
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+// newSubscriber запускает тестового подписчика, отвечающего status
+func newSubscriber(t *testing.T, status int) (*httptest.Server, <-chan received) {
+	t.Helper()
+	requests := make(chan received, 16)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- received{header: r.Header.Clone(), body: body}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+func newSubscription(t *testing.T, target string) *model.WebhookSubscription {
+	t.Helper()
+	subscription, err := model.NewWebhookSubscription(target, testSecret, nil, "", true)
+	if err != nil {
+		t.Fatalf("NewWebhookSubscription: %v", err)
+	}
+	return subscription
+}
+
+func newDelivery(subscription *model.WebhookSubscription) *repository.WebhookDelivery {
+	payload, _ := json.Marshal(map[string]string{"type": "equipment.created", "aggregate_id": "PUMP-1"})
+	return &repository.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID(),
+		EventID:        uuid.New(),
+		EventType:      "equipment.created",
+		Payload:        payload,
+		Status:         model.WebhookDeliveryPending,
+	}
+}
+
+func TestWebhookSenderSignature(t *testing.T) {
+	srv, requests := newSubscriber(t, http.StatusNoContent)
+	subscription := newSubscription(t, srv.URL)
+	delivery := newDelivery(subscription)
+
+	status, err := events.NewWebhookSender(nil, time.Second).Send(context.Background(), subscription, delivery)
+	if err != nil || status != http.StatusNoContent {
+		t.Fatalf("Send = %d, %v; want 204, nil", status, err)
+	}
+
+	req := <-requests
+	if got := req.header.Get(events.HeaderWebhookDelivery); got != delivery.ID.String() {
+		t.Errorf("%s = %q, want %q", events.HeaderWebhookDelivery, got, delivery.ID)
+	}
+	if got := req.header.Get(events.HeaderWebhookEvent); got != delivery.EventType {
+		t.Errorf("%s = %q, want %q", events.HeaderWebhookEvent, got, delivery.EventType)
+	}
+	if err := events.VerifyWebhook(testSecret, req.header, req.body, time.Minute); err != nil {
+		t.Errorf("VerifyWebhook: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		secret string
+		body   []byte
+	}{
+		{"wrong secret", "other", req.body},
+		{"tampered body", testSecret, append([]byte(" "), req.body...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := events.VerifyWebhook(tt.secret, req.header, tt.body, time.Minute)
+			if !errors.Is(err, events.ErrWebhookSignatureMismatch) {
+				t.Errorf("VerifyWebhook = %v, want ErrWebhookSignatureMismatch", err)
+			}
+		})
+	}
+
+	t.Run("stale timestamp", func(t *testing.T) {
+		header := req.header.Clone()
+		stale := time.Now().Add(-time.Hour).Unix()
+		header.Set(events.HeaderWebhookTimestamp, strconv.FormatInt(stale, 10))
+		header.Set(events.HeaderWebhookSignature, events.SignWebhook(testSecret, stale, req.body))
+		err := events.VerifyWebhook(testSecret, header, req.body, time.Minute)
+		if !errors.Is(err, events.ErrWebhookSignatureMismatch) {
+			t.Errorf("VerifyWebhook = %v, want ErrWebhookSignatureMismatch", err)
+		}
+	})
+}
+
+func TestWebhookSenderStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{"ok", http.StatusOK, false},
+		{"accepted", http.StatusAccepted, false},
+		{"client error", http.StatusGone, true},
+		{"server error", http.StatusInternalServerError, true},
+		{"unavailable", http.StatusServiceUnavailable, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := newSubscriber(t, tt.status)
+			subscription := newSubscription(t, srv.URL)
+
+			status, err := events.NewWebhookSender(nil, time.Second).Send(context.Background(), subscription, newDelivery(subscription))
+			if status != tt.status {
+				t.Errorf("status = %d, want %d", status, tt.status)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookSenderRedirect(t *testing.T) {
+	internal, requests := newSubscriber(t, http.StatusOK)
+	redirect := httptest.NewServer(http.RedirectHandler(internal.URL, http.StatusTemporaryRedirect))
+	t.Cleanup(redirect.Close)
+	subscription := newSubscription(t, redirect.URL)
+
+	status, err := events.NewWebhookSender(nil, time.Second).Send(context.Background(), subscription, newDelivery(subscription))
+	if err == nil || status != http.StatusTemporaryRedirect {
+		t.Fatalf("Send = %d, %v; want 307 and error", status, err)
+	}
+	select {
+	case <-requests:
+		t.Fatal("redirect was followed")
+	default:
+	}
+}
+
+// attempt неудачная попытка, сохранённая MarkFailed
+type attempt struct {
+	status model.WebhookDeliveryStatus
+	delay  time.Duration
+}
+
+// fakeWebhookRepository очередь доставок в памяти; методы, не нужные
+// диспетчеру, не реализованы
+type fakeWebhookRepository struct {
+	repository.WebhookRepository
+
+	mu            sync.Mutex
+	subscriptions map[uuid.UUID]*model.WebhookSubscription
+	deliveries    []*repository.WebhookDelivery
+	attempts      []attempt
+	delivered     map[uuid.UUID]int
+}
+
+func newFakeWebhookRepository(subscription *model.WebhookSubscription, deliveries ...*repository.WebhookDelivery) *fakeWebhookRepository {
+	return &fakeWebhookRepository{
+		subscriptions: map[uuid.UUID]*model.WebhookSubscription{subscription.ID(): subscription},
+		deliveries:    deliveries,
+		delivered:     map[uuid.UUID]int{},
+	}
+}
+
+func (r *fakeWebhookRepository) Get(_ context.Context, id uuid.UUID) (*model.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if subscription, ok := r.subscriptions[id]; ok {
+		return subscription, nil
+	}
+	return nil, model.ErrWebhookNotFound
+}
+
+// ClaimDeliveries отдаёт все ожидающие доставки независимо от времени
+// повтора: тест сам управляет попытками
+func (r *fakeWebhookRepository) ClaimDeliveries(_ context.Context, limit int32, _ time.Time) ([]*repository.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var claimed []*repository.WebhookDelivery
+	for _, d := range r.deliveries {
+		if d.Status == model.WebhookDeliveryPending && len(claimed) < int(limit) {
+			claim := *d
+			claimed = append(claimed, &claim)
+		}
+	}
+	return claimed, nil
+}
+
+func (r *fakeWebhookRepository) MarkDelivered(_ context.Context, id uuid.UUID, responseStatus int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d := r.find(id)
+	d.Status, d.ResponseStatus = model.WebhookDeliveryDelivered, responseStatus
+	r.delivered[id] = responseStatus
+	return nil
+}
+
+func (r *fakeWebhookRepository) MarkFailed(_ context.Context, id uuid.UUID, status model.WebhookDeliveryStatus, nextAttemptAt time.Time, responseStatus int, cause error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d := r.find(id)
+	d.Status, d.Attempts, d.NextAttemptAt = status, d.Attempts+1, nextAttemptAt
+	d.ResponseStatus, d.LastError = responseStatus, cause.Error()
+	r.attempts = append(r.attempts, attempt{status: status, delay: time.Until(nextAttemptAt)})
+	return nil
+}
+
+func (r *fakeWebhookRepository) find(id uuid.UUID) *repository.WebhookDelivery {
+	for _, d := range r.deliveries {
+		if d.ID == id {
+			return d
+		}
+	}
+	panic("unknown delivery " + id.String())
+}
+
+func TestWebhookDispatcherDelivered(t *testing.T) {
+	srv, requests := newSubscriber(t, http.StatusNoContent)
+	subscription := newSubscription(t, srv.URL)
+	delivery := newDelivery(subscription)
+	repo := newFakeWebhookRepository(subscription, delivery)
+
+	dispatcher := app.NewWebhookDispatcher(repo, events.NewWebhookSender(nil, time.Second), app.WebhookDispatcherConfig{})
+	if n, err := dispatcher.DispatchOnce(context.Background()); n != 1 || err != nil {
+		t.Fatalf("DispatchOnce = %d, %v; want 1, nil", n, err)
+	}
+
+	req := <-requests
+	if err := events.VerifyWebhook(testSecret, req.header, req.body, time.Minute); err != nil {
+		t.Errorf("VerifyWebhook: %v", err)
+	}
+	if got := repo.delivered[delivery.ID]; got != http.StatusNoContent {
+		t.Errorf("delivered status = %d, want 204", got)
+	}
+	if len(repo.attempts) != 0 {
+		t.Errorf("failed attempts = %v, want none", repo.attempts)
+	}
+}
+
+func TestWebhookDispatcherBackoffAndDeadLetter(t *testing.T) {
+	srv, requests := newSubscriber(t, http.StatusInternalServerError)
+	subscription := newSubscription(t, srv.URL)
+	delivery := newDelivery(subscription)
+	repo := newFakeWebhookRepository(subscription, delivery)
+
+	const (
+		minBackoff  = 10 * time.Second
+		maxBackoff  = 30 * time.Second
+		maxAttempts = 4
+	)
+	dispatcher := app.NewWebhookDispatcher(repo, events.NewWebhookSender(nil, time.Second), app.WebhookDispatcherConfig{
+		MinBackoff:  minBackoff,
+		MaxBackoff:  maxBackoff,
+		MaxAttempts: maxAttempts,
+	})
+	for range maxAttempts + 1 {
+		if _, err := dispatcher.DispatchOnce(context.Background()); err != nil {
+			t.Fatalf("DispatchOnce: %v", err)
+		}
+	}
+
+	if got := len(requests); got != maxAttempts {
+		t.Errorf("requests = %d, want %d", got, maxAttempts)
+	}
+	if delivery.Status != model.WebhookDeliveryDead || delivery.Attempts != maxAttempts {
+		t.Errorf("delivery = %s after %d attempts, want dead after %d", delivery.Status, delivery.Attempts, maxAttempts)
+	}
+	if delivery.ResponseStatus != http.StatusInternalServerError || delivery.LastError == "" {
+		t.Errorf("last response = %d %q, want 500 with error", delivery.ResponseStatus, delivery.LastError)
+	}
+
+	// Задержка удваивается от MinBackoff до MaxBackoff, джиттер - до 10%
+	base := []time.Duration{minBackoff, 2 * minBackoff, maxBackoff, maxBackoff}
+	wantStatus := []model.WebhookDeliveryStatus{
+		model.WebhookDeliveryPending, model.WebhookDeliveryPending, model.WebhookDeliveryPending, model.WebhookDeliveryDead,
+	}
+	if len(repo.attempts) != maxAttempts {
+		t.Fatalf("failed attempts = %d, want %d", len(repo.attempts), maxAttempts)
+	}
+	for i, a := range repo.attempts {
+		if a.status != wantStatus[i] {
+			t.Errorf("attempt %d status = %s, want %s", i+1, a.status, wantStatus[i])
+		}
+		if lo, hi := base[i]-time.Second, base[i]+base[i]/10+time.Second; a.delay < lo || a.delay > hi {
+			t.Errorf("attempt %d delay = %s, want %s..%s", i+1, a.delay, lo, hi)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+// lockID ключ advisory lock, под которым выполняются миграции.
+// Защищает от одновременного запуска миграций несколькими репликами
+const lockID int64 = 0x636d6d735f6d6967 // "cmms_mig"
+
+// fileNamePattern формат имени файла миграции: NNN_name.up.sql / NNN_name.down.sql
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+var (
+	// ErrNoMigrations возвращается, если в источнике нет ни одной миграции
+	ErrNoMigrations = errors.New("no migrations found")
+	// ErrUnknownVersion возвращается для версии, которой нет среди миграций
+	ErrUnknownVersion = errors.New("unknown migration version")
+	// ErrNoDownMigration возвращается при откате миграции без down-файла
+	ErrNoDownMigration = errors.New("down migration not found")
+)
+
+// Migration одна версия схемы
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status состояние миграции в БД
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Migrator применяет миграции из fs.FS к БД Postgres
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// Source возвращает источник миграций: каталог dir (допускается префикс file://),
+// если он задан, иначе миграции, встроенные в бинарник
+func Source(dir string) (fs.FS, error) {
+	if dir = strings.TrimPrefix(dir, "file://"); dir != "" {
+		return os.DirFS(dir), nil
+	}
+	sub, err := fs.Sub(postgres.Migrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedded migrations: %w", err)
+	}
+	return sub, nil
+}
+
+// New загружает миграции из fsys и создаёт Migrator
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Migrations возвращает загруженные миграции по возрастанию версии
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Up применяет все непримененные миграции и возвращает их список
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	return m.Goto(ctx, m.migrations[len(m.migrations)-1].Version)
+}
+
+// Down откатывает последнюю применённую миграцию
+func (m *Migrator) Down(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			if _, ok := applied[m.migrations[i].Version]; ok {
+				if err := m.down(ctx, conn, m.migrations[i]); err != nil {
+					return err
+				}
+				done = append(done, m.migrations[i])
+				return nil
+			}
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Goto приводит схему к версии version: применяет недостающие миграции
+// до неё включительно и откатывает все более поздние.
+// Версия 0 откатывает все миграции
+func (m *Migrator) Goto(ctx context.Context, version int64) ([]Migration, error) {
+	if version != 0 && m.find(version) < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+
+	var done []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok || mig.Version <= version {
+				continue
+			}
+			if err := m.down(ctx, conn, mig); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		for _, mig := range m.migrations {
+			if _, ok := applied[mig.Version]; ok || mig.Version > version {
+				continue
+			}
+			if err := m.up(ctx, conn, mig); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Status возвращает состояние всех миграций
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			appliedAt, ok := applied[mig.Version]
+			statuses = append(statuses, Status{Migration: mig, Applied: ok, AppliedAt: appliedAt})
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// withLock выполняет fn на выделенном соединении под advisory lock.
+// Таблица schema_migrations создаётся при первом обращении
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockID); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		// Контекст мог быть отменён, но блокировку нужно снять в любом случае
+		if _, unlockErr := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, lockID); unlockErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to release migration lock: %w", unlockErr))
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	return fn(conn)
+}
+
+// up применяет миграцию в отдельной транзакции
+func (m *Migrator) up(ctx context.Context, conn *sql.Conn, mig Migration) error {
+	return inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, mig.Up); err != nil {
+			return fmt.Errorf("migration %d_%s up: %w", mig.Version, mig.Name, err)
+		}
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`,
+			mig.Version, mig.Name,
+		); err != nil {
+			return fmt.Errorf("failed to record migration %d: %w", mig.Version, err)
+		}
+		return nil
+	})
+}
+
+// down откатывает миграцию в отдельной транзакции
+func (m *Migrator) down(ctx context.Context, conn *sql.Conn, mig Migration) error {
+	if mig.Down == "" {
+		return fmt.Errorf("%w: %d_%s", ErrNoDownMigration, mig.Version, mig.Name)
+	}
+	return inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, mig.Down); err != nil {
+			return fmt.Errorf("migration %d_%s down: %w", mig.Version, mig.Name, err)
+		}
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM schema_migrations WHERE version = $1`,
+			mig.Version,
+		); err != nil {
+			return fmt.Errorf("failed to unrecord migration %d: %w", mig.Version, err)
+		}
+		return nil
+	})
+}
+
+func (m *Migrator) find(version int64) int {
+	for i, mig := range m.migrations {
+		if mig.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// inTx выполняет fn в транзакции на соединении conn
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// appliedVersions возвращает применённые версии и время их применения
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			version   int64
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// load читает миграции из корня fsys
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %s", entry.Name())
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = mig
+		} else if mig.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by %q and %q", version, mig.Name, match[2])
+		}
+		if match[3] == "up" {
+			mig.Up = string(content)
+		} else {
+			mig.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	if len(migrations) == 0 {
+		return nil, ErrNoMigrations
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
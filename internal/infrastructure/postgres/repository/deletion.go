@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+func (r *EquipmentRepositoryImpl) Delete(ctx context.Context, equipment *model.Equipment, opts model.DeleteOptions, expectedVersion int64) error {
+	row, err := r.queries.GetEquipmentByExternalID(ctx, equipment.ID().String())
+	if err != nil {
+		return equipmentError(err)
+	}
+	if row.RecordVersion != expectedVersion {
+		return model.ErrEquipmentVersionConflict
+	}
+
+	if opts.Children == model.DeleteOrphan {
+		for _, child := range equipment.Children() {
+			childRow, err := r.queries.GetEquipmentByExternalID(ctx, child.ID().String())
+			if err != nil {
+				return equipmentError(err)
+			}
+			_, err = r.queries.MoveEquipment(ctx, &postgres.MoveEquipmentParams{
+				RecordVersion:   child.Version(),
+				ID:              childRow.ID,
+				ExpectedVersion: childRow.RecordVersion,
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				return model.ErrEquipmentVersionConflict
+			}
+			if err != nil {
+				return fmt.Errorf("failed to detach equipment %s: %w", child.ID(), err)
+			}
+			if err := recordHistory(ctx, r.queries, childRow.ID, model.ChangeMoved); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = r.queries.DeleteEquipment(ctx, &postgres.DeleteEquipmentParams{
+		RecordVersion:   equipment.Version(),
+		ID:              row.ID,
+		ExpectedVersion: expectedVersion,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ErrEquipmentVersionConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete equipment %s: %w", equipment.ID(), err)
+	}
+	err = r.queries.MarkEquipmentDeleted(ctx, &postgres.MarkEquipmentDeletedParams{
+		EquipmentID: row.ID,
+		RootID:      row.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark equipment %s deleted: %w", equipment.ID(), err)
+	}
+
+	deleted := []uuid.UUID{row.ID}
+	if opts.Children == model.DeleteCascade {
+		ids, err := r.queries.DeleteEquipmentDescendants(ctx, row.ID)
+		if err != nil {
+			return fmt.Errorf("failed to delete descendants of equipment %s: %w", equipment.ID(), err)
+		}
+		deleted = append(deleted, ids...)
+	}
+	if opts.Classes == model.DeleteOrphan {
+		if err := r.queries.RemoveDeletedEquipmentFromClasses(ctx, row.ID); err != nil {
+			return fmt.Errorf("failed to remove class mappings of equipment %s: %w", equipment.ID(), err)
+		}
+	}
+
+	for _, id := range deleted {
+		if err := recordHistory(ctx, r.queries, id, model.ChangeDeleted); err != nil {
+			return err
+		}
+	}
+	return saveEvents(ctx, r.queries, pullSubtreeEvents(equipment))
+}
+
+func (r *EquipmentRepositoryImpl) ListDeleted(ctx context.Context, page repository.PageRequest) (*repository.Page[*model.Equipment], error) {
+	// Курсор хранит deleted_at граничной записи вместо created_at
+	rows, next, prev, err := keysetPage(page,
+		func(cursor *repository.Cursor, limit int32) ([]*postgres.Equipment, error) {
+			deletedAt, id := afterCursor(cursor)
+			return r.queries.ListDeletedEquipmentAfter(ctx, &postgres.ListDeletedEquipmentAfterParams{
+				CursorDeletedAt: deletedAt,
+				CursorID:        id,
+				PageLimit:       limit,
+			})
+		},
+		func(cursor repository.Cursor, limit int32) ([]*postgres.Equipment, error) {
+			return r.queries.ListDeletedEquipmentBefore(ctx, &postgres.ListDeletedEquipmentBeforeParams{
+				CursorDeletedAt: cursor.CreatedAt,
+				CursorID:        cursor.ID,
+				PageLimit:       limit,
+			})
+		},
+		func(row *postgres.Equipment) (time.Time, uuid.UUID) {
+			return row.DeletedAt.Time, row.ID
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted equipment: %w", err)
+	}
+	items, err := r.toDomainList(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+	return &repository.Page[*model.Equipment]{Items: items, NextCursor: next, PrevCursor: prev}, nil
+}
+
+func (r *EquipmentRepositoryImpl) GetDeleted(ctx context.Context, externalID string) (*model.Equipment, error) {
+	root, err := r.getDeleted(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.queries.ListEquipmentDeletedWith(ctx, root.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load descendants deleted with equipment %s: %w", externalID, err)
+	}
+
+	nodes := make([]*postgres.Equipment, 0, len(rows))
+	for _, row := range rows {
+		if row.Depth > 0 {
+			nodes = append(nodes, &row.Equipment)
+		}
+	}
+	return r.buildSubtree(ctx, root, nodes)
+}
+
+func (r *EquipmentRepositoryImpl) Restore(ctx context.Context, equipment *model.Equipment, expectedVersion int64) error {
+	row, err := r.getDeleted(ctx, equipment.ID().String())
+	if err != nil {
+		return err
+	}
+	if row.ParentEquipmentID.Valid {
+		if _, err := r.queries.GetEquipmentByID(ctx, row.ParentEquipmentID.UUID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return model.ErrEquipmentParentDeleted
+			}
+			return fmt.Errorf("failed to get parent of equipment %s: %w", equipment.ID(), err)
+		}
+	}
+
+	_, err = r.queries.RestoreEquipment(ctx, &postgres.RestoreEquipmentParams{
+		RecordVersion:   equipment.Version(),
+		ID:              row.ID,
+		ExpectedVersion: expectedVersion,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ErrEquipmentVersionConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to restore equipment %s: %w", equipment.ID(), err)
+	}
+	descendants, err := r.queries.RestoreEquipmentDeletedWith(ctx, row.ID)
+	if err != nil {
+		return fmt.Errorf("failed to restore descendants of equipment %s: %w", equipment.ID(), err)
+	}
+	if err := r.queries.ClearEquipmentDeletions(ctx, row.ID); err != nil {
+		return fmt.Errorf("failed to clear deletion of equipment %s: %w", equipment.ID(), err)
+	}
+
+	for _, id := range append([]uuid.UUID{row.ID}, descendants...) {
+		if err := recordHistory(ctx, r.queries, id, model.ChangeRestored); err != nil {
+			return err
+		}
+	}
+	return saveEvents(ctx, r.queries, pullSubtreeEvents(equipment))
+}
+
+func (r *EquipmentRepositoryImpl) Purge(ctx context.Context, externalID string) error {
+	row, err := r.getDeleted(ctx, externalID)
+	if err != nil {
+		return err
+	}
+	purged, err := r.queries.PurgeEquipment(ctx, row.ID)
+	if err != nil {
+		return fmt.Errorf("failed to purge equipment %s: %w", externalID, err)
+	}
+	return savePurgedEvents(ctx, r.queries, purged)
+}
+
+func (r *EquipmentRepositoryImpl) PurgeDeletedBefore(ctx context.Context, before time.Time, limit int32) (int, error) {
+	purged, err := r.queries.PurgeEquipmentDeletedBefore(ctx, &postgres.PurgeEquipmentDeletedBeforeParams{
+		Before:    before,
+		BatchSize: limit,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge equipment deleted before %s: %w", before, err)
+	}
+	if err := savePurgedEvents(ctx, r.queries, purged); err != nil {
+		return 0, err
+	}
+	return len(purged), nil
+}
+
+// getDeleted возвращает запись удалённого оборудования; для активного
+// оборудования - model.ErrEquipmentNotDeleted
+func (r *EquipmentRepositoryImpl) getDeleted(ctx context.Context, externalID string) (*postgres.Equipment, error) {
+	row, err := r.queries.GetDeletedEquipmentByExternalID(ctx, externalID)
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, err = r.queries.GetEquipmentByExternalID(ctx, externalID); err == nil {
+			return nil, model.ErrEquipmentNotDeleted
+		}
+		return nil, equipmentError(err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted equipment %s: %w", externalID, err)
+	}
+	return row, nil
+}
+
+// pullSubtreeEvents забирает события оборудования и загруженного поддерева
+func pullSubtreeEvents(equipment *model.Equipment) []model.DomainEvent {
+	events := equipment.PullEvents()
+	for _, child := range equipment.Children() {
+		events = append(events, pullSubtreeEvents(child)...)
+	}
+	return events
+}
+
+// savePurgedEvents записывает в outbox события окончательного удаления оборудования
+func savePurgedEvents(ctx context.Context, queries *postgres.Queries, externalIDs []string) error {
+	events := make([]model.DomainEvent, 0, len(externalIDs))
+	for _, externalID := range externalIDs {
+		id, err := model.NewEquipmentID(externalID)
+		if err != nil {
+			return err
+		}
+		events = append(events, model.NewEquipmentPurgedEvent(id))
+	}
+	return saveEvents(ctx, queries, events)
+}
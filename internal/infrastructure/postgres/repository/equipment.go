@@ -2,11 +2,16 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 
 	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
 	"github.com/grnsv/go-cmms/internal/domain/repository"
 	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
 )
@@ -14,40 +19,654 @@ import (
 // EquipmentRepository реализация репозитория Equipment
 type EquipmentRepositoryImpl struct {
 	queries *postgres.Queries
+	classes *EquipmentClassRepositoryImpl
 }
 
 // NewEquipmentRepository создаёт новый репозиторий Equipment
 func NewEquipmentRepository(queries *postgres.Queries) repository.EquipmentRepository {
-	return &EquipmentRepositoryImpl{queries: queries}
+	return &EquipmentRepositoryImpl{
+		queries: queries,
+		classes: &EquipmentClassRepositoryImpl{queries: queries},
+	}
 }
 
 func (r *EquipmentRepositoryImpl) Create(ctx context.Context, equipment *model.Equipment) error {
-	// Заглушка: в реальности нужно преобразовать доменную модель в sqlc параметры
-	return fmt.Errorf("not implemented")
+	_, err := r.create(ctx, equipment, uuid.NullUUID{}, 0)
+	return err
 }
 
 func (r *EquipmentRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*model.Equipment, error) {
-	return nil, fmt.Errorf("not implemented")
+	row, err := r.queries.GetEquipmentByID(ctx, id)
+	if err != nil {
+		return nil, equipmentError(err)
+	}
+	return r.toDomain(ctx, row)
 }
 
 func (r *EquipmentRepositoryImpl) GetByExternalID(ctx context.Context, externalID string) (*model.Equipment, error) {
-	return nil, fmt.Errorf("not implemented")
+	row, err := r.queries.GetEquipmentByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, equipmentError(err)
+	}
+	return r.toDomain(ctx, row)
+}
+
+func (r *EquipmentRepositoryImpl) List(ctx context.Context, page repository.PageRequest) (*repository.Page[*model.Equipment], error) {
+	result, err := r.page(ctx, page,
+		func(cursor *repository.Cursor, limit int32) ([]*postgres.Equipment, error) {
+			createdAt, id := afterCursor(cursor)
+			return r.queries.ListEquipmentAfter(ctx, &postgres.ListEquipmentAfterParams{
+				CursorCreatedAt: createdAt,
+				CursorID:        id,
+				PageLimit:       limit,
+			})
+		},
+		func(cursor repository.Cursor, limit int32) ([]*postgres.Equipment, error) {
+			return r.queries.ListEquipmentBefore(ctx, &postgres.ListEquipmentBeforeParams{
+				CursorCreatedAt: cursor.CreatedAt,
+				CursorID:        cursor.ID,
+				PageLimit:       limit,
+			})
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list equipment: %w", err)
+	}
+	return result, nil
+}
+
+func (r *EquipmentRepositoryImpl) ListByStatus(ctx context.Context, status model.OperatingStatus, page repository.PageRequest) (*repository.Page[*model.Equipment], error) {
+	result, err := r.page(ctx, page,
+		func(cursor *repository.Cursor, limit int32) ([]*postgres.Equipment, error) {
+			createdAt, id := afterCursor(cursor)
+			return r.queries.ListEquipmentByStatusAfter(ctx, &postgres.ListEquipmentByStatusAfterParams{
+				OperatingStatus: nullString(string(status)),
+				CursorCreatedAt: createdAt,
+				CursorID:        id,
+				PageLimit:       limit,
+			})
+		},
+		func(cursor repository.Cursor, limit int32) ([]*postgres.Equipment, error) {
+			return r.queries.ListEquipmentByStatusBefore(ctx, &postgres.ListEquipmentByStatusBeforeParams{
+				OperatingStatus: nullString(string(status)),
+				CursorCreatedAt: cursor.CreatedAt,
+				CursorID:        cursor.ID,
+				PageLimit:       limit,
+			})
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list equipment by status: %w", err)
+	}
+	return result, nil
+}
+
+func (r *EquipmentRepositoryImpl) ListByClass(ctx context.Context, classID model.EquipmentClassID, page repository.PageRequest) (*repository.Page[*model.Equipment], error) {
+	class, err := r.queries.GetEquipmentClassByExternalID(ctx, classID.String())
+	if err != nil {
+		return nil, equipmentClassError(err)
+	}
+
+	result, err := r.page(ctx, page,
+		func(cursor *repository.Cursor, limit int32) ([]*postgres.Equipment, error) {
+			createdAt, id := afterCursor(cursor)
+			return r.queries.ListEquipmentByClassAfter(ctx, &postgres.ListEquipmentByClassAfterParams{
+				EquipmentClassID: class.ID,
+				CursorCreatedAt:  createdAt,
+				CursorID:         id,
+				PageLimit:        limit,
+			})
+		},
+		func(cursor repository.Cursor, limit int32) ([]*postgres.Equipment, error) {
+			return r.queries.ListEquipmentByClassBefore(ctx, &postgres.ListEquipmentByClassBeforeParams{
+				EquipmentClassID: class.ID,
+				CursorCreatedAt:  cursor.CreatedAt,
+				CursorID:         cursor.ID,
+				PageLimit:        limit,
+			})
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list equipment of class %s: %w", classID, err)
+	}
+	return result, nil
 }
 
-func (r *EquipmentRepositoryImpl) List(ctx context.Context, limit int32, offset int32) ([]*model.Equipment, error) {
-	return nil, fmt.Errorf("not implemented")
+func (r *EquipmentRepositoryImpl) Update(ctx context.Context, equipment *model.Equipment, expectedVersion int64) error {
+	row, err := r.queries.GetEquipmentByExternalID(ctx, equipment.ID().String())
+	if err != nil {
+		return equipmentError(err)
+	}
+	if row.RecordVersion != expectedVersion {
+		return model.ErrEquipmentVersionConflict
+	}
+	return r.update(ctx, equipment, row)
 }
 
-func (r *EquipmentRepositoryImpl) ListByStatus(ctx context.Context, status model.OperatingStatus, limit int32, offset int32) ([]*model.Equipment, error) {
-	return nil, fmt.Errorf("not implemented")
+func (r *EquipmentRepositoryImpl) GetSubtree(ctx context.Context, externalID string, depth int32) (*model.Equipment, error) {
+	root, err := r.queries.GetEquipmentByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, equipmentError(err)
+	}
+	rows, err := r.queries.ListEquipmentSubtree(ctx, &postgres.ListEquipmentSubtreeParams{
+		RootID:   root.ID,
+		MaxDepth: depth,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load subtree of equipment %s: %w", externalID, err)
+	}
+
+	nodes := make([]*postgres.Equipment, 0, len(rows))
+	for _, row := range rows {
+		if row.Depth > 0 {
+			nodes = append(nodes, &row.Equipment)
+		}
+	}
+	return r.buildSubtree(ctx, root, nodes)
 }
 
-func (r *EquipmentRepositoryImpl) Update(ctx context.Context, equipment *model.Equipment) error {
-	return fmt.Errorf("not implemented")
+// buildSubtree собирает агрегат с поддеревом из корня и строк его потомков.
+// Строки отсортированы по глубине и позиции, поэтому дети каждого узла
+// собираются в порядке их позиций
+func (r *EquipmentRepositoryImpl) buildSubtree(ctx context.Context, root *postgres.Equipment, nodes []*postgres.Equipment) (*model.Equipment, error) {
+	children := make(map[uuid.UUID][]*postgres.Equipment)
+	for _, node := range nodes {
+		children[node.ParentEquipmentID.UUID] = append(children[node.ParentEquipmentID.UUID], node)
+	}
+
+	var build func(row *postgres.Equipment) (*model.Equipment, error)
+	build = func(row *postgres.Equipment) (*model.Equipment, error) {
+		nodes := make([]*model.Equipment, 0, len(children[row.ID]))
+		for _, childRow := range children[row.ID] {
+			child, err := build(childRow)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, child)
+		}
+		return r.restore(ctx, row, nodes)
+	}
+	return build(root)
 }
 
-func (r *EquipmentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.queries.DeleteEquipment(ctx, id)
+func (r *EquipmentRepositoryImpl) GetRef(ctx context.Context, externalID string) (*repository.EquipmentRef, error) {
+	row, err := r.queries.GetEquipmentRef(ctx, externalID)
+	if err != nil {
+		return nil, equipmentError(err)
+	}
+	id, err := model.NewEquipmentID(row.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+	level, err := unmarshalB2MML[b2mml.EquipmentLevelType](pqtype.NullRawMessage{RawMessage: row.EquipmentLevel, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	return &repository.EquipmentRef{ID: id, Level: level, Version: row.RecordVersion}, nil
+}
+
+func (r *EquipmentRepositoryImpl) Ancestors(ctx context.Context, externalID string) ([]model.EquipmentID, error) {
+	row, err := r.queries.GetEquipmentByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, equipmentError(err)
+	}
+	externalIDs, err := r.queries.ListEquipmentAncestors(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ancestors of equipment %s: %w", externalID, err)
+	}
+
+	ancestors := make([]model.EquipmentID, 0, len(externalIDs))
+	for _, id := range externalIDs {
+		ancestor, err := model.NewEquipmentID(id)
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, ancestor)
+	}
+	return ancestors, nil
+}
+
+func (r *EquipmentRepositoryImpl) Hierarchy(ctx context.Context, externalIDs []string) ([]model.EquipmentID, error) {
+	rows, err := r.queries.ListEquipmentHierarchy(ctx, externalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list equipment hierarchy: %w", err)
+	}
+
+	nodes := make([]model.EquipmentID, 0, len(rows))
+	for _, id := range rows {
+		node, err := model.NewEquipmentID(id)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func (r *EquipmentRepositoryImpl) Move(ctx context.Context, equipment *model.Equipment, parentID *model.EquipmentID, expectedVersion int64) error {
+	row, err := r.queries.GetEquipmentByExternalID(ctx, equipment.ID().String())
+	if err != nil {
+		return equipmentError(err)
+	}
+	if row.RecordVersion != expectedVersion {
+		return model.ErrEquipmentVersionConflict
+	}
+
+	var parent uuid.NullUUID
+	if parentID != nil {
+		parentRow, err := r.queries.GetEquipmentByExternalID(ctx, parentID.String())
+		if err != nil {
+			return equipmentError(err)
+		}
+		parent = uuid.NullUUID{UUID: parentRow.ID, Valid: true}
+	}
+
+	_, err = r.queries.MoveEquipment(ctx, &postgres.MoveEquipmentParams{
+		ParentID:        parent,
+		RecordVersion:   equipment.Version(),
+		ID:              row.ID,
+		ExpectedVersion: expectedVersion,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ErrEquipmentVersionConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to move equipment %s: %w", equipment.ID(), err)
+	}
+	if err := saveEvents(ctx, r.queries, equipment.PullEvents()); err != nil {
+		return err
+	}
+	return recordHistory(ctx, r.queries, row.ID, model.ChangeMoved)
+}
+
+// create сохраняет оборудование вместе со свойствами, классами и дочерним оборудованием
+func (r *EquipmentRepositoryImpl) create(ctx context.Context, e *model.Equipment, parentID uuid.NullUUID, position int32) (uuid.UUID, error) {
+	fields, err := newEquipmentFields(e)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("equipment %s: %w", e.ID(), err)
+	}
+
+	row, err := r.queries.CreateEquipment(ctx, &postgres.CreateEquipmentParams{
+		ExternalID:            e.ID().String(),
+		Version:               fields.version,
+		Description:           fields.description,
+		PublishedDate:         fields.dates.published,
+		EffectiveStartDate:    fields.dates.start,
+		EffectiveEndDate:      fields.dates.end,
+		HierarchyScopeID:      fields.hierarchyScopeID,
+		EquipmentLevel:        fields.equipmentLevel,
+		OperatingStatus:       fields.operatingStatus,
+		PhysicalAssetID:       fields.physicalAssetID,
+		OperationalLocationID: fields.operationalLocationID,
+		ParentEquipmentID:     parentID,
+		B2mmlData:             fields.b2mmlData,
+		RecordVersion:         e.Version(),
+		Position:              position,
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create equipment %s: %w", e.ID(), err)
+	}
+	if err := saveEvents(ctx, r.queries, e.PullEvents()); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := r.saveRelations(ctx, e, row.ID); err != nil {
+		return uuid.Nil, err
+	}
+	if err := recordHistory(ctx, r.queries, row.ID, model.ChangeCreated); err != nil {
+		return uuid.Nil, err
+	}
+	return row.ID, nil
+}
+
+// update обновляет строку оборудования и синхронизирует связанные данные.
+// Строка обновляется только если её версия не изменилась с момента чтения row
+func (r *EquipmentRepositoryImpl) update(ctx context.Context, e *model.Equipment, row *postgres.Equipment) error {
+	fields, err := newEquipmentFields(e)
+	if err != nil {
+		return fmt.Errorf("equipment %s: %w", e.ID(), err)
+	}
+
+	_, err = r.queries.UpdateEquipment(ctx, &postgres.UpdateEquipmentParams{
+		Version:               fields.version,
+		Description:           fields.description,
+		PublishedDate:         fields.dates.published,
+		EffectiveStartDate:    fields.dates.start,
+		EffectiveEndDate:      fields.dates.end,
+		HierarchyScopeID:      fields.hierarchyScopeID,
+		EquipmentLevel:        fields.equipmentLevel,
+		OperatingStatus:       fields.operatingStatus,
+		PhysicalAssetID:       fields.physicalAssetID,
+		OperationalLocationID: fields.operationalLocationID,
+		B2mmlData:             fields.b2mmlData,
+		RecordVersion:         e.Version(),
+		Position:              row.Position,
+		ID:                    row.ID,
+		ExpectedVersion:       row.RecordVersion,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// Строка была изменена или удалена после чтения
+		return model.ErrEquipmentVersionConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update equipment %s: %w", e.ID(), err)
+	}
+	if err := saveEvents(ctx, r.queries, e.PullEvents()); err != nil {
+		return err
+	}
+
+	if err := r.saveRelations(ctx, e, row.ID); err != nil {
+		return err
+	}
+	return recordHistory(ctx, r.queries, row.ID, model.ChangeUpdated)
+}
+
+// saveRelations синхронизирует классы, свойства и дочернее оборудование
+func (r *EquipmentRepositoryImpl) saveRelations(ctx context.Context, e *model.Equipment, equipmentID uuid.UUID) error {
+	classProperties, err := r.saveClassMappings(ctx, e, equipmentID)
+	if err != nil {
+		return err
+	}
+	if err := r.saveProperties(ctx, e, equipmentID, classProperties); err != nil {
+		return err
+	}
+	return r.saveChildren(ctx, e, equipmentID)
+}
+
+// saveClassMappings заменяет связи оборудования с классами и возвращает
+// идентификаторы свойств этих классов для привязки свойств оборудования
+func (r *EquipmentRepositoryImpl) saveClassMappings(ctx context.Context, e *model.Equipment, equipmentID uuid.UUID) (map[string]uuid.UUID, error) {
+	existing, err := r.queries.ListEquipmentClassesForEquipment(ctx, equipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list classes of equipment %s: %w", e.ID(), err)
+	}
+	for _, class := range existing {
+		err := r.queries.RemoveEquipmentFromClass(ctx, &postgres.RemoveEquipmentFromClassParams{
+			EquipmentID:      equipmentID,
+			EquipmentClassID: class.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to unlink equipment %s from class %s: %w", e.ID(), class.ExternalID, err)
+		}
+	}
+
+	classProperties := make(map[string]uuid.UUID)
+	for i, class := range e.Classes() {
+		classRow, err := r.queries.GetEquipmentClassByExternalID(ctx, class.ID().String())
+		if err != nil {
+			return nil, fmt.Errorf("equipment class %s: %w", class.ID(), equipmentClassError(err))
+		}
+
+		_, err = r.queries.AddEquipmentToClass(ctx, &postgres.AddEquipmentToClassParams{
+			EquipmentID:      equipmentID,
+			EquipmentClassID: classRow.ID,
+			Position:         int32(i),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to link equipment %s to class %s: %w", e.ID(), class.ID(), err)
+		}
+
+		props, err := r.queries.ListEquipmentClassProperties(ctx, classRow.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list properties of class %s: %w", class.ID(), err)
+		}
+		for _, prop := range props {
+			if _, ok := classProperties[prop.ExternalID]; !ok {
+				classProperties[prop.ExternalID] = prop.ID
+			}
+		}
+	}
+	return classProperties, nil
+}
+
+// saveProperties синхронизирует свойства оборудования: существующие обновляются,
+// новые создаются, отсутствующие в агрегате удаляются
+func (r *EquipmentRepositoryImpl) saveProperties(ctx context.Context, e *model.Equipment, equipmentID uuid.UUID, classProperties map[string]uuid.UUID) error {
+	rows, err := r.queries.ListEquipmentProperties(ctx, equipmentID)
+	if err != nil {
+		return fmt.Errorf("failed to list properties of equipment %s: %w", e.ID(), err)
+	}
+	existing := make(map[string]*postgres.EquipmentProperty, len(rows))
+	for _, row := range rows {
+		existing[row.ExternalID] = row
+	}
+
+	for i, prop := range e.Properties() {
+		b2mmlData, err := marshalB2MML(prop.GetB2MMLData())
+		if err != nil {
+			return fmt.Errorf("equipment property %s: %w", prop.ID(), err)
+		}
+		value := prop.Value()
+
+		if row, ok := existing[prop.ID().String()]; ok {
+			delete(existing, row.ExternalID)
+			_, err = r.queries.UpdateEquipmentProperty(ctx, &postgres.UpdateEquipmentPropertyParams{
+				ID:               row.ID,
+				PropertyValue:    nullString(value.Value()),
+				PropertyDataType: nullString(value.DataType()),
+				PropertyUnit:     nullString(value.Unit()),
+				Description:      nullString(value.Description()),
+				B2mmlData:        b2mmlData,
+				Position:         int32(i),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update equipment property %s: %w", prop.ID(), err)
+			}
+			continue
+		}
+
+		var classPropertyID uuid.NullUUID
+		if data := prop.GetB2MMLData(); data != nil {
+			if id, ok := classProperties[identifierValue(data.EquipmentClassPropertyID)]; ok {
+				classPropertyID = uuid.NullUUID{UUID: id, Valid: true}
+			}
+		}
+
+		_, err = r.queries.CreateEquipmentProperty(ctx, &postgres.CreateEquipmentPropertyParams{
+			EquipmentID:      equipmentID,
+			ExternalID:       prop.ID().String(),
+			ClassPropertyID:  classPropertyID,
+			PropertyValue:    nullString(value.Value()),
+			PropertyDataType: nullString(value.DataType()),
+			PropertyUnit:     nullString(value.Unit()),
+			Description:      nullString(value.Description()),
+			B2mmlData:        b2mmlData,
+			Position:         int32(i),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create equipment property %s: %w", prop.ID(), err)
+		}
+	}
+
+	for _, row := range existing {
+		if err := r.queries.DeleteEquipmentProperty(ctx, row.ID); err != nil {
+			return fmt.Errorf("failed to delete equipment property %s: %w", row.ExternalID, err)
+		}
+	}
+	return nil
+}
+
+// saveChildren создаёт или обновляет дочернее оборудование
+func (r *EquipmentRepositoryImpl) saveChildren(ctx context.Context, e *model.Equipment, equipmentID uuid.UUID) error {
+	parentID := uuid.NullUUID{UUID: equipmentID, Valid: true}
+	for i, child := range e.Children() {
+		row, err := r.queries.GetEquipmentByExternalID(ctx, child.ID().String())
+		if errors.Is(err, sql.ErrNoRows) {
+			if _, err := r.create(ctx, child, parentID, int32(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get equipment %s: %w", child.ID(), err)
+		}
+
+		row.Position = int32(i)
+		if err := r.update(ctx, child, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toDomain восстанавливает агрегат Equipment из строки БД и связанных таблиц
+// без дочернего оборудования; поддерево загружает GetSubtree одним запросом
+func (r *EquipmentRepositoryImpl) toDomain(ctx context.Context, row *postgres.Equipment) (*model.Equipment, error) {
+	return r.restore(ctx, row, nil)
+}
+
+// restore восстанавливает агрегат Equipment с уже загруженным дочерним оборудованием
+func (r *EquipmentRepositoryImpl) restore(ctx context.Context, row *postgres.Equipment, children []*model.Equipment) (*model.Equipment, error) {
+	id, err := model.NewEquipmentID(row.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := unmarshalB2MML[b2mml.EquipmentType](row.B2mmlData)
+	if err != nil {
+		return nil, fmt.Errorf("equipment %s: %w", row.ExternalID, err)
+	}
+
+	classRows, err := r.queries.ListEquipmentClassesForEquipment(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list classes of equipment %s: %w", row.ExternalID, err)
+	}
+	classes := make([]*model.EquipmentClass, 0, len(classRows))
+	for _, classRow := range classRows {
+		// Подклассы для оборудования не нужны: наследование свойств читает Lineage
+		class, err := r.classes.restore(ctx, classRow)
+		if err != nil {
+			return nil, err
+		}
+		classes = append(classes, class)
+	}
+
+	properties, err := r.loadProperties(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.RestoreEquipment(
+		id,
+		data,
+		classes,
+		properties,
+		children,
+		model.OperatingStatus(row.OperatingStatus.String),
+		row.RecordVersion,
+	), nil
+}
+
+// toDomainList восстанавливает список агрегатов Equipment
+func (r *EquipmentRepositoryImpl) toDomainList(ctx context.Context, rows []*postgres.Equipment) ([]*model.Equipment, error) {
+	result := make([]*model.Equipment, 0, len(rows))
+	for _, row := range rows {
+		e, err := r.toDomain(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+	return result, nil
+}
+
+// page выбирает страницу оборудования с помощью keyset-запросов after/before
+func (r *EquipmentRepositoryImpl) page(
+	ctx context.Context,
+	req repository.PageRequest,
+	after func(cursor *repository.Cursor, limit int32) ([]*postgres.Equipment, error),
+	before func(cursor repository.Cursor, limit int32) ([]*postgres.Equipment, error),
+) (*repository.Page[*model.Equipment], error) {
+	rows, next, prev, err := keysetPage(req, after, before, func(row *postgres.Equipment) (time.Time, uuid.UUID) {
+		return row.CreatedAt, row.ID
+	})
+	if err != nil {
+		return nil, err
+	}
+	items, err := r.toDomainList(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+	return &repository.Page[*model.Equipment]{Items: items, NextCursor: next, PrevCursor: prev}, nil
+}
+
+// loadProperties загружает свойства оборудования
+func (r *EquipmentRepositoryImpl) loadProperties(ctx context.Context, row *postgres.Equipment) ([]*model.EquipmentProperty, error) {
+	rows, err := r.queries.ListEquipmentProperties(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list properties of equipment %s: %w", row.ExternalID, err)
+	}
+
+	properties := make([]*model.EquipmentProperty, 0, len(rows))
+	for _, propRow := range rows {
+		id, err := model.NewEquipmentPropertyID(propRow.ExternalID)
+		if err != nil {
+			return nil, err
+		}
+		data, err := unmarshalB2MML[b2mml.EquipmentPropertyType](propRow.B2mmlData)
+		if err != nil {
+			return nil, fmt.Errorf("equipment property %s: %w", propRow.ExternalID, err)
+		}
+
+		value := model.NewPropertyValueWithUnit(
+			propRow.PropertyValue.String,
+			propRow.PropertyDataType.String,
+			propRow.PropertyUnit.String,
+		)
+		value.SetDescription(propRow.Description.String)
+
+		properties = append(properties, model.NewEquipmentProperty(id, data, value))
+	}
+	return properties, nil
+}
+
+// equipmentFields колонки таблицы equipment, извлечённые из агрегата
+type equipmentFields struct {
+	version               sql.NullString
+	description           sql.NullString
+	dates                 effectiveDates
+	hierarchyScopeID      sql.NullString
+	equipmentLevel        sql.NullString
+	operatingStatus       sql.NullString
+	physicalAssetID       sql.NullString
+	operationalLocationID sql.NullString
+	b2mmlData             pqtype.NullRawMessage
+}
+
+// newEquipmentFields извлекает индексируемые колонки из B2MML данных агрегата
+func newEquipmentFields(e *model.Equipment) (equipmentFields, error) {
+	var (
+		fields equipmentFields
+		err    error
+	)
+	fields.operatingStatus = nullString(string(e.GetOperatingStatus()))
+
+	data := e.GetB2MMLData()
+	if data == nil {
+		return fields, nil
+	}
+
+	if fields.b2mmlData, err = marshalB2MML(data); err != nil {
+		return fields, err
+	}
+	if fields.dates, err = parseEffectiveDates(data.PublishedDate, data.EffectiveStartDate, data.EffectiveEndDate); err != nil {
+		return fields, err
+	}
+	fields.version = nullString(identifierValue(data.Version))
+	fields.description = nullString(descriptionText(data.Description))
+	fields.hierarchyScopeID = nullString(hierarchyScopeID(data.HierarchyScope))
+	fields.equipmentLevel = nullString(equipmentLevelValue(data.EquipmentLevel))
+	fields.physicalAssetID = nullString(identifierValue(data.PhysicalAssetID))
+	fields.operationalLocationID = nullString(operationalLocationID(data.OperationalLocation))
+	return fields, nil
+}
+
+// equipmentError преобразует ошибки БД в доменные ошибки Equipment
+func equipmentError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ErrEquipmentNotFound
+	}
+	return err
 }
 
 // EquipmentClassRepositoryImpl реализация репозитория EquipmentClass
@@ -61,64 +680,380 @@ func NewEquipmentClassRepository(queries *postgres.Queries) repository.Equipment
 }
 
 func (r *EquipmentClassRepositoryImpl) Create(ctx context.Context, class *model.EquipmentClass) error {
-	return fmt.Errorf("not implemented")
+	return r.create(ctx, class, uuid.NullUUID{}, 0)
 }
 
 func (r *EquipmentClassRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*model.EquipmentClass, error) {
-	return nil, fmt.Errorf("not implemented")
+	row, err := r.queries.GetEquipmentClassByID(ctx, id)
+	if err != nil {
+		return nil, equipmentClassError(err)
+	}
+	return r.toDomain(ctx, row)
 }
 
 func (r *EquipmentClassRepositoryImpl) GetByExternalID(ctx context.Context, externalID string) (*model.EquipmentClass, error) {
-	return nil, fmt.Errorf("not implemented")
+	row, err := r.queries.GetEquipmentClassByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, equipmentClassError(err)
+	}
+	return r.toDomain(ctx, row)
 }
 
-func (r *EquipmentClassRepositoryImpl) List(ctx context.Context, limit int32, offset int32) ([]*model.EquipmentClass, error) {
-	return nil, fmt.Errorf("not implemented")
+func (r *EquipmentClassRepositoryImpl) List(ctx context.Context, page repository.PageRequest) (*repository.Page[*model.EquipmentClass], error) {
+	rows, next, prev, err := keysetPage(page,
+		func(cursor *repository.Cursor, limit int32) ([]*postgres.EquipmentClass, error) {
+			createdAt, id := afterCursor(cursor)
+			return r.queries.ListEquipmentClassesAfter(ctx, &postgres.ListEquipmentClassesAfterParams{
+				CursorCreatedAt: createdAt,
+				CursorID:        id,
+				PageLimit:       limit,
+			})
+		},
+		func(cursor repository.Cursor, limit int32) ([]*postgres.EquipmentClass, error) {
+			return r.queries.ListEquipmentClassesBefore(ctx, &postgres.ListEquipmentClassesBeforeParams{
+				CursorCreatedAt: cursor.CreatedAt,
+				CursorID:        cursor.ID,
+				PageLimit:       limit,
+			})
+		},
+		func(row *postgres.EquipmentClass) (time.Time, uuid.UUID) {
+			return row.CreatedAt, row.ID
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list equipment classes: %w", err)
+	}
+
+	result := make([]*model.EquipmentClass, 0, len(rows))
+	for _, row := range rows {
+		class, err := r.toDomain(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, class)
+	}
+	return &repository.Page[*model.EquipmentClass]{Items: result, NextCursor: next, PrevCursor: prev}, nil
+}
+
+func (r *EquipmentClassRepositoryImpl) Lineage(ctx context.Context, id model.EquipmentClassID) ([]*model.EquipmentClass, error) {
+	row, err := r.queries.GetEquipmentClassByExternalID(ctx, id.String())
+	if err != nil {
+		return nil, equipmentClassError(err)
+	}
+	rows, err := r.queries.ListEquipmentClassLineage(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lineage of class %s: %w", id, err)
+	}
+
+	lineage := make([]*model.EquipmentClass, 0, len(rows))
+	for _, lineageRow := range rows {
+		class, err := r.restore(ctx, &lineageRow.EquipmentClass)
+		if err != nil {
+			return nil, err
+		}
+		lineage = append(lineage, class)
+	}
+	return lineage, nil
 }
 
 func (r *EquipmentClassRepositoryImpl) Update(ctx context.Context, class *model.EquipmentClass) error {
-	return fmt.Errorf("not implemented")
+	row, err := r.queries.GetEquipmentClassByExternalID(ctx, class.ID().String())
+	if err != nil {
+		return equipmentClassError(err)
+	}
+	return r.update(ctx, class, row)
+}
+
+func (r *EquipmentClassRepositoryImpl) Delete(ctx context.Context, class *model.EquipmentClass) error {
+	row, err := r.queries.GetEquipmentClassByExternalID(ctx, class.ID().String())
+	if err != nil {
+		return equipmentClassError(err)
+	}
+
+	children, err := r.queries.ListChildEquipmentClasses(ctx, uuid.NullUUID{UUID: row.ID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to list subclasses of class %s: %w", class.ID(), err)
+	}
+	members, err := r.queries.ListEquipmentByClassAfter(ctx, &postgres.ListEquipmentByClassAfterParams{
+		EquipmentClassID: row.ID,
+		PageLimit:        1,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list equipment of class %s: %w", class.ID(), err)
+	}
+	if len(children) > 0 || len(members) > 0 {
+		return fmt.Errorf("%w: %s", model.ErrEquipmentClassInUse, class.ID())
+	}
+
+	if err := r.queries.DeleteEquipmentClass(ctx, row.ID); err != nil {
+		return fmt.Errorf("failed to delete equipment class %s: %w", class.ID(), err)
+	}
+	return nil
 }
 
-func (r *EquipmentClassRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.queries.DeleteEquipmentClass(ctx, id)
+// create сохраняет класс вместе со свойствами и дочерними классами
+func (r *EquipmentClassRepositoryImpl) create(ctx context.Context, ec *model.EquipmentClass, parentID uuid.NullUUID, position int32) error {
+	fields, err := newEquipmentClassFields(ec)
+	if err != nil {
+		return fmt.Errorf("equipment class %s: %w", ec.ID(), err)
+	}
+
+	row, err := r.queries.CreateEquipmentClass(ctx, &postgres.CreateEquipmentClassParams{
+		ExternalID:         ec.ID().String(),
+		Version:            fields.version,
+		Description:        fields.description,
+		PublishedDate:      fields.dates.published,
+		EffectiveStartDate: fields.dates.start,
+		EffectiveEndDate:   fields.dates.end,
+		HierarchyScopeID:   fields.hierarchyScopeID,
+		EquipmentLevel:     fields.equipmentLevel,
+		ParentClassID:      parentID,
+		B2mmlData:          fields.b2mmlData,
+		Position:           position,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create equipment class %s: %w", ec.ID(), err)
+	}
+	if err := saveEvents(ctx, r.queries, ec.PullEvents()); err != nil {
+		return err
+	}
+
+	if err := r.saveProperties(ctx, ec, row.ID); err != nil {
+		return err
+	}
+	return r.saveChildren(ctx, ec, row.ID)
 }
 
-// UnitOfWorkImpl реализация UnitOfWork
-type UnitOfWorkImpl struct {
-	queries           *postgres.Queries
-	equipmentRepo     repository.EquipmentRepository
-	equipmentClassRepo repository.EquipmentClassRepository
+// update обновляет строку класса и синхронизирует свойства и дочерние классы
+func (r *EquipmentClassRepositoryImpl) update(ctx context.Context, ec *model.EquipmentClass, row *postgres.EquipmentClass) error {
+	fields, err := newEquipmentClassFields(ec)
+	if err != nil {
+		return fmt.Errorf("equipment class %s: %w", ec.ID(), err)
+	}
+
+	_, err = r.queries.UpdateEquipmentClass(ctx, &postgres.UpdateEquipmentClassParams{
+		ID:                 row.ID,
+		Version:            fields.version,
+		Description:        fields.description,
+		PublishedDate:      fields.dates.published,
+		EffectiveStartDate: fields.dates.start,
+		EffectiveEndDate:   fields.dates.end,
+		HierarchyScopeID:   fields.hierarchyScopeID,
+		EquipmentLevel:     fields.equipmentLevel,
+		B2mmlData:          fields.b2mmlData,
+		Position:           row.Position,
+	})
+	if err != nil {
+		return equipmentClassError(err)
+	}
+	if err := saveEvents(ctx, r.queries, ec.PullEvents()); err != nil {
+		return err
+	}
+
+	if err := r.saveProperties(ctx, ec, row.ID); err != nil {
+		return err
+	}
+	return r.saveChildren(ctx, ec, row.ID)
+}
+
+// saveProperties синхронизирует дерево свойств класса. Свойства сохраняются
+// в порядке обхода в глубину, поэтому родитель всегда предшествует потомкам
+func (r *EquipmentClassRepositoryImpl) saveProperties(ctx context.Context, ec *model.EquipmentClass, classID uuid.UUID) error {
+	rows, err := r.queries.ListEquipmentClassProperties(ctx, classID)
+	if err != nil {
+		return fmt.Errorf("failed to list properties of class %s: %w", ec.ID(), err)
+	}
+	existing := make(map[string]*postgres.EquipmentClassProperty, len(rows))
+	for _, row := range rows {
+		existing[row.ExternalID] = row
+	}
+
+	var position int32
+	var save func(props []*model.EquipmentClassProperty, parentID uuid.NullUUID) error
+	save = func(props []*model.EquipmentClassProperty, parentID uuid.NullUUID) error {
+		for _, prop := range props {
+			b2mmlData, err := marshalB2MML(prop.GetB2MMLData())
+			if err != nil {
+				return fmt.Errorf("equipment class property %s: %w", prop.ID(), err)
+			}
+
+			var propID uuid.UUID
+			if row, ok := existing[prop.ID().String()]; ok {
+				delete(existing, row.ExternalID)
+				updated, err := r.queries.UpdateEquipmentClassProperty(ctx, &postgres.UpdateEquipmentClassPropertyParams{
+					ID:               row.ID,
+					Description:      row.Description,
+					PropertyType:     row.PropertyType,
+					ParentPropertyID: parentID,
+					B2mmlData:        b2mmlData,
+					Position:         position,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to update equipment class property %s: %w", prop.ID(), err)
+				}
+				propID = updated.ID
+			} else {
+				created, err := r.queries.CreateEquipmentClassProperty(ctx, &postgres.CreateEquipmentClassPropertyParams{
+					EquipmentClassID: classID,
+					ExternalID:       prop.ID().String(),
+					ParentPropertyID: parentID,
+					B2mmlData:        b2mmlData,
+					Position:         position,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create equipment class property %s: %w", prop.ID(), err)
+				}
+				propID = created.ID
+			}
+			position++
+
+			if err := save(prop.Children(), uuid.NullUUID{UUID: propID, Valid: true}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := save(ec.Properties(), uuid.NullUUID{}); err != nil {
+		return err
+	}
+
+	for _, row := range existing {
+		if err := r.queries.DeleteEquipmentClassProperty(ctx, row.ID); err != nil {
+			return fmt.Errorf("failed to delete equipment class property %s: %w", row.ExternalID, err)
+		}
+	}
+	return nil
 }
 
-// NewUnitOfWork создаёт новый UnitOfWork
-func NewUnitOfWork(queries *postgres.Queries) repository.UnitOfWork {
-	return &UnitOfWorkImpl{
-		queries:           queries,
-		equipmentRepo:     NewEquipmentRepository(queries),
-		equipmentClassRepo: NewEquipmentClassRepository(queries),
+// saveChildren создаёт или обновляет дочерние классы
+func (r *EquipmentClassRepositoryImpl) saveChildren(ctx context.Context, ec *model.EquipmentClass, classID uuid.UUID) error {
+	parentID := uuid.NullUUID{UUID: classID, Valid: true}
+	for i, child := range ec.Children() {
+		row, err := r.queries.GetEquipmentClassByExternalID(ctx, child.ID().String())
+		if errors.Is(err, sql.ErrNoRows) {
+			if err := r.create(ctx, child, parentID, int32(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get equipment class %s: %w", child.ID(), err)
+		}
+
+		row.Position = int32(i)
+		if err := r.update(ctx, child, row); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-func (u *UnitOfWorkImpl) Equipment() repository.EquipmentRepository {
-	return u.equipmentRepo
+// toDomain восстанавливает агрегат EquipmentClass из строки БД и связанных таблиц
+func (r *EquipmentClassRepositoryImpl) toDomain(ctx context.Context, row *postgres.EquipmentClass) (*model.EquipmentClass, error) {
+	class, err := r.restore(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	childRows, err := r.queries.ListChildEquipmentClasses(ctx, uuid.NullUUID{UUID: row.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list children of class %s: %w", row.ExternalID, err)
+	}
+	for _, childRow := range childRows {
+		child, err := r.toDomain(ctx, childRow)
+		if err != nil {
+			return nil, err
+		}
+		if err := class.AddChild(child); err != nil {
+			return nil, err
+		}
+	}
+
+	return class, nil
 }
 
-func (u *UnitOfWorkImpl) EquipmentClass() repository.EquipmentClassRepository {
-	return u.equipmentClassRepo
+// restore восстанавливает класс со свойствами, без дочерних классов
+func (r *EquipmentClassRepositoryImpl) restore(ctx context.Context, row *postgres.EquipmentClass) (*model.EquipmentClass, error) {
+	id, err := model.NewEquipmentClassID(row.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := unmarshalB2MML[b2mml.EquipmentClassType](row.B2mmlData)
+	if err != nil {
+		return nil, fmt.Errorf("equipment class %s: %w", row.ExternalID, err)
+	}
+	class := model.RestoreEquipmentClass(id, data)
+
+	propRows, err := r.queries.ListEquipmentClassProperties(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list properties of class %s: %w", row.ExternalID, err)
+	}
+	props := make(map[uuid.UUID]*model.EquipmentClassProperty, len(propRows))
+	for _, propRow := range propRows {
+		propID, err := model.NewEquipmentClassPropertyID(propRow.ExternalID)
+		if err != nil {
+			return nil, err
+		}
+		propData, err := unmarshalB2MML[b2mml.EquipmentClassPropertyType](propRow.B2mmlData)
+		if err != nil {
+			return nil, fmt.Errorf("equipment class property %s: %w", propRow.ExternalID, err)
+		}
+		props[propRow.ID] = model.NewEquipmentClassProperty(propID, propData)
+	}
+	for _, propRow := range propRows {
+		prop := props[propRow.ID]
+		if parent, ok := props[propRow.ParentPropertyID.UUID]; propRow.ParentPropertyID.Valid && ok {
+			err = parent.AddChild(prop)
+		} else {
+			err = class.AddProperty(prop)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return class, nil
 }
 
-func (u *UnitOfWorkImpl) Begin(ctx context.Context) (repository.UnitOfWork, error) {
-	// Заглушка для транзакций
-	return u, nil
+// equipmentClassFields колонки таблицы equipment_classes, извлечённые из агрегата
+type equipmentClassFields struct {
+	version          sql.NullString
+	description      sql.NullString
+	dates            effectiveDates
+	hierarchyScopeID sql.NullString
+	equipmentLevel   sql.NullString
+	b2mmlData        pqtype.NullRawMessage
 }
 
-func (u *UnitOfWorkImpl) Commit(ctx context.Context) error {
-	// Заглушка для транзакций
-	return nil
+// newEquipmentClassFields извлекает индексируемые колонки из B2MML данных класса
+func newEquipmentClassFields(ec *model.EquipmentClass) (equipmentClassFields, error) {
+	var (
+		fields equipmentClassFields
+		err    error
+	)
+
+	data := ec.GetB2MMLData()
+	if data == nil {
+		return fields, nil
+	}
+
+	if fields.b2mmlData, err = marshalB2MML(data); err != nil {
+		return fields, err
+	}
+	if fields.dates, err = parseEffectiveDates(data.PublishedDate, data.EffectiveStartDate, data.EffectiveEndDate); err != nil {
+		return fields, err
+	}
+	fields.version = nullString(identifierValue(data.Version))
+	fields.description = nullString(descriptionText(data.Description))
+	fields.hierarchyScopeID = nullString(hierarchyScopeID(data.HierarchyScope))
+	fields.equipmentLevel = nullString(equipmentLevelValue(data.EquipmentLevel))
+	return fields, nil
 }
 
-func (u *UnitOfWorkImpl) Rollback(ctx context.Context) error {
-	// Заглушка для транзакций
-	return nil
+// equipmentClassError преобразует ошибки БД в доменные ошибки EquipmentClass
+func equipmentClassError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ErrEquipmentClassNotFound
+	}
+	return err
 }
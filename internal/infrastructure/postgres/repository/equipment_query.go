@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+func (r *EquipmentRepositoryImpl) Query(ctx context.Context, query repository.EquipmentQuery) (*repository.Page[*model.Equipment], error) {
+	sort := query.Sort
+	if sort.Field == "" {
+		sort = repository.DefaultEquipmentSort
+	}
+	cursor, err := repository.DecodeCursor(query.Page.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil {
+		if cursor.Sort != sort.String() || postgres.ParseEquipmentSortKey(string(sort.Field), cursor.Key) != nil {
+			return nil, repository.ErrInvalidCursor
+		}
+	}
+
+	filter, err := r.filterParams(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	params := &postgres.QueryEquipmentParams{
+		EquipmentFilterParams: filter,
+		SortColumn:            string(sort.Field),
+		SortDesc:              sort.Desc,
+		PageLimit:             query.Page.Limit + 1,
+	}
+	if cursor != nil {
+		params.CursorKey = sql.NullString{String: cursor.Key, Valid: true}
+		params.CursorID = uuid.NullUUID{UUID: cursor.ID, Valid: true}
+		params.Backward = cursor.Backward
+	}
+
+	rows, err := r.queries.QueryEquipment(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query equipment: %w", err)
+	}
+
+	limit := int(query.Page.Limit)
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		slices.Reverse(rows)
+	}
+
+	cursorAt := func(row *postgres.Equipment, backward bool) (string, error) {
+		key, err := postgres.EquipmentSortKey(row, string(sort.Field))
+		if err != nil {
+			return "", err
+		}
+		return repository.Cursor{ID: row.ID, Backward: backward, Sort: sort.String(), Key: key}.Encode(), nil
+	}
+
+	page := &repository.Page[*model.Equipment]{}
+	if len(rows) > 0 {
+		// При движении вперёд следующая страница есть, если нашлась лишняя строка,
+		// а предыдущая - если страница выбрана от курсора. При движении назад наоборот
+		if backward || hasMore {
+			if page.NextCursor, err = cursorAt(rows[len(rows)-1], false); err != nil {
+				return nil, err
+			}
+		}
+		if (backward && hasMore) || (!backward && cursor != nil) {
+			if page.PrevCursor, err = cursorAt(rows[0], true); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if page.Items, err = r.toDomainList(ctx, rows); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// filterParams преобразует фильтры запроса в параметры запроса к БД
+func (r *EquipmentRepositoryImpl) filterParams(ctx context.Context, query repository.EquipmentQuery) (postgres.EquipmentFilterParams, error) {
+	params := postgres.EquipmentFilterParams{
+		EquipmentLevels:   query.Levels,
+		HierarchyScopeIDs: query.HierarchyScopeIDs,
+		IncludeSubclasses: query.IncludeSubclasses,
+		EffectiveAt:       nullTime(query.EffectiveAt),
+		EffectiveFrom:     nullTime(query.EffectiveFrom),
+		EffectiveTo:       nullTime(query.EffectiveTo),
+	}
+	for _, status := range query.Statuses {
+		params.OperatingStatuses = append(params.OperatingStatuses, string(status))
+	}
+	for _, pred := range query.Properties {
+		p := postgres.EquipmentPropertyPredicate{
+			ExternalID: pred.PropertyID,
+			Operator:   string(pred.Operator),
+			ValueType:  string(pred.Type),
+			Value:      pred.Value,
+			Unit:       pred.Unit,
+		}
+		for _, c := range pred.UnitConversions {
+			p.UnitConversions = append(p.UnitConversions, postgres.EquipmentUnitConversion{
+				Unit:   c.Unit,
+				Factor: c.Factor,
+				Offset: c.Offset,
+			})
+		}
+		params.Properties = append(params.Properties, p)
+	}
+	if query.ClassID != nil {
+		class, err := r.queries.GetEquipmentClassByExternalID(ctx, query.ClassID.String())
+		if err != nil {
+			return params, equipmentClassError(err)
+		}
+		params.EquipmentClassID = uuid.NullUUID{UUID: class.ID, Valid: true}
+	}
+	return params, nil
+}
+
+// nullTime преобразует необязательное время в sql.NullTime
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func (r *EquipmentRepositoryImpl) Export(ctx context.Context, query repository.EquipmentExportQuery, fn func(*repository.EquipmentRecord) error) error {
+	filter, err := r.filterParams(ctx, query.Filter)
+	if err != nil {
+		return err
+	}
+	params := &postgres.ExportEquipmentParams{
+		EquipmentFilterParams: filter,
+		RootExternalIDs:       query.RootIDs,
+	}
+	if err := r.queries.DeclareEquipmentExportCursor(ctx, params); err != nil {
+		return fmt.Errorf("failed to declare equipment export cursor: %w", err)
+	}
+
+	for {
+		rows, err := r.queries.FetchEquipmentExportCursor(ctx, query.BatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to fetch equipment export cursor: %w", err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+		// Связанные данные читаются после FETCH: соединение транзакции одно
+		for _, row := range rows {
+			equipment, err := r.restore(ctx, &row.Equipment, nil)
+			if err != nil {
+				return err
+			}
+			record := &repository.EquipmentRecord{
+				Equipment: equipment,
+				ParentID:  row.ParentExternalID.String,
+				Path:      row.Ancestors,
+			}
+			if err := fn(record); err != nil {
+				return err
+			}
+		}
+	}
+	return r.queries.CloseEquipmentExportCursor(ctx)
+}
+
+func (r *EquipmentRepositoryImpl) PropertyIDs(ctx context.Context) ([]string, error) {
+	ids, err := r.queries.ListPropertyExternalIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list property ids: %w", err)
+	}
+	return ids, nil
+}
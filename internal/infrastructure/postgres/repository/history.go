@@ -0,0 +1,301 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+// EquipmentHistoryRepositoryImpl реализация истории изменений оборудования
+type EquipmentHistoryRepositoryImpl struct {
+	queries *postgres.Queries
+	classes *EquipmentClassRepositoryImpl
+}
+
+// NewEquipmentHistoryRepository создаёт новое хранилище истории оборудования
+func NewEquipmentHistoryRepository(queries *postgres.Queries) repository.EquipmentHistoryRepository {
+	return &EquipmentHistoryRepositoryImpl{
+		queries: queries,
+		classes: &EquipmentClassRepositoryImpl{queries: queries},
+	}
+}
+
+func (r *EquipmentHistoryRepositoryImpl) List(ctx context.Context, externalID string, before int32, limit int32) ([]*repository.EquipmentRevision, error) {
+	equipmentID, err := r.owner(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.queries.ListEquipmentHistory(ctx, &postgres.ListEquipmentHistoryParams{
+		EquipmentID:    equipmentID,
+		BeforeRevision: sql.NullInt32{Int32: before, Valid: before > 0},
+		PageLimit:      limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list history of equipment %s: %w", externalID, err)
+	}
+
+	revisions := make([]*repository.EquipmentRevision, 0, len(rows))
+	for _, row := range rows {
+		revisions = append(revisions, toEquipmentRevision(row))
+	}
+	return revisions, nil
+}
+
+func (r *EquipmentHistoryRepositoryImpl) Get(ctx context.Context, externalID string, revision int32) (*repository.EquipmentRevision, error) {
+	equipmentID, err := r.owner(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *postgres.EquipmentHistory
+	if revision > 0 {
+		row, err = r.queries.GetEquipmentRevision(ctx, &postgres.GetEquipmentRevisionParams{
+			EquipmentID: equipmentID,
+			Revision:    revision,
+		})
+	} else {
+		row, err = r.queries.GetLatestEquipmentRevision(ctx, equipmentID)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, model.ErrEquipmentRevisionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revision %d of equipment %s: %w", revision, externalID, err)
+	}
+
+	result := toEquipmentRevision(row)
+	if result.Snapshot, err = decodeEquipmentSnapshot(row.Snapshot); err != nil {
+		return nil, fmt.Errorf("revision %d of equipment %s: %w", row.Revision, externalID, err)
+	}
+	return result, nil
+}
+
+func (r *EquipmentHistoryRepositoryImpl) GetAsOf(ctx context.Context, externalID string, asOf time.Time) (*model.Equipment, error) {
+	return r.GetSubtreeAsOf(ctx, externalID, -1, asOf)
+}
+
+func (r *EquipmentHistoryRepositoryImpl) GetSubtreeAsOf(ctx context.Context, externalID string, depth int32, asOf time.Time) (*model.Equipment, error) {
+	row, err := r.queries.GetEquipmentRevisionAt(ctx, &postgres.GetEquipmentRevisionAtParams{
+		ExternalID: externalID,
+		AsOf:       asOf,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, model.ErrEquipmentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get equipment %s as of %s: %w", externalID, asOf, err)
+	}
+	if model.ChangeOperation(row.Operation) == model.ChangeDeleted {
+		return nil, model.ErrEquipmentNotFound
+	}
+
+	// Классы загружаются в текущем состоянии: история ведётся только для оборудования
+	classes := make(map[string]*model.EquipmentClass)
+	visited := make(map[uuid.UUID]bool)
+
+	var build func(row *postgres.EquipmentHistory, depth int32) (*model.Equipment, error)
+	build = func(row *postgres.EquipmentHistory, depth int32) (*model.Equipment, error) {
+		visited[row.EquipmentID] = true
+
+		var children []*model.Equipment
+		if depth != 0 {
+			childRows, err := r.queries.ListEquipmentChildrenAt(ctx, &postgres.ListEquipmentChildrenAtParams{
+				ParentID: row.EquipmentID,
+				AsOf:     asOf,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list children of equipment %s as of %s: %w", row.ExternalID, asOf, err)
+			}
+			for _, childRow := range childRows {
+				if visited[childRow.EquipmentID] {
+					continue
+				}
+				child, err := build(childRow, depth-1)
+				if err != nil {
+					return nil, err
+				}
+				children = append(children, child)
+			}
+		}
+		return r.restore(ctx, row, children, classes)
+	}
+	return build(row, depth)
+}
+
+// owner возвращает идентификатор оборудования, которому принадлежит история внешнего ID
+func (r *EquipmentHistoryRepositoryImpl) owner(ctx context.Context, externalID string) (uuid.UUID, error) {
+	id, err := r.queries.GetEquipmentHistoryOwner(ctx, externalID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, model.ErrEquipmentNotFound
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get history of equipment %s: %w", externalID, err)
+	}
+	return id, nil
+}
+
+// restore восстанавливает агрегат Equipment из снимка ревизии
+func (r *EquipmentHistoryRepositoryImpl) restore(ctx context.Context, row *postgres.EquipmentHistory, children []*model.Equipment, classes map[string]*model.EquipmentClass) (*model.Equipment, error) {
+	snapshot, err := decodeEquipmentSnapshot(row.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("revision %d of equipment %s: %w", row.Revision, row.ExternalID, err)
+	}
+	id, err := model.NewEquipmentID(row.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	equipmentClasses := make([]*model.EquipmentClass, 0, len(snapshot.Classes))
+	for _, classID := range snapshot.Classes {
+		class, ok := classes[classID]
+		if !ok {
+			class, err = r.classes.GetByExternalID(ctx, classID)
+			if errors.Is(err, model.ErrEquipmentClassNotFound) {
+				// Класс удалён после ревизии: остаётся только его идентификатор
+				var deletedID model.EquipmentClassID
+				if deletedID, err = model.NewEquipmentClassID(classID); err == nil {
+					class = model.RestoreEquipmentClass(deletedID, nil)
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+			classes[classID] = class
+		}
+		equipmentClasses = append(equipmentClasses, class)
+	}
+
+	properties := make([]*model.EquipmentProperty, 0, len(snapshot.Properties))
+	for _, p := range snapshot.Properties {
+		propID, err := model.NewEquipmentPropertyID(p.ID)
+		if err != nil {
+			return nil, err
+		}
+		value := model.NewPropertyValueWithUnit(p.Value, p.DataType, p.Unit)
+		value.SetDescription(p.Description)
+		properties = append(properties, model.NewEquipmentProperty(propID, p.B2MML, value))
+	}
+
+	return model.RestoreEquipment(
+		id,
+		snapshot.B2MML,
+		equipmentClasses,
+		properties,
+		children,
+		model.OperatingStatus(snapshot.OperatingStatus),
+		row.RecordVersion,
+	), nil
+}
+
+// recordHistory добавляет ревизию оборудования с автором и причиной изменения
+// из контекста. Вызывается репозиторием оборудования через те же queries,
+// что и изменение, после сохранения свойств и классов
+func recordHistory(ctx context.Context, queries *postgres.Queries, equipmentID uuid.UUID, operation model.ChangeOperation) error {
+	info := repository.ChangeInfoFromContext(ctx)
+	_, err := queries.RecordEquipmentHistory(ctx, &postgres.RecordEquipmentHistoryParams{
+		Operation:    string(operation),
+		ChangedBy:    nullString(info.Actor),
+		ChangeReason: nullString(info.Reason),
+		EquipmentID:  equipmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record history of equipment %s: %w", equipmentID, err)
+	}
+	return nil
+}
+
+func toEquipmentRevision(row *postgres.EquipmentHistory) *repository.EquipmentRevision {
+	return &repository.EquipmentRevision{
+		Revision:      row.Revision,
+		RecordVersion: row.RecordVersion,
+		Operation:     model.ChangeOperation(row.Operation),
+		ChangedAt:     row.ChangedAt,
+		ChangedBy:     row.ChangedBy.String,
+		Reason:        row.ChangeReason.String,
+	}
+}
+
+// equipmentSnapshotJSON формат снимка, который строит функция БД equipment_snapshot
+type equipmentSnapshotJSON struct {
+	ExternalID            string          `json:"external_id"`
+	ParentID              string          `json:"parent_id"`
+	Position              int32           `json:"position"`
+	Version               string          `json:"version"`
+	Description           string          `json:"description"`
+	PublishedDate         *time.Time      `json:"published_date"`
+	EffectiveStartDate    *time.Time      `json:"effective_start_date"`
+	EffectiveEndDate      *time.Time      `json:"effective_end_date"`
+	HierarchyScopeID      string          `json:"hierarchy_scope_id"`
+	EquipmentLevel        string          `json:"equipment_level"`
+	OperatingStatus       string          `json:"operating_status"`
+	PhysicalAssetID       string          `json:"physical_asset_id"`
+	OperationalLocationID string          `json:"operational_location_id"`
+	Deleted               bool            `json:"deleted"`
+	B2MMLData             json.RawMessage `json:"b2mml_data"`
+	Classes               []string        `json:"classes"`
+	Properties            []struct {
+		ID          string          `json:"id"`
+		Value       string          `json:"value"`
+		DataType    string          `json:"data_type"`
+		Unit        string          `json:"unit"`
+		Description string          `json:"description"`
+		B2MMLData   json.RawMessage `json:"b2mml_data"`
+	} `json:"properties"`
+}
+
+// decodeEquipmentSnapshot разбирает снимок ревизии
+func decodeEquipmentSnapshot(raw json.RawMessage) (*model.EquipmentSnapshot, error) {
+	var data equipmentSnapshotJSON
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+
+	b2mmlData, err := unmarshalB2MML[b2mml.EquipmentType](pqtype.NullRawMessage{RawMessage: data.B2MMLData, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &model.EquipmentSnapshot{
+		ExternalID:            data.ExternalID,
+		ParentID:              data.ParentID,
+		Position:              data.Position,
+		Version:               data.Version,
+		Description:           data.Description,
+		PublishedDate:         data.PublishedDate,
+		EffectiveStartDate:    data.EffectiveStartDate,
+		EffectiveEndDate:      data.EffectiveEndDate,
+		HierarchyScopeID:      data.HierarchyScopeID,
+		EquipmentLevel:        data.EquipmentLevel,
+		OperatingStatus:       data.OperatingStatus,
+		PhysicalAssetID:       data.PhysicalAssetID,
+		OperationalLocationID: data.OperationalLocationID,
+		Deleted:               data.Deleted,
+		Classes:               data.Classes,
+		B2MML:                 b2mmlData,
+	}
+	for _, p := range data.Properties {
+		propData, err := unmarshalB2MML[b2mml.EquipmentPropertyType](pqtype.NullRawMessage{RawMessage: p.B2MMLData, Valid: true})
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", p.ID, err)
+		}
+		snapshot.Properties = append(snapshot.Properties, model.PropertySnapshot{
+			ID:          p.ID,
+			Value:       p.Value,
+			DataType:    p.DataType,
+			Unit:        p.Unit,
+			Description: p.Description,
+			B2MML:       propData,
+		})
+	}
+	return snapshot, nil
+}
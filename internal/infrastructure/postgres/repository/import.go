@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+// ImportJobRepositoryImpl реализация хранилища заданий импорта
+type ImportJobRepositoryImpl struct {
+	queries *postgres.Queries
+}
+
+// NewImportJobRepository создаёт новое хранилище заданий импорта
+func NewImportJobRepository(queries *postgres.Queries) repository.ImportJobRepository {
+	return &ImportJobRepositoryImpl{queries: queries}
+}
+
+// importRowError ошибка строки в колонке row_errors
+type importRowError struct {
+	Row         int64  `json:"row"`
+	Line        int64  `json:"line,omitempty"`
+	EquipmentID string `json:"equipment_id,omitempty"`
+	Column      string `json:"column,omitempty"`
+	Message     string `json:"message"`
+}
+
+func (r *ImportJobRepositoryImpl) Create(ctx context.Context, job *repository.ImportJob, source []byte, lockedUntil time.Time) error {
+	mapping, err := json.Marshal(job.Mapping)
+	if err != nil {
+		return fmt.Errorf("failed to encode mapping of import job %s: %w", job.ID, err)
+	}
+	params := &postgres.CreateImportJobParams{
+		ID:        job.ID,
+		Format:    string(job.Format),
+		Delimiter: string(job.Delimiter),
+		Mapping:   mapping,
+		DryRun:    job.DryRun,
+		ChunkSize: job.ChunkSize,
+		Status:    string(job.Status),
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+	if job.Status == model.ImportJobRunning {
+		params.LockedUntil = nullTime(&lockedUntil)
+	}
+	if err := r.queries.CreateImportJob(ctx, params); err != nil {
+		return fmt.Errorf("failed to create import job %s: %w", job.ID, err)
+	}
+	err = r.queries.CreateImportJobSource(ctx, &postgres.CreateImportJobSourceParams{
+		JobID: job.ID,
+		Data:  source,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save source of import job %s: %w", job.ID, err)
+	}
+	return nil
+}
+
+func (r *ImportJobRepositoryImpl) Get(ctx context.Context, id uuid.UUID) (*repository.ImportJob, error) {
+	row, err := r.queries.GetImportJob(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, model.ErrImportJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get import job %s: %w", id, err)
+	}
+	return toImportJob(row)
+}
+
+func (r *ImportJobRepositoryImpl) Source(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	data, err := r.queries.GetImportJobSource(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, model.ErrImportJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source of import job %s: %w", id, err)
+	}
+	return data, nil
+}
+
+func (r *ImportJobRepositoryImpl) Claim(ctx context.Context, lockedUntil time.Time) (*repository.ImportJob, error) {
+	row, err := r.queries.ClaimImportJob(ctx, &postgres.ClaimImportJobParams{
+		LockedUntil: lockedUntil,
+		Now:         time.Now(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim import job: %w", err)
+	}
+	return toImportJob(row)
+}
+
+func (r *ImportJobRepositoryImpl) SaveProgress(ctx context.Context, job *repository.ImportJob, fromRows int64, lockedUntil *time.Time) error {
+	rowErrors := make([]importRowError, 0, len(job.RowErrors))
+	for _, e := range job.RowErrors {
+		rowErrors = append(rowErrors, importRowError(e))
+	}
+	data, err := json.Marshal(rowErrors)
+	if err != nil {
+		return fmt.Errorf("failed to encode row errors of import job %s: %w", job.ID, err)
+	}
+	n, err := r.queries.SaveImportJobProgress(ctx, &postgres.SaveImportJobProgressParams{
+		Status:        string(job.Status),
+		RowsProcessed: job.RowsProcessed,
+		RowsImported:  job.RowsImported,
+		ErrorCount:    job.ErrorCount,
+		RowErrors:     data,
+		Error:         nullString(job.Error),
+		LockedUntil:   nullTime(lockedUntil),
+		FinishedAt:    nullTime(job.FinishedAt),
+		ID:            job.ID,
+		FromRows:      fromRows,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save progress of import job %s: %w", job.ID, err)
+	}
+	if n == 0 {
+		return model.ErrImportJobLeaseLost
+	}
+	return nil
+}
+
+func (r *ImportJobRepositoryImpl) Resume(ctx context.Context, id uuid.UUID, lockedUntil *time.Time) error {
+	n, err := r.queries.ResumeImportJob(ctx, &postgres.ResumeImportJobParams{
+		LockedUntil: nullTime(lockedUntil),
+		ID:          id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resume import job %s: %w", id, err)
+	}
+	if n == 0 {
+		if _, err := r.Get(ctx, id); err != nil {
+			return err
+		}
+		return model.ErrImportJobNotResumable
+	}
+	return nil
+}
+
+func toImportJob(row *postgres.ImportJob) (*repository.ImportJob, error) {
+	job := &repository.ImportJob{
+		ID:            row.ID,
+		Format:        model.ImportFormat(row.Format),
+		DryRun:        row.DryRun,
+		ChunkSize:     row.ChunkSize,
+		Status:        model.ImportJobStatus(row.Status),
+		RowsProcessed: row.RowsProcessed,
+		RowsImported:  row.RowsImported,
+		ErrorCount:    row.ErrorCount,
+		Error:         row.Error.String,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+	job.Delimiter, _ = utf8.DecodeRuneInString(row.Delimiter)
+	if err := json.Unmarshal(row.Mapping, &job.Mapping); err != nil {
+		return nil, fmt.Errorf("failed to decode mapping of import job %s: %w", row.ID, err)
+	}
+	var rowErrors []importRowError
+	if err := json.Unmarshal(row.RowErrors, &rowErrors); err != nil {
+		return nil, fmt.Errorf("failed to decode row errors of import job %s: %w", row.ID, err)
+	}
+	for _, e := range rowErrors {
+		job.RowErrors = append(job.RowErrors, repository.ImportRowError(e))
+	}
+	if row.FinishedAt.Valid {
+		job.FinishedAt = &row.FinishedAt.Time
+	}
+	return job, nil
+}
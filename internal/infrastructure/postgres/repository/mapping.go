@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sqlc-dev/pqtype"
+
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+)
+
+// dateTimeLayouts форматы xsd:dateTime, которые встречаются в B2MML документах
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// nullString преобразует строку в sql.NullString (пустая строка - NULL)
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// identifierValue возвращает значение B2MML идентификатора
+func identifierValue(id *b2mml.IdentifierType) string {
+	if id == nil {
+		return ""
+	}
+	return id.Value
+}
+
+// hierarchyScopeID возвращает ID оборудования, задающего иерархический уровень
+func hierarchyScopeID(scope *b2mml.HierarchyScopeType) string {
+	if scope == nil {
+		return ""
+	}
+	return identifierValue(scope.EquipmentID)
+}
+
+// equipmentLevelValue возвращает уровень оборудования в иерархии ISA-95
+func equipmentLevelValue(level *b2mml.EquipmentLevelType) string {
+	if level == nil {
+		return ""
+	}
+	if level.EquipmentLevel1Type != nil && level.Value != "" {
+		return level.Value
+	}
+	if level.OtherValueAttr != nil {
+		return *level.OtherValueAttr
+	}
+	return ""
+}
+
+// descriptionText возвращает первое описание B2MML объекта
+func descriptionText(descriptions []*b2mml.DescriptionType) string {
+	for _, d := range descriptions {
+		if d != nil && d.Value != "" {
+			return d.Value
+		}
+	}
+	return ""
+}
+
+// operationalLocationID возвращает идентификатор операционного расположения
+func operationalLocationID(location *b2mml.ResourceLocationType) string {
+	if location == nil || location.Location == nil {
+		return ""
+	}
+	return location.Location.Value
+}
+
+// parseDateTime преобразует B2MML дату в sql.NullTime
+func parseDateTime(dt *b2mml.DateTimeType) (sql.NullTime, error) {
+	if dt == nil || dt.Value == "" {
+		return sql.NullTime{}, nil
+	}
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, dt.Value); err == nil {
+			return sql.NullTime{Time: t, Valid: true}, nil
+		}
+	}
+	return sql.NullTime{}, fmt.Errorf("invalid date time %q", dt.Value)
+}
+
+// marshalB2MML сериализует B2MML структуру для хранения в JSONB
+func marshalB2MML[T any](data *T) (pqtype.NullRawMessage, error) {
+	if data == nil {
+		return pqtype.NullRawMessage{}, nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return pqtype.NullRawMessage{}, fmt.Errorf("failed to marshal b2mml data: %w", err)
+	}
+	return pqtype.NullRawMessage{RawMessage: raw, Valid: true}, nil
+}
+
+// unmarshalB2MML восстанавливает B2MML структуру из JSONB
+func unmarshalB2MML[T any](raw pqtype.NullRawMessage) (*T, error) {
+	if !raw.Valid || len(raw.RawMessage) == 0 || string(raw.RawMessage) == "null" {
+		return nil, nil
+	}
+	var data T
+	if err := json.Unmarshal(raw.RawMessage, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal b2mml data: %w", err)
+	}
+	return &data, nil
+}
+
+// effectiveDates даты публикации и периода действия B2MML объекта
+type effectiveDates struct {
+	published sql.NullTime
+	start     sql.NullTime
+	end       sql.NullTime
+}
+
+// parseEffectiveDates разбирает даты публикации и периода действия
+func parseEffectiveDates(published, start, end *b2mml.DateTimeType) (effectiveDates, error) {
+	var (
+		d   effectiveDates
+		err error
+	)
+	if d.published, err = parseDateTime(published); err != nil {
+		return d, fmt.Errorf("published date: %w", err)
+	}
+	if d.start, err = parseDateTime(start); err != nil {
+		return d, fmt.Errorf("effective start date: %w", err)
+	}
+	if d.end, err = parseDateTime(end); err != nil {
+		return d, fmt.Errorf("effective end date: %w", err)
+	}
+	return d, nil
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+// OutboxRepositoryImpl реализация хранилища outbox
+type OutboxRepositoryImpl struct {
+	queries *postgres.Queries
+}
+
+// NewOutboxRepository создаёт новое хранилище outbox
+func NewOutboxRepository(queries *postgres.Queries) repository.OutboxRepository {
+	return &OutboxRepositoryImpl{queries: queries}
+}
+
+func (r *OutboxRepositoryImpl) Claim(ctx context.Context, limit int32, lockedUntil time.Time) ([]*repository.OutboxEvent, error) {
+	rows, err := r.queries.ClaimOutboxEvents(ctx, &postgres.ClaimOutboxEventsParams{
+		LockedUntil: lockedUntil,
+		Now:         time.Now(),
+		BatchSize:   limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	return toOutboxEvents(rows), nil
+}
+
+func (r *OutboxRepositoryImpl) MarkDispatched(ctx context.Context, eventID uuid.UUID) error {
+	if err := r.queries.MarkOutboxEventDispatched(ctx, eventID); err != nil {
+		return fmt.Errorf("failed to mark outbox event %s dispatched: %w", eventID, err)
+	}
+	return nil
+}
+
+func (r *OutboxRepositoryImpl) MarkFailed(ctx context.Context, eventID uuid.UUID, nextAttemptAt time.Time, cause error) error {
+	var lastError string
+	if cause != nil {
+		lastError = cause.Error()
+	}
+	err := r.queries.MarkOutboxEventFailed(ctx, &postgres.MarkOutboxEventFailedParams{
+		NextAttemptAt: nextAttemptAt,
+		LastError:     nullString(lastError),
+		EventID:       eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event %s failed: %w", eventID, err)
+	}
+	return nil
+}
+
+func (r *OutboxRepositoryImpl) PurgeDispatched(ctx context.Context, before time.Time) (int64, error) {
+	n, err := r.queries.DeleteDispatchedOutboxEvents(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge dispatched outbox events: %w", err)
+	}
+	return n, nil
+}
+
+func (r *OutboxRepositoryImpl) Stream(ctx context.Context, after repository.StreamPosition, limit int32) ([]*repository.OutboxEvent, error) {
+	rows, err := r.queries.StreamOutboxEvents(ctx, &postgres.StreamOutboxEventsParams{
+		AfterTxid: after.TxID,
+		AfterID:   after.Sequence,
+		BatchSize: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read outbox stream: %w", err)
+	}
+	return toOutboxEvents(rows), nil
+}
+
+func (r *OutboxRepositoryImpl) StreamHead(ctx context.Context) (repository.StreamPosition, error) {
+	txid, err := r.queries.GetOutboxStreamHead(ctx)
+	if err != nil {
+		return repository.StreamPosition{}, fmt.Errorf("failed to get outbox stream head: %w", err)
+	}
+	return repository.StreamPosition{TxID: txid, Sequence: math.MaxInt64}, nil
+}
+
+func (r *OutboxRepositoryImpl) PositionOf(ctx context.Context, sequence int64) (repository.StreamPosition, error) {
+	txid, err := r.queries.GetOutboxStreamPosition(ctx, sequence)
+	if err != nil {
+		return repository.StreamPosition{}, fmt.Errorf("failed to get outbox stream position of %d: %w", sequence, err)
+	}
+	return repository.StreamPosition{TxID: txid, Sequence: sequence}, nil
+}
+
+func toOutboxEvents(rows []*postgres.Outbox) []*repository.OutboxEvent {
+	events := make([]*repository.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, &repository.OutboxEvent{
+			Sequence:      row.ID,
+			TxID:          row.Txid,
+			EventID:       row.EventID,
+			AggregateType: row.AggregateType,
+			AggregateID:   row.AggregateID,
+			EventType:     row.EventType,
+			Payload:       row.Payload,
+			OccurredAt:    row.OccurredAt,
+			Attempts:      row.Attempts,
+			LastError:     row.LastError.String,
+		})
+	}
+	return events
+}
+
+// saveEvents записывает доменные события агрегата в outbox. Вызывается
+// репозиториями агрегатов через те же queries, что и изменение агрегата,
+// поэтому внутри UnitOfWork.Do события фиксируются вместе с ним
+func saveEvents(ctx context.Context, queries *postgres.Queries, events []model.DomainEvent) error {
+	for _, event := range events {
+		payload, err := eventPayload(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s event of %s: %w", event.EventType(), event.AggregateID(), err)
+		}
+		err = queries.CreateOutboxEvent(ctx, &postgres.CreateOutboxEventParams{
+			AggregateType: event.AggregateType(),
+			AggregateID:   event.AggregateID(),
+			EventType:     event.EventType(),
+			Payload:       payload,
+			OccurredAt:    event.OccurredAt(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save %s event of %s: %w", event.EventType(), event.AggregateID(), err)
+		}
+	}
+	return nil
+}
+
+// eventPayload сериализует событие в JSON для колонки outbox.payload
+func eventPayload(event model.DomainEvent) (json.RawMessage, error) {
+	var payload any
+	switch e := event.(type) {
+	case *model.EquipmentCreatedEvent:
+		payload = struct {
+			EquipmentID string `json:"equipment_id"`
+			ClassID     string `json:"class_id,omitempty"`
+		}{e.AggregateID(), e.ClassID().String()}
+	case *model.EquipmentStatusChangedEvent:
+		payload = struct {
+			EquipmentID string `json:"equipment_id"`
+			OldStatus   string `json:"old_status"`
+			NewStatus   string `json:"new_status"`
+		}{e.AggregateID(), string(e.OldStatus()), string(e.NewStatus())}
+	case *model.EquipmentMovedEvent:
+		payload = struct {
+			EquipmentID string  `json:"equipment_id"`
+			OldParentID *string `json:"old_parent_id"`
+			NewParentID *string `json:"new_parent_id"`
+		}{e.AggregateID(), equipmentIDString(e.OldParentID()), equipmentIDString(e.NewParentID())}
+	case *model.EquipmentDeletedEvent:
+		payload = struct {
+			EquipmentID string  `json:"equipment_id"`
+			ParentID    *string `json:"parent_id"`
+			CascadeRoot *string `json:"cascade_root,omitempty"`
+		}{e.AggregateID(), equipmentIDString(e.ParentID()), equipmentIDString(e.CascadeRoot())}
+	case *model.EquipmentRestoredEvent:
+		payload = struct {
+			EquipmentID string  `json:"equipment_id"`
+			CascadeRoot *string `json:"cascade_root,omitempty"`
+		}{e.AggregateID(), equipmentIDString(e.CascadeRoot())}
+	case *model.EquipmentPurgedEvent:
+		payload = struct {
+			EquipmentID string `json:"equipment_id"`
+		}{e.AggregateID()}
+	case *model.EquipmentClassCreatedEvent:
+		payload = struct {
+			ClassID string `json:"class_id"`
+		}{e.AggregateID()}
+	default:
+		return nil, fmt.Errorf("unsupported event type %T", event)
+	}
+	return json.Marshal(payload)
+}
+
+func equipmentIDString(id *model.EquipmentID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
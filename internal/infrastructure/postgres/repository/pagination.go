@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+)
+
+// keysetPage выбирает страницу строк по курсору запроса и формирует курсоры
+// соседних страниц. after выбирает строки после курсора (nil - с начала списка)
+// в порядке (created_at, id) DESC, before - строки перед курсором в обратном
+// порядке. Запрашивается на одну строку больше лимита, чтобы узнать, есть ли
+// следующая страница.
+func keysetPage[R any](
+	req repository.PageRequest,
+	after func(cursor *repository.Cursor, limit int32) ([]R, error),
+	before func(cursor repository.Cursor, limit int32) ([]R, error),
+	key func(R) (time.Time, uuid.UUID),
+) (rows []R, next, prev string, err error) {
+	cursor, err := repository.DecodeCursor(req.Cursor)
+	if err != nil {
+		return nil, "", "", err
+	}
+	if cursor != nil && cursor.Sort != "" {
+		// Курсор выдан для списка с другой сортировкой
+		return nil, "", "", repository.ErrInvalidCursor
+	}
+
+	limit := req.Limit
+	cursorAt := func(row R, backward bool) string {
+		createdAt, id := key(row)
+		return repository.Cursor{CreatedAt: createdAt, ID: id, Backward: backward}.Encode()
+	}
+
+	if cursor == nil || !cursor.Backward {
+		if rows, err = after(cursor, limit+1); err != nil {
+			return nil, "", "", err
+		}
+		hasMore := len(rows) > int(limit)
+		if hasMore {
+			rows = rows[:limit]
+			next = cursorAt(rows[len(rows)-1], false)
+		}
+		if cursor != nil && len(rows) > 0 {
+			prev = cursorAt(rows[0], true)
+		}
+		return rows, next, prev, nil
+	}
+
+	if rows, err = before(*cursor, limit+1); err != nil {
+		return nil, "", "", err
+	}
+	hasMore := len(rows) > int(limit)
+	if hasMore {
+		rows = rows[:limit]
+	}
+	slices.Reverse(rows)
+	if hasMore {
+		prev = cursorAt(rows[0], true)
+	}
+	if len(rows) > 0 {
+		next = cursorAt(rows[len(rows)-1], false)
+	}
+	return rows, next, prev, nil
+}
+
+// afterCursor преобразует курсор в параметры запросов *After
+func afterCursor(cursor *repository.Cursor) (sql.NullTime, uuid.NullUUID) {
+	if cursor == nil {
+		return sql.NullTime{}, uuid.NullUUID{}
+	}
+	return sql.NullTime{Time: cursor.CreatedAt, Valid: true}, uuid.NullUUID{UUID: cursor.ID, Valid: true}
+}
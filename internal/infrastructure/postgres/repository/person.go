@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/model/b2mml"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+// PersonRepositoryImpl реализация репозитория Person
+type PersonRepositoryImpl struct {
+	queries *postgres.Queries
+}
+
+// NewPersonRepository создаёт новый репозиторий Person
+func NewPersonRepository(queries *postgres.Queries) repository.PersonRepository {
+	return &PersonRepositoryImpl{queries: queries}
+}
+
+func (r *PersonRepositoryImpl) Create(ctx context.Context, person *model.Person) error {
+	data := person.GetB2MMLData()
+	b2mmlData, err := marshalB2MML(data)
+	if err != nil {
+		return fmt.Errorf("person %s: %w", person.ID(), err)
+	}
+
+	params := &postgres.CreatePersonParams{
+		ExternalID:    person.ID().String(),
+		PersonName:    nullString(person.Name()),
+		B2mmlData:     b2mmlData,
+		RecordVersion: person.Version(),
+	}
+	if data != nil {
+		params.Version = nullString(identifierValue(data.Version))
+		params.HierarchyScopeID = nullString(hierarchyScopeID(data.HierarchyScope))
+	}
+
+	if _, err := r.queries.CreatePerson(ctx, params); err != nil {
+		return fmt.Errorf("failed to create person %s: %w", person.ID(), err)
+	}
+	return nil
+}
+
+func (r *PersonRepositoryImpl) GetByExternalID(ctx context.Context, externalID string) (*model.Person, error) {
+	row, err := r.queries.GetPersonByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, personError(err)
+	}
+	return r.toDomain(row)
+}
+
+func (r *PersonRepositoryImpl) List(ctx context.Context, limit int32, offset int32) ([]*model.Person, error) {
+	rows, err := r.queries.ListPersons(ctx, &postgres.ListPersonsParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persons: %w", err)
+	}
+
+	persons := make([]*model.Person, 0, len(rows))
+	for _, row := range rows {
+		p, err := r.toDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		persons = append(persons, p)
+	}
+	return persons, nil
+}
+
+// toDomain восстанавливает агрегат Person из строки БД
+func (r *PersonRepositoryImpl) toDomain(row *postgres.Person) (*model.Person, error) {
+	id, err := model.NewPersonID(row.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := unmarshalB2MML[b2mml.PersonType](row.B2mmlData)
+	if err != nil {
+		return nil, fmt.Errorf("person %s: %w", row.ExternalID, err)
+	}
+	if data == nil {
+		data = &b2mml.PersonType{ID: &b2mml.IdentifierType{Value: row.ExternalID}}
+		if row.PersonName.Valid {
+			data.PersonName = &b2mml.PersonNameType{Value: row.PersonName.String}
+		}
+	}
+	return model.RestorePerson(id, data, row.RecordVersion), nil
+}
+
+// personError преобразует ошибки БД в доменные ошибки Person
+func personError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ErrPersonNotFound
+	}
+	return err
+}
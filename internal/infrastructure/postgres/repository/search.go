@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+// Маркеры совпадений, которыми SearchCatalog выделяет слова в headline
+const (
+	highlightStart = '\x02'
+	highlightEnd   = '\x03'
+)
+
+// SearchRepositoryImpl реализация полнотекстового поиска на tsvector
+type SearchRepositoryImpl struct {
+	queries *postgres.Queries
+}
+
+// NewSearchRepository создаёт новый репозиторий поиска
+func NewSearchRepository(queries *postgres.Queries) repository.SearchRepository {
+	return &SearchRepositoryImpl{queries: queries}
+}
+
+func (r *SearchRepositoryImpl) Search(ctx context.Context, query repository.SearchQuery) ([]*repository.SearchHit, error) {
+	kinds := make([]string, 0, len(query.Kinds))
+	for _, kind := range query.Kinds {
+		kinds = append(kinds, string(kind))
+	}
+
+	rows, err := r.queries.SearchCatalog(ctx, &postgres.SearchCatalogParams{
+		Query:     prefixTSQuery(query.Terms),
+		Language:  query.Language,
+		Kinds:     kinds,
+		PageLimit: query.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+
+	hits := make([]*repository.SearchHit, 0, len(rows))
+	for _, row := range rows {
+		hits = append(hits, &repository.SearchHit{
+			Kind:        repository.SearchKind(row.Kind),
+			ID:          row.ExternalID,
+			EquipmentID: row.RelatedID,
+			Title:       row.Title,
+			Highlight:   parseHeadline(row.Headline),
+			Rank:        row.Rank,
+		})
+	}
+	return hits, nil
+}
+
+// prefixTSQuery собирает выражение to_tsquery, в котором все слова
+// обязательны и совпадают по префиксу. Слова экранируются как строковые
+// литералы tsquery, поэтому операторы во вводе пользователя не действуют
+func prefixTSQuery(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		term = strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(term)
+		parts = append(parts, "'"+term+"':*")
+	}
+	return strings.Join(parts, " & ")
+}
+
+// parseHeadline разбивает результат ts_headline на сегменты по маркерам совпадений
+func parseHeadline(headline string) []repository.HighlightSegment {
+	var (
+		segments []repository.HighlightSegment
+		current  strings.Builder
+		match    bool
+	)
+	flush := func() {
+		if current.Len() > 0 {
+			segments = append(segments, repository.HighlightSegment{Text: current.String(), Match: match})
+			current.Reset()
+		}
+	}
+	for _, r := range headline {
+		switch r {
+		case highlightStart:
+			flush()
+			match = true
+		case highlightEnd:
+			flush()
+			match = false
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return segments
+}
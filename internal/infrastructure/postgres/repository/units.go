@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+// SiteUnitRepositoryImpl реализация репозитория предпочтительных единиц площадок
+type SiteUnitRepositoryImpl struct {
+	queries *postgres.Queries
+}
+
+// NewSiteUnitRepository создаёт новый репозиторий предпочтительных единиц площадок
+func NewSiteUnitRepository(queries *postgres.Queries) repository.SiteUnitRepository {
+	return &SiteUnitRepositoryImpl{queries: queries}
+}
+
+func (r *SiteUnitRepositoryImpl) Get(ctx context.Context, siteID model.EquipmentID) (model.UnitPreferences, error) {
+	rows, err := r.queries.ListSiteUnits(ctx, siteID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list units of site %s: %w", siteID, err)
+	}
+	prefs := make(model.UnitPreferences, len(rows))
+	for _, row := range rows {
+		prefs[model.QuantityKind(row.QuantityKind)] = row.UnitCode
+	}
+	return prefs, nil
+}
+
+// Replace удаляет прежние единицы площадки и сохраняет новые.
+// Должен вызываться в транзакции (UnitOfWork.Do)
+func (r *SiteUnitRepositoryImpl) Replace(ctx context.Context, siteID model.EquipmentID, prefs model.UnitPreferences) error {
+	if err := r.queries.DeleteSiteUnits(ctx, siteID.String()); err != nil {
+		return fmt.Errorf("failed to delete units of site %s: %w", siteID, err)
+	}
+	for kind, code := range prefs {
+		err := r.queries.CreateSiteUnit(ctx, &postgres.CreateSiteUnitParams{
+			SiteID:       siteID.String(),
+			QuantityKind: string(kind),
+			UnitCode:     code,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save %s unit of site %s: %w", kind, siteID, err)
+		}
+	}
+	return nil
+}
+
+func (r *SiteUnitRepositoryImpl) ForEquipment(ctx context.Context, externalID string) (*model.EquipmentID, model.UnitPreferences, error) {
+	rows, err := r.queries.ListEquipmentSiteUnits(ctx, externalID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find site units of equipment %s: %w", externalID, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil, nil
+	}
+
+	siteID, err := model.NewEquipmentID(rows[0].SiteExternalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	prefs := make(model.UnitPreferences, len(rows))
+	for _, row := range rows {
+		if row.QuantityKind.Valid {
+			prefs[model.QuantityKind(row.QuantityKind.String)] = row.UnitCode.String
+		}
+	}
+	return &siteID, prefs, nil
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+const (
+	// maxTxAttempts максимальное число попыток выполнить транзакцию в Do
+	maxTxAttempts = 5
+	// txRetryBackoff базовая задержка между попытками
+	txRetryBackoff = 20 * time.Millisecond
+)
+
+var (
+	// ErrTxNotStarted возвращается при Commit/Rollback вне транзакции
+	ErrTxNotStarted = errors.New("transaction not started")
+	// ErrTxAlreadyStarted возвращается при попытке начать вложенную транзакцию
+	ErrTxAlreadyStarted = errors.New("transaction already started")
+)
+
+// UnitOfWorkImpl реализация UnitOfWork поверх sql.Tx
+type UnitOfWorkImpl struct {
+	db                 *sql.DB
+	tx                 *sql.Tx
+	queries            *postgres.Queries
+	equipmentRepo      repository.EquipmentRepository
+	equipmentClassRepo repository.EquipmentClassRepository
+	personRepo         repository.PersonRepository
+	siteUnitRepo       repository.SiteUnitRepository
+	importJobRepo      repository.ImportJobRepository
+}
+
+// NewUnitOfWork создаёт новый UnitOfWork.
+// Репозитории, полученные до Begin, работают вне транзакции.
+func NewUnitOfWork(db *sql.DB, queries *postgres.Queries) repository.UnitOfWork {
+	return newUnitOfWork(db, nil, queries)
+}
+
+func newUnitOfWork(db *sql.DB, tx *sql.Tx, queries *postgres.Queries) *UnitOfWorkImpl {
+	return &UnitOfWorkImpl{
+		db:                 db,
+		tx:                 tx,
+		queries:            queries,
+		equipmentRepo:      NewEquipmentRepository(queries),
+		equipmentClassRepo: NewEquipmentClassRepository(queries),
+		personRepo:         NewPersonRepository(queries),
+		siteUnitRepo:       NewSiteUnitRepository(queries),
+		importJobRepo:      NewImportJobRepository(queries),
+	}
+}
+
+func (u *UnitOfWorkImpl) Equipment() repository.EquipmentRepository {
+	return u.equipmentRepo
+}
+
+func (u *UnitOfWorkImpl) EquipmentClass() repository.EquipmentClassRepository {
+	return u.equipmentClassRepo
+}
+
+func (u *UnitOfWorkImpl) Person() repository.PersonRepository {
+	return u.personRepo
+}
+
+func (u *UnitOfWorkImpl) SiteUnits() repository.SiteUnitRepository {
+	return u.siteUnitRepo
+}
+
+func (u *UnitOfWorkImpl) ImportJobs() repository.ImportJobRepository {
+	return u.importJobRepo
+}
+
+// Begin открывает транзакцию и возвращает UnitOfWork, репозитории которого
+// работают в её рамках
+func (u *UnitOfWorkImpl) Begin(ctx context.Context) (repository.UnitOfWork, error) {
+	return u.begin(ctx, nil)
+}
+
+// BeginReadOnly открывает транзакцию REPEATABLE READ только для чтения
+func (u *UnitOfWorkImpl) BeginReadOnly(ctx context.Context) (repository.UnitOfWork, error) {
+	return u.begin(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+}
+
+func (u *UnitOfWorkImpl) begin(ctx context.Context, opts *sql.TxOptions) (*UnitOfWorkImpl, error) {
+	if u.tx != nil {
+		return nil, ErrTxAlreadyStarted
+	}
+	tx, err := u.db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return newUnitOfWork(u.db, tx, u.queries.WithTx(tx)), nil
+}
+
+func (u *UnitOfWorkImpl) Commit(ctx context.Context) error {
+	if u.tx == nil {
+		return ErrTxNotStarted
+	}
+	if err := u.tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (u *UnitOfWorkImpl) Rollback(ctx context.Context) error {
+	if u.tx == nil {
+		return ErrTxNotStarted
+	}
+	if err := u.tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		return fmt.Errorf("failed to rollback transaction: %w", err)
+	}
+	return nil
+}
+
+// Do выполняет fn в сериализуемой транзакции. Транзакция фиксируется, если fn
+// завершилась без ошибки, иначе откатывается. При конфликте сериализации
+// Postgres вся транзакция повторяется заново.
+func (u *UnitOfWorkImpl) Do(ctx context.Context, fn func(repository.UnitOfWork) error) error {
+	var err error
+	for attempt := 1; attempt <= maxTxAttempts; attempt++ {
+		err = u.do(ctx, fn)
+		if err == nil || !isSerializationFailure(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * txRetryBackoff):
+		}
+	}
+	return fmt.Errorf("transaction failed after %d attempts: %w", maxTxAttempts, err)
+}
+
+func (u *UnitOfWorkImpl) do(ctx context.Context, fn func(repository.UnitOfWork) error) error {
+	txUoW, err := u.begin(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return err
+	}
+	// Паника в fn не должна оставлять транзакцию открытой до конца контекста
+	defer func() {
+		if p := recover(); p != nil {
+			txUoW.Rollback(ctx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(txUoW); err != nil {
+		if rbErr := txUoW.Rollback(ctx); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+	return txUoW.Commit(ctx)
+}
+
+// isSerializationFailure проверяет, что транзакция прервана из-за конфликта
+// сериализации или взаимной блокировки и может быть повторена
+func isSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	switch pqErr.Code {
+	case "40001", // serialization_failure
+		"40P01": // deadlock_detected
+		return true
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/grnsv/go-cmms/internal/domain/model"
+	"github.com/grnsv/go-cmms/internal/domain/repository"
+	postgres "github.com/grnsv/go-cmms/internal/infrastructure/postgres/sqlc"
+)
+
+// WebhookRepositoryImpl реализация хранилища подписок и доставок webhook
+type WebhookRepositoryImpl struct {
+	queries *postgres.Queries
+}
+
+// NewWebhookRepository создаёт новое хранилище подписок webhook
+func NewWebhookRepository(queries *postgres.Queries) repository.WebhookRepository {
+	return &WebhookRepositoryImpl{queries: queries}
+}
+
+func (r *WebhookRepositoryImpl) Create(ctx context.Context, subscription *model.WebhookSubscription) error {
+	err := r.queries.CreateWebhookSubscription(ctx, &postgres.CreateWebhookSubscriptionParams{
+		ID:          subscription.ID(),
+		Url:         subscription.URL(),
+		Secret:      subscription.Secret(),
+		EventTypes:  subscription.EventTypes(),
+		Description: nullString(subscription.Description()),
+		Active:      subscription.Active(),
+		CreatedAt:   subscription.CreatedAt(),
+		UpdatedAt:   subscription.UpdatedAt(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create webhook subscription %s: %w", subscription.ID(), err)
+	}
+	return nil
+}
+
+func (r *WebhookRepositoryImpl) Get(ctx context.Context, id uuid.UUID) (*model.WebhookSubscription, error) {
+	row, err := r.queries.GetWebhookSubscription(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, model.ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook subscription %s: %w", id, err)
+	}
+	return toWebhookSubscription(row), nil
+}
+
+func (r *WebhookRepositoryImpl) List(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	rows, err := r.queries.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	return toWebhookSubscriptions(rows), nil
+}
+
+func (r *WebhookRepositoryImpl) ListActive(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	rows, err := r.queries.ListActiveWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active webhook subscriptions: %w", err)
+	}
+	return toWebhookSubscriptions(rows), nil
+}
+
+func (r *WebhookRepositoryImpl) Update(ctx context.Context, subscription *model.WebhookSubscription) error {
+	n, err := r.queries.UpdateWebhookSubscription(ctx, &postgres.UpdateWebhookSubscriptionParams{
+		Url:         subscription.URL(),
+		EventTypes:  subscription.EventTypes(),
+		Description: nullString(subscription.Description()),
+		Active:      subscription.Active(),
+		UpdatedAt:   subscription.UpdatedAt(),
+		ID:          subscription.ID(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update webhook subscription %s: %w", subscription.ID(), err)
+	}
+	if n == 0 {
+		return model.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *WebhookRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	n, err := r.queries.DeleteWebhookSubscription(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription %s: %w", id, err)
+	}
+	if n == 0 {
+		return model.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *WebhookRepositoryImpl) Enqueue(ctx context.Context, deliveries ...*repository.WebhookDelivery) error {
+	for _, d := range deliveries {
+		err := r.queries.CreateWebhookDelivery(ctx, &postgres.CreateWebhookDeliveryParams{
+			SubscriptionID: d.SubscriptionID,
+			EventID:        d.EventID,
+			EventType:      d.EventType,
+			Payload:        d.Payload,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to enqueue event %s for webhook %s: %w", d.EventID, d.SubscriptionID, err)
+		}
+	}
+	return nil
+}
+
+func (r *WebhookRepositoryImpl) ClaimDeliveries(ctx context.Context, limit int32, lockedUntil time.Time) ([]*repository.WebhookDelivery, error) {
+	rows, err := r.queries.ClaimWebhookDeliveries(ctx, &postgres.ClaimWebhookDeliveriesParams{
+		LockedUntil: lockedUntil,
+		Now:         time.Now(),
+		BatchSize:   limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	return toWebhookDeliveries(rows), nil
+}
+
+func (r *WebhookRepositoryImpl) MarkDelivered(ctx context.Context, id uuid.UUID, responseStatus int) error {
+	err := r.queries.MarkWebhookDeliveryDelivered(ctx, &postgres.MarkWebhookDeliveryDeliveredParams{
+		ResponseStatus: nullInt32(responseStatus),
+		ID:             id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark webhook delivery %s delivered: %w", id, err)
+	}
+	return nil
+}
+
+func (r *WebhookRepositoryImpl) MarkFailed(ctx context.Context, id uuid.UUID, status model.WebhookDeliveryStatus, nextAttemptAt time.Time, responseStatus int, cause error) error {
+	var lastError string
+	if cause != nil {
+		lastError = cause.Error()
+	}
+	err := r.queries.MarkWebhookDeliveryFailed(ctx, &postgres.MarkWebhookDeliveryFailedParams{
+		Status:         string(status),
+		ResponseStatus: nullInt32(responseStatus),
+		LastError:      nullString(lastError),
+		NextAttemptAt:  nextAttemptAt,
+		ID:             id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark webhook delivery %s failed: %w", id, err)
+	}
+	return nil
+}
+
+func (r *WebhookRepositoryImpl) GetDelivery(ctx context.Context, id uuid.UUID) (*repository.WebhookDelivery, error) {
+	row, err := r.queries.GetWebhookDelivery(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, model.ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery %s: %w", id, err)
+	}
+	return toWebhookDelivery(row), nil
+}
+
+func (r *WebhookRepositoryImpl) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, status model.WebhookDeliveryStatus, limit int32) ([]*repository.WebhookDelivery, error) {
+	rows, err := r.queries.ListWebhookDeliveries(ctx, &postgres.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		Status:         nullString(string(status)),
+		PageLimit:      limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deliveries of webhook %s: %w", subscriptionID, err)
+	}
+	return toWebhookDeliveries(rows), nil
+}
+
+func (r *WebhookRepositoryImpl) Replay(ctx context.Context, id uuid.UUID) error {
+	n, err := r.queries.ReplayWebhookDelivery(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to replay webhook delivery %s: %w", id, err)
+	}
+	if n == 0 {
+		if _, err := r.GetDelivery(ctx, id); err != nil {
+			return err
+		}
+		return model.ErrWebhookDeliveryNotDead
+	}
+	return nil
+}
+
+func (r *WebhookRepositoryImpl) PurgeDelivered(ctx context.Context, before time.Time) (int64, error) {
+	n, err := r.queries.DeleteDeliveredWebhookDeliveries(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge delivered webhook deliveries: %w", err)
+	}
+	return n, nil
+}
+
+func toWebhookSubscription(row *postgres.WebhookSubscription) *model.WebhookSubscription {
+	return model.RestoreWebhookSubscription(
+		row.ID,
+		row.Url,
+		row.Secret,
+		row.EventTypes,
+		row.Description.String,
+		row.Active,
+		row.CreatedAt,
+		row.UpdatedAt,
+	)
+}
+
+func toWebhookSubscriptions(rows []*postgres.WebhookSubscription) []*model.WebhookSubscription {
+	subscriptions := make([]*model.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		subscriptions = append(subscriptions, toWebhookSubscription(row))
+	}
+	return subscriptions
+}
+
+func toWebhookDelivery(row *postgres.WebhookDelivery) *repository.WebhookDelivery {
+	d := &repository.WebhookDelivery{
+		ID:             row.ID,
+		SubscriptionID: row.SubscriptionID,
+		EventID:        row.EventID,
+		EventType:      row.EventType,
+		Payload:        row.Payload,
+		Status:         model.WebhookDeliveryStatus(row.Status),
+		Attempts:       row.Attempts,
+		NextAttemptAt:  row.NextAttemptAt,
+		LastError:      row.LastError.String,
+		ResponseStatus: int(row.ResponseStatus.Int32),
+		CreatedAt:      row.CreatedAt,
+	}
+	if row.DeliveredAt.Valid {
+		d.DeliveredAt = &row.DeliveredAt.Time
+	}
+	return d
+}
+
+func toWebhookDeliveries(rows []*postgres.WebhookDelivery) []*repository.WebhookDelivery {
+	deliveries := make([]*repository.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, toWebhookDelivery(row))
+	}
+	return deliveries
+}
+
+func nullInt32(v int) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(v), Valid: v != 0}
+}
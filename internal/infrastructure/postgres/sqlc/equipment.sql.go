@@ -8,8 +8,11 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/sqlc-dev/pqtype"
 )
 
@@ -17,29 +20,33 @@ const addEquipmentToClass = `-- name: AddEquipmentToClass :one
 
 INSERT INTO equipment_class_mappings (
     equipment_id,
-    equipment_class_id
-) VALUES ($1, $2)
+    equipment_class_id,
+    position
+) VALUES ($1, $2, $3)
 RETURNING
     id,
     equipment_id,
     equipment_class_id,
-    created_at
+    created_at,
+    position
 `
 
 type AddEquipmentToClassParams struct {
 	EquipmentID      uuid.UUID `db:"equipment_id" json:"equipment_id"`
 	EquipmentClassID uuid.UUID `db:"equipment_class_id" json:"equipment_class_id"`
+	Position         int32     `db:"position" json:"position"`
 }
 
 // Equipment Class Mappings queries
 func (q *Queries) AddEquipmentToClass(ctx context.Context, arg *AddEquipmentToClassParams) (*EquipmentClassMapping, error) {
-	row := q.db.QueryRowContext(ctx, addEquipmentToClass, arg.EquipmentID, arg.EquipmentClassID)
+	row := q.db.QueryRowContext(ctx, addEquipmentToClass, arg.EquipmentID, arg.EquipmentClassID, arg.Position)
 	var i EquipmentClassMapping
 	err := row.Scan(
 		&i.ID,
 		&i.EquipmentID,
 		&i.EquipmentClassID,
 		&i.CreatedAt,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -59,9 +66,11 @@ INSERT INTO equipment (
     physical_asset_id,
     operational_location_id,
     parent_equipment_id,
-    b2mml_data
+    b2mml_data,
+    record_version,
+    position
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 )
 RETURNING
     id,
@@ -81,7 +90,8 @@ RETURNING
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 `
 
 type CreateEquipmentParams struct {
@@ -98,6 +108,8 @@ type CreateEquipmentParams struct {
 	OperationalLocationID sql.NullString        `db:"operational_location_id" json:"operational_location_id"`
 	ParentEquipmentID     uuid.NullUUID         `db:"parent_equipment_id" json:"parent_equipment_id"`
 	B2mmlData             pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
+	RecordVersion         int64                 `db:"record_version" json:"record_version"`
+	Position              int32                 `db:"position" json:"position"`
 }
 
 // Equipment queries
@@ -116,6 +128,8 @@ func (q *Queries) CreateEquipment(ctx context.Context, arg *CreateEquipmentParam
 		arg.OperationalLocationID,
 		arg.ParentEquipmentID,
 		arg.B2mmlData,
+		arg.RecordVersion,
+		arg.Position,
 	)
 	var i Equipment
 	err := row.Scan(
@@ -137,6 +151,7 @@ func (q *Queries) CreateEquipment(ctx context.Context, arg *CreateEquipmentParam
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.RecordVersion,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -153,9 +168,10 @@ INSERT INTO equipment_classes (
     hierarchy_scope_id,
     equipment_level,
     parent_class_id,
-    b2mml_data
+    b2mml_data,
+    position
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
 RETURNING
     id,
@@ -171,7 +187,8 @@ RETURNING
     b2mml_data,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    position
 `
 
 type CreateEquipmentClassParams struct {
@@ -185,6 +202,7 @@ type CreateEquipmentClassParams struct {
 	EquipmentLevel     sql.NullString        `db:"equipment_level" json:"equipment_level"`
 	ParentClassID      uuid.NullUUID         `db:"parent_class_id" json:"parent_class_id"`
 	B2mmlData          pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
+	Position           int32                 `db:"position" json:"position"`
 }
 
 // Equipment Classes queries
@@ -200,6 +218,7 @@ func (q *Queries) CreateEquipmentClass(ctx context.Context, arg *CreateEquipment
 		arg.EquipmentLevel,
 		arg.ParentClassID,
 		arg.B2mmlData,
+		arg.Position,
 	)
 	var i EquipmentClass
 	err := row.Scan(
@@ -217,6 +236,7 @@ func (q *Queries) CreateEquipmentClass(ctx context.Context, arg *CreateEquipment
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -229,8 +249,9 @@ INSERT INTO equipment_class_properties (
     description,
     property_type,
     parent_property_id,
-    b2mml_data
-) VALUES ($1, $2, $3, $4, $5, $6)
+    b2mml_data,
+    position
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING
     id,
     equipment_class_id,
@@ -240,7 +261,8 @@ RETURNING
     parent_property_id,
     b2mml_data,
     created_at,
-    updated_at
+    updated_at,
+    position
 `
 
 type CreateEquipmentClassPropertyParams struct {
@@ -250,6 +272,7 @@ type CreateEquipmentClassPropertyParams struct {
 	PropertyType     sql.NullString        `db:"property_type" json:"property_type"`
 	ParentPropertyID uuid.NullUUID         `db:"parent_property_id" json:"parent_property_id"`
 	B2mmlData        pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
+	Position         int32                 `db:"position" json:"position"`
 }
 
 // Equipment Class Properties queries
@@ -261,6 +284,7 @@ func (q *Queries) CreateEquipmentClassProperty(ctx context.Context, arg *CreateE
 		arg.PropertyType,
 		arg.ParentPropertyID,
 		arg.B2mmlData,
+		arg.Position,
 	)
 	var i EquipmentClassProperty
 	err := row.Scan(
@@ -273,6 +297,7 @@ func (q *Queries) CreateEquipmentClassProperty(ctx context.Context, arg *CreateE
 		&i.B2mmlData,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -287,8 +312,9 @@ INSERT INTO equipment_properties (
     property_data_type,
     property_unit,
     description,
-    b2mml_data
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    b2mml_data,
+    position
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING
     id,
     equipment_id,
@@ -300,7 +326,8 @@ RETURNING
     description,
     b2mml_data,
     created_at,
-    updated_at
+    updated_at,
+    position
 `
 
 type CreateEquipmentPropertyParams struct {
@@ -312,6 +339,7 @@ type CreateEquipmentPropertyParams struct {
 	PropertyUnit     sql.NullString        `db:"property_unit" json:"property_unit"`
 	Description      sql.NullString        `db:"description" json:"description"`
 	B2mmlData        pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
+	Position         int32                 `db:"position" json:"position"`
 }
 
 // Equipment Properties queries
@@ -325,6 +353,7 @@ func (q *Queries) CreateEquipmentProperty(ctx context.Context, arg *CreateEquipm
 		arg.PropertyUnit,
 		arg.Description,
 		arg.B2mmlData,
+		arg.Position,
 	)
 	var i EquipmentProperty
 	err := row.Scan(
@@ -339,19 +368,35 @@ func (q *Queries) CreateEquipmentProperty(ctx context.Context, arg *CreateEquipm
 		&i.B2mmlData,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Position,
 	)
 	return &i, err
 }
 
-const deleteEquipment = `-- name: DeleteEquipment :exec
+const deleteEquipment = `-- name: DeleteEquipment :one
 UPDATE equipment
-SET deleted_at = NOW()
-WHERE id = $1
+SET
+    deleted_at = NOW(),
+    record_version = $1,
+    updated_at = NOW()
+WHERE id = $2
+    AND record_version = $3
+    AND deleted_at IS NULL
+RETURNING id
 `
 
-func (q *Queries) DeleteEquipment(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteEquipment, id)
-	return err
+type DeleteEquipmentParams struct {
+	RecordVersion   int64     `db:"record_version" json:"record_version"`
+	ID              uuid.UUID `db:"id" json:"id"`
+	ExpectedVersion int64     `db:"expected_version" json:"expected_version"`
+}
+
+// Помечает оборудование удалённым, если сохранённая версия совпадает с ожидаемой
+func (q *Queries) DeleteEquipment(ctx context.Context, arg *DeleteEquipmentParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, deleteEquipment, arg.RecordVersion, arg.ID, arg.ExpectedVersion)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
 }
 
 const deleteEquipmentClass = `-- name: DeleteEquipmentClass :exec
@@ -365,6 +410,16 @@ func (q *Queries) DeleteEquipmentClass(ctx context.Context, id uuid.UUID) error
 	return err
 }
 
+const deleteEquipmentClassProperty = `-- name: DeleteEquipmentClassProperty :exec
+DELETE FROM equipment_class_properties
+WHERE id = $1
+`
+
+func (q *Queries) DeleteEquipmentClassProperty(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteEquipmentClassProperty, id)
+	return err
+}
+
 const deleteEquipmentProperty = `-- name: DeleteEquipmentProperty :exec
 DELETE FROM equipment_properties
 WHERE id = $1
@@ -394,7 +449,8 @@ SELECT
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 FROM equipment
 WHERE external_id = $1 AND deleted_at IS NULL
 `
@@ -421,6 +477,7 @@ func (q *Queries) GetEquipmentByExternalID(ctx context.Context, externalID strin
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.RecordVersion,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -444,7 +501,8 @@ SELECT
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 FROM equipment
 WHERE id = $1 AND deleted_at IS NULL
 `
@@ -471,6 +529,7 @@ func (q *Queries) GetEquipmentByID(ctx context.Context, id uuid.UUID) (*Equipmen
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.RecordVersion,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -490,7 +549,8 @@ SELECT
     b2mml_data,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    position
 FROM equipment_classes
 WHERE external_id = $1 AND deleted_at IS NULL
 `
@@ -513,6 +573,7 @@ func (q *Queries) GetEquipmentClassByExternalID(ctx context.Context, externalID
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -532,7 +593,8 @@ SELECT
     b2mml_data,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    position
 FROM equipment_classes
 WHERE id = $1 AND deleted_at IS NULL
 `
@@ -555,11 +617,35 @@ func (q *Queries) GetEquipmentClassByID(ctx context.Context, id uuid.UUID) (*Equ
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Position,
 	)
 	return &i, err
 }
 
-const listAllEquipmentClasses = `-- name: ListAllEquipmentClasses :many
+const getEquipmentRef = `-- name: GetEquipmentRef :one
+SELECT
+    external_id,
+    record_version,
+    COALESCE(b2mml_data -> 'EquipmentLevel', 'null')::jsonb AS equipment_level
+FROM equipment
+WHERE external_id = $1 AND deleted_at IS NULL
+`
+
+type GetEquipmentRefRow struct {
+	ExternalID     string          `db:"external_id" json:"external_id"`
+	RecordVersion  int64           `db:"record_version" json:"record_version"`
+	EquipmentLevel json.RawMessage `db:"equipment_level" json:"equipment_level"`
+}
+
+// Внешний ID, версия и уровень оборудования без загрузки агрегата
+func (q *Queries) GetEquipmentRef(ctx context.Context, externalID string) (*GetEquipmentRefRow, error) {
+	row := q.db.QueryRowContext(ctx, getEquipmentRef, externalID)
+	var i GetEquipmentRefRow
+	err := row.Scan(&i.ExternalID, &i.RecordVersion, &i.EquipmentLevel)
+	return &i, err
+}
+
+const listChildEquipment = `-- name: ListChildEquipment :many
 SELECT
     id,
     external_id,
@@ -570,24 +656,88 @@ SELECT
     effective_end_date,
     hierarchy_scope_id,
     equipment_level,
-    parent_class_id,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
     b2mml_data,
     created_at,
     updated_at,
-    deleted_at
-FROM equipment_classes
-WHERE deleted_at IS NULL
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+    deleted_at,
+    record_version,
+    position
+FROM equipment
+WHERE parent_equipment_id = $1 AND deleted_at IS NULL
+ORDER BY position, created_at
 `
 
-type ListAllEquipmentClassesParams struct {
-	Limit  int32 `db:"limit" json:"limit"`
-	Offset int32 `db:"offset" json:"offset"`
+func (q *Queries) ListChildEquipment(ctx context.Context, parentEquipmentID uuid.NullUUID) ([]*Equipment, error) {
+	rows, err := q.db.QueryContext(ctx, listChildEquipment, parentEquipmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Equipment{}
+	for rows.Next() {
+		var i Equipment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExternalID,
+			&i.Version,
+			&i.Description,
+			&i.PublishedDate,
+			&i.EffectiveStartDate,
+			&i.EffectiveEndDate,
+			&i.HierarchyScopeID,
+			&i.EquipmentLevel,
+			&i.OperatingStatus,
+			&i.PhysicalAssetID,
+			&i.OperationalLocationID,
+			&i.ParentEquipmentID,
+			&i.B2mmlData,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.RecordVersion,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) ListAllEquipmentClasses(ctx context.Context, arg *ListAllEquipmentClassesParams) ([]*EquipmentClass, error) {
-	rows, err := q.db.QueryContext(ctx, listAllEquipmentClasses, arg.Limit, arg.Offset)
+const listChildEquipmentClasses = `-- name: ListChildEquipmentClasses :many
+SELECT
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    parent_class_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    position
+FROM equipment_classes
+WHERE parent_class_id = $1 AND deleted_at IS NULL
+ORDER BY position, created_at
+`
+
+func (q *Queries) ListChildEquipmentClasses(ctx context.Context, parentClassID uuid.NullUUID) ([]*EquipmentClass, error) {
+	rows, err := q.db.QueryContext(ctx, listChildEquipmentClasses, parentClassID)
 	if err != nil {
 		return nil, err
 	}
@@ -610,6 +760,7 @@ func (q *Queries) ListAllEquipmentClasses(ctx context.Context, arg *ListAllEquip
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Position,
 		); err != nil {
 			return nil, err
 		}
@@ -624,7 +775,7 @@ func (q *Queries) ListAllEquipmentClasses(ctx context.Context, arg *ListAllEquip
 	return items, nil
 }
 
-const listChildEquipment = `-- name: ListChildEquipment :many
+const listEquipmentAfter = `-- name: ListEquipmentAfter :many
 SELECT
     id,
     external_id,
@@ -643,14 +794,24 @@ SELECT
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 FROM equipment
-WHERE parent_equipment_id = $1 AND deleted_at IS NULL
-ORDER BY created_at
+WHERE deleted_at IS NULL
+  AND ($1::timestamptz IS NULL
+       OR (created_at, id) < ($1::timestamptz, $2::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $3
 `
 
-func (q *Queries) ListChildEquipment(ctx context.Context, parentEquipmentID uuid.NullUUID) ([]*Equipment, error) {
-	rows, err := q.db.QueryContext(ctx, listChildEquipment, parentEquipmentID)
+type ListEquipmentAfterParams struct {
+	CursorCreatedAt sql.NullTime  `db:"cursor_created_at" json:"cursor_created_at"`
+	CursorID        uuid.NullUUID `db:"cursor_id" json:"cursor_id"`
+	PageLimit       int32         `db:"page_limit" json:"page_limit"`
+}
+
+func (q *Queries) ListEquipmentAfter(ctx context.Context, arg *ListEquipmentAfterParams) ([]*Equipment, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentAfter, arg.CursorCreatedAt, arg.CursorID, arg.PageLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -677,6 +838,7 @@ func (q *Queries) ListChildEquipment(ctx context.Context, parentEquipmentID uuid
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.RecordVersion,
+			&i.Position,
 		); err != nil {
 			return nil, err
 		}
@@ -691,7 +853,48 @@ func (q *Queries) ListChildEquipment(ctx context.Context, parentEquipmentID uuid
 	return items, nil
 }
 
-const listEquipment = `-- name: ListEquipment :many
+const listEquipmentAncestors = `-- name: ListEquipmentAncestors :many
+WITH RECURSIVE ancestors AS (
+    SELECT node.parent_equipment_id AS ancestor_id, 1 AS depth
+    FROM equipment node
+    WHERE node.id = $1
+    UNION ALL
+    SELECT parent.parent_equipment_id, a.depth + 1
+    FROM equipment parent
+    JOIN ancestors a ON parent.id = a.ancestor_id
+    WHERE a.depth < 1000
+)
+SELECT equipment.external_id
+FROM ancestors
+JOIN equipment ON equipment.id = ancestors.ancestor_id
+ORDER BY ancestors.depth DESC
+`
+
+// Внешние ID предков оборудования от корня иерархии до непосредственного родителя
+func (q *Queries) ListEquipmentAncestors(ctx context.Context, id uuid.UUID) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentAncestors, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var external_id string
+		if err := rows.Scan(&external_id); err != nil {
+			return nil, err
+		}
+		items = append(items, external_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEquipmentBefore = `-- name: ListEquipmentBefore :many
 SELECT
     id,
     external_id,
@@ -710,20 +913,108 @@ SELECT
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 FROM equipment
 WHERE deleted_at IS NULL
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+  AND (created_at, id) > ($1::timestamptz, $2::uuid)
+ORDER BY created_at, id
+LIMIT $3
+`
+
+type ListEquipmentBeforeParams struct {
+	CursorCreatedAt time.Time `db:"cursor_created_at" json:"cursor_created_at"`
+	CursorID        uuid.UUID `db:"cursor_id" json:"cursor_id"`
+	PageLimit       int32     `db:"page_limit" json:"page_limit"`
+}
+
+func (q *Queries) ListEquipmentBefore(ctx context.Context, arg *ListEquipmentBeforeParams) ([]*Equipment, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentBefore, arg.CursorCreatedAt, arg.CursorID, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Equipment{}
+	for rows.Next() {
+		var i Equipment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExternalID,
+			&i.Version,
+			&i.Description,
+			&i.PublishedDate,
+			&i.EffectiveStartDate,
+			&i.EffectiveEndDate,
+			&i.HierarchyScopeID,
+			&i.EquipmentLevel,
+			&i.OperatingStatus,
+			&i.PhysicalAssetID,
+			&i.OperationalLocationID,
+			&i.ParentEquipmentID,
+			&i.B2mmlData,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.RecordVersion,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEquipmentByClassAfter = `-- name: ListEquipmentByClassAfter :many
+SELECT
+    e.id,
+    e.external_id,
+    e.version,
+    e.description,
+    e.published_date,
+    e.effective_start_date,
+    e.effective_end_date,
+    e.hierarchy_scope_id,
+    e.equipment_level,
+    e.operating_status,
+    e.physical_asset_id,
+    e.operational_location_id,
+    e.parent_equipment_id,
+    e.b2mml_data,
+    e.created_at,
+    e.updated_at,
+    e.deleted_at,
+    e.record_version,
+    e.position
+FROM equipment e
+JOIN equipment_class_mappings ecm ON e.id = ecm.equipment_id
+WHERE ecm.equipment_class_id = $1 AND e.deleted_at IS NULL
+  AND ($2::timestamptz IS NULL
+       OR (e.created_at, e.id) < ($2::timestamptz, $3::uuid))
+ORDER BY e.created_at DESC, e.id DESC
+LIMIT $4
 `
 
-type ListEquipmentParams struct {
-	Limit  int32 `db:"limit" json:"limit"`
-	Offset int32 `db:"offset" json:"offset"`
+type ListEquipmentByClassAfterParams struct {
+	EquipmentClassID uuid.UUID     `db:"equipment_class_id" json:"equipment_class_id"`
+	CursorCreatedAt  sql.NullTime  `db:"cursor_created_at" json:"cursor_created_at"`
+	CursorID         uuid.NullUUID `db:"cursor_id" json:"cursor_id"`
+	PageLimit        int32         `db:"page_limit" json:"page_limit"`
 }
 
-func (q *Queries) ListEquipment(ctx context.Context, arg *ListEquipmentParams) ([]*Equipment, error) {
-	rows, err := q.db.QueryContext(ctx, listEquipment, arg.Limit, arg.Offset)
+func (q *Queries) ListEquipmentByClassAfter(ctx context.Context, arg *ListEquipmentByClassAfterParams) ([]*Equipment, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentByClassAfter,
+		arg.EquipmentClassID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -750,6 +1041,7 @@ func (q *Queries) ListEquipment(ctx context.Context, arg *ListEquipmentParams) (
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.RecordVersion,
+			&i.Position,
 		); err != nil {
 			return nil, err
 		}
@@ -764,7 +1056,7 @@ func (q *Queries) ListEquipment(ctx context.Context, arg *ListEquipmentParams) (
 	return items, nil
 }
 
-const listEquipmentByClass = `-- name: ListEquipmentByClass :many
+const listEquipmentByClassBefore = `-- name: ListEquipmentByClassBefore :many
 SELECT
     e.id,
     e.external_id,
@@ -783,15 +1075,30 @@ SELECT
     e.created_at,
     e.updated_at,
     e.deleted_at,
-    e.record_version
+    e.record_version,
+    e.position
 FROM equipment e
 JOIN equipment_class_mappings ecm ON e.id = ecm.equipment_id
 WHERE ecm.equipment_class_id = $1 AND e.deleted_at IS NULL
-ORDER BY e.created_at DESC
+  AND (e.created_at, e.id) > ($2::timestamptz, $3::uuid)
+ORDER BY e.created_at, e.id
+LIMIT $4
 `
 
-func (q *Queries) ListEquipmentByClass(ctx context.Context, equipmentClassID uuid.UUID) ([]*Equipment, error) {
-	rows, err := q.db.QueryContext(ctx, listEquipmentByClass, equipmentClassID)
+type ListEquipmentByClassBeforeParams struct {
+	EquipmentClassID uuid.UUID `db:"equipment_class_id" json:"equipment_class_id"`
+	CursorCreatedAt  time.Time `db:"cursor_created_at" json:"cursor_created_at"`
+	CursorID         uuid.UUID `db:"cursor_id" json:"cursor_id"`
+	PageLimit        int32     `db:"page_limit" json:"page_limit"`
+}
+
+func (q *Queries) ListEquipmentByClassBefore(ctx context.Context, arg *ListEquipmentByClassBeforeParams) ([]*Equipment, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentByClassBefore,
+		arg.EquipmentClassID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -818,6 +1125,7 @@ func (q *Queries) ListEquipmentByClass(ctx context.Context, equipmentClassID uui
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.RecordVersion,
+			&i.Position,
 		); err != nil {
 			return nil, err
 		}
@@ -832,7 +1140,7 @@ func (q *Queries) ListEquipmentByClass(ctx context.Context, equipmentClassID uui
 	return items, nil
 }
 
-const listEquipmentByStatus = `-- name: ListEquipmentByStatus :many
+const listEquipmentByStatusAfter = `-- name: ListEquipmentByStatusAfter :many
 SELECT
     id,
     external_id,
@@ -851,21 +1159,30 @@ SELECT
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 FROM equipment
 WHERE operating_status = $1 AND deleted_at IS NULL
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3
+  AND ($2::timestamptz IS NULL
+       OR (created_at, id) < ($2::timestamptz, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4
 `
 
-type ListEquipmentByStatusParams struct {
+type ListEquipmentByStatusAfterParams struct {
 	OperatingStatus sql.NullString `db:"operating_status" json:"operating_status"`
-	Limit           int32          `db:"limit" json:"limit"`
-	Offset          int32          `db:"offset" json:"offset"`
+	CursorCreatedAt sql.NullTime   `db:"cursor_created_at" json:"cursor_created_at"`
+	CursorID        uuid.NullUUID  `db:"cursor_id" json:"cursor_id"`
+	PageLimit       int32          `db:"page_limit" json:"page_limit"`
 }
 
-func (q *Queries) ListEquipmentByStatus(ctx context.Context, arg *ListEquipmentByStatusParams) ([]*Equipment, error) {
-	rows, err := q.db.QueryContext(ctx, listEquipmentByStatus, arg.OperatingStatus, arg.Limit, arg.Offset)
+func (q *Queries) ListEquipmentByStatusAfter(ctx context.Context, arg *ListEquipmentByStatusAfterParams) ([]*Equipment, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentByStatusAfter,
+		arg.OperatingStatus,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -892,6 +1209,7 @@ func (q *Queries) ListEquipmentByStatus(ctx context.Context, arg *ListEquipmentB
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.RecordVersion,
+			&i.Position,
 		); err != nil {
 			return nil, err
 		}
@@ -906,41 +1224,75 @@ func (q *Queries) ListEquipmentByStatus(ctx context.Context, arg *ListEquipmentB
 	return items, nil
 }
 
-const listEquipmentClassProperties = `-- name: ListEquipmentClassProperties :many
+const listEquipmentByStatusBefore = `-- name: ListEquipmentByStatusBefore :many
 SELECT
     id,
-    equipment_class_id,
     external_id,
+    version,
     description,
-    property_type,
-    parent_property_id,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
     b2mml_data,
     created_at,
-    updated_at
-FROM equipment_class_properties
-WHERE equipment_class_id = $1
-ORDER BY created_at
+    updated_at,
+    deleted_at,
+    record_version,
+    position
+FROM equipment
+WHERE operating_status = $1 AND deleted_at IS NULL
+  AND (created_at, id) > ($2::timestamptz, $3::uuid)
+ORDER BY created_at, id
+LIMIT $4
 `
 
-func (q *Queries) ListEquipmentClassProperties(ctx context.Context, equipmentClassID uuid.UUID) ([]*EquipmentClassProperty, error) {
-	rows, err := q.db.QueryContext(ctx, listEquipmentClassProperties, equipmentClassID)
+type ListEquipmentByStatusBeforeParams struct {
+	OperatingStatus sql.NullString `db:"operating_status" json:"operating_status"`
+	CursorCreatedAt time.Time      `db:"cursor_created_at" json:"cursor_created_at"`
+	CursorID        uuid.UUID      `db:"cursor_id" json:"cursor_id"`
+	PageLimit       int32          `db:"page_limit" json:"page_limit"`
+}
+
+func (q *Queries) ListEquipmentByStatusBefore(ctx context.Context, arg *ListEquipmentByStatusBeforeParams) ([]*Equipment, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentByStatusBefore,
+		arg.OperatingStatus,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []*EquipmentClassProperty{}
+	items := []*Equipment{}
 	for rows.Next() {
-		var i EquipmentClassProperty
+		var i Equipment
 		if err := rows.Scan(
 			&i.ID,
-			&i.EquipmentClassID,
 			&i.ExternalID,
+			&i.Version,
 			&i.Description,
-			&i.PropertyType,
-			&i.ParentPropertyID,
+			&i.PublishedDate,
+			&i.EffectiveStartDate,
+			&i.EffectiveEndDate,
+			&i.HierarchyScopeID,
+			&i.EquipmentLevel,
+			&i.OperatingStatus,
+			&i.PhysicalAssetID,
+			&i.OperationalLocationID,
+			&i.ParentEquipmentID,
 			&i.B2mmlData,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.RecordVersion,
+			&i.Position,
 		); err != nil {
 			return nil, err
 		}
@@ -955,11 +1307,265 @@ func (q *Queries) ListEquipmentClassProperties(ctx context.Context, equipmentCla
 	return items, nil
 }
 
-const listEquipmentClassesForEquipment = `-- name: ListEquipmentClassesForEquipment :many
-SELECT
-    ec.id,
-    ec.external_id,
-    ec.version,
+const listEquipmentClassLineage = `-- name: ListEquipmentClassLineage :many
+WITH RECURSIVE lineage AS (
+    SELECT node.id AS class_id, node.parent_class_id, 0 AS depth
+    FROM equipment_classes node
+    WHERE node.id = $1 AND node.deleted_at IS NULL
+    UNION ALL
+    SELECT parent.id, parent.parent_class_id, l.depth + 1
+    FROM equipment_classes parent
+    JOIN lineage l ON parent.id = l.parent_class_id
+    WHERE parent.deleted_at IS NULL AND l.depth < 1000
+)
+SELECT equipment_classes.id, equipment_classes.external_id, equipment_classes.version, equipment_classes.description, equipment_classes.published_date, equipment_classes.effective_start_date, equipment_classes.effective_end_date, equipment_classes.hierarchy_scope_id, equipment_classes.equipment_level, equipment_classes.parent_class_id, equipment_classes.b2mml_data, equipment_classes.created_at, equipment_classes.updated_at, equipment_classes.deleted_at, equipment_classes.position
+FROM lineage
+JOIN equipment_classes ON equipment_classes.id = lineage.class_id
+ORDER BY lineage.depth DESC
+`
+
+type ListEquipmentClassLineageRow struct {
+	EquipmentClass EquipmentClass `db:"equipment_class" json:"equipment_class"`
+}
+
+// Класс и все его предки от корневого класса до самого класса
+func (q *Queries) ListEquipmentClassLineage(ctx context.Context, id uuid.UUID) ([]*ListEquipmentClassLineageRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentClassLineage, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListEquipmentClassLineageRow{}
+	for rows.Next() {
+		var i ListEquipmentClassLineageRow
+		if err := rows.Scan(
+			&i.EquipmentClass.ID,
+			&i.EquipmentClass.ExternalID,
+			&i.EquipmentClass.Version,
+			&i.EquipmentClass.Description,
+			&i.EquipmentClass.PublishedDate,
+			&i.EquipmentClass.EffectiveStartDate,
+			&i.EquipmentClass.EffectiveEndDate,
+			&i.EquipmentClass.HierarchyScopeID,
+			&i.EquipmentClass.EquipmentLevel,
+			&i.EquipmentClass.ParentClassID,
+			&i.EquipmentClass.B2mmlData,
+			&i.EquipmentClass.CreatedAt,
+			&i.EquipmentClass.UpdatedAt,
+			&i.EquipmentClass.DeletedAt,
+			&i.EquipmentClass.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEquipmentClassProperties = `-- name: ListEquipmentClassProperties :many
+SELECT
+    id,
+    equipment_class_id,
+    external_id,
+    description,
+    property_type,
+    parent_property_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    position
+FROM equipment_class_properties
+WHERE equipment_class_id = $1
+ORDER BY position, created_at
+`
+
+func (q *Queries) ListEquipmentClassProperties(ctx context.Context, equipmentClassID uuid.UUID) ([]*EquipmentClassProperty, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentClassProperties, equipmentClassID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*EquipmentClassProperty{}
+	for rows.Next() {
+		var i EquipmentClassProperty
+		if err := rows.Scan(
+			&i.ID,
+			&i.EquipmentClassID,
+			&i.ExternalID,
+			&i.Description,
+			&i.PropertyType,
+			&i.ParentPropertyID,
+			&i.B2mmlData,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEquipmentClassesAfter = `-- name: ListEquipmentClassesAfter :many
+
+SELECT
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    parent_class_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    position
+FROM equipment_classes
+WHERE deleted_at IS NULL
+  AND ($1::timestamptz IS NULL
+       OR (created_at, id) < ($1::timestamptz, $2::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $3
+`
+
+type ListEquipmentClassesAfterParams struct {
+	CursorCreatedAt sql.NullTime  `db:"cursor_created_at" json:"cursor_created_at"`
+	CursorID        uuid.NullUUID `db:"cursor_id" json:"cursor_id"`
+	PageLimit       int32         `db:"page_limit" json:"page_limit"`
+}
+
+// Keyset-пагинация: After - страница после курсора в порядке (created_at, id) DESC,
+// Before - страница перед курсором (в обратном порядке, переворачивается в коде)
+func (q *Queries) ListEquipmentClassesAfter(ctx context.Context, arg *ListEquipmentClassesAfterParams) ([]*EquipmentClass, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentClassesAfter, arg.CursorCreatedAt, arg.CursorID, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*EquipmentClass{}
+	for rows.Next() {
+		var i EquipmentClass
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExternalID,
+			&i.Version,
+			&i.Description,
+			&i.PublishedDate,
+			&i.EffectiveStartDate,
+			&i.EffectiveEndDate,
+			&i.HierarchyScopeID,
+			&i.EquipmentLevel,
+			&i.ParentClassID,
+			&i.B2mmlData,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEquipmentClassesBefore = `-- name: ListEquipmentClassesBefore :many
+SELECT
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    parent_class_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    position
+FROM equipment_classes
+WHERE deleted_at IS NULL
+  AND (created_at, id) > ($1::timestamptz, $2::uuid)
+ORDER BY created_at, id
+LIMIT $3
+`
+
+type ListEquipmentClassesBeforeParams struct {
+	CursorCreatedAt time.Time `db:"cursor_created_at" json:"cursor_created_at"`
+	CursorID        uuid.UUID `db:"cursor_id" json:"cursor_id"`
+	PageLimit       int32     `db:"page_limit" json:"page_limit"`
+}
+
+func (q *Queries) ListEquipmentClassesBefore(ctx context.Context, arg *ListEquipmentClassesBeforeParams) ([]*EquipmentClass, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentClassesBefore, arg.CursorCreatedAt, arg.CursorID, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*EquipmentClass{}
+	for rows.Next() {
+		var i EquipmentClass
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExternalID,
+			&i.Version,
+			&i.Description,
+			&i.PublishedDate,
+			&i.EffectiveStartDate,
+			&i.EffectiveEndDate,
+			&i.HierarchyScopeID,
+			&i.EquipmentLevel,
+			&i.ParentClassID,
+			&i.B2mmlData,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEquipmentClassesForEquipment = `-- name: ListEquipmentClassesForEquipment :many
+SELECT
+    ec.id,
+    ec.external_id,
+    ec.version,
     ec.description,
     ec.published_date,
     ec.effective_start_date,
@@ -970,11 +1576,12 @@ SELECT
     ec.b2mml_data,
     ec.created_at,
     ec.updated_at,
-    ec.deleted_at
+    ec.deleted_at,
+    ec.position
 FROM equipment_class_mappings ecm
 JOIN equipment_classes ec ON ecm.equipment_class_id = ec.id
 WHERE ecm.equipment_id = $1 AND ec.deleted_at IS NULL
-ORDER BY ec.created_at
+ORDER BY ecm.position, ecm.created_at
 `
 
 func (q *Queries) ListEquipmentClassesForEquipment(ctx context.Context, equipmentID uuid.UUID) ([]*EquipmentClass, error) {
@@ -1001,6 +1608,7 @@ func (q *Queries) ListEquipmentClassesForEquipment(ctx context.Context, equipmen
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Position,
 		); err != nil {
 			return nil, err
 		}
@@ -1015,6 +1623,47 @@ func (q *Queries) ListEquipmentClassesForEquipment(ctx context.Context, equipmen
 	return items, nil
 }
 
+const listEquipmentHierarchy = `-- name: ListEquipmentHierarchy :many
+WITH RECURSIVE hierarchy AS (
+    SELECT node.id, node.parent_equipment_id, 1 AS depth
+    FROM equipment node
+    WHERE node.external_id = ANY($1::text[]) AND node.deleted_at IS NULL
+    UNION
+    SELECT parent.id, parent.parent_equipment_id, h.depth + 1
+    FROM equipment parent
+    JOIN hierarchy h ON parent.id = h.parent_equipment_id
+    WHERE h.depth < 1000
+)
+SELECT DISTINCT equipment.external_id
+FROM hierarchy
+JOIN equipment ON equipment.id = hierarchy.id
+`
+
+// Внешние ID оборудования и всех его предков; удалённое и отсутствующее
+// оборудование пропускается
+func (q *Queries) ListEquipmentHierarchy(ctx context.Context, externalIds []string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentHierarchy, pq.Array(externalIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var external_id string
+		if err := rows.Scan(&external_id); err != nil {
+			return nil, err
+		}
+		items = append(items, external_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEquipmentProperties = `-- name: ListEquipmentProperties :many
 SELECT
     id,
@@ -1027,10 +1676,11 @@ SELECT
     description,
     b2mml_data,
     created_at,
-    updated_at
+    updated_at,
+    position
 FROM equipment_properties
 WHERE equipment_id = $1
-ORDER BY created_at
+ORDER BY position, created_at
 `
 
 func (q *Queries) ListEquipmentProperties(ctx context.Context, equipmentID uuid.UUID) ([]*EquipmentProperty, error) {
@@ -1054,6 +1704,82 @@ func (q *Queries) ListEquipmentProperties(ctx context.Context, equipmentID uuid.
 			&i.B2mmlData,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEquipmentSubtree = `-- name: ListEquipmentSubtree :many
+WITH RECURSIVE subtree AS (
+    SELECT root.id AS node_id, 0 AS depth, ARRAY[root.id] AS path
+    FROM equipment root
+    WHERE root.id = $1 AND root.deleted_at IS NULL
+    UNION ALL
+    SELECT child.id, s.depth + 1, s.path || child.id
+    FROM equipment child
+    JOIN subtree s ON child.parent_equipment_id = s.node_id
+    WHERE child.deleted_at IS NULL
+        AND s.depth < $2::int
+        AND NOT child.id = ANY(s.path)
+)
+SELECT equipment.id, equipment.external_id, equipment.version, equipment.description, equipment.published_date, equipment.effective_start_date, equipment.effective_end_date, equipment.hierarchy_scope_id, equipment.equipment_level, equipment.operating_status, equipment.physical_asset_id, equipment.operational_location_id, equipment.parent_equipment_id, equipment.b2mml_data, equipment.created_at, equipment.updated_at, equipment.deleted_at, equipment.record_version, equipment.position, subtree.depth::int AS depth
+FROM subtree
+JOIN equipment ON equipment.id = subtree.node_id
+ORDER BY subtree.depth, equipment.parent_equipment_id, equipment.position, equipment.created_at
+`
+
+type ListEquipmentSubtreeParams struct {
+	RootID   uuid.UUID `db:"root_id" json:"root_id"`
+	MaxDepth int32     `db:"max_depth" json:"max_depth"`
+}
+
+type ListEquipmentSubtreeRow struct {
+	Equipment Equipment `db:"equipment" json:"equipment"`
+	Depth     int32     `db:"depth" json:"depth"`
+}
+
+// Поддерево оборудования с корнем root_id до глубины max_depth (корень - глубина 0).
+// Строки упорядочены так, что родитель идёт раньше своих потомков
+func (q *Queries) ListEquipmentSubtree(ctx context.Context, arg *ListEquipmentSubtreeParams) ([]*ListEquipmentSubtreeRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEquipmentSubtree, arg.RootID, arg.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListEquipmentSubtreeRow{}
+	for rows.Next() {
+		var i ListEquipmentSubtreeRow
+		if err := rows.Scan(
+			&i.Equipment.ID,
+			&i.Equipment.ExternalID,
+			&i.Equipment.Version,
+			&i.Equipment.Description,
+			&i.Equipment.PublishedDate,
+			&i.Equipment.EffectiveStartDate,
+			&i.Equipment.EffectiveEndDate,
+			&i.Equipment.HierarchyScopeID,
+			&i.Equipment.EquipmentLevel,
+			&i.Equipment.OperatingStatus,
+			&i.Equipment.PhysicalAssetID,
+			&i.Equipment.OperationalLocationID,
+			&i.Equipment.ParentEquipmentID,
+			&i.Equipment.B2mmlData,
+			&i.Equipment.CreatedAt,
+			&i.Equipment.UpdatedAt,
+			&i.Equipment.DeletedAt,
+			&i.Equipment.RecordVersion,
+			&i.Equipment.Position,
+			&i.Depth,
 		); err != nil {
 			return nil, err
 		}
@@ -1068,6 +1794,120 @@ func (q *Queries) ListEquipmentProperties(ctx context.Context, equipmentID uuid.
 	return items, nil
 }
 
+const listPropertyExternalIDs = `-- name: ListPropertyExternalIDs :many
+SELECT p.external_id::text AS external_id
+FROM equipment_properties p
+JOIN equipment e ON e.id = p.equipment_id
+WHERE e.deleted_at IS NULL
+UNION
+SELECT cp.external_id::text
+FROM equipment_class_properties cp
+JOIN equipment_classes c ON c.id = cp.equipment_class_id
+WHERE c.deleted_at IS NULL
+ORDER BY external_id
+`
+
+// ID свойств, заданных действующим оборудованием или определённых действующими классами
+func (q *Queries) ListPropertyExternalIDs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listPropertyExternalIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var external_id string
+		if err := rows.Scan(&external_id); err != nil {
+			return nil, err
+		}
+		items = append(items, external_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveEquipment = `-- name: MoveEquipment :one
+UPDATE equipment
+SET
+    parent_equipment_id = $1,
+    position = (
+        SELECT COALESCE(MAX(sibling.position) + 1, 0)
+        FROM equipment sibling
+        WHERE sibling.parent_equipment_id IS NOT DISTINCT FROM $1
+            AND sibling.deleted_at IS NULL
+    ),
+    record_version = $2,
+    updated_at = NOW()
+WHERE equipment.id = $3
+    AND equipment.record_version = $4
+    AND equipment.deleted_at IS NULL
+RETURNING
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version,
+    position
+`
+
+type MoveEquipmentParams struct {
+	ParentID        uuid.NullUUID `db:"parent_id" json:"parent_id"`
+	RecordVersion   int64         `db:"record_version" json:"record_version"`
+	ID              uuid.UUID     `db:"id" json:"id"`
+	ExpectedVersion int64         `db:"expected_version" json:"expected_version"`
+}
+
+// Переносит оборудование под другого родителя (NULL - в корень) в конец списка его детей
+func (q *Queries) MoveEquipment(ctx context.Context, arg *MoveEquipmentParams) (*Equipment, error) {
+	row := q.db.QueryRowContext(ctx, moveEquipment,
+		arg.ParentID,
+		arg.RecordVersion,
+		arg.ID,
+		arg.ExpectedVersion,
+	)
+	var i Equipment
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.Version,
+		&i.Description,
+		&i.PublishedDate,
+		&i.EffectiveStartDate,
+		&i.EffectiveEndDate,
+		&i.HierarchyScopeID,
+		&i.EquipmentLevel,
+		&i.OperatingStatus,
+		&i.PhysicalAssetID,
+		&i.OperationalLocationID,
+		&i.ParentEquipmentID,
+		&i.B2mmlData,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.RecordVersion,
+		&i.Position,
+	)
+	return &i, err
+}
+
 const removeEquipmentFromClass = `-- name: RemoveEquipmentFromClass :exec
 DELETE FROM equipment_class_mappings
 WHERE equipment_id = $1 AND equipment_class_id = $2
@@ -1083,6 +1923,109 @@ func (q *Queries) RemoveEquipmentFromClass(ctx context.Context, arg *RemoveEquip
 	return err
 }
 
+const updateEquipment = `-- name: UpdateEquipment :one
+UPDATE equipment
+SET
+    version = $1,
+    description = $2,
+    published_date = $3,
+    effective_start_date = $4,
+    effective_end_date = $5,
+    hierarchy_scope_id = $6,
+    equipment_level = $7,
+    operating_status = $8,
+    physical_asset_id = $9,
+    operational_location_id = $10,
+    b2mml_data = $11,
+    record_version = $12,
+    position = $13,
+    updated_at = NOW()
+WHERE id = $14
+    AND record_version = $15
+    AND deleted_at IS NULL
+RETURNING
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version,
+    position
+`
+
+type UpdateEquipmentParams struct {
+	Version               sql.NullString        `db:"version" json:"version"`
+	Description           sql.NullString        `db:"description" json:"description"`
+	PublishedDate         sql.NullTime          `db:"published_date" json:"published_date"`
+	EffectiveStartDate    sql.NullTime          `db:"effective_start_date" json:"effective_start_date"`
+	EffectiveEndDate      sql.NullTime          `db:"effective_end_date" json:"effective_end_date"`
+	HierarchyScopeID      sql.NullString        `db:"hierarchy_scope_id" json:"hierarchy_scope_id"`
+	EquipmentLevel        sql.NullString        `db:"equipment_level" json:"equipment_level"`
+	OperatingStatus       sql.NullString        `db:"operating_status" json:"operating_status"`
+	PhysicalAssetID       sql.NullString        `db:"physical_asset_id" json:"physical_asset_id"`
+	OperationalLocationID sql.NullString        `db:"operational_location_id" json:"operational_location_id"`
+	B2mmlData             pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
+	RecordVersion         int64                 `db:"record_version" json:"record_version"`
+	Position              int32                 `db:"position" json:"position"`
+	ID                    uuid.UUID             `db:"id" json:"id"`
+	ExpectedVersion       int64                 `db:"expected_version" json:"expected_version"`
+}
+
+func (q *Queries) UpdateEquipment(ctx context.Context, arg *UpdateEquipmentParams) (*Equipment, error) {
+	row := q.db.QueryRowContext(ctx, updateEquipment,
+		arg.Version,
+		arg.Description,
+		arg.PublishedDate,
+		arg.EffectiveStartDate,
+		arg.EffectiveEndDate,
+		arg.HierarchyScopeID,
+		arg.EquipmentLevel,
+		arg.OperatingStatus,
+		arg.PhysicalAssetID,
+		arg.OperationalLocationID,
+		arg.B2mmlData,
+		arg.RecordVersion,
+		arg.Position,
+		arg.ID,
+		arg.ExpectedVersion,
+	)
+	var i Equipment
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.Version,
+		&i.Description,
+		&i.PublishedDate,
+		&i.EffectiveStartDate,
+		&i.EffectiveEndDate,
+		&i.HierarchyScopeID,
+		&i.EquipmentLevel,
+		&i.OperatingStatus,
+		&i.PhysicalAssetID,
+		&i.OperationalLocationID,
+		&i.ParentEquipmentID,
+		&i.B2mmlData,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.RecordVersion,
+		&i.Position,
+	)
+	return &i, err
+}
+
 const updateEquipmentClass = `-- name: UpdateEquipmentClass :one
 UPDATE equipment_classes
 SET
@@ -1094,6 +2037,7 @@ SET
     hierarchy_scope_id = $7,
     equipment_level = $8,
     b2mml_data = $9,
+    position = $10,
     updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING
@@ -1110,7 +2054,8 @@ RETURNING
     b2mml_data,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    position
 `
 
 type UpdateEquipmentClassParams struct {
@@ -1123,6 +2068,7 @@ type UpdateEquipmentClassParams struct {
 	HierarchyScopeID   sql.NullString        `db:"hierarchy_scope_id" json:"hierarchy_scope_id"`
 	EquipmentLevel     sql.NullString        `db:"equipment_level" json:"equipment_level"`
 	B2mmlData          pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
+	Position           int32                 `db:"position" json:"position"`
 }
 
 func (q *Queries) UpdateEquipmentClass(ctx context.Context, arg *UpdateEquipmentClassParams) (*EquipmentClass, error) {
@@ -1136,6 +2082,7 @@ func (q *Queries) UpdateEquipmentClass(ctx context.Context, arg *UpdateEquipment
 		arg.HierarchyScopeID,
 		arg.EquipmentLevel,
 		arg.B2mmlData,
+		arg.Position,
 	)
 	var i EquipmentClass
 	err := row.Scan(
@@ -1153,6 +2100,64 @@ func (q *Queries) UpdateEquipmentClass(ctx context.Context, arg *UpdateEquipment
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Position,
+	)
+	return &i, err
+}
+
+const updateEquipmentClassProperty = `-- name: UpdateEquipmentClassProperty :one
+UPDATE equipment_class_properties
+SET
+    description = $2,
+    property_type = $3,
+    parent_property_id = $4,
+    b2mml_data = $5,
+    position = $6,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING
+    id,
+    equipment_class_id,
+    external_id,
+    description,
+    property_type,
+    parent_property_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    position
+`
+
+type UpdateEquipmentClassPropertyParams struct {
+	ID               uuid.UUID             `db:"id" json:"id"`
+	Description      sql.NullString        `db:"description" json:"description"`
+	PropertyType     sql.NullString        `db:"property_type" json:"property_type"`
+	ParentPropertyID uuid.NullUUID         `db:"parent_property_id" json:"parent_property_id"`
+	B2mmlData        pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
+	Position         int32                 `db:"position" json:"position"`
+}
+
+func (q *Queries) UpdateEquipmentClassProperty(ctx context.Context, arg *UpdateEquipmentClassPropertyParams) (*EquipmentClassProperty, error) {
+	row := q.db.QueryRowContext(ctx, updateEquipmentClassProperty,
+		arg.ID,
+		arg.Description,
+		arg.PropertyType,
+		arg.ParentPropertyID,
+		arg.B2mmlData,
+		arg.Position,
+	)
+	var i EquipmentClassProperty
+	err := row.Scan(
+		&i.ID,
+		&i.EquipmentClassID,
+		&i.ExternalID,
+		&i.Description,
+		&i.PropertyType,
+		&i.ParentPropertyID,
+		&i.B2mmlData,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -1165,6 +2170,7 @@ SET
     property_unit = $4,
     description = $5,
     b2mml_data = $6,
+    position = $7,
     updated_at = NOW()
 WHERE id = $1
 RETURNING
@@ -1178,7 +2184,8 @@ RETURNING
     description,
     b2mml_data,
     created_at,
-    updated_at
+    updated_at,
+    position
 `
 
 type UpdateEquipmentPropertyParams struct {
@@ -1188,6 +2195,7 @@ type UpdateEquipmentPropertyParams struct {
 	PropertyUnit     sql.NullString        `db:"property_unit" json:"property_unit"`
 	Description      sql.NullString        `db:"description" json:"description"`
 	B2mmlData        pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
+	Position         int32                 `db:"position" json:"position"`
 }
 
 func (q *Queries) UpdateEquipmentProperty(ctx context.Context, arg *UpdateEquipmentPropertyParams) (*EquipmentProperty, error) {
@@ -1198,6 +2206,7 @@ func (q *Queries) UpdateEquipmentProperty(ctx context.Context, arg *UpdateEquipm
 		arg.PropertyUnit,
 		arg.Description,
 		arg.B2mmlData,
+		arg.Position,
 	)
 	var i EquipmentProperty
 	err := row.Scan(
@@ -1212,6 +2221,7 @@ func (q *Queries) UpdateEquipmentProperty(ctx context.Context, arg *UpdateEquipm
 		&i.B2mmlData,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -1219,10 +2229,12 @@ func (q *Queries) UpdateEquipmentProperty(ctx context.Context, arg *UpdateEquipm
 const updateEquipmentStatus = `-- name: UpdateEquipmentStatus :one
 UPDATE equipment
 SET
-    operating_status = $2,
+    operating_status = $1,
     updated_at = NOW(),
     record_version = record_version + 1
-WHERE id = $1 AND deleted_at IS NULL
+WHERE id = $2
+    AND record_version = $3
+    AND deleted_at IS NULL
 RETURNING
     id,
     external_id,
@@ -1241,16 +2253,18 @@ RETURNING
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 `
 
 type UpdateEquipmentStatusParams struct {
-	ID              uuid.UUID      `db:"id" json:"id"`
 	OperatingStatus sql.NullString `db:"operating_status" json:"operating_status"`
+	ID              uuid.UUID      `db:"id" json:"id"`
+	ExpectedVersion int64          `db:"expected_version" json:"expected_version"`
 }
 
 func (q *Queries) UpdateEquipmentStatus(ctx context.Context, arg *UpdateEquipmentStatusParams) (*Equipment, error) {
-	row := q.db.QueryRowContext(ctx, updateEquipmentStatus, arg.ID, arg.OperatingStatus)
+	row := q.db.QueryRowContext(ctx, updateEquipmentStatus, arg.OperatingStatus, arg.ID, arg.ExpectedVersion)
 	var i Equipment
 	err := row.Scan(
 		&i.ID,
@@ -1271,6 +2285,7 @@ func (q *Queries) UpdateEquipmentStatus(ctx context.Context, arg *UpdateEquipmen
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.RecordVersion,
+		&i.Position,
 	)
 	return &i, err
 }
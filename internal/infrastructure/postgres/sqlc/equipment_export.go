@@ -0,0 +1,146 @@
+package postgres
+
+// Выгрузка оборудования серверным курсором. Фильтры те же, что у
+// QueryEquipment, поэтому запрос собирается в коде, а не генерируется sqlc.
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// equipmentExportCursor имя курсора выгрузки; курсор живёт до конца транзакции
+const equipmentExportCursor = "equipment_export"
+
+// equipmentTreeKey ключ сортировки узла среди соседей: позиция, время
+// создания и id, как у ListEquipmentSubtree. Ключи сравниваются побайтно
+const equipmentTreeKey = `lpad(%[1]s.position::text, 10, '0')
+        || to_char(%[1]s.created_at AT TIME ZONE 'UTC', 'YYYYMMDDHH24MISSUS') || %[1]s.id::text`
+
+// ExportEquipmentParams параметры DeclareEquipmentExportCursor
+type ExportEquipmentParams struct {
+	EquipmentFilterParams
+	// RootExternalIDs корни выгружаемых поддеревьев; пусто - корни иерархии
+	RootExternalIDs []string
+}
+
+// ExportEquipmentRow строка выгрузки оборудования
+type ExportEquipmentRow struct {
+	Equipment
+	// ParentExternalID внешний ID действующего родителя
+	ParentExternalID sql.NullString
+	// Ancestors внешние ID предков от корня выгрузки до непосредственного родителя
+	Ancestors []string
+}
+
+// DeclareEquipmentExportCursor открывает курсор выгрузки оборудования в
+// порядке обхода иерархии: родитель раньше потомков, соседи по позиции.
+// Курсор существует только в транзакции
+func (q *Queries) DeclareEquipmentExportCursor(ctx context.Context, arg *ExportEquipmentParams) error {
+	query, args, err := buildEquipmentExportQuery(arg)
+	if err != nil {
+		return err
+	}
+	_, err = q.db.ExecContext(ctx, fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR %s", equipmentExportCursor, query), args...)
+	return err
+}
+
+// FetchEquipmentExportCursor читает из курсора выгрузки до n строк; пустой
+// результат - курсор прочитан
+func (q *Queries) FetchEquipmentExportCursor(ctx context.Context, n int32) ([]*ExportEquipmentRow, error) {
+	rows, err := q.db.QueryContext(ctx, fmt.Sprintf("FETCH FORWARD %d FROM %s", n, equipmentExportCursor))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ExportEquipmentRow{}
+	for rows.Next() {
+		var i ExportEquipmentRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExternalID,
+			&i.Version,
+			&i.Description,
+			&i.PublishedDate,
+			&i.EffectiveStartDate,
+			&i.EffectiveEndDate,
+			&i.HierarchyScopeID,
+			&i.EquipmentLevel,
+			&i.OperatingStatus,
+			&i.PhysicalAssetID,
+			&i.OperationalLocationID,
+			&i.ParentEquipmentID,
+			&i.B2mmlData,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.RecordVersion,
+			&i.Position,
+			&i.ParentExternalID,
+			pq.Array(&i.Ancestors),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// CloseEquipmentExportCursor закрывает курсор выгрузки
+func (q *Queries) CloseEquipmentExportCursor(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, "CLOSE "+equipmentExportCursor)
+	return err
+}
+
+// buildEquipmentExportQuery собирает запрос выгрузки и его параметры
+func buildEquipmentExportQuery(arg *ExportEquipmentParams) (string, []any, error) {
+	var args []any
+	param := func(v any) int {
+		args = append(args, v)
+		return len(args)
+	}
+	with, where, err := equipmentFilters(&arg.EquipmentFilterParams, param)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Оборудование под удалённым родителем считается корнем иерархии
+	root := `NOT EXISTS (SELECT 1 FROM equipment parent
+            WHERE parent.id = root.parent_equipment_id AND parent.deleted_at IS NULL)`
+	if len(arg.RootExternalIDs) > 0 {
+		root = fmt.Sprintf("root.external_id = ANY($%d)", param(pq.Array(arg.RootExternalIDs)))
+	}
+	with = append(with, fmt.Sprintf(`tree AS (
+    SELECT root.id, root.external_id::text AS external_id, ARRAY[root.id] AS ids,
+        ARRAY[]::text[] AS ancestors, ARRAY[%s] AS sort_path
+    FROM equipment root
+    WHERE root.deleted_at IS NULL
+        AND %s
+    UNION ALL
+    SELECT child.id, child.external_id::text, t.ids || child.id,
+        t.ancestors || t.external_id, t.sort_path || (%s)
+    FROM equipment child
+    JOIN tree t ON child.parent_equipment_id = t.id
+    WHERE child.deleted_at IS NULL
+        AND NOT child.id = ANY(t.ids)
+)`, fmt.Sprintf(equipmentTreeKey, "root"), root, fmt.Sprintf(equipmentTreeKey, "child")))
+
+	query := fmt.Sprintf(`%sSELECT %s,
+    parent.external_id, tree.ancestors
+FROM tree
+JOIN equipment e ON e.id = tree.id
+LEFT JOIN equipment parent ON parent.id = e.parent_equipment_id AND parent.deleted_at IS NULL
+WHERE %s
+ORDER BY tree.sort_path COLLATE "C"`,
+		withClause(with), equipmentColumns, strings.Join(where, "\n  AND "))
+	return query, args, nil
+}
@@ -0,0 +1,390 @@
+package postgres
+
+// Динамический запрос оборудования. Набор фильтров и сортировка задаются
+// во время выполнения, поэтому запрос собирается в коде, а не генерируется sqlc.
+// В текст запроса попадают только выражения из белых списков ниже, все значения
+// передаются параметрами.
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// equipmentColumns колонки equipment в порядке полей Equipment
+const equipmentColumns = `e.id, e.external_id, e.version, e.description, e.published_date,
+    e.effective_start_date, e.effective_end_date, e.hierarchy_scope_id, e.equipment_level,
+    e.operating_status, e.physical_asset_id, e.operational_location_id, e.parent_equipment_id,
+    e.b2mml_data, e.created_at, e.updated_at, e.deleted_at, e.record_version, e.position`
+
+// equipmentSortColumn выражение сортировки. NULL заменяется значением,
+// которое сортируется первым, чтобы выражение можно было сравнивать с курсором
+type equipmentSortColumn struct {
+	expr string
+	cast string
+	key  func(e *Equipment) string
+}
+
+var equipmentSortColumns = map[string]equipmentSortColumn{
+	"created_at": {
+		expr: "e.created_at",
+		cast: "timestamptz",
+		key:  func(e *Equipment) string { return formatTimestamp(e.CreatedAt) },
+	},
+	"updated_at": {
+		expr: "e.updated_at",
+		cast: "timestamptz",
+		key:  func(e *Equipment) string { return formatTimestamp(e.UpdatedAt) },
+	},
+	"external_id": {
+		expr: "e.external_id",
+		cast: "text",
+		key:  func(e *Equipment) string { return e.ExternalID },
+	},
+	"effective_start_date": {
+		expr: "COALESCE(e.effective_start_date, '-infinity')",
+		cast: "timestamptz",
+		key: func(e *Equipment) string {
+			if !e.EffectiveStartDate.Valid {
+				return "-infinity"
+			}
+			return formatTimestamp(e.EffectiveStartDate.Time)
+		},
+	},
+	"equipment_level": {
+		expr: "COALESCE(e.equipment_level, '')",
+		cast: "text",
+		key:  func(e *Equipment) string { return e.EquipmentLevel.String },
+	},
+	"operating_status": {
+		expr: "COALESCE(e.operating_status, '')",
+		cast: "text",
+		key:  func(e *Equipment) string { return e.OperatingStatus.String },
+	},
+}
+
+// propertyOperators операторы сравнения значений свойств
+var propertyOperators = map[string]string{
+	"eq":     "=",
+	"ne":     "<>",
+	"lt":     "<",
+	"lte":    "<=",
+	"gt":     ">",
+	"gte":    ">=",
+	"like":   "ILIKE",
+	"exists": "",
+}
+
+// propertyCasts приведение текстового значения свойства к типу сравнения.
+// Значения, которые не удаётся привести, дают NULL и не проходят фильтр
+var propertyCasts = map[string]struct {
+	value string
+	param string
+}{
+	"string": {value: "p.property_value", param: "$%d::text"},
+	"number": {
+		value: `CASE WHEN p.property_value ~ '^\s*[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?\s*$'
+            THEN p.property_value::numeric END`,
+		param: "$%d::numeric",
+	},
+	"datetime": {
+		value: `CASE WHEN p.property_value ~ '^\d{4}-\d{2}-\d{2}'
+            THEN p.property_value::timestamptz END`,
+		param: "$%d::timestamptz",
+	},
+	"boolean": {
+		value: `CASE WHEN lower(p.property_value) IN ('true', 'false', 't', 'f', '1', '0', 'yes', 'no')
+            THEN p.property_value::boolean END`,
+		param: "$%d::boolean",
+	},
+}
+
+// EquipmentPropertyPredicate условие на значение свойства оборудования
+type EquipmentPropertyPredicate struct {
+	ExternalID string
+	// Operator eq, ne, lt, lte, gt, gte, like или exists
+	Operator string
+	// ValueType string, number, datetime или boolean
+	ValueType string
+	Value     string
+	Unit      string
+	// UnitConversions перевод значений в других единицах в Unit перед сравнением
+	// (только для number). Если пусто, сравниваются лишь значения в Unit
+	UnitConversions []EquipmentUnitConversion
+}
+
+// EquipmentUnitConversion перевод значения свойства: value*Factor + Offset
+type EquipmentUnitConversion struct {
+	Unit   string
+	Factor float64
+	Offset float64
+}
+
+// EquipmentFilterParams фильтры оборудования. Пустые фильтры не применяются
+type EquipmentFilterParams struct {
+	OperatingStatuses []string
+	EquipmentLevels   []string
+	HierarchyScopeIDs []string
+
+	EquipmentClassID  uuid.NullUUID
+	IncludeSubclasses bool
+
+	EffectiveAt   sql.NullTime
+	EffectiveFrom sql.NullTime
+	EffectiveTo   sql.NullTime
+
+	Properties []EquipmentPropertyPredicate
+}
+
+// QueryEquipmentParams параметры QueryEquipment
+type QueryEquipmentParams struct {
+	EquipmentFilterParams
+
+	// SortColumn колонка сортировки: created_at, updated_at, external_id,
+	// effective_start_date, equipment_level или operating_status
+	SortColumn string
+	SortDesc   bool
+
+	// CursorKey и CursorID граничная запись страницы. Если Backward,
+	// выбираются записи перед ней в обратном порядке
+	CursorKey sql.NullString
+	CursorID  uuid.NullUUID
+	Backward  bool
+
+	PageLimit int32
+}
+
+// EquipmentSortKey возвращает значение ключа сортировки строки для курсора
+func EquipmentSortKey(e *Equipment, column string) (string, error) {
+	sort, ok := equipmentSortColumns[column]
+	if !ok {
+		return "", fmt.Errorf("unsupported sort column %q", column)
+	}
+	return sort.key(e), nil
+}
+
+// QueryEquipment выбирает оборудование по динамическому набору фильтров
+func (q *Queries) QueryEquipment(ctx context.Context, arg *QueryEquipmentParams) ([]*Equipment, error) {
+	query, args, err := buildEquipmentQuery(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Equipment{}
+	for rows.Next() {
+		var i Equipment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExternalID,
+			&i.Version,
+			&i.Description,
+			&i.PublishedDate,
+			&i.EffectiveStartDate,
+			&i.EffectiveEndDate,
+			&i.HierarchyScopeID,
+			&i.EquipmentLevel,
+			&i.OperatingStatus,
+			&i.PhysicalAssetID,
+			&i.OperationalLocationID,
+			&i.ParentEquipmentID,
+			&i.B2mmlData,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.RecordVersion,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// buildEquipmentQuery собирает текст запроса и его параметры
+func buildEquipmentQuery(arg *QueryEquipmentParams) (string, []any, error) {
+	sort, ok := equipmentSortColumns[arg.SortColumn]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported sort column %q", arg.SortColumn)
+	}
+
+	var args []any
+	param := func(v any) int {
+		args = append(args, v)
+		return len(args)
+	}
+	with, where, err := equipmentFilters(&arg.EquipmentFilterParams, param)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Прямой порядок страницы и направление сравнения с курсором
+	desc := arg.SortDesc != arg.Backward
+	order, cmp := "ASC", ">"
+	if desc {
+		order, cmp = "DESC", "<"
+	}
+	if arg.CursorKey.Valid && arg.CursorID.Valid {
+		where = append(where, fmt.Sprintf("(%s, e.id) %s ($%d::%s, $%d::uuid)",
+			sort.expr, cmp, param(arg.CursorKey.String), sort.cast, param(arg.CursorID.UUID)))
+	}
+
+	query := fmt.Sprintf(`%sSELECT %s
+FROM equipment e
+WHERE %s
+ORDER BY %s %s, e.id %s
+LIMIT $%d`,
+		withClause(with), equipmentColumns, strings.Join(where, "\n  AND "), sort.expr, order, order, param(arg.PageLimit))
+	return query, args, nil
+}
+
+// equipmentFilters возвращает общие табличные выражения и условия WHERE
+// фильтров оборудования e
+func equipmentFilters(arg *EquipmentFilterParams, param func(any) int) (with, where []string, err error) {
+	where = []string{"e.deleted_at IS NULL"}
+	if len(arg.OperatingStatuses) > 0 {
+		where = append(where, fmt.Sprintf("e.operating_status = ANY($%d)", param(pq.Array(arg.OperatingStatuses))))
+	}
+	if len(arg.EquipmentLevels) > 0 {
+		where = append(where, fmt.Sprintf("e.equipment_level = ANY($%d)", param(pq.Array(arg.EquipmentLevels))))
+	}
+	if len(arg.HierarchyScopeIDs) > 0 {
+		where = append(where, fmt.Sprintf("e.hierarchy_scope_id = ANY($%d)", param(pq.Array(arg.HierarchyScopeIDs))))
+	}
+
+	if arg.EquipmentClassID.Valid {
+		n := param(arg.EquipmentClassID.UUID)
+		if arg.IncludeSubclasses {
+			with = append(with, fmt.Sprintf(`classes AS (
+    SELECT id FROM equipment_classes WHERE id = $%d
+    UNION
+    SELECT c.id FROM equipment_classes c
+    JOIN classes ON c.parent_class_id = classes.id
+    WHERE c.deleted_at IS NULL
+)`, n))
+			where = append(where, `EXISTS (SELECT 1 FROM equipment_class_mappings ecm
+    WHERE ecm.equipment_id = e.id AND ecm.equipment_class_id IN (SELECT id FROM classes))`)
+		} else {
+			where = append(where, fmt.Sprintf(`EXISTS (SELECT 1 FROM equipment_class_mappings ecm
+    WHERE ecm.equipment_id = e.id AND ecm.equipment_class_id = $%d)`, n))
+		}
+	}
+
+	if arg.EffectiveAt.Valid {
+		n := param(arg.EffectiveAt.Time)
+		where = append(where,
+			fmt.Sprintf("(e.effective_start_date IS NULL OR e.effective_start_date <= $%d)", n),
+			fmt.Sprintf("(e.effective_end_date IS NULL OR e.effective_end_date > $%d)", n),
+		)
+	}
+	if arg.EffectiveFrom.Valid {
+		where = append(where, fmt.Sprintf("(e.effective_end_date IS NULL OR e.effective_end_date > $%d)", param(arg.EffectiveFrom.Time)))
+	}
+	if arg.EffectiveTo.Valid {
+		where = append(where, fmt.Sprintf("(e.effective_start_date IS NULL OR e.effective_start_date < $%d)", param(arg.EffectiveTo.Time)))
+	}
+
+	for _, pred := range arg.Properties {
+		cond, err := propertyCondition(pred, param)
+		if err != nil {
+			return nil, nil, err
+		}
+		where = append(where, cond)
+	}
+	return with, where, nil
+}
+
+// withClause собирает WITH RECURSIVE из табличных выражений
+func withClause(with []string) string {
+	if len(with) == 0 {
+		return ""
+	}
+	return "WITH RECURSIVE " + strings.Join(with, ",\n") + "\n"
+}
+
+// propertyCondition формирует условие EXISTS для предиката по свойству
+func propertyCondition(pred EquipmentPropertyPredicate, param func(any) int) (string, error) {
+	op, ok := propertyOperators[pred.Operator]
+	if !ok {
+		return "", fmt.Errorf("unsupported property operator %q", pred.Operator)
+	}
+	valueType := pred.ValueType
+	if valueType == "" {
+		valueType = "string"
+	}
+	cast, ok := propertyCasts[valueType]
+	if !ok {
+		return "", fmt.Errorf("unsupported property value type %q", pred.ValueType)
+	}
+	if op == "ILIKE" && valueType != "string" {
+		return "", fmt.Errorf("operator like requires string value type")
+	}
+
+	conds := []string{
+		"p.equipment_id = e.id",
+		fmt.Sprintf("p.external_id = $%d", param(pred.ExternalID)),
+	}
+	value := cast.value
+	switch {
+	case len(pred.UnitConversions) > 0 && valueType == "number":
+		// Единицы без перевода дают NULL и не проходят фильтр
+		var units, factors, offsets []string
+		for _, c := range pred.UnitConversions {
+			unit := param(c.Unit)
+			units = append(units, fmt.Sprintf("$%d", unit))
+			factors = append(factors, fmt.Sprintf("WHEN $%d THEN $%d::numeric", unit, param(formatFactor(c.Factor))))
+			offsets = append(offsets, fmt.Sprintf("WHEN $%d THEN $%d::numeric", unit, param(formatFactor(c.Offset))))
+		}
+		conds = append(conds, fmt.Sprintf("p.property_unit IN (%s)", strings.Join(units, ", ")))
+		value = fmt.Sprintf("(%s) * CASE p.property_unit %s END\n        + CASE p.property_unit %s END",
+			cast.value, strings.Join(factors, " "), strings.Join(offsets, " "))
+	case pred.Unit != "":
+		conds = append(conds, fmt.Sprintf("p.property_unit = $%d", param(pred.Unit)))
+	}
+	if op != "" {
+		conds = append(conds, fmt.Sprintf("%s %s "+cast.param, value, op, param(pred.Value)))
+	}
+	return "EXISTS (SELECT 1 FROM equipment_properties p\n    WHERE " + strings.Join(conds, "\n      AND ") + ")", nil
+}
+
+// formatFactor форматирует коэффициент перевода единиц для параметра numeric
+func formatFactor(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// formatTimestamp форматирует время для сравнения с timestamptz на стороне БД
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// ParseEquipmentSortKey проверяет, что значение курсора приводится к типу колонки сортировки
+func ParseEquipmentSortKey(column, key string) error {
+	sort, ok := equipmentSortColumns[column]
+	if !ok {
+		return fmt.Errorf("unsupported sort column %q", column)
+	}
+	if sort.cast != "timestamptz" || key == "-infinity" {
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339Nano, key); err != nil {
+		return fmt.Errorf("invalid sort key %q", key)
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package postgres
+
+import "embed"
+
+// Migrations содержит SQL миграции схемы в формате golang-migrate:
+// NNN_name.up.sql и NNN_name.down.sql (down-файлы sqlc игнорирует)
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS equipment_class_mappings;
+DROP TABLE IF EXISTS equipment_properties;
+DROP TABLE IF EXISTS equipment;
+DROP TABLE IF EXISTS equipment_class_properties;
+DROP TABLE IF EXISTS equipment_classes;
@@ -0,0 +1,5 @@
+ALTER TABLE equipment_class_mappings DROP COLUMN IF EXISTS position;
+ALTER TABLE equipment_properties DROP COLUMN IF EXISTS position;
+ALTER TABLE equipment DROP COLUMN IF EXISTS position;
+ALTER TABLE equipment_class_properties DROP COLUMN IF EXISTS position;
+ALTER TABLE equipment_classes DROP COLUMN IF EXISTS position;
@@ -0,0 +1,7 @@
+-- Порядок элементов внутри агрегатов (свойства, дочерние элементы, классы).
+-- В рамках одной транзакции created_at совпадает, поэтому порядок храним явно.
+ALTER TABLE equipment_classes ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE equipment_class_properties ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE equipment ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE equipment_properties ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE equipment_class_mappings ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,17 @@
+-- Create persons table (B2MML Person)
+CREATE TABLE persons (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    external_id VARCHAR(255) NOT NULL UNIQUE,
+    version VARCHAR(50),
+    person_name VARCHAR(255),
+    hierarchy_scope_id VARCHAR(255),
+    b2mml_data JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    record_version BIGINT NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_persons_external_id ON persons(external_id);
+CREATE INDEX idx_persons_deleted_at ON persons(deleted_at);
+CREATE INDEX idx_persons_created_at ON persons(created_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_equipment_classes_created_at_id;
+DROP INDEX IF EXISTS idx_equipment_status_created_at_id;
+DROP INDEX IF EXISTS idx_equipment_created_at_id;
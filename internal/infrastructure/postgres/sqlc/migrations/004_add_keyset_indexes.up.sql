@@ -0,0 +1,4 @@
+-- Индексы для keyset-пагинации по (created_at, id)
+CREATE INDEX idx_equipment_created_at_id ON equipment(created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_equipment_status_created_at_id ON equipment(operating_status, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_equipment_classes_created_at_id ON equipment_classes(created_at, id) WHERE deleted_at IS NULL;
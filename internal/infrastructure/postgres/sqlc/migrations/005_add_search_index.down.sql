@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_equipment_physical_asset_search;
+DROP INDEX IF EXISTS idx_equipment_properties_search;
+DROP INDEX IF EXISTS idx_equipment_classes_search;
+DROP INDEX IF EXISTS idx_equipment_search;
+
+DROP FUNCTION IF EXISTS property_search_vector(TEXT, TEXT);
+DROP FUNCTION IF EXISTS catalog_search_vector(TEXT, TEXT, JSONB);
+DROP FUNCTION IF EXISTS b2mml_descriptions_tsvector(JSONB);
+DROP FUNCTION IF EXISTS b2mml_descriptions_text(JSONB);
+DROP FUNCTION IF EXISTS search_config(TEXT);
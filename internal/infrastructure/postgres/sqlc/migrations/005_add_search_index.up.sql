@@ -0,0 +1,74 @@
+-- Полнотекстовый поиск по оборудованию, классам оборудования и физическим активам.
+-- Поисковые векторы вычисляются функциями и индексируются выражениями GIN,
+-- поэтому схема таблиц не меняется.
+
+-- search_config возвращает конфигурацию текстового поиска для кода языка B2MML (languageID)
+CREATE FUNCTION search_config(language TEXT) RETURNS regconfig
+LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+    SELECT CASE lower(split_part(coalesce(language, ''), '-', 1))
+        WHEN 'ru' THEN 'russian'::regconfig
+        WHEN 'en' THEN 'english'::regconfig
+        WHEN 'de' THEN 'german'::regconfig
+        WHEN 'fr' THEN 'french'::regconfig
+        WHEN 'es' THEN 'spanish'::regconfig
+        ELSE 'simple'::regconfig
+    END
+$$;
+
+-- b2mml_descriptions_text возвращает все описания B2MML объекта одной строкой
+CREATE FUNCTION b2mml_descriptions_text(data JSONB) RETURNS TEXT
+LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+    SELECT coalesce(string_agg(d->>'Value', ' '), '')
+    FROM jsonb_array_elements(
+        CASE WHEN jsonb_typeof(data->'Description') = 'array' THEN data->'Description' ELSE '[]'::jsonb END
+    ) AS d
+$$;
+
+-- b2mml_descriptions_tsvector индексирует каждое описание B2MML в конфигурации его языка.
+-- Слова дополнительно индексируются без стемминга, чтобы находиться по точному написанию
+CREATE FUNCTION b2mml_descriptions_tsvector(data JSONB) RETURNS tsvector
+LANGUAGE plpgsql IMMUTABLE PARALLEL SAFE AS $$
+DECLARE
+    d JSONB;
+    result tsvector := ''::tsvector;
+BEGIN
+    IF jsonb_typeof(data->'Description') IS DISTINCT FROM 'array' THEN
+        RETURN result;
+    END IF;
+    FOR d IN SELECT value FROM jsonb_array_elements(data->'Description') LOOP
+        result := result
+            || to_tsvector(search_config(d->>'LanguageIDAttr'), coalesce(d->>'Value', ''))
+            || to_tsvector('simple', coalesce(d->>'Value', ''));
+    END LOOP;
+    RETURN result;
+END
+$$;
+
+-- catalog_search_vector поисковый вектор оборудования и класса оборудования:
+-- внешний ID (вес A), описание и описания B2MML (вес B)
+CREATE FUNCTION catalog_search_vector(external_id TEXT, description TEXT, data JSONB) RETURNS tsvector
+LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+    SELECT setweight(to_tsvector('simple', coalesce(external_id, '')), 'A')
+        || setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+        || setweight(b2mml_descriptions_tsvector(data), 'B')
+$$;
+
+-- property_search_vector поисковый вектор свойства оборудования:
+-- значение (вес B) и описание (вес C)
+CREATE FUNCTION property_search_vector(value TEXT, description TEXT) RETURNS tsvector
+LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+    SELECT setweight(to_tsvector('simple', coalesce(value, '')), 'B')
+        || setweight(to_tsvector('simple', coalesce(description, '')), 'C')
+$$;
+
+CREATE INDEX idx_equipment_search ON equipment
+    USING GIN (catalog_search_vector(external_id, description, b2mml_data))
+    WHERE deleted_at IS NULL;
+CREATE INDEX idx_equipment_classes_search ON equipment_classes
+    USING GIN (catalog_search_vector(external_id, description, b2mml_data))
+    WHERE deleted_at IS NULL;
+CREATE INDEX idx_equipment_properties_search ON equipment_properties
+    USING GIN (property_search_vector(property_value, description));
+CREATE INDEX idx_equipment_physical_asset_search ON equipment
+    USING GIN (to_tsvector('simple', physical_asset_id))
+    WHERE physical_asset_id IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,8 @@
+-- Предпочтительные единицы измерения площадок (оборудование уровня Site)
+CREATE TABLE site_units (
+    site_id UUID NOT NULL REFERENCES equipment(id) ON DELETE CASCADE,
+    quantity_kind VARCHAR(64) NOT NULL,
+    unit_code VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (site_id, quantity_kind)
+);
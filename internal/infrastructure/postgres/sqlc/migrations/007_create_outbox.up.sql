@@ -0,0 +1,26 @@
+-- Транзакционный outbox доменных событий: строки пишутся в одной транзакции
+-- с изменением агрегата и доставляются фоновым диспетчером
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    aggregate_type VARCHAR(64) NOT NULL,
+    aggregate_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(128) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMPTZ,
+    last_error TEXT,
+    dispatched_at TIMESTAMPTZ
+);
+
+-- Недоставленные события в порядке записи (выборка диспетчера)
+CREATE INDEX idx_outbox_pending ON outbox(next_attempt_at, id) WHERE dispatched_at IS NULL;
+
+-- Проверка, что событие первое недоставленное в своём агрегате
+CREATE INDEX idx_outbox_aggregate_pending ON outbox(aggregate_type, aggregate_id, id) WHERE dispatched_at IS NULL;
+
+-- Очистка доставленных событий
+CREATE INDEX idx_outbox_dispatched ON outbox(dispatched_at) WHERE dispatched_at IS NOT NULL;
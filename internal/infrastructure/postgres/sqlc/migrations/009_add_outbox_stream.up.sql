@@ -0,0 +1,6 @@
+-- Идентификатор транзакции, записавшей событие. Поток событий читает outbox в
+-- порядке (txid, id) и только строки транзакций старше самой старой активной,
+-- поэтому событие поздно зафиксированной транзакции не пропускается
+ALTER TABLE outbox ADD COLUMN txid BIGINT NOT NULL DEFAULT (pg_current_xact_id()::text::bigint);
+
+CREATE INDEX idx_outbox_stream ON outbox(txid, id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS equipment_history;
+DROP FUNCTION IF EXISTS equipment_snapshot(UUID);
@@ -0,0 +1,77 @@
+-- История изменений оборудования. Каждая ревизия хранит полный снимок записи
+-- equipment вместе со свойствами (equipment_properties) и классами
+-- (equipment_class_mappings), поэтому состояние на любой момент читается одной строкой.
+
+-- equipment_snapshot возвращает текущее состояние оборудования в формате снимка истории
+CREATE FUNCTION equipment_snapshot(target UUID) RETURNS JSONB
+LANGUAGE sql STABLE AS $$
+    SELECT jsonb_build_object(
+        'external_id', e.external_id,
+        'parent_id', parent.external_id,
+        'position', e.position,
+        'version', e.version,
+        'description', e.description,
+        'published_date', e.published_date,
+        'effective_start_date', e.effective_start_date,
+        'effective_end_date', e.effective_end_date,
+        'hierarchy_scope_id', e.hierarchy_scope_id,
+        'equipment_level', e.equipment_level,
+        'operating_status', e.operating_status,
+        'physical_asset_id', e.physical_asset_id,
+        'operational_location_id', e.operational_location_id,
+        'deleted', e.deleted_at IS NOT NULL,
+        'b2mml_data', e.b2mml_data,
+        'classes', COALESCE((
+            SELECT jsonb_agg(c.external_id ORDER BY m.position, c.external_id)
+            FROM equipment_class_mappings m
+            JOIN equipment_classes c ON c.id = m.equipment_class_id
+            WHERE m.equipment_id = e.id
+        ), '[]'::jsonb),
+        'properties', COALESCE((
+            SELECT jsonb_agg(jsonb_build_object(
+                'id', p.external_id,
+                'value', p.property_value,
+                'data_type', p.property_data_type,
+                'unit', p.property_unit,
+                'description', p.description,
+                'b2mml_data', p.b2mml_data
+            ) ORDER BY p.position, p.external_id)
+            FROM equipment_properties p
+            WHERE p.equipment_id = e.id
+        ), '[]'::jsonb)
+    )
+    FROM equipment e
+    LEFT JOIN equipment parent ON parent.id = e.parent_equipment_id
+    WHERE e.id = target
+$$;
+
+-- Ревизии оборудования. equipment_id без внешнего ключа: история сохраняется
+-- и после физического удаления оборудования
+CREATE TABLE equipment_history (
+    id BIGSERIAL PRIMARY KEY,
+    equipment_id UUID NOT NULL,
+    external_id VARCHAR(255) NOT NULL,
+    revision INTEGER NOT NULL,
+    record_version BIGINT NOT NULL,
+    operation VARCHAR(16) NOT NULL,
+    parent_equipment_id UUID,
+    snapshot JSONB NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    changed_by TEXT,
+    change_reason TEXT,
+    UNIQUE (equipment_id, revision)
+);
+
+CREATE INDEX idx_equipment_history_external_id ON equipment_history(external_id, changed_at);
+CREATE INDEX idx_equipment_history_parent ON equipment_history(parent_equipment_id, changed_at);
+
+-- Начальная ревизия существующего оборудования: более ранние изменения неизвестны
+INSERT INTO equipment_history (
+    equipment_id, external_id, revision, record_version, operation,
+    parent_equipment_id, snapshot, changed_at
+)
+SELECT
+    e.id, e.external_id, 1, e.record_version,
+    CASE WHEN e.deleted_at IS NULL THEN 'created' ELSE 'deleted' END,
+    e.parent_equipment_id, equipment_snapshot(e.id), COALESCE(e.deleted_at, e.updated_at)
+FROM equipment e;
@@ -0,0 +1,15 @@
+-- Отметки удаления оборудования (soft delete). root_id - оборудование, удаление
+-- которого удалило запись: само оборудование или предок при каскадном удалении.
+-- По root_id восстановление и окончательное удаление (purge) обрабатывают
+-- каскадно удалённых потомков вместе с корнем
+CREATE TABLE equipment_deletions (
+    equipment_id UUID PRIMARY KEY REFERENCES equipment(id) ON DELETE CASCADE,
+    root_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_equipment_deletions_root_id ON equipment_deletions(root_id);
+
+-- Оборудование, удалённое до миграции, считается удалённым самостоятельно
+INSERT INTO equipment_deletions (equipment_id, root_id, created_at)
+SELECT id, id, deleted_at FROM equipment WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS import_job_sources;
+DROP TABLE IF EXISTS import_jobs;
@@ -0,0 +1,31 @@
+-- Задания импорта оборудования из CSV/JSONL: pending - ожидает обработчика,
+-- running - выполняется (locked_until - срок аренды обработчика), completed,
+-- failed - прервано ошибкой и может быть продолжено. rows_processed - число
+-- строк источника, результат которых зафиксирован: с него продолжается
+-- прерванное задание. row_errors - первые ошибки строк, error_count - все
+CREATE TABLE import_jobs (
+    id UUID PRIMARY KEY,
+    format VARCHAR(16) NOT NULL,
+    delimiter TEXT NOT NULL DEFAULT ',',
+    mapping JSONB NOT NULL DEFAULT '{}',
+    dry_run BOOLEAN NOT NULL DEFAULT FALSE,
+    chunk_size INTEGER NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    rows_processed BIGINT NOT NULL DEFAULT 0,
+    rows_imported BIGINT NOT NULL DEFAULT 0,
+    error_count BIGINT NOT NULL DEFAULT 0,
+    row_errors JSONB NOT NULL DEFAULT '[]',
+    error TEXT,
+    locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_import_jobs_active ON import_jobs(created_at) WHERE status IN ('pending', 'running');
+
+-- Исходные файлы заданий хранятся отдельно, чтобы отчёт читался без них
+CREATE TABLE import_job_sources (
+    job_id UUID PRIMARY KEY REFERENCES import_jobs(id) ON DELETE CASCADE,
+    data BYTEA NOT NULL
+);
@@ -6,6 +6,7 @@ package postgres
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -31,6 +32,7 @@ type Equipment struct {
 	UpdatedAt             time.Time             `db:"updated_at" json:"updated_at"`
 	DeletedAt             sql.NullTime          `db:"deleted_at" json:"deleted_at"`
 	RecordVersion         int64                 `db:"record_version" json:"record_version"`
+	Position              int32                 `db:"position" json:"position"`
 }
 
 type EquipmentClass struct {
@@ -48,6 +50,7 @@ type EquipmentClass struct {
 	CreatedAt          time.Time             `db:"created_at" json:"created_at"`
 	UpdatedAt          time.Time             `db:"updated_at" json:"updated_at"`
 	DeletedAt          sql.NullTime          `db:"deleted_at" json:"deleted_at"`
+	Position           int32                 `db:"position" json:"position"`
 }
 
 type EquipmentClassMapping struct {
@@ -55,6 +58,7 @@ type EquipmentClassMapping struct {
 	EquipmentID      uuid.UUID `db:"equipment_id" json:"equipment_id"`
 	EquipmentClassID uuid.UUID `db:"equipment_class_id" json:"equipment_class_id"`
 	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	Position         int32     `db:"position" json:"position"`
 }
 
 type EquipmentClassProperty struct {
@@ -67,6 +71,27 @@ type EquipmentClassProperty struct {
 	B2mmlData        pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
 	CreatedAt        time.Time             `db:"created_at" json:"created_at"`
 	UpdatedAt        time.Time             `db:"updated_at" json:"updated_at"`
+	Position         int32                 `db:"position" json:"position"`
+}
+
+type EquipmentDeletion struct {
+	EquipmentID uuid.UUID `db:"equipment_id" json:"equipment_id"`
+	RootID      uuid.UUID `db:"root_id" json:"root_id"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+}
+
+type EquipmentHistory struct {
+	ID                int64           `db:"id" json:"id"`
+	EquipmentID       uuid.UUID       `db:"equipment_id" json:"equipment_id"`
+	ExternalID        string          `db:"external_id" json:"external_id"`
+	Revision          int32           `db:"revision" json:"revision"`
+	RecordVersion     int64           `db:"record_version" json:"record_version"`
+	Operation         string          `db:"operation" json:"operation"`
+	ParentEquipmentID uuid.NullUUID   `db:"parent_equipment_id" json:"parent_equipment_id"`
+	Snapshot          json.RawMessage `db:"snapshot" json:"snapshot"`
+	ChangedAt         time.Time       `db:"changed_at" json:"changed_at"`
+	ChangedBy         sql.NullString  `db:"changed_by" json:"changed_by"`
+	ChangeReason      sql.NullString  `db:"change_reason" json:"change_reason"`
 }
 
 type EquipmentProperty struct {
@@ -81,4 +106,93 @@ type EquipmentProperty struct {
 	B2mmlData        pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
 	CreatedAt        time.Time             `db:"created_at" json:"created_at"`
 	UpdatedAt        time.Time             `db:"updated_at" json:"updated_at"`
+	Position         int32                 `db:"position" json:"position"`
+}
+
+type ImportJob struct {
+	ID            uuid.UUID       `db:"id" json:"id"`
+	Format        string          `db:"format" json:"format"`
+	Delimiter     string          `db:"delimiter" json:"delimiter"`
+	Mapping       json.RawMessage `db:"mapping" json:"mapping"`
+	DryRun        bool            `db:"dry_run" json:"dry_run"`
+	ChunkSize     int32           `db:"chunk_size" json:"chunk_size"`
+	Status        string          `db:"status" json:"status"`
+	RowsProcessed int64           `db:"rows_processed" json:"rows_processed"`
+	RowsImported  int64           `db:"rows_imported" json:"rows_imported"`
+	ErrorCount    int64           `db:"error_count" json:"error_count"`
+	RowErrors     json.RawMessage `db:"row_errors" json:"row_errors"`
+	Error         sql.NullString  `db:"error" json:"error"`
+	LockedUntil   sql.NullTime    `db:"locked_until" json:"locked_until"`
+	CreatedAt     time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time       `db:"updated_at" json:"updated_at"`
+	FinishedAt    sql.NullTime    `db:"finished_at" json:"finished_at"`
+}
+
+type ImportJobSource struct {
+	JobID uuid.UUID `db:"job_id" json:"job_id"`
+	Data  []byte    `db:"data" json:"data"`
+}
+
+type Outbox struct {
+	ID            int64           `db:"id" json:"id"`
+	EventID       uuid.UUID       `db:"event_id" json:"event_id"`
+	AggregateType string          `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   string          `db:"aggregate_id" json:"aggregate_id"`
+	EventType     string          `db:"event_type" json:"event_type"`
+	Payload       json.RawMessage `db:"payload" json:"payload"`
+	OccurredAt    time.Time       `db:"occurred_at" json:"occurred_at"`
+	CreatedAt     time.Time       `db:"created_at" json:"created_at"`
+	Attempts      int32           `db:"attempts" json:"attempts"`
+	NextAttemptAt time.Time       `db:"next_attempt_at" json:"next_attempt_at"`
+	LockedUntil   sql.NullTime    `db:"locked_until" json:"locked_until"`
+	LastError     sql.NullString  `db:"last_error" json:"last_error"`
+	DispatchedAt  sql.NullTime    `db:"dispatched_at" json:"dispatched_at"`
+	Txid          int64           `db:"txid" json:"txid"`
+}
+
+type Person struct {
+	ID               uuid.UUID             `db:"id" json:"id"`
+	ExternalID       string                `db:"external_id" json:"external_id"`
+	Version          sql.NullString        `db:"version" json:"version"`
+	PersonName       sql.NullString        `db:"person_name" json:"person_name"`
+	HierarchyScopeID sql.NullString        `db:"hierarchy_scope_id" json:"hierarchy_scope_id"`
+	B2mmlData        pqtype.NullRawMessage `db:"b2mml_data" json:"b2mml_data"`
+	CreatedAt        time.Time             `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time             `db:"updated_at" json:"updated_at"`
+	DeletedAt        sql.NullTime          `db:"deleted_at" json:"deleted_at"`
+	RecordVersion    int64                 `db:"record_version" json:"record_version"`
+}
+
+type SiteUnit struct {
+	SiteID       uuid.UUID `db:"site_id" json:"site_id"`
+	QuantityKind string    `db:"quantity_kind" json:"quantity_kind"`
+	UnitCode     string    `db:"unit_code" json:"unit_code"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID       `db:"id" json:"id"`
+	SubscriptionID uuid.UUID       `db:"subscription_id" json:"subscription_id"`
+	EventID        uuid.UUID       `db:"event_id" json:"event_id"`
+	EventType      string          `db:"event_type" json:"event_type"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	Status         string          `db:"status" json:"status"`
+	Attempts       int32           `db:"attempts" json:"attempts"`
+	NextAttemptAt  time.Time       `db:"next_attempt_at" json:"next_attempt_at"`
+	LockedUntil    sql.NullTime    `db:"locked_until" json:"locked_until"`
+	LastError      sql.NullString  `db:"last_error" json:"last_error"`
+	ResponseStatus sql.NullInt32   `db:"response_status" json:"response_status"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+	DeliveredAt    sql.NullTime    `db:"delivered_at" json:"delivered_at"`
+}
+
+type WebhookSubscription struct {
+	ID          uuid.UUID      `db:"id" json:"id"`
+	Url         string         `db:"url" json:"url"`
+	Secret      string         `db:"secret" json:"secret"`
+	EventTypes  []string       `db:"event_types" json:"event_types"`
+	Description sql.NullString `db:"description" json:"description"`
+	Active      bool           `db:"active" json:"active"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
 }
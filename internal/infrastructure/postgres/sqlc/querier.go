@@ -6,6 +6,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -13,6 +14,16 @@ import (
 type Querier interface {
 	// Equipment Class Mappings queries
 	AddEquipmentToClass(ctx context.Context, arg *AddEquipmentToClassParams) (*EquipmentClassMapping, error)
+	// Захватывает самое раннее ожидающее задание или задание, аренда которого истекла
+	ClaimImportJob(ctx context.Context, arg *ClaimImportJobParams) (*ImportJob, error)
+	// Захватывает готовые к доставке события на время аренды. Берётся только
+	// первое недоставленное событие каждого агрегата, поэтому события одного
+	// агрегата доставляются строго по порядку
+	ClaimOutboxEvents(ctx context.Context, arg *ClaimOutboxEventsParams) ([]*Outbox, error)
+	// Захватывает готовые к отправке доставки активных подписок на время аренды
+	ClaimWebhookDeliveries(ctx context.Context, arg *ClaimWebhookDeliveriesParams) ([]*WebhookDelivery, error)
+	// Снимает отметки удаления с оборудования root_id и удалённых каскадно с ним потомков
+	ClearEquipmentDeletions(ctx context.Context, rootID uuid.UUID) error
 	// Equipment queries
 	CreateEquipment(ctx context.Context, arg *CreateEquipmentParams) (*Equipment, error)
 	// Equipment Classes queries
@@ -21,25 +32,147 @@ type Querier interface {
 	CreateEquipmentClassProperty(ctx context.Context, arg *CreateEquipmentClassPropertyParams) (*EquipmentClassProperty, error)
 	// Equipment Properties queries
 	CreateEquipmentProperty(ctx context.Context, arg *CreateEquipmentPropertyParams) (*EquipmentProperty, error)
-	DeleteEquipment(ctx context.Context, id uuid.UUID) error
+	// Задания импорта оборудования
+	CreateImportJob(ctx context.Context, arg *CreateImportJobParams) error
+	CreateImportJobSource(ctx context.Context, arg *CreateImportJobSourceParams) error
+	// Транзакционный outbox доменных событий
+	CreateOutboxEvent(ctx context.Context, arg *CreateOutboxEventParams) error
+	// Persons queries
+	CreatePerson(ctx context.Context, arg *CreatePersonParams) (*Person, error)
+	CreateSiteUnit(ctx context.Context, arg *CreateSiteUnitParams) error
+	// Повторная постановка того же события (повтор доставки из outbox) игнорируется
+	CreateWebhookDelivery(ctx context.Context, arg *CreateWebhookDeliveryParams) error
+	// Подписки на доменные события и доставки webhook
+	CreateWebhookSubscription(ctx context.Context, arg *CreateWebhookSubscriptionParams) error
+	DeleteDeliveredWebhookDeliveries(ctx context.Context, before time.Time) (int64, error)
+	DeleteDispatchedOutboxEvents(ctx context.Context, before time.Time) (int64, error)
+	// Помечает оборудование удалённым, если сохранённая версия совпадает с ожидаемой
+	DeleteEquipment(ctx context.Context, arg *DeleteEquipmentParams) (uuid.UUID, error)
 	DeleteEquipmentClass(ctx context.Context, id uuid.UUID) error
+	DeleteEquipmentClassProperty(ctx context.Context, id uuid.UUID) error
+	// Каскадно удаляет активных потомков оборудования root_id и отмечает их удалёнными с ним
+	DeleteEquipmentDescendants(ctx context.Context, rootID uuid.UUID) ([]uuid.UUID, error)
 	DeleteEquipmentProperty(ctx context.Context, id uuid.UUID) error
+	DeleteSiteUnits(ctx context.Context, siteID string) error
+	DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) (int64, error)
+	GetDeletedEquipmentByExternalID(ctx context.Context, externalID string) (*Equipment, error)
 	GetEquipmentByExternalID(ctx context.Context, externalID string) (*Equipment, error)
 	GetEquipmentByID(ctx context.Context, id uuid.UUID) (*Equipment, error)
 	GetEquipmentClassByExternalID(ctx context.Context, externalID string) (*EquipmentClass, error)
 	GetEquipmentClassByID(ctx context.Context, id uuid.UUID) (*EquipmentClass, error)
-	ListAllEquipmentClasses(ctx context.Context, arg *ListAllEquipmentClassesParams) ([]*EquipmentClass, error)
+	// Оборудование, которому последним принадлежал внешний ID (в том числе удалённое)
+	GetEquipmentHistoryOwner(ctx context.Context, externalID string) (uuid.UUID, error)
+	// Внешний ID, версия и уровень оборудования без загрузки агрегата
+	GetEquipmentRef(ctx context.Context, externalID string) (*GetEquipmentRefRow, error)
+	GetEquipmentRevision(ctx context.Context, arg *GetEquipmentRevisionParams) (*EquipmentHistory, error)
+	// Последняя ревизия оборудования с внешним ID на момент as_of
+	GetEquipmentRevisionAt(ctx context.Context, arg *GetEquipmentRevisionAtParams) (*EquipmentHistory, error)
+	GetImportJob(ctx context.Context, id uuid.UUID) (*ImportJob, error)
+	GetImportJobSource(ctx context.Context, jobID uuid.UUID) ([]byte, error)
+	GetLatestEquipmentRevision(ctx context.Context, equipmentID uuid.UUID) (*EquipmentHistory, error)
+	// Граница потока: все будущие события будут после (txid, MaxInt64)
+	GetOutboxStreamHead(ctx context.Context) (int64, error)
+	// Позиция события по его номеру (id). Для удалённого события берётся txid
+	// ближайшего предыдущего
+	GetOutboxStreamPosition(ctx context.Context, id int64) (int64, error)
+	GetPersonByExternalID(ctx context.Context, externalID string) (*Person, error)
+	GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error)
+	GetWebhookSubscription(ctx context.Context, id uuid.UUID) (*WebhookSubscription, error)
+	ListActiveWebhookSubscriptions(ctx context.Context) ([]*WebhookSubscription, error)
 	ListChildEquipment(ctx context.Context, parentEquipmentID uuid.NullUUID) ([]*Equipment, error)
-	ListEquipment(ctx context.Context, arg *ListEquipmentParams) ([]*Equipment, error)
-	ListEquipmentByClass(ctx context.Context, equipmentClassID uuid.UUID) ([]*Equipment, error)
-	ListEquipmentByStatus(ctx context.Context, arg *ListEquipmentByStatusParams) ([]*Equipment, error)
+	ListChildEquipmentClasses(ctx context.Context, parentClassID uuid.NullUUID) ([]*EquipmentClass, error)
+	// Keyset-пагинация удалённого оборудования по (deleted_at, id): сначала удалённое последним
+	ListDeletedEquipmentAfter(ctx context.Context, arg *ListDeletedEquipmentAfterParams) ([]*Equipment, error)
+	ListDeletedEquipmentBefore(ctx context.Context, arg *ListDeletedEquipmentBeforeParams) ([]*Equipment, error)
+	ListEquipmentAfter(ctx context.Context, arg *ListEquipmentAfterParams) ([]*Equipment, error)
+	// Внешние ID предков оборудования от корня иерархии до непосредственного родителя
+	ListEquipmentAncestors(ctx context.Context, id uuid.UUID) ([]string, error)
+	ListEquipmentBefore(ctx context.Context, arg *ListEquipmentBeforeParams) ([]*Equipment, error)
+	ListEquipmentByClassAfter(ctx context.Context, arg *ListEquipmentByClassAfterParams) ([]*Equipment, error)
+	ListEquipmentByClassBefore(ctx context.Context, arg *ListEquipmentByClassBeforeParams) ([]*Equipment, error)
+	ListEquipmentByStatusAfter(ctx context.Context, arg *ListEquipmentByStatusAfterParams) ([]*Equipment, error)
+	ListEquipmentByStatusBefore(ctx context.Context, arg *ListEquipmentByStatusBeforeParams) ([]*Equipment, error)
+	// Дочернее оборудование parent_id на момент as_of: последние ревизии всех
+	// когда-либо дочерних элементов, у которых на этот момент тот же родитель
+	ListEquipmentChildrenAt(ctx context.Context, arg *ListEquipmentChildrenAtParams) ([]*EquipmentHistory, error)
+	// Класс и все его предки от корневого класса до самого класса
+	ListEquipmentClassLineage(ctx context.Context, id uuid.UUID) ([]*ListEquipmentClassLineageRow, error)
 	ListEquipmentClassProperties(ctx context.Context, equipmentClassID uuid.UUID) ([]*EquipmentClassProperty, error)
+	// Keyset-пагинация: After - страница после курсора в порядке (created_at, id) DESC,
+	// Before - страница перед курсором (в обратном порядке, переворачивается в коде)
+	ListEquipmentClassesAfter(ctx context.Context, arg *ListEquipmentClassesAfterParams) ([]*EquipmentClass, error)
+	ListEquipmentClassesBefore(ctx context.Context, arg *ListEquipmentClassesBeforeParams) ([]*EquipmentClass, error)
 	ListEquipmentClassesForEquipment(ctx context.Context, equipmentID uuid.UUID) ([]*EquipmentClass, error)
+	// Удалённое оборудование root_id с потомками, удалёнными каскадно с ним (корень - глубина 0).
+	// Строки упорядочены так, что родитель идёт раньше своих потомков
+	ListEquipmentDeletedWith(ctx context.Context, rootID uuid.UUID) ([]*ListEquipmentDeletedWithRow, error)
+	// Внешние ID оборудования и всех его предков; удалённое и отсутствующее
+	// оборудование пропускается
+	ListEquipmentHierarchy(ctx context.Context, externalIds []string) ([]string, error)
+	// Ревизии оборудования от новых к старым; before_revision ограничивает страницу
+	ListEquipmentHistory(ctx context.Context, arg *ListEquipmentHistoryParams) ([]*EquipmentHistory, error)
 	ListEquipmentProperties(ctx context.Context, equipmentID uuid.UUID) ([]*EquipmentProperty, error)
+	// Единицы ближайшей площадки среди оборудования и его предков.
+	// Площадка без единиц возвращается одной строкой с NULL вместо единицы
+	ListEquipmentSiteUnits(ctx context.Context, externalID string) ([]*ListEquipmentSiteUnitsRow, error)
+	// Поддерево оборудования с корнем root_id до глубины max_depth (корень - глубина 0).
+	// Строки упорядочены так, что родитель идёт раньше своих потомков
+	ListEquipmentSubtree(ctx context.Context, arg *ListEquipmentSubtreeParams) ([]*ListEquipmentSubtreeRow, error)
+	ListPersons(ctx context.Context, arg *ListPersonsParams) ([]*Person, error)
+	// ID свойств, заданных действующим оборудованием или определённых действующими классами
+	ListPropertyExternalIDs(ctx context.Context) ([]string, error)
+	// Предпочтительные единицы измерения площадок
+	ListSiteUnits(ctx context.Context, siteID string) ([]*ListSiteUnitsRow, error)
+	ListWebhookDeliveries(ctx context.Context, arg *ListWebhookDeliveriesParams) ([]*WebhookDelivery, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]*WebhookSubscription, error)
+	// Удаление оборудования (soft delete), восстановление и окончательное удаление
+	// Отмечает оборудование удалённым вместе с root_id (само оборудование для корня удаления)
+	MarkEquipmentDeleted(ctx context.Context, arg *MarkEquipmentDeletedParams) error
+	MarkOutboxEventDispatched(ctx context.Context, eventID uuid.UUID) error
+	MarkOutboxEventFailed(ctx context.Context, arg *MarkOutboxEventFailedParams) error
+	MarkWebhookDeliveryDelivered(ctx context.Context, arg *MarkWebhookDeliveryDeliveredParams) error
+	MarkWebhookDeliveryFailed(ctx context.Context, arg *MarkWebhookDeliveryFailedParams) error
+	// Переносит оборудование под другого родителя (NULL - в корень) в конец списка его детей
+	MoveEquipment(ctx context.Context, arg *MoveEquipmentParams) (*Equipment, error)
+	// Окончательно удаляет удалённое оборудование вместе с удалёнными каскадно с ним
+	// потомками; свойства, связи с классами и отметки удаляются внешними ключами
+	PurgeEquipment(ctx context.Context, rootID uuid.UUID) ([]string, error)
+	// Окончательно удаляет пачку оборудования, удалённого раньше before
+	PurgeEquipmentDeletedBefore(ctx context.Context, arg *PurgeEquipmentDeletedBeforeParams) ([]string, error)
+	// История изменений оборудования
+	// Добавляет ревизию с текущим состоянием оборудования. Ревизия не добавляется,
+	// если состояние не отличается от последней ревизии (например, при сохранении
+	// неизменённого дочернего оборудования вместе с родителем)
+	RecordEquipmentHistory(ctx context.Context, arg *RecordEquipmentHistoryParams) (int64, error)
+	// Удаляет связи с классами у оборудования, удалённого вместе с root_id (и у него самого)
+	RemoveDeletedEquipmentFromClasses(ctx context.Context, rootID uuid.UUID) error
 	RemoveEquipmentFromClass(ctx context.Context, arg *RemoveEquipmentFromClassParams) error
+	// Возвращает доставку из dead letter в очередь с обнулённым счётчиком попыток
+	ReplayWebhookDelivery(ctx context.Context, id uuid.UUID) (int64, error)
+	// Восстанавливает удалённое оборудование в конец списка детей его родителя,
+	// если сохранённая версия совпадает с ожидаемой
+	RestoreEquipment(ctx context.Context, arg *RestoreEquipmentParams) (uuid.UUID, error)
+	// Восстанавливает потомков, удалённых каскадно вместе с root_id
+	RestoreEquipmentDeletedWith(ctx context.Context, rootID uuid.UUID) ([]uuid.UUID, error)
+	// Возвращает прерванное задание в очередь или, если задан locked_until, сразу
+	// захватывает его до этого момента
+	ResumeImportJob(ctx context.Context, arg *ResumeImportJobParams) (int64, error)
+	// Сохраняет прогресс выполняемого задания, если его не продолжил другой обработчик
+	SaveImportJobProgress(ctx context.Context, arg *SaveImportJobProgressParams) (int64, error)
+	// Полнотекстовый поиск
+	// Ищет оборудование (по описаниям и значениям свойств), классы оборудования
+	// и физические активы. query - выражение в синтаксисе to_tsquery.
+	// Совпадения в headline выделяются символами \x02 и \x03
+	SearchCatalog(ctx context.Context, arg *SearchCatalogParams) ([]*SearchCatalogRow, error)
+	// События после позиции (after_txid, after_id) в порядке фиксации. Строки
+	// транзакций, которые ещё могут быть активны, не возвращаются до их завершения
+	StreamOutboxEvents(ctx context.Context, arg *StreamOutboxEventsParams) ([]*Outbox, error)
+	UpdateEquipment(ctx context.Context, arg *UpdateEquipmentParams) (*Equipment, error)
 	UpdateEquipmentClass(ctx context.Context, arg *UpdateEquipmentClassParams) (*EquipmentClass, error)
+	UpdateEquipmentClassProperty(ctx context.Context, arg *UpdateEquipmentClassPropertyParams) (*EquipmentClassProperty, error)
 	UpdateEquipmentProperty(ctx context.Context, arg *UpdateEquipmentPropertyParams) (*EquipmentProperty, error)
 	UpdateEquipmentStatus(ctx context.Context, arg *UpdateEquipmentStatusParams) (*Equipment, error)
+	UpdateWebhookSubscription(ctx context.Context, arg *UpdateWebhookSubscriptionParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,204 @@
+-- Удаление оборудования (soft delete), восстановление и окончательное удаление
+
+-- name: MarkEquipmentDeleted :exec
+-- Отмечает оборудование удалённым вместе с root_id (само оборудование для корня удаления)
+INSERT INTO equipment_deletions (equipment_id, root_id)
+VALUES (@equipment_id, @root_id)
+ON CONFLICT (equipment_id) DO UPDATE SET root_id = EXCLUDED.root_id, created_at = NOW();
+
+-- name: DeleteEquipmentDescendants :many
+-- Каскадно удаляет активных потомков оборудования root_id и отмечает их удалёнными с ним
+WITH RECURSIVE subtree AS (
+    SELECT child.id AS node_id, ARRAY[@root_id::uuid, child.id] AS path
+    FROM equipment child
+    WHERE child.parent_equipment_id = @root_id AND child.deleted_at IS NULL
+    UNION ALL
+    SELECT child.id, s.path || child.id
+    FROM equipment child
+    JOIN subtree s ON child.parent_equipment_id = s.node_id
+    WHERE child.deleted_at IS NULL
+        AND NOT child.id = ANY(s.path)
+),
+deleted AS (
+    UPDATE equipment
+    SET
+        deleted_at = NOW(),
+        record_version = equipment.record_version + 1,
+        updated_at = NOW()
+    FROM subtree
+    WHERE equipment.id = subtree.node_id
+    RETURNING equipment.id
+)
+INSERT INTO equipment_deletions (equipment_id, root_id)
+SELECT deleted.id, @root_id FROM deleted
+ON CONFLICT (equipment_id) DO UPDATE SET root_id = EXCLUDED.root_id, created_at = NOW()
+RETURNING equipment_id;
+
+-- name: RemoveDeletedEquipmentFromClasses :exec
+-- Удаляет связи с классами у оборудования, удалённого вместе с root_id (и у него самого)
+DELETE FROM equipment_class_mappings
+WHERE equipment_id IN (
+    SELECT equipment_id FROM equipment_deletions WHERE root_id = @root_id
+);
+
+-- name: GetDeletedEquipmentByExternalID :one
+SELECT
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version,
+    position
+FROM equipment
+WHERE external_id = $1 AND deleted_at IS NOT NULL;
+
+-- name: ListEquipmentDeletedWith :many
+-- Удалённое оборудование root_id с потомками, удалёнными каскадно с ним (корень - глубина 0).
+-- Строки упорядочены так, что родитель идёт раньше своих потомков
+WITH RECURSIVE subtree AS (
+    SELECT root.id AS node_id, 0 AS depth, ARRAY[root.id] AS path
+    FROM equipment root
+    WHERE root.id = @root_id AND root.deleted_at IS NOT NULL
+    UNION ALL
+    SELECT child.id, s.depth + 1, s.path || child.id
+    FROM equipment child
+    JOIN subtree s ON child.parent_equipment_id = s.node_id
+    JOIN equipment_deletions d ON d.equipment_id = child.id
+    WHERE d.root_id = @root_id
+        AND child.deleted_at IS NOT NULL
+        AND NOT child.id = ANY(s.path)
+)
+SELECT sqlc.embed(equipment), subtree.depth::int AS depth
+FROM subtree
+JOIN equipment ON equipment.id = subtree.node_id
+ORDER BY subtree.depth, equipment.parent_equipment_id, equipment.position, equipment.created_at;
+
+-- Keyset-пагинация удалённого оборудования по (deleted_at, id): сначала удалённое последним
+
+-- name: ListDeletedEquipmentAfter :many
+SELECT
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version,
+    position
+FROM equipment
+WHERE deleted_at IS NOT NULL
+  AND (sqlc.narg(cursor_deleted_at)::timestamptz IS NULL
+       OR (deleted_at, id) < (sqlc.narg(cursor_deleted_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+ORDER BY deleted_at DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListDeletedEquipmentBefore :many
+SELECT
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version,
+    position
+FROM equipment
+WHERE deleted_at IS NOT NULL
+  AND (deleted_at, id) > (sqlc.arg(cursor_deleted_at)::timestamptz, sqlc.arg(cursor_id)::uuid)
+ORDER BY deleted_at, id
+LIMIT sqlc.arg(page_limit);
+
+-- name: RestoreEquipment :one
+-- Восстанавливает удалённое оборудование в конец списка детей его родителя,
+-- если сохранённая версия совпадает с ожидаемой
+UPDATE equipment
+SET
+    deleted_at = NULL,
+    position = (
+        SELECT COALESCE(MAX(sibling.position) + 1, 0)
+        FROM equipment sibling
+        WHERE sibling.parent_equipment_id IS NOT DISTINCT FROM equipment.parent_equipment_id
+            AND sibling.deleted_at IS NULL
+    ),
+    record_version = @record_version,
+    updated_at = NOW()
+WHERE equipment.id = @id
+    AND equipment.record_version = @expected_version
+    AND equipment.deleted_at IS NOT NULL
+RETURNING id;
+
+-- name: RestoreEquipmentDeletedWith :many
+-- Восстанавливает потомков, удалённых каскадно вместе с root_id
+UPDATE equipment
+SET
+    deleted_at = NULL,
+    record_version = equipment.record_version + 1,
+    updated_at = NOW()
+FROM equipment_deletions d
+WHERE d.equipment_id = equipment.id
+    AND d.root_id = @root_id
+    AND equipment.id <> @root_id
+    AND equipment.deleted_at IS NOT NULL
+RETURNING equipment.id;
+
+-- name: ClearEquipmentDeletions :exec
+-- Снимает отметки удаления с оборудования root_id и удалённых каскадно с ним потомков
+DELETE FROM equipment_deletions
+WHERE root_id = @root_id OR equipment_id = @root_id;
+
+-- name: PurgeEquipment :many
+-- Окончательно удаляет удалённое оборудование вместе с удалёнными каскадно с ним
+-- потомками; свойства, связи с классами и отметки удаляются внешними ключами
+DELETE FROM equipment
+WHERE equipment.deleted_at IS NOT NULL
+    AND (equipment.id = @root_id OR equipment.id IN (
+        SELECT equipment_id FROM equipment_deletions WHERE root_id = @root_id
+    ))
+RETURNING external_id;
+
+-- name: PurgeEquipmentDeletedBefore :many
+-- Окончательно удаляет пачку оборудования, удалённого раньше before
+DELETE FROM equipment
+WHERE id IN (
+    SELECT expired.id FROM equipment expired
+    WHERE expired.deleted_at < @before::timestamptz
+    ORDER BY expired.deleted_at
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING external_id;
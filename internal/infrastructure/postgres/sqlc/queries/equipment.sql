@@ -11,9 +11,10 @@ INSERT INTO equipment_classes (
     hierarchy_scope_id,
     equipment_level,
     parent_class_id,
-    b2mml_data
+    b2mml_data,
+    position
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
 RETURNING
     id,
@@ -29,7 +30,8 @@ RETURNING
     b2mml_data,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    position;
 
 -- name: GetEquipmentClassByID :one
 SELECT
@@ -46,7 +48,8 @@ SELECT
     b2mml_data,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    position
 FROM equipment_classes
 WHERE id = $1 AND deleted_at IS NULL;
 
@@ -65,11 +68,15 @@ SELECT
     b2mml_data,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    position
 FROM equipment_classes
 WHERE external_id = $1 AND deleted_at IS NULL;
 
--- name: ListAllEquipmentClasses :many
+-- Keyset-пагинация: After - страница после курсора в порядке (created_at, id) DESC,
+-- Before - страница перед курсором (в обратном порядке, переворачивается в коде)
+
+-- name: ListEquipmentClassesAfter :many
 SELECT
     id,
     external_id,
@@ -84,11 +91,37 @@ SELECT
     b2mml_data,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    position
 FROM equipment_classes
 WHERE deleted_at IS NULL
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+  AND (sqlc.narg(cursor_created_at)::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListEquipmentClassesBefore :many
+SELECT
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    parent_class_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    position
+FROM equipment_classes
+WHERE deleted_at IS NULL
+  AND (created_at, id) > (sqlc.arg(cursor_created_at)::timestamptz, sqlc.arg(cursor_id)::uuid)
+ORDER BY created_at, id
+LIMIT sqlc.arg(page_limit);
 
 -- name: UpdateEquipmentClass :one
 UPDATE equipment_classes
@@ -101,6 +134,7 @@ SET
     hierarchy_scope_id = $7,
     equipment_level = $8,
     b2mml_data = $9,
+    position = $10,
     updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING
@@ -117,7 +151,46 @@ RETURNING
     b2mml_data,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    position;
+
+-- name: ListChildEquipmentClasses :many
+SELECT
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    parent_class_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    position
+FROM equipment_classes
+WHERE parent_class_id = $1 AND deleted_at IS NULL
+ORDER BY position, created_at;
+
+-- name: ListEquipmentClassLineage :many
+-- Класс и все его предки от корневого класса до самого класса
+WITH RECURSIVE lineage AS (
+    SELECT node.id AS class_id, node.parent_class_id, 0 AS depth
+    FROM equipment_classes node
+    WHERE node.id = @id AND node.deleted_at IS NULL
+    UNION ALL
+    SELECT parent.id, parent.parent_class_id, l.depth + 1
+    FROM equipment_classes parent
+    JOIN lineage l ON parent.id = l.parent_class_id
+    WHERE parent.deleted_at IS NULL AND l.depth < 1000
+)
+SELECT sqlc.embed(equipment_classes)
+FROM lineage
+JOIN equipment_classes ON equipment_classes.id = lineage.class_id
+ORDER BY lineage.depth DESC;
 
 -- name: DeleteEquipmentClass :exec
 UPDATE equipment_classes
@@ -133,8 +206,9 @@ INSERT INTO equipment_class_properties (
     description,
     property_type,
     parent_property_id,
-    b2mml_data
-) VALUES ($1, $2, $3, $4, $5, $6)
+    b2mml_data,
+    position
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING
     id,
     equipment_class_id,
@@ -144,7 +218,8 @@ RETURNING
     parent_property_id,
     b2mml_data,
     created_at,
-    updated_at;
+    updated_at,
+    position;
 
 -- name: ListEquipmentClassProperties :many
 SELECT
@@ -156,10 +231,37 @@ SELECT
     parent_property_id,
     b2mml_data,
     created_at,
-    updated_at
+    updated_at,
+    position
 FROM equipment_class_properties
 WHERE equipment_class_id = $1
-ORDER BY created_at;
+ORDER BY position, created_at;
+
+-- name: UpdateEquipmentClassProperty :one
+UPDATE equipment_class_properties
+SET
+    description = $2,
+    property_type = $3,
+    parent_property_id = $4,
+    b2mml_data = $5,
+    position = $6,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING
+    id,
+    equipment_class_id,
+    external_id,
+    description,
+    property_type,
+    parent_property_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    position;
+
+-- name: DeleteEquipmentClassProperty :exec
+DELETE FROM equipment_class_properties
+WHERE id = $1;
 
 -- Equipment queries
 
@@ -177,9 +279,11 @@ INSERT INTO equipment (
     physical_asset_id,
     operational_location_id,
     parent_equipment_id,
-    b2mml_data
+    b2mml_data,
+    record_version,
+    position
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 )
 RETURNING
     id,
@@ -199,7 +303,8 @@ RETURNING
     created_at,
     updated_at,
     deleted_at,
-    record_version;
+    record_version,
+    position;
 
 -- name: GetEquipmentByID :one
 SELECT
@@ -220,7 +325,8 @@ SELECT
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 FROM equipment
 WHERE id = $1 AND deleted_at IS NULL;
 
@@ -243,11 +349,49 @@ SELECT
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
+FROM equipment
+WHERE external_id = $1 AND deleted_at IS NULL;
+
+-- name: GetEquipmentRef :one
+-- Внешний ID, версия и уровень оборудования без загрузки агрегата
+SELECT
+    external_id,
+    record_version,
+    COALESCE(b2mml_data -> 'EquipmentLevel', 'null')::jsonb AS equipment_level
 FROM equipment
 WHERE external_id = $1 AND deleted_at IS NULL;
 
--- name: ListEquipment :many
+-- name: ListEquipmentAfter :many
+SELECT
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version,
+    position
+FROM equipment
+WHERE deleted_at IS NULL
+  AND (sqlc.narg(cursor_created_at)::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListEquipmentBefore :many
 SELECT
     id,
     external_id,
@@ -266,13 +410,15 @@ SELECT
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 FROM equipment
 WHERE deleted_at IS NULL
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+  AND (created_at, id) > (sqlc.arg(cursor_created_at)::timestamptz, sqlc.arg(cursor_id)::uuid)
+ORDER BY created_at, id
+LIMIT sqlc.arg(page_limit);
 
--- name: ListEquipmentByStatus :many
+-- name: ListEquipmentByStatusAfter :many
 SELECT
     id,
     external_id,
@@ -291,11 +437,41 @@ SELECT
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 FROM equipment
-WHERE operating_status = $1 AND deleted_at IS NULL
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
+WHERE operating_status = sqlc.arg(operating_status) AND deleted_at IS NULL
+  AND (sqlc.narg(cursor_created_at)::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListEquipmentByStatusBefore :many
+SELECT
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version,
+    position
+FROM equipment
+WHERE operating_status = sqlc.arg(operating_status) AND deleted_at IS NULL
+  AND (created_at, id) > (sqlc.arg(cursor_created_at)::timestamptz, sqlc.arg(cursor_id)::uuid)
+ORDER BY created_at, id
+LIMIT sqlc.arg(page_limit);
 
 -- name: ListChildEquipment :many
 SELECT
@@ -316,18 +492,112 @@ SELECT
     created_at,
     updated_at,
     deleted_at,
-    record_version
+    record_version,
+    position
 FROM equipment
 WHERE parent_equipment_id = $1 AND deleted_at IS NULL
-ORDER BY created_at;
+ORDER BY position, created_at;
+
+-- name: ListEquipmentSubtree :many
+-- Поддерево оборудования с корнем root_id до глубины max_depth (корень - глубина 0).
+-- Строки упорядочены так, что родитель идёт раньше своих потомков
+WITH RECURSIVE subtree AS (
+    SELECT root.id AS node_id, 0 AS depth, ARRAY[root.id] AS path
+    FROM equipment root
+    WHERE root.id = @root_id AND root.deleted_at IS NULL
+    UNION ALL
+    SELECT child.id, s.depth + 1, s.path || child.id
+    FROM equipment child
+    JOIN subtree s ON child.parent_equipment_id = s.node_id
+    WHERE child.deleted_at IS NULL
+        AND s.depth < @max_depth::int
+        AND NOT child.id = ANY(s.path)
+)
+SELECT sqlc.embed(equipment), subtree.depth::int AS depth
+FROM subtree
+JOIN equipment ON equipment.id = subtree.node_id
+ORDER BY subtree.depth, equipment.parent_equipment_id, equipment.position, equipment.created_at;
+
+-- name: ListEquipmentAncestors :many
+-- Внешние ID предков оборудования от корня иерархии до непосредственного родителя
+WITH RECURSIVE ancestors AS (
+    SELECT node.parent_equipment_id AS ancestor_id, 1 AS depth
+    FROM equipment node
+    WHERE node.id = @id
+    UNION ALL
+    SELECT parent.parent_equipment_id, a.depth + 1
+    FROM equipment parent
+    JOIN ancestors a ON parent.id = a.ancestor_id
+    WHERE a.depth < 1000
+)
+SELECT equipment.external_id
+FROM ancestors
+JOIN equipment ON equipment.id = ancestors.ancestor_id
+ORDER BY ancestors.depth DESC;
+
+-- name: ListEquipmentHierarchy :many
+-- Внешние ID оборудования и всех его предков; удалённое и отсутствующее
+-- оборудование пропускается
+WITH RECURSIVE hierarchy AS (
+    SELECT node.id, node.parent_equipment_id, 1 AS depth
+    FROM equipment node
+    WHERE node.external_id = ANY(@external_ids::text[]) AND node.deleted_at IS NULL
+    UNION
+    SELECT parent.id, parent.parent_equipment_id, h.depth + 1
+    FROM equipment parent
+    JOIN hierarchy h ON parent.id = h.parent_equipment_id
+    WHERE h.depth < 1000
+)
+SELECT DISTINCT equipment.external_id
+FROM hierarchy
+JOIN equipment ON equipment.id = hierarchy.id;
+
+-- name: MoveEquipment :one
+-- Переносит оборудование под другого родителя (NULL - в корень) в конец списка его детей
+UPDATE equipment
+SET
+    parent_equipment_id = sqlc.narg(parent_id),
+    position = (
+        SELECT COALESCE(MAX(sibling.position) + 1, 0)
+        FROM equipment sibling
+        WHERE sibling.parent_equipment_id IS NOT DISTINCT FROM sqlc.narg(parent_id)
+            AND sibling.deleted_at IS NULL
+    ),
+    record_version = sqlc.arg(record_version),
+    updated_at = NOW()
+WHERE equipment.id = sqlc.arg(id)
+    AND equipment.record_version = sqlc.arg(expected_version)
+    AND equipment.deleted_at IS NULL
+RETURNING
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version,
+    position;
 
 -- name: UpdateEquipmentStatus :one
 UPDATE equipment
 SET
-    operating_status = $2,
+    operating_status = @operating_status,
     updated_at = NOW(),
     record_version = record_version + 1
-WHERE id = $1 AND deleted_at IS NULL
+WHERE id = @id
+    AND record_version = @expected_version
+    AND deleted_at IS NULL
 RETURNING
     id,
     external_id,
@@ -346,12 +616,61 @@ RETURNING
     created_at,
     updated_at,
     deleted_at,
-    record_version;
+    record_version,
+    position;
 
--- name: DeleteEquipment :exec
+-- name: UpdateEquipment :one
 UPDATE equipment
-SET deleted_at = NOW()
-WHERE id = $1;
+SET
+    version = @version,
+    description = @description,
+    published_date = @published_date,
+    effective_start_date = @effective_start_date,
+    effective_end_date = @effective_end_date,
+    hierarchy_scope_id = @hierarchy_scope_id,
+    equipment_level = @equipment_level,
+    operating_status = @operating_status,
+    physical_asset_id = @physical_asset_id,
+    operational_location_id = @operational_location_id,
+    b2mml_data = @b2mml_data,
+    record_version = @record_version,
+    position = @position,
+    updated_at = NOW()
+WHERE id = @id
+    AND record_version = @expected_version
+    AND deleted_at IS NULL
+RETURNING
+    id,
+    external_id,
+    version,
+    description,
+    published_date,
+    effective_start_date,
+    effective_end_date,
+    hierarchy_scope_id,
+    equipment_level,
+    operating_status,
+    physical_asset_id,
+    operational_location_id,
+    parent_equipment_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version,
+    position;
+
+-- name: DeleteEquipment :one
+-- Помечает оборудование удалённым, если сохранённая версия совпадает с ожидаемой
+UPDATE equipment
+SET
+    deleted_at = NOW(),
+    record_version = @record_version,
+    updated_at = NOW()
+WHERE id = @id
+    AND record_version = @expected_version
+    AND deleted_at IS NULL
+RETURNING id;
 
 -- Equipment Properties queries
 
@@ -364,8 +683,9 @@ INSERT INTO equipment_properties (
     property_data_type,
     property_unit,
     description,
-    b2mml_data
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    b2mml_data,
+    position
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING
     id,
     equipment_id,
@@ -377,7 +697,8 @@ RETURNING
     description,
     b2mml_data,
     created_at,
-    updated_at;
+    updated_at,
+    position;
 
 -- name: ListEquipmentProperties :many
 SELECT
@@ -391,10 +712,24 @@ SELECT
     description,
     b2mml_data,
     created_at,
-    updated_at
+    updated_at,
+    position
 FROM equipment_properties
 WHERE equipment_id = $1
-ORDER BY created_at;
+ORDER BY position, created_at;
+
+-- name: ListPropertyExternalIDs :many
+-- ID свойств, заданных действующим оборудованием или определённых действующими классами
+SELECT p.external_id::text AS external_id
+FROM equipment_properties p
+JOIN equipment e ON e.id = p.equipment_id
+WHERE e.deleted_at IS NULL
+UNION
+SELECT cp.external_id::text
+FROM equipment_class_properties cp
+JOIN equipment_classes c ON c.id = cp.equipment_class_id
+WHERE c.deleted_at IS NULL
+ORDER BY external_id;
 
 -- name: UpdateEquipmentProperty :one
 UPDATE equipment_properties
@@ -404,6 +739,7 @@ SET
     property_unit = $4,
     description = $5,
     b2mml_data = $6,
+    position = $7,
     updated_at = NOW()
 WHERE id = $1
 RETURNING
@@ -417,7 +753,8 @@ RETURNING
     description,
     b2mml_data,
     created_at,
-    updated_at;
+    updated_at,
+    position;
 
 -- name: DeleteEquipmentProperty :exec
 DELETE FROM equipment_properties
@@ -428,13 +765,15 @@ WHERE id = $1;
 -- name: AddEquipmentToClass :one
 INSERT INTO equipment_class_mappings (
     equipment_id,
-    equipment_class_id
-) VALUES ($1, $2)
+    equipment_class_id,
+    position
+) VALUES ($1, $2, $3)
 RETURNING
     id,
     equipment_id,
     equipment_class_id,
-    created_at;
+    created_at,
+    position;
 
 -- name: RemoveEquipmentFromClass :exec
 DELETE FROM equipment_class_mappings
@@ -455,13 +794,43 @@ SELECT
     ec.b2mml_data,
     ec.created_at,
     ec.updated_at,
-    ec.deleted_at
+    ec.deleted_at,
+    ec.position
 FROM equipment_class_mappings ecm
 JOIN equipment_classes ec ON ecm.equipment_class_id = ec.id
 WHERE ecm.equipment_id = $1 AND ec.deleted_at IS NULL
-ORDER BY ec.created_at;
+ORDER BY ecm.position, ecm.created_at;
+
+-- name: ListEquipmentByClassAfter :many
+SELECT
+    e.id,
+    e.external_id,
+    e.version,
+    e.description,
+    e.published_date,
+    e.effective_start_date,
+    e.effective_end_date,
+    e.hierarchy_scope_id,
+    e.equipment_level,
+    e.operating_status,
+    e.physical_asset_id,
+    e.operational_location_id,
+    e.parent_equipment_id,
+    e.b2mml_data,
+    e.created_at,
+    e.updated_at,
+    e.deleted_at,
+    e.record_version,
+    e.position
+FROM equipment e
+JOIN equipment_class_mappings ecm ON e.id = ecm.equipment_id
+WHERE ecm.equipment_class_id = sqlc.arg(equipment_class_id) AND e.deleted_at IS NULL
+  AND (sqlc.narg(cursor_created_at)::timestamptz IS NULL
+       OR (e.created_at, e.id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+ORDER BY e.created_at DESC, e.id DESC
+LIMIT sqlc.arg(page_limit);
 
--- name: ListEquipmentByClass :many
+-- name: ListEquipmentByClassBefore :many
 SELECT
     e.id,
     e.external_id,
@@ -480,8 +849,11 @@ SELECT
     e.created_at,
     e.updated_at,
     e.deleted_at,
-    e.record_version
+    e.record_version,
+    e.position
 FROM equipment e
 JOIN equipment_class_mappings ecm ON e.id = ecm.equipment_id
-WHERE ecm.equipment_class_id = $1 AND e.deleted_at IS NULL
-ORDER BY e.created_at DESC;
+WHERE ecm.equipment_class_id = sqlc.arg(equipment_class_id) AND e.deleted_at IS NULL
+  AND (e.created_at, e.id) > (sqlc.arg(cursor_created_at)::timestamptz, sqlc.arg(cursor_id)::uuid)
+ORDER BY e.created_at, e.id
+LIMIT sqlc.arg(page_limit);
@@ -0,0 +1,78 @@
+-- История изменений оборудования
+
+-- name: RecordEquipmentHistory :execrows
+-- Добавляет ревизию с текущим состоянием оборудования. Ревизия не добавляется,
+-- если состояние не отличается от последней ревизии (например, при сохранении
+-- неизменённого дочернего оборудования вместе с родителем)
+INSERT INTO equipment_history (
+    equipment_id, external_id, revision, record_version, operation,
+    parent_equipment_id, snapshot, changed_by, change_reason
+)
+SELECT
+    e.id,
+    e.external_id,
+    COALESCE((SELECT MAX(h.revision) FROM equipment_history h WHERE h.equipment_id = e.id), 0) + 1,
+    e.record_version,
+    @operation,
+    e.parent_equipment_id,
+    s.snapshot,
+    sqlc.narg(changed_by),
+    sqlc.narg(change_reason)
+FROM equipment e
+CROSS JOIN LATERAL (SELECT equipment_snapshot(e.id) AS snapshot) s
+WHERE e.id = @equipment_id
+    AND s.snapshot IS DISTINCT FROM (
+        SELECT last.snapshot FROM equipment_history last
+        WHERE last.equipment_id = e.id
+        ORDER BY last.revision DESC
+        LIMIT 1
+    );
+
+-- name: GetEquipmentHistoryOwner :one
+-- Оборудование, которому последним принадлежал внешний ID (в том числе удалённое)
+SELECT equipment_id FROM equipment_history
+WHERE external_id = @external_id
+ORDER BY changed_at DESC, id DESC
+LIMIT 1;
+
+-- name: ListEquipmentHistory :many
+-- Ревизии оборудования от новых к старым; before_revision ограничивает страницу
+SELECT * FROM equipment_history
+WHERE equipment_id = @equipment_id
+    AND (sqlc.narg(before_revision)::int IS NULL OR revision < sqlc.narg(before_revision)::int)
+ORDER BY revision DESC
+LIMIT @page_limit;
+
+-- name: GetEquipmentRevision :one
+SELECT * FROM equipment_history
+WHERE equipment_id = @equipment_id AND revision = @revision;
+
+-- name: GetLatestEquipmentRevision :one
+SELECT * FROM equipment_history
+WHERE equipment_id = @equipment_id
+ORDER BY revision DESC
+LIMIT 1;
+
+-- name: GetEquipmentRevisionAt :one
+-- Последняя ревизия оборудования с внешним ID на момент as_of
+SELECT * FROM equipment_history
+WHERE external_id = @external_id AND changed_at <= @as_of::timestamptz
+ORDER BY changed_at DESC, revision DESC
+LIMIT 1;
+
+-- name: ListEquipmentChildrenAt :many
+-- Дочернее оборудование parent_id на момент as_of: последние ревизии всех
+-- когда-либо дочерних элементов, у которых на этот момент тот же родитель
+SELECT h.* FROM (
+    SELECT DISTINCT ON (history.equipment_id) history.*
+    FROM equipment_history history
+    WHERE history.equipment_id IN (
+            SELECT candidate.equipment_id FROM equipment_history candidate
+            WHERE candidate.parent_equipment_id = @parent_id::uuid
+                AND candidate.changed_at <= @as_of::timestamptz
+        )
+        AND history.changed_at <= @as_of::timestamptz
+    ORDER BY history.equipment_id, history.changed_at DESC, history.revision DESC
+) h
+WHERE h.parent_equipment_id = @parent_id::uuid AND h.operation <> 'deleted'
+ORDER BY (h.snapshot->>'position')::int, h.external_id;
@@ -0,0 +1,62 @@
+-- Задания импорта оборудования
+
+-- name: CreateImportJob :exec
+INSERT INTO import_jobs (id, format, delimiter, mapping, dry_run, chunk_size, status, locked_until, created_at, updated_at)
+VALUES (@id, @format, @delimiter, @mapping, @dry_run, @chunk_size, @status, @locked_until, @created_at, @updated_at);
+
+-- name: CreateImportJobSource :exec
+INSERT INTO import_job_sources (job_id, data)
+VALUES (@job_id, @data);
+
+-- name: GetImportJob :one
+SELECT * FROM import_jobs
+WHERE id = @id;
+
+-- name: GetImportJobSource :one
+SELECT data FROM import_job_sources
+WHERE job_id = @job_id;
+
+-- name: ClaimImportJob :one
+-- Захватывает самое раннее ожидающее задание или задание, аренда которого истекла
+UPDATE import_jobs j
+SET status = 'running',
+    locked_until = @locked_until::timestamptz,
+    updated_at = NOW()
+WHERE j.id = (
+    SELECT c.id
+    FROM import_jobs c
+    WHERE c.status = 'pending'
+       OR (c.status = 'running' AND c.locked_until <= @now::timestamptz)
+    ORDER BY c.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING j.*;
+
+-- name: SaveImportJobProgress :execrows
+-- Сохраняет прогресс выполняемого задания, если его не продолжил другой обработчик
+UPDATE import_jobs
+SET status = @status,
+    rows_processed = @rows_processed,
+    rows_imported = @rows_imported,
+    error_count = @error_count,
+    row_errors = @row_errors,
+    error = @error,
+    locked_until = sqlc.narg('locked_until'),
+    finished_at = sqlc.narg('finished_at'),
+    updated_at = NOW()
+WHERE id = @id
+  AND status = 'running'
+  AND rows_processed = @from_rows;
+
+-- name: ResumeImportJob :execrows
+-- Возвращает прерванное задание в очередь или, если задан locked_until, сразу
+-- захватывает его до этого момента
+UPDATE import_jobs
+SET status = CASE WHEN sqlc.narg('locked_until')::timestamptz IS NULL THEN 'pending' ELSE 'running' END,
+    error = NULL,
+    locked_until = sqlc.narg('locked_until')::timestamptz,
+    finished_at = NULL,
+    updated_at = NOW()
+WHERE id = @id
+  AND status = 'failed';
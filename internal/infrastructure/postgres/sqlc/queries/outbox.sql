@@ -0,0 +1,74 @@
+-- Транзакционный outbox доменных событий
+
+-- name: CreateOutboxEvent :exec
+INSERT INTO outbox (aggregate_type, aggregate_id, event_type, payload, occurred_at)
+VALUES (@aggregate_type, @aggregate_id, @event_type, @payload, @occurred_at);
+
+-- name: ClaimOutboxEvents :many
+-- Захватывает готовые к доставке события на время аренды. Берётся только
+-- первое недоставленное событие каждого агрегата, поэтому события одного
+-- агрегата доставляются строго по порядку
+UPDATE outbox o
+SET locked_until = @locked_until::timestamptz
+WHERE o.id IN (
+    SELECT c.id
+    FROM outbox c
+    WHERE c.dispatched_at IS NULL
+      AND c.next_attempt_at <= @now::timestamptz
+      AND (c.locked_until IS NULL OR c.locked_until <= @now::timestamptz)
+      AND NOT EXISTS (
+          SELECT 1
+          FROM outbox prev
+          WHERE prev.aggregate_type = c.aggregate_type
+            AND prev.aggregate_id = c.aggregate_id
+            AND prev.dispatched_at IS NULL
+            AND prev.id < c.id
+      )
+    ORDER BY c.id
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING o.*;
+
+-- name: MarkOutboxEventDispatched :exec
+UPDATE outbox
+SET dispatched_at = NOW(),
+    attempts = attempts + 1,
+    locked_until = NULL,
+    last_error = NULL
+WHERE event_id = @event_id;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox
+SET attempts = attempts + 1,
+    next_attempt_at = @next_attempt_at,
+    locked_until = NULL,
+    last_error = @last_error
+WHERE event_id = @event_id;
+
+-- name: DeleteDispatchedOutboxEvents :execrows
+DELETE FROM outbox
+WHERE dispatched_at IS NOT NULL
+  AND dispatched_at < @before::timestamptz;
+
+-- name: StreamOutboxEvents :many
+-- События после позиции (after_txid, after_id) в порядке фиксации. Строки
+-- транзакций, которые ещё могут быть активны, не возвращаются до их завершения
+SELECT * FROM outbox
+WHERE (txid, id) > (@after_txid::bigint, @after_id::bigint)
+  AND txid < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY txid, id
+LIMIT @batch_size;
+
+-- name: GetOutboxStreamHead :one
+-- Граница потока: все будущие события будут после (txid, MaxInt64)
+SELECT (pg_snapshot_xmin(pg_current_snapshot())::text::bigint - 1)::bigint AS txid;
+
+-- name: GetOutboxStreamPosition :one
+-- Позиция события по его номеру (id). Для удалённого события берётся txid
+-- ближайшего предыдущего
+SELECT COALESCE(
+    (SELECT o.txid FROM outbox o WHERE o.id = @id),
+    (SELECT max(p.txid) FROM outbox p WHERE p.id < @id),
+    0
+)::bigint AS txid;
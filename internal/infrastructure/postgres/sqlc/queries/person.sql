@@ -0,0 +1,54 @@
+-- Persons queries
+
+-- name: CreatePerson :one
+INSERT INTO persons (
+    external_id,
+    version,
+    person_name,
+    hierarchy_scope_id,
+    b2mml_data,
+    record_version
+) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING
+    id,
+    external_id,
+    version,
+    person_name,
+    hierarchy_scope_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version;
+
+-- name: GetPersonByExternalID :one
+SELECT
+    id,
+    external_id,
+    version,
+    person_name,
+    hierarchy_scope_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version
+FROM persons
+WHERE external_id = $1 AND deleted_at IS NULL;
+
+-- name: ListPersons :many
+SELECT
+    id,
+    external_id,
+    version,
+    person_name,
+    hierarchy_scope_id,
+    b2mml_data,
+    created_at,
+    updated_at,
+    deleted_at,
+    record_version
+FROM persons
+WHERE deleted_at IS NULL
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;
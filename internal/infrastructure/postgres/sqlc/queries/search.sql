@@ -0,0 +1,81 @@
+-- Полнотекстовый поиск
+
+-- name: SearchCatalog :many
+-- Ищет оборудование (по описаниям и значениям свойств), классы оборудования
+-- и физические активы. query - выражение в синтаксисе to_tsquery.
+-- Совпадения в headline выделяются символами \x02 и \x03
+WITH q AS (
+    SELECT to_tsquery(search_config(sqlc.arg(language)::text), sqlc.arg(query)::text)
+        || to_tsquery('simple', sqlc.arg(query)::text) AS query
+),
+equipment_matches AS (
+    SELECT e.id
+    FROM equipment e, q
+    WHERE 'equipment' = ANY(sqlc.arg(kinds)::text[])
+      AND e.deleted_at IS NULL
+      AND catalog_search_vector(e.external_id, e.description, e.b2mml_data) @@ q.query
+    UNION
+    SELECT ep.equipment_id
+    FROM equipment_properties ep, q
+    WHERE 'equipment' = ANY(sqlc.arg(kinds)::text[])
+      AND property_search_vector(ep.property_value, ep.description) @@ q.query
+),
+hits AS (
+    SELECT
+        'equipment'::text AS kind,
+        e.external_id::text AS external_id,
+        ''::text AS related_id,
+        coalesce(nullif(e.description, ''), b2mml_descriptions_text(e.b2mml_data))::text AS title,
+        concat_ws(' ', e.external_id, e.description, b2mml_descriptions_text(e.b2mml_data), p.values)::text AS document,
+        (ts_rank_cd(catalog_search_vector(e.external_id, e.description, e.b2mml_data), q.query)
+            + coalesce(p.rank, 0))::real AS rank
+    FROM equipment_matches m
+    JOIN equipment e ON e.id = m.id AND e.deleted_at IS NULL
+    CROSS JOIN q
+    LEFT JOIN LATERAL (
+        SELECT
+            string_agg(ep.property_value, ' ') AS values,
+            max(ts_rank_cd(property_search_vector(ep.property_value, ep.description), q.query)) AS rank
+        FROM equipment_properties ep
+        WHERE ep.equipment_id = e.id
+          AND property_search_vector(ep.property_value, ep.description) @@ q.query
+    ) p ON true
+    UNION ALL
+    SELECT
+        'equipment_class'::text,
+        c.external_id::text,
+        ''::text,
+        coalesce(nullif(c.description, ''), b2mml_descriptions_text(c.b2mml_data))::text,
+        concat_ws(' ', c.external_id, c.description, b2mml_descriptions_text(c.b2mml_data))::text,
+        ts_rank_cd(catalog_search_vector(c.external_id, c.description, c.b2mml_data), q.query)::real
+    FROM equipment_classes c, q
+    WHERE 'equipment_class' = ANY(sqlc.arg(kinds)::text[])
+      AND c.deleted_at IS NULL
+      AND catalog_search_vector(c.external_id, c.description, c.b2mml_data) @@ q.query
+    UNION ALL
+    SELECT
+        'physical_asset'::text,
+        e.physical_asset_id::text,
+        e.external_id::text,
+        e.physical_asset_id::text,
+        e.physical_asset_id::text,
+        ts_rank_cd(to_tsvector('simple', e.physical_asset_id), q.query)::real
+    FROM equipment e, q
+    WHERE 'physical_asset' = ANY(sqlc.arg(kinds)::text[])
+      AND e.physical_asset_id IS NOT NULL
+      AND e.deleted_at IS NULL
+      AND to_tsvector('simple', e.physical_asset_id) @@ q.query
+)
+SELECT
+    h.kind,
+    h.external_id,
+    h.related_id,
+    h.title,
+    ts_headline(
+        search_config(sqlc.arg(language)::text), h.document, q.query,
+        'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=3, MaxWords=20, MinWords=5'
+    )::text AS headline,
+    h.rank
+FROM hits h, q
+ORDER BY h.rank DESC, h.kind, h.external_id
+LIMIT sqlc.arg(page_limit);
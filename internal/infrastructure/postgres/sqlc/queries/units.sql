@@ -0,0 +1,48 @@
+-- Предпочтительные единицы измерения площадок
+
+-- name: ListSiteUnits :many
+SELECT su.quantity_kind, su.unit_code
+FROM site_units su
+JOIN equipment e ON e.id = su.site_id
+WHERE e.external_id = @site_id
+ORDER BY su.quantity_kind;
+
+-- name: DeleteSiteUnits :exec
+DELETE FROM site_units su
+USING equipment e
+WHERE e.id = su.site_id
+  AND e.external_id = @site_id;
+
+-- name: CreateSiteUnit :exec
+INSERT INTO site_units (site_id, quantity_kind, unit_code)
+SELECT e.id, @quantity_kind, @unit_code
+FROM equipment e
+WHERE e.external_id = @site_id
+  AND e.deleted_at IS NULL;
+
+-- name: ListEquipmentSiteUnits :many
+-- Единицы ближайшей площадки среди оборудования и его предков.
+-- Площадка без единиц возвращается одной строкой с NULL вместо единицы
+WITH RECURSIVE chain AS (
+    SELECT node.id AS node_id, node.parent_equipment_id AS next_id, node.equipment_level AS node_level, 0 AS depth
+    FROM equipment node
+    WHERE node.external_id = @external_id
+      AND node.deleted_at IS NULL
+    UNION ALL
+    SELECT parent.id, parent.parent_equipment_id, parent.equipment_level, c.depth + 1
+    FROM equipment parent
+    JOIN chain c ON parent.id = c.next_id
+    WHERE c.depth < 1000
+),
+site AS (
+    SELECT node_id
+    FROM chain
+    WHERE replace(trim(node_level), ' ', '') = 'Site'
+    ORDER BY depth
+    LIMIT 1
+)
+SELECT s.external_id AS site_external_id, su.quantity_kind, su.unit_code
+FROM site
+JOIN equipment s ON s.id = site.node_id
+LEFT JOIN site_units su ON su.site_id = s.id
+ORDER BY su.quantity_kind;
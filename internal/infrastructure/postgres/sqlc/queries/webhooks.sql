@@ -0,0 +1,102 @@
+-- Подписки на доменные события и доставки webhook
+
+-- name: CreateWebhookSubscription :exec
+INSERT INTO webhook_subscriptions (id, url, secret, event_types, description, active, created_at, updated_at)
+VALUES (@id, @url, @secret, @event_types, @description, @active, @created_at, @updated_at);
+
+-- name: GetWebhookSubscription :one
+SELECT * FROM webhook_subscriptions
+WHERE id = @id;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+ORDER BY created_at, id;
+
+-- name: ListActiveWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+WHERE active
+ORDER BY created_at, id;
+
+-- name: UpdateWebhookSubscription :execrows
+UPDATE webhook_subscriptions
+SET url = @url,
+    event_types = @event_types,
+    description = @description,
+    active = @active,
+    updated_at = @updated_at
+WHERE id = @id;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = @id;
+
+-- name: CreateWebhookDelivery :exec
+-- Повторная постановка того же события (повтор доставки из outbox) игнорируется
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+VALUES (@subscription_id, @event_id, @event_type, @payload)
+ON CONFLICT (subscription_id, event_id) DO NOTHING;
+
+-- name: ClaimWebhookDeliveries :many
+-- Захватывает готовые к отправке доставки активных подписок на время аренды
+UPDATE webhook_deliveries d
+SET locked_until = @locked_until::timestamptz
+WHERE d.id IN (
+    SELECT c.id
+    FROM webhook_deliveries c
+    JOIN webhook_subscriptions s ON s.id = c.subscription_id
+    WHERE c.status = 'pending'
+      AND s.active
+      AND c.next_attempt_at <= @now::timestamptz
+      AND (c.locked_until IS NULL OR c.locked_until <= @now::timestamptz)
+    ORDER BY c.next_attempt_at, c.created_at
+    LIMIT @batch_size
+    FOR UPDATE OF c SKIP LOCKED
+)
+RETURNING d.*;
+
+-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE webhook_deliveries
+SET status = 'delivered',
+    attempts = attempts + 1,
+    response_status = @response_status,
+    last_error = NULL,
+    locked_until = NULL,
+    delivered_at = NOW()
+WHERE id = @id;
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET status = @status,
+    attempts = attempts + 1,
+    response_status = @response_status,
+    last_error = @last_error,
+    next_attempt_at = @next_attempt_at,
+    locked_until = NULL
+WHERE id = @id;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = @id;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = @subscription_id
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text)
+ORDER BY created_at DESC, id
+LIMIT @page_limit;
+
+-- name: ReplayWebhookDelivery :execrows
+-- Возвращает доставку из dead letter в очередь с обнулённым счётчиком попыток
+UPDATE webhook_deliveries
+SET status = 'pending',
+    attempts = 0,
+    next_attempt_at = NOW(),
+    locked_until = NULL,
+    last_error = NULL
+WHERE id = @id
+  AND status = 'dead';
+
+-- name: DeleteDeliveredWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries
+WHERE status = 'delivered'
+  AND delivered_at < @before::timestamptz;